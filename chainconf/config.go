@@ -1,14 +1,20 @@
 package conf
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/parser"
 	"github.com/imdario/mergo"
+	"github.com/trino-network/trino/pkg/pluginregistry"
+	"github.com/trino-network/trino/pkg/scaffoldhook"
+	"github.com/trino-network/trino/pkg/servehook"
 )
 
 var (
@@ -28,6 +34,15 @@ var (
 			GRPC:    "0.0.0.0:9090",
 			GRPCWeb: "0.0.0.0:9091",
 			API:     "0.0.0.0:1317",
+
+			APIConsole: "0.0.0.0:1319",
+			Docs:       "0.0.0.0:1320",
+			Explorer:   "0.0.0.0:1321",
+
+			// the node accepts any signer that connects, so it only listens on localhost.
+			Signer: "127.0.0.1:26659",
+
+			Prometheus: "0.0.0.0:26660",
 		},
 		Build: Build{
 			Proto: Proto{
@@ -55,6 +70,10 @@ type Config struct {
 	Init      Init                   `yaml:"init"`
 	Genesis   map[string]interface{} `yaml:"genesis"`
 	Host      Host                   `yaml:"host"`
+	Networks  map[string]Network     `yaml:"networks"`
+	Plugins   []Plugin               `yaml:"plugins"`
+	Scaffold  Scaffold               `yaml:"scaffold"`
+	Serve     Serve                  `yaml:"serve"`
 }
 
 // AccountByName finds account by name.
@@ -67,6 +86,12 @@ func (c Config) AccountByName(name string) (acc Account, found bool) {
 	return Account{}, false
 }
 
+// NetworkByName finds network presets by name.
+func (c Config) NetworkByName(name string) (network Network, found bool) {
+	network, found = c.Networks[name]
+	return network, found
+}
+
 // Account holds the options related to setting up Cosmos wallets.
 type Account struct {
 	Name     string   `yaml:"name"`
@@ -82,6 +107,10 @@ type Account struct {
 type Validator struct {
 	Name   string `yaml:"name"`
 	Staked string `yaml:"staked"`
+
+	// RemoteSigner moves the consensus key of the validator from the node into the keyring of a
+	// remote signer, which signs for the node over the privval socket at host.signer.
+	RemoteSigner bool `yaml:"remote_signer"`
 }
 
 // Build holds build configs.
@@ -99,18 +128,85 @@ type Proto struct {
 	// ThirdPartyPath is the relative path of where the third party proto files are
 	// located that used by the app.
 	ThirdPartyPaths []string `yaml:"third_party_paths"`
+
+	// Buf enables resolving the third party proto files from the dependencies of
+	// the buf config in the proto dir.
+	Buf bool `yaml:"buf"`
+
+	// Plugins overrides the pinned versions of protoc plugins, keyed by plugin names.
+	Plugins map[string]string `yaml:"plugins"`
 }
 
 // Client configures code generation for clients.
 type Client struct {
+	// Vue configures code generation for the stores of the Vue app, Vuex or Pinia stores.
+	Vue Vue `yaml:"vue"`
+
 	// Vuex configures code generation for Vuex.
 	Vuex Vuex `yaml:"vuex"`
 
+	// TypeScript configures code generation for the standalone TypeScript client.
+	TypeScript TypeScript `yaml:"typescript"`
+
+	// Composables configures code generation for Vue 3 composables.
+	Composables Composables `yaml:"composables"`
+
+	// Hooks configures code generation for React hooks.
+	Hooks Hooks `yaml:"hooks"`
+
+	// Pinia configures code generation for Pinia stores.
+	Pinia Pinia `yaml:"pinia"`
+
+	// Go configures code generation for the standalone Go client.
+	Go Go `yaml:"go"`
+
 	// Dart configures client code generation for Dart.
 	Dart Dart `yaml:"dart"`
 
+	// Kotlin configures client code generation for Android apps.
+	Kotlin Kotlin `yaml:"kotlin"`
+
+	// Swift configures client code generation for iOS apps.
+	Swift Swift `yaml:"swift"`
+
 	// OpenAPI configures OpenAPI spec generation for API.
 	OpenAPI OpenAPI `yaml:"openapi"`
+
+	// Docs configures generation of the reference docs site for proto files.
+	Docs Docs `yaml:"docs"`
+}
+
+const (
+	// VueStoreVuex is the store of Vue apps that generates Vuex stores, for Vue 2 apps.
+	VueStoreVuex = "vuex"
+
+	// VueStorePinia is the store of Vue apps that generates Pinia stores, for Vue 3 apps.
+	VueStorePinia = "pinia"
+)
+
+// Vue configures code generation for the stores of the Vue app.
+type Vue struct {
+	// Path configures out location for the generated stores.
+	Path string `yaml:"path"`
+
+	// Store is the flavor of the generated stores, vuex or pinia. it's vuex by default.
+	Store string `yaml:"store"`
+}
+
+// VuexPath returns the out location of the Vuex stores, from client.vue when its store is Vuex.
+func (c Client) VuexPath() string {
+	if c.Vue.Path != "" && c.Vue.Store != VueStorePinia {
+		return c.Vue.Path
+	}
+	return c.Vuex.Path
+}
+
+// PiniaPath returns the out location of the Pinia stores, from client.vue when its store is Pinia.
+func (c Client) PiniaPath() string {
+	if c.Vue.Path != "" && c.Vue.Store == VueStorePinia {
+		return c.Vue.Path
+	}
+	return c.Pinia.Path
 }
 
 // Vuex configures code generation for Vuex.
@@ -119,17 +215,68 @@ type Vuex struct {
 	Path string `yaml:"path"`
 }
 
+// TypeScript configures code generation for the standalone TypeScript client.
+type TypeScript struct {
+	// Path configures out location for generated TypeScript client package.
+	Path string `yaml:"path"`
+
+	// GRPCWeb enables generating gRPC-web query clients that use the gRPC-web endpoint of the chain.
+	GRPCWeb bool `yaml:"grpc-web"`
+}
+
+// Pinia configures code generation for Pinia stores.
+type Pinia struct {
+	// Path configures out location for generated Pinia stores.
+	Path string `yaml:"path"`
+}
+
+// Composables configures code generation for Vue 3 composables.
+type Composables struct {
+	// Path configures out location for generated Vue 3 composables.
+	Path string `yaml:"path"`
+}
+
+// Hooks configures code generation for React hooks.
+type Hooks struct {
+	// Path configures out location for generated React hooks.
+	Path string `yaml:"path"`
+}
+
+// Go configures code generation for the standalone Go client.
+type Go struct {
+	// Path configures out location for generated Go client module.
+	Path string `yaml:"path"`
+}
+
 // Dart configures client code generation for Dart.
 type Dart struct {
 	// Path configures out location for generated Dart code.
 	Path string `yaml:"path"`
 }
 
+// Kotlin configures client code generation for Android apps.
+type Kotlin struct {
+	// Path configures out location for generated Kotlin client library.
+	Path string `yaml:"path"`
+}
+
+// Swift configures client code generation for iOS apps.
+type Swift struct {
+	// Path configures out location for generated Swift client package.
+	Path string `yaml:"path"`
+}
+
 // OpenAPI configures OpenAPI spec generation for API.
 type OpenAPI struct {
 	Path string `yaml:"path"`
 }
 
+// Docs configures generation of the reference docs site for proto files.
+type Docs struct {
+	// Path configures out location for the generated docs site.
+	Path string `yaml:"path"`
+}
+
 // Faucet configuration.
 type Faucet struct {
 	// Name is faucet account's name.
@@ -150,6 +297,21 @@ type Faucet struct {
 
 	// Port number for faucet server to listen at.
 	Port int `yaml:"port"`
+
+	// FeeGrant enables issuing x/feegrant allowances to requesters.
+	FeeGrant *FeeGrant `yaml:"fee_grant"`
+}
+
+// FeeGrant configures the fee allowances issued by the faucet.
+type FeeGrant struct {
+	// SpendLimit holds the coin denoms and the maximum amounts that a requester can spend on fees.
+	SpendLimit []string `yaml:"spend_limit"`
+
+	// Expiration is the duration after which an allowance expires.
+	Expiration string `yaml:"expiration"`
+
+	// Only disables coin transfers so the faucet only issues fee allowances.
+	Only bool `yaml:"only"`
 }
 
 // Init overwrites sdk configurations with given values.
@@ -178,6 +340,234 @@ type Host struct {
 	GRPC    string `yaml:"grpc"`
 	GRPCWeb string `yaml:"grpc-web"`
 	API     string `yaml:"api"`
+
+	// APIConsole is the address of the Swagger UI console served for the OpenAPI spec.
+	APIConsole string `yaml:"api-console"`
+
+	// Docs is the address of the reference docs site served for proto files.
+	Docs string `yaml:"docs"`
+
+	// Explorer is the address of the explorer of the blocks and txs of the served chain.
+	Explorer string `yaml:"explorer"`
+
+	// Signer is the address that the node listens on for the remote signer of the validator.
+	Signer string `yaml:"signer"`
+
+	// Prometheus is the address of the Prometheus metrics of the node when it's served with metrics.
+	Prometheus string `yaml:"prometheus"`
+}
+
+// Network holds presets of a named environment such as localnet, testnet or mainnet.
+type Network struct {
+	// ChainID is the chain id used in the environment.
+	ChainID string `yaml:"chain_id"`
+
+	// Denom is the default denom used for fees and faucet transfers.
+	Denom string `yaml:"denom"`
+
+	// AddressPrefix is the bech32 prefix of account addresses.
+	AddressPrefix string `yaml:"address_prefix"`
+
+	// GasPrice is the default gas price used for transactions.
+	GasPrice string `yaml:"gas_price"`
+
+	// RPC is the address of the Tendermint RPC endpoint.
+	RPC string `yaml:"rpc"`
+
+	// API is the address of the Cosmos SDK REST API endpoint.
+	API string `yaml:"api"`
+
+	// GRPC is the address of the gRPC endpoint.
+	GRPC string `yaml:"grpc"`
+
+	// Faucet is the address of the faucet server.
+	Faucet string `yaml:"faucet"`
+}
+
+// Plugin is an external plugin that adds commands to Starport, it's either at Path or it's
+// installed from a registry at Version.
+type Plugin struct {
+	// Name is the name of the command that the commands of the plugin are added under, and the
+	// name of plugins in registries.
+	Name string `yaml:"name"`
+
+	// Path is the path of the executable of the plugin or of the dir of its Go main package,
+	// that's built by Starport. relative paths are relative to the config file.
+	Path string `yaml:"path,omitempty"`
+
+	// Version is the version of the plugin in the registry that it's installed from.
+	Version string `yaml:"version,omitempty"`
+
+	// Commit is the SHA of the commit of the version of the plugin, the fetched source of the
+	// plugin is verified to be at this commit.
+	Commit string `yaml:"commit,omitempty"`
+
+	// Registry is the git repository of the registry that the plugin is installed from, the
+	// default registry when it's empty.
+	Registry string `yaml:"registry,omitempty"`
+
+	// With holds the params that are passed to the plugin.
+	With map[string]string `yaml:"with,omitempty"`
+
+	// Hooks are the scaffold events that the plugin is notified of.
+	Hooks []scaffoldhook.EventType `yaml:"hooks,omitempty"`
+
+	// ServeHooks are the serve events that the plugin is notified of.
+	ServeHooks []servehook.EventType `yaml:"serve_hooks,omitempty"`
+}
+
+// Scaffold configures scaffolding.
+type Scaffold struct {
+	// Hooks are the shell commands that are run on scaffold events.
+	Hooks []ScaffoldHook `yaml:"hooks"`
+}
+
+// ScaffoldHook is a shell command that's run in the app's dir on the scaffold events in On, or
+// on all events when On is empty.
+type ScaffoldHook struct {
+	Name string                   `yaml:"name"`
+	On   []scaffoldhook.EventType `yaml:"on"`
+	Run  string                   `yaml:"run"`
+}
+
+// Serve configures serving chains in development.
+type Serve struct {
+	// Hooks are notified of the serve events.
+	Hooks []ServeHook `yaml:"hooks"`
+}
+
+// ServeHook is notified of the serve events in On, or of all events when On is empty. the event
+// is either posted to the webhook at URL or passed to the shell command Run in the app's dir.
+type ServeHook struct {
+	Name string                `yaml:"name"`
+	On   []servehook.EventType `yaml:"on"`
+
+	// URL is the url of the webhook, env vars in it and in its headers are expanded.
+	URL     string            `yaml:"url"`
+	Headers map[string]string `yaml:"headers"`
+
+	Run string `yaml:"run"`
+}
+
+// ValidatePlugins validates the plugins of a config.
+func ValidatePlugins(plugins []Plugin) error {
+	names := make(map[string]bool)
+	for i, plugin := range plugins {
+		if plugin.Name == "" {
+			return &ValidationError{fmt.Sprintf("name is required for plugin #%d", i+1)}
+		}
+		if !pluginregistry.IsValidRefName(plugin.Name) {
+			return &ValidationError{fmt.Sprintf("name %q of plugin #%d is invalid", plugin.Name, i+1)}
+		}
+		if plugin.Version != "" && !pluginregistry.IsValidRefName(plugin.Version) {
+			return &ValidationError{fmt.Sprintf("version %q of plugin %q is invalid", plugin.Version, plugin.Name)}
+		}
+		if (plugin.Path == "") == (plugin.Version == "") {
+			return &ValidationError{fmt.Sprintf("either path or version is required for plugin %q", plugin.Name)}
+		}
+		if plugin.Commit != "" && plugin.Version == "" {
+			return &ValidationError{fmt.Sprintf("commit is only pinned with the version of plugin %q", plugin.Name)}
+		}
+		if names[plugin.Name] {
+			return &ValidationError{fmt.Sprintf("plugin %q is declared more than once", plugin.Name)}
+		}
+		names[plugin.Name] = true
+
+		for _, eventType := range plugin.Hooks {
+			if !scaffoldhook.IsEventType(eventType) {
+				return &ValidationError{fmt.Sprintf("plugin %q has an unknown hook %q, hooks are: %v", plugin.Name, eventType, scaffoldhook.EventTypes)}
+			}
+		}
+		for _, eventType := range plugin.ServeHooks {
+			if !servehook.IsEventType(eventType) {
+				return &ValidationError{fmt.Sprintf("plugin %q has an unknown serve hook %q, serve hooks are: %v", plugin.Name, eventType, servehook.EventTypes)}
+			}
+		}
+	}
+	return nil
+}
+
+// SetPlugins sets the plugins of the config file at path, the rest of the file is kept as it is.
+// the file is created when it doesn't exist.
+func SetPlugins(path string, plugins []Plugin) error {
+	if err := ValidatePlugins(plugins); err != nil {
+		return err
+	}
+	if plugins == nil {
+		plugins = []Plugin{}
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	file, err := parser.ParseBytes(b, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	pluginsPath, err := yaml.PathString("$.plugins")
+	if err != nil {
+		return err
+	}
+
+	var content string
+	if _, err := pluginsPath.FilterFile(file); err == nil {
+		value, err := yaml.Marshal(plugins)
+		if err != nil {
+			return err
+		}
+		if err := pluginsPath.ReplaceWithReader(file, bytes.NewReader(value)); err != nil {
+			return err
+		}
+		content = file.String() + "\n"
+	} else {
+		value, err := yaml.Marshal(map[string][]Plugin{"plugins": plugins})
+		if err != nil {
+			return err
+		}
+		content = string(b)
+		if content != "" && !strings.HasSuffix(content, "\n") {
+			content += "\n"
+		}
+		content += string(value)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(content), 0644)
+}
+
+// ValidateScaffoldHooks validates the scaffold hooks of a config.
+func ValidateScaffoldHooks(hooks []ScaffoldHook) error {
+	for i, hook := range hooks {
+		if hook.Run == "" {
+			return &ValidationError{fmt.Sprintf("scaffold hook #%d has no command to run", i+1)}
+		}
+		for _, eventType := range hook.On {
+			if !scaffoldhook.IsEventType(eventType) {
+				return &ValidationError{fmt.Sprintf("scaffold hook #%d has an unknown event %q, events are: %v", i+1, eventType, scaffoldhook.EventTypes)}
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateServeHooks validates the serve hooks of a config.
+func ValidateServeHooks(hooks []ServeHook) error {
+	for i, hook := range hooks {
+		if (hook.URL == "") == (hook.Run == "") {
+			return &ValidationError{fmt.Sprintf("either url or run is required for serve hook #%d", i+1)}
+		}
+		for _, eventType := range hook.On {
+			if !servehook.IsEventType(eventType) {
+				return &ValidationError{fmt.Sprintf("serve hook #%d has an unknown event %q, events are: %v", i+1, eventType, servehook.EventTypes)}
+			}
+		}
+	}
+	return nil
 }
 
 // Parse parses config.yml into UserConfig.
@@ -210,7 +600,23 @@ func validate(conf Config) error {
 	if conf.Validator.Name == "" {
 		return &ValidationError{"validator is required"}
 	}
-	return nil
+	switch conf.Client.Vue.Store {
+	case "", VueStoreVuex, VueStorePinia:
+	default:
+		return &ValidationError{fmt.Sprintf("unknown store %q of client.vue, stores are: %s, %s", conf.Client.Vue.Store, VueStoreVuex, VueStorePinia)}
+	}
+	for name, network := range conf.Networks {
+		if network.ChainID == "" {
+			return &ValidationError{fmt.Sprintf("chain_id is required for network %q", name)}
+		}
+	}
+	if err := ValidatePlugins(conf.Plugins); err != nil {
+		return err
+	}
+	if err := ValidateScaffoldHooks(conf.Scaffold.Hooks); err != nil {
+		return err
+	}
+	return ValidateServeHooks(conf.Serve.Hooks)
 }
 
 // ValidationError is returned when a configuration is invalid.
@@ -235,6 +641,28 @@ func LocateDefault(root string) (path string, err error) {
 	return "", ErrCouldntLocateConfig
 }
 
+// LocateDir locates the dir of the nearest chain by its config file, in dir or in its parents.
+// if no config file is found returns ErrCouldntLocateConfig.
+func LocateDir(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := LocateDefault(dir); err == nil {
+			return dir, nil
+		} else if !errors.Is(err, ErrCouldntLocateConfig) {
+			return "", err
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", ErrCouldntLocateConfig
+		}
+		dir = parent
+	}
+}
+
 // FaucetHost returns the faucet host to use
 func FaucetHost(conf Config) string {
 	// We keep supporting Port option for backward compatibility
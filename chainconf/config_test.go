@@ -1,10 +1,15 @@
 package conf
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/trino-network/trino/pkg/scaffoldhook"
+	"github.com/trino-network/trino/pkg/servehook"
 )
 
 func TestParse(t *testing.T) {
@@ -101,3 +106,270 @@ faucet:
 	require.NoError(t, err)
 	require.Equal(t, ":4700", FaucetHost(conf))
 }
+
+func TestParseNetworks(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+    coins: ["1000token", "100000000stake"]
+validator:
+  name: me
+  staked: "100000000stake"
+networks:
+  testnet:
+    chain_id: mars-testnet-1
+    denom: stake
+    rpc: https://rpc.testnet.mars.network:443
+    faucet: https://faucet.testnet.mars.network
+`
+
+	conf, err := Parse(strings.NewReader(confyml))
+	require.NoError(t, err)
+
+	network, found := conf.NetworkByName("testnet")
+	require.True(t, found)
+	require.Equal(t, Network{
+		ChainID: "mars-testnet-1",
+		Denom:   "stake",
+		RPC:     "https://rpc.testnet.mars.network:443",
+		Faucet:  "https://faucet.testnet.mars.network",
+	}, network)
+
+	_, found = conf.NetworkByName("mainnet")
+	require.False(t, found)
+}
+
+func TestParseNetworksInvalid(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+    coins: ["1000token", "100000000stake"]
+validator:
+  name: me
+  staked: "100000000stake"
+networks:
+  testnet:
+    denom: stake
+`
+
+	_, err := Parse(strings.NewReader(confyml))
+	require.Equal(t, &ValidationError{`chain_id is required for network "testnet"`}, err)
+}
+
+func TestParseFaucetFeeGrant(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+    coins: ["1000token", "100000000stake"]
+validator:
+  name: me
+  staked: "100000000stake"
+faucet:
+  name: me
+  fee_grant:
+    spend_limit: ["100000stake"]
+    expiration: "72h"
+    only: true
+`
+
+	conf, err := Parse(strings.NewReader(confyml))
+	require.NoError(t, err)
+	require.Equal(t, &FeeGrant{
+		SpendLimit: []string{"100000stake"},
+		Expiration: "72h",
+		Only:       true,
+	}, conf.Faucet.FeeGrant)
+}
+
+func TestParseVue(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+    coins: ["1000token", "100000000stake"]
+validator:
+  name: me
+  staked: "100000000stake"
+client:
+  vue:
+    path: "vue/src/stores"
+    store: %s
+`
+
+	conf, err := Parse(strings.NewReader(fmt.Sprintf(confyml, "pinia")))
+	require.NoError(t, err)
+	require.Equal(t, "vue/src/stores", conf.Client.PiniaPath())
+	require.Empty(t, conf.Client.VuexPath())
+
+	conf, err = Parse(strings.NewReader(fmt.Sprintf(confyml, `""`)))
+	require.NoError(t, err)
+	require.Equal(t, "vue/src/stores", conf.Client.VuexPath())
+	require.Empty(t, conf.Client.PiniaPath())
+
+	_, err = Parse(strings.NewReader(fmt.Sprintf(confyml, "redux")))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `unknown store "redux" of client.vue`)
+}
+
+func TestParsePlugins(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+    coins: ["1000token", "100000000stake"]
+validator:
+  name: me
+  staked: "100000000stake"
+plugins:
+  - name: mycompany
+    path: ./plugins/mycompany
+    with:
+      region: eu
+`
+
+	conf, err := Parse(strings.NewReader(confyml))
+	require.NoError(t, err)
+	require.Equal(t, []Plugin{{
+		Name: "mycompany",
+		Path: "./plugins/mycompany",
+		With: map[string]string{"region": "eu"},
+	}}, conf.Plugins)
+
+	_, err = Parse(strings.NewReader(confyml + "  - name: mycompany\n    path: mycompany\n"))
+	require.Equal(t, &ValidationError{`plugin "mycompany" is declared more than once`}, err)
+
+	_, err = Parse(strings.NewReader(confyml + "  - name: ../mycompany\n    path: mycompany\n"))
+	require.Equal(t, &ValidationError{`name "../mycompany" of plugin #2 is invalid`}, err)
+	_, err = Parse(strings.NewReader(confyml + "  - name: deployer\n    version: ../../v0.1.0\n"))
+	require.Equal(t, &ValidationError{`version "../../v0.1.0" of plugin "deployer" is invalid`}, err)
+}
+
+func TestParseScaffoldHooks(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+    coins: ["1000token", "100000000stake"]
+validator:
+  name: me
+  staked: "100000000stake"
+scaffold:
+  hooks:
+    - name: license
+      on: [post-module, post-type]
+      run: ./scripts/license.sh
+`
+
+	conf, err := Parse(strings.NewReader(confyml))
+	require.NoError(t, err)
+	require.Equal(t, []ScaffoldHook{{
+		Name: "license",
+		On:   []scaffoldhook.EventType{"post-module", "post-type"},
+		Run:  "./scripts/license.sh",
+	}}, conf.Scaffold.Hooks)
+
+	_, err = Parse(strings.NewReader(strings.Replace(confyml, "post-type", "post-chain", 1)))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `unknown event "post-chain"`)
+}
+
+func TestParseServeHooks(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+    coins: ["1000token", "100000000stake"]
+validator:
+  name: me
+  staked: "100000000stake"
+serve:
+  hooks:
+    - name: slack
+      on: [chain-started]
+      url: ${SLACK_WEBHOOK_URL}
+    - run: ./scripts/refresh.sh
+`
+
+	conf, err := Parse(strings.NewReader(confyml))
+	require.NoError(t, err)
+	require.Equal(t, []ServeHook{
+		{
+			Name: "slack",
+			On:   []servehook.EventType{servehook.EventChainStarted},
+			URL:  "${SLACK_WEBHOOK_URL}",
+		},
+		{Run: "./scripts/refresh.sh"},
+	}, conf.Serve.Hooks)
+
+	_, err = Parse(strings.NewReader(strings.Replace(confyml, "chain-started", "chain-stopped", 1)))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `unknown event "chain-stopped"`)
+
+	_, err = Parse(strings.NewReader(strings.Replace(confyml, "refresh.sh", "refresh.sh\n      url: https://example.com", 1)))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "either url or run is required for serve hook #2")
+}
+
+func TestSetPlugins(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yml")
+	confyml := `# accounts of the chain
+accounts:
+  - name: me # the validator
+    coins: ["1000token", "100000000stake"]
+plugins:
+  - name: local
+    path: ./plugins/local
+validator:
+  name: me
+  staked: "100000000stake"
+`
+	require.NoError(t, os.WriteFile(path, []byte(confyml), 0644))
+
+	plugins := []Plugin{
+		{Name: "local", Path: "./plugins/local"},
+		{Name: "deployer", Version: "v0.2.0"},
+	}
+	require.NoError(t, SetPlugins(path, plugins))
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(b), "# accounts of the chain")
+	require.Contains(t, string(b), "- name: me # the validator")
+
+	conf, err := ParseFile(path)
+	require.NoError(t, err)
+	require.Equal(t, plugins, conf.Plugins)
+	require.Equal(t, "me", conf.Validator.Name)
+
+	require.NoError(t, SetPlugins(path, nil))
+	conf, err = ParseFile(path)
+	require.NoError(t, err)
+	require.Empty(t, conf.Plugins)
+
+	// the plugins are added to files without plugins and to new files.
+	path = filepath.Join(t.TempDir(), "plugins.yml")
+	require.NoError(t, SetPlugins(path, plugins[1:]))
+	require.NoError(t, os.WriteFile(path, []byte("# global plugins"), 0644))
+	require.NoError(t, SetPlugins(path, plugins[1:]))
+
+	b, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "# global plugins\nplugins:\n- name: deployer\n  version: v0.2.0\n", string(b))
+
+	require.Error(t, SetPlugins(path, []Plugin{{Name: "deployer"}}))
+}
+
+func TestLocateDir(t *testing.T) {
+	root := t.TempDir()
+	chainDir := filepath.Join(root, "chains", "mars")
+	moduleDir := filepath.Join(chainDir, "x", "mars", "keeper")
+	require.NoError(t, os.MkdirAll(moduleDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(chainDir, "config.yml"), nil, 0644))
+
+	dir, err := LocateDir(moduleDir)
+	require.NoError(t, err)
+	require.Equal(t, chainDir, dir)
+
+	dir, err = LocateDir(chainDir)
+	require.NoError(t, err)
+	require.Equal(t, chainDir, dir)
+
+	_, err = LocateDir(filepath.Join(root, "chains"))
+	require.ErrorIs(t, err, ErrCouldntLocateConfig)
+}
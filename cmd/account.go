@@ -5,10 +5,14 @@ import (
 	"os"
 	"text/tabwriter"
 
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
-	"github.com/tendermint/starport/starport/pkg/cliquiz"
-	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	starportaccount "github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/pkg/cosmosclient"
+	"github.com/trino-network/trino/pkg/cliquiz"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
 )
 
 const (
@@ -16,6 +20,20 @@ const (
 	flagPassphrase     = "passphrase"
 	flagNonInteractive = "non-interactive"
 	flagKeyringBackend = "keyring-backend"
+	flagKeyFormat      = "format"
+
+	flagLedger = "ledger"
+
+	flagCoinType     = "coin-type"
+	flagAccountIndex = "account-index"
+	flagAddressIndex = "address-index"
+
+	flagMnemonicLength  = "mnemonic-length"
+	flagBIP39Passphrase = "bip39-passphrase"
+
+	flagNode = "node"
+
+	flagChain = "chain"
 )
 
 func NewAccount() *cobra.Command {
@@ -23,27 +41,81 @@ func NewAccount() *cobra.Command {
 		Use:   "account [command]",
 		Short: "Commands for managing accounts",
 		Long: `Commands for managing accounts. An account is a pair of a private key and a public key.
-Starport uses accounts to interact with the Starport Network blockchain, use an IBC relayer, and more.`,
+Starport uses accounts to interact with the Starport Network blockchain, use an IBC relayer, and more.
+
+Hooks can be configured in ~/.starport/accounts/hooks.yml to run shell commands after
+accounts are created, imported or deleted, such as to fund new accounts from a faucet:
+
+  hooks:
+    - name: faucet
+      on: [created, imported]
+      address_prefix: cosmos
+      run: curl -X POST -d "{\"address\": \"$ACCOUNT_ADDRESS\"}" http://localhost:4500
+
+The event is passed to commands with the ACCOUNT_EVENT, ACCOUNT_NAME, ACCOUNT_CHAIN,
+ACCOUNT_ADDRESS and ACCOUNT_PUBKEY env vars. Use --no-hooks to skip them.`,
 		Aliases: []string{"a"},
 		Args:    cobra.ExactArgs(1),
 	}
 
+	c.PersistentFlags().AddFlagSet(flagSetAccountNamespace())
+
 	c.AddCommand(NewAccountCreate())
 	c.AddCommand(NewAccountDelete())
 	c.AddCommand(NewAccountShow())
 	c.AddCommand(NewAccountList())
 	c.AddCommand(NewAccountImport())
 	c.AddCommand(NewAccountExport())
+	c.AddCommand(NewAccountMultisig())
+	c.AddCommand(NewAccountBalance())
+	c.AddCommand(NewAccountSend())
+	c.AddCommand(NewAccountConvert())
+	c.AddCommand(NewAccountWatch())
+	c.AddCommand(NewAccountMigrate())
+	c.AddCommand(NewAccountBackup())
+	c.AddCommand(NewAccountRestore())
 
 	return c
 }
 
-func printAccounts(cmd *cobra.Command, accounts ...cosmosaccount.Account) {
+// accountResult is an account in the json and yaml outputs.
+type accountResult struct {
+	Name      string `json:"name"`
+	Address   string `json:"address"`
+	PublicKey string `json:"public_key"`
+}
+
+func newAccountResult(cmd *cobra.Command, acc cosmosaccount.Account) accountResult {
+	return accountResult{
+		Name:      acc.Name,
+		Address:   acc.Address(getAddressPrefix(cmd)),
+		PublicKey: acc.PubKey(),
+	}
+}
+
+// printAccount prints acc, it's printed as an object in the json and yaml outputs.
+func printAccount(cmd *cobra.Command, acc cosmosaccount.Account) error {
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, newAccountResult(cmd, acc))
+	}
+	return printAccounts(cmd, acc)
+}
+
+// printAccounts prints accounts, they are printed as a list in the json and yaml outputs.
+func printAccounts(cmd *cobra.Command, accounts ...cosmosaccount.Account) error {
+	if isStructuredOutput(cmd) {
+		results := []accountResult{}
+		for _, acc := range accounts {
+			results = append(results, newAccountResult(cmd, acc))
+		}
+		return printResult(cmd, results)
+	}
+
 	w := &tabwriter.Writer{}
 	w.Init(os.Stdout, 0, 8, 0, '\t', 0)
 
 	if len(accounts) == 0 {
-		return
+		return nil
 	}
 
 	fmt.Fprintln(w, "name\taddress\tpublic key")
@@ -57,12 +129,12 @@ func printAccounts(cmd *cobra.Command, accounts ...cosmosaccount.Account) {
 	}
 
 	fmt.Fprintln(w)
-	w.Flush()
+	return w.Flush()
 }
 
 func flagSetKeyringBackend() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
-	fs.String(flagKeyringBackend, "test", "Keyring backend to store your account keys")
+	fs.String(flagKeyringBackend, "test", "Keyring backend to store your account keys (test|os|file|pass|kwallet|keychain)")
 	return fs
 }
 
@@ -71,6 +143,64 @@ func getKeyringBackend(cmd *cobra.Command) cosmosaccount.KeyringBackend {
 	return cosmosaccount.KeyringBackend(backend)
 }
 
+// askKeyringBackend asks to pick one of the keyring backends, defaultBackend is picked by default.
+func askKeyringBackend(defaultBackend cosmosaccount.KeyringBackend) (cosmosaccount.KeyringBackend, error) {
+	var backends []string
+	for _, backend := range cosmosaccount.KeyringBackends {
+		backends = append(backends, string(backend))
+	}
+
+	var backend string
+	err := cliquiz.Ask(cliquiz.NewQuestion(
+		"Keyring Backend",
+		&backend,
+		cliquiz.DefaultAnswer(defaultBackend),
+		cliquiz.Select(backends...),
+		cliquiz.Required(),
+	))
+	return cosmosaccount.KeyringBackend(backend), err
+}
+
+func flagSetAccountNamespace() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagChain, "", "Chain ID to scope the accounts to, the same names can be used by the accounts of different chains")
+	return fs
+}
+
+// getAccountRegistryOptions returns the registry options for the keyring backend and the chain
+// namespace set with flags.
+func getAccountRegistryOptions(cmd *cobra.Command) []cosmosaccount.Option {
+	chainID, _ := cmd.Flags().GetString(flagChain)
+
+	return []cosmosaccount.Option{
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+		cosmosaccount.WithNamespace(chainID),
+	}
+}
+
+func flagSetHDPath() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Uint32(flagCoinType, sdktypes.CoinType, "Coin type of the HD path to derive the key from, such as 529 for Secret Network. 60 of EVM chains is not supported")
+	fs.Uint32(flagAccountIndex, 0, "Account index of the HD path to derive the key from")
+	fs.Uint32(flagAddressIndex, 0, "Address index of the HD path to derive the key from")
+	return fs
+}
+
+// getHDPathOptions returns the registry options for the HD path set with flags.
+func getHDPathOptions(cmd *cobra.Command) []cosmosaccount.Option {
+	var (
+		coinType, _     = cmd.Flags().GetUint32(flagCoinType)
+		accountIndex, _ = cmd.Flags().GetUint32(flagAccountIndex)
+		addressIndex, _ = cmd.Flags().GetUint32(flagAddressIndex)
+	)
+
+	return []cosmosaccount.Option{
+		cosmosaccount.WithCoinType(coinType),
+		cosmosaccount.WithAccountIndex(accountIndex),
+		cosmosaccount.WithAddressIndex(addressIndex),
+	}
+}
+
 func flagSetAccountPrefixes() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.String(flagAddressPrefix, "cosmos", "Account address prefix")
@@ -82,6 +212,60 @@ func getAddressPrefix(cmd *cobra.Command) string {
 	return prefix
 }
 
+func flagSetNode() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagNode, "http://localhost:26657", "RPC address of a node of the chain")
+	return fs
+}
+
+func getNode(cmd *cobra.Command) string {
+	node, _ := cmd.Flags().GetString(flagNode)
+	return node
+}
+
+// newAccountClient creates a client for the chain's node set with flags, it uses the keyring of
+// Starport's accounts to sign transactions.
+func newAccountClient(cmd *cobra.Command) (cosmosclient.Client, error) {
+	backend, err := getKeyringBackend(cmd).SDKBackend()
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	return cosmosclient.New(
+		cmd.Context(),
+		cosmosclient.WithNodeAddress(getNode(cmd)),
+		cosmosclient.WithAddressPrefix(getAddressPrefix(cmd)),
+		cosmosclient.WithKeyringBackend(starportaccount.KeyringBackend(backend)),
+		cosmosclient.WithKeyringServiceName(sdktypes.KeyringServiceName()),
+		cosmosclient.WithHome(cosmosaccount.KeyringHome),
+	)
+}
+
+// resolveAccountAddress returns the address of the account with nameOrAddress, including
+// watch-only accounts, or nameOrAddress itself when it's an address with prefix.
+func resolveAccountAddress(cmd *cobra.Command, nameOrAddress string) (string, error) {
+	prefix := getAddressPrefix(cmd)
+
+	ca, err := cosmosaccount.New(getAccountRegistryOptions(cmd)...)
+	if err != nil {
+		return "", err
+	}
+
+	if acc, err := ca.GetByName(nameOrAddress); err == nil {
+		return acc.Address(prefix), nil
+	}
+
+	hrp, _, err := bech32.DecodeAndConvert(nameOrAddress)
+	if err != nil {
+		return "", fmt.Errorf("%q is neither an account nor an address", nameOrAddress)
+	}
+	if hrp != prefix {
+		return "", fmt.Errorf("address %s doesn't have the %q prefix, set it with --%s", nameOrAddress, prefix, flagAddressPrefix)
+	}
+
+	return nameOrAddress, nil
+}
+
 func flagSetAccountImportExport() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.Bool(flagNonInteractive, false, "Do not enter into interactive mode")
@@ -101,7 +285,7 @@ func getPassphrase(cmd *cobra.Command) (string, error) {
 		if err := cliquiz.Ask(
 			cliquiz.NewQuestion("Passphrase",
 				&pass,
-				cliquiz.HideAnswer(),
+				cliquiz.Hidden(),
 				cliquiz.GetConfirmation(),
 			)); err != nil {
 			return "", err
@@ -0,0 +1,63 @@
+package starportcmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+func NewAccountBackup() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "backup",
+		Short: "Back up all accounts into a single encrypted file",
+		Long: `Back up all accounts into a single encrypted file.
+
+Accounts of all chains are backed up with their private keys into a file that's encrypted
+with the passphrase, restore them on another machine with "starport account restore".
+Ledger accounts are skipped since their private keys never leave the device.`,
+		Args: cobra.NoArgs,
+		RunE: accountBackupHandler,
+	}
+
+	addOutputPathFlag(c, flagOutputDocument, "keys.enc", "Path of the backup file")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetAccountImportExport())
+
+	return c
+}
+
+func accountBackupHandler(cmd *cobra.Command, args []string) error {
+	output := getOutputPath(cmd)
+
+	passphrase, err := getPassphrase(cmd)
+	if err != nil {
+		return err
+	}
+	if passphrase == "" {
+		return errors.New("a passphrase is required to encrypt the backup")
+	}
+
+	ca, err := cosmosaccount.New(getAccountRegistryOptions(cmd)...)
+	if err != nil {
+		return err
+	}
+
+	archive, skipped, err := ca.Backup(passphrase)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(output, archive, 0600); err != nil {
+		return err
+	}
+
+	if len(skipped) > 0 {
+		fmt.Printf("Skipped Ledger accounts: %s\n", strings.Join(skipped, ", "))
+	}
+	fmt.Printf("Accounts backed up to %s\n", output)
+	return nil
+}
@@ -0,0 +1,77 @@
+package starportcmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+)
+
+func NewAccountBalance() *cobra.Command {
+	c := &cobra.Command{
+		Use:               "balance [name or address]",
+		Short:             "Show the balances of an account on a running chain",
+		Args:              cobra.ExactArgs(1),
+		RunE:              accountBalanceHandler,
+		Annotations:       resultAnnotations(),
+		ValidArgsFunction: completeAccounts,
+	}
+
+	c.Flags().AddFlagSet(flagSetNode())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+
+	return c
+}
+
+// balanceResult is a balance of account balance in the json and yaml outputs.
+type balanceResult struct {
+	Amount string `json:"amount"`
+	Denom  string `json:"denom"`
+}
+
+func accountBalanceHandler(cmd *cobra.Command, args []string) error {
+	s := clispinner.New().SetText("Querying balances...")
+	defer s.Stop()
+
+	client, err := newAccountClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	address, err := resolveAccountAddress(cmd, args[0])
+	if err != nil {
+		return err
+	}
+
+	res, err := banktypes.NewQueryClient(client.Context).AllBalances(cmd.Context(), &banktypes.QueryAllBalancesRequest{
+		Address: address,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		results := []balanceResult{}
+		for _, coin := range res.Balances {
+			results = append(results, balanceResult{Amount: coin.Amount.String(), Denom: coin.Denom})
+		}
+		return printResult(cmd, results)
+	}
+
+	w := &tabwriter.Writer{}
+	w.Init(os.Stdout, 0, 8, 0, '\t', 0)
+
+	fmt.Fprintln(w, "amount\tdenom")
+	for _, coin := range res.Balances {
+		fmt.Fprintf(w, "%s\t%s\n", coin.Amount, coin.Denom)
+	}
+
+	fmt.Fprintln(w)
+	return w.Flush()
+}
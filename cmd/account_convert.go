@@ -0,0 +1,70 @@
+package starportcmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+const flagPrefix = "prefix"
+
+func NewAccountConvert() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "convert [address]",
+		Short: "Convert an address to the bech32 prefix of another chain",
+		Long: `Convert an address to the bech32 prefix of another chain.
+
+The address can be a bech32 address of any prefix, such as an account or a validator
+operator address, or a hex address. Its account, validator operator, consensus and hex
+forms are shown for the prefix, which defaults to the one of the address:
+
+  starport account convert cosmos1rg4ncn27dacgry4rknzadelcpydzk0zdngxhna --prefix osmo`,
+		Args:        cobra.ExactArgs(1),
+		RunE:        accountConvertHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().String(flagPrefix, "", "Account address prefix to convert the address to")
+
+	return c
+}
+
+// convertedAddressResult is the result of account convert in the json and yaml outputs.
+type convertedAddressResult struct {
+	Account   string `json:"account"`
+	Validator string `json:"validator"`
+	Consensus string `json:"consensus"`
+	Hex       string `json:"hex"`
+}
+
+func accountConvertHandler(cmd *cobra.Command, args []string) error {
+	prefix, _ := cmd.Flags().GetString(flagPrefix)
+
+	forms, err := cosmosaccount.ConvertAddressForms(args[0], prefix)
+	if err != nil {
+		return err
+	}
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, convertedAddressResult{
+			Account:   forms.Account,
+			Validator: forms.Validator,
+			Consensus: forms.Consensus,
+			Hex:       forms.Hex,
+		})
+	}
+
+	w := &tabwriter.Writer{}
+	w.Init(os.Stdout, 0, 8, 0, '\t', 0)
+
+	fmt.Fprintf(w, "account\t%s\n", forms.Account)
+	fmt.Fprintf(w, "validator\t%s\n", forms.Validator)
+	fmt.Fprintf(w, "consensus\t%s\n", forms.Consensus)
+	fmt.Fprintf(w, "hex\t%s\n", forms.Hex)
+
+	fmt.Fprintln(w)
+	return w.Flush()
+}
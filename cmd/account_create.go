@@ -4,18 +4,24 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
 )
 
 func NewAccountCreate() *cobra.Command {
 	c := &cobra.Command{
-		Use:   "create [name]",
-		Short: "Create a new account",
-		Args:  cobra.ExactArgs(1),
-		RunE:  accountCreateHandler,
+		Use:         "create [name]",
+		Short:       "Create a new account",
+		Args:        cobra.ExactArgs(1),
+		RunE:        accountCreateHandler,
+		Annotations: resultAnnotations(),
 	}
 
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetHDPath())
+	c.Flags().AddFlagSet(flagSetAccountHooks())
+	c.Flags().Int(flagMnemonicLength, cosmosaccount.DefaultMnemonicLength, "Number of words of the mnemonic (12|15|18|21|24)")
+	c.Flags().String(flagBIP39Passphrase, "", "BIP39 passphrase used with the mnemonic to derive the key, also known as the 25th word")
+	c.Flags().Bool(flagLedger, false, "Add the account of a connected Ledger device, its private key never leaves the device. The relayer and the faucet cannot sign with it")
 
 	return c
 }
@@ -23,18 +29,74 @@ func NewAccountCreate() *cobra.Command {
 func accountCreateHandler(cmd *cobra.Command, args []string) error {
 	name := args[0]
 
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	hookOptions, err := getAccountHookOptions(cmd)
 	if err != nil {
 		return err
 	}
 
-	_, mnemonic, err := ca.Create(name)
+	options := append(getHDPathOptions(cmd), getAccountRegistryOptions(cmd)...)
+	options = append(options, hookOptions...)
+
+	ca, err := cosmosaccount.New(options...)
 	if err != nil {
 		return err
 	}
 
+	if ledger, _ := cmd.Flags().GetBool(flagLedger); ledger {
+		acc, err := ca.CreateLedger(name)
+		if !hasAccountChanged(err) {
+			return err
+		}
+
+		if isStructuredOutput(cmd) {
+			if perr := printAccount(cmd, acc); perr != nil {
+				return perr
+			}
+			return err
+		}
+
+		fmt.Printf("Account %q created from the Ledger device, its transactions are signed on the device\n", name)
+		return err
+	}
+
+	var (
+		mnemonicLength, _  = cmd.Flags().GetInt(flagMnemonicLength)
+		bip39Passphrase, _ = cmd.Flags().GetString(flagBIP39Passphrase)
+	)
+
+	acc, mnemonic, err := ca.Create(
+		name,
+		cosmosaccount.WithMnemonicLength(mnemonicLength),
+		cosmosaccount.WithBIP39Passphrase(bip39Passphrase),
+	)
+	if !hasAccountChanged(err) {
+		return err
+	}
+
+	if isStructuredOutput(cmd) {
+		result := newAccountResult(cmd, acc)
+		if perr := printResult(cmd, createdAccountResult{
+			Name:      result.Name,
+			Address:   result.Address,
+			PublicKey: result.PublicKey,
+			Mnemonic:  mnemonic,
+		}); perr != nil {
+			return perr
+		}
+		return err
+	}
+
 	fmt.Printf("Account %q created, keep your mnemonic in a secret place:\n\n%s\n", name, mnemonic)
-	return nil
+	if bip39Passphrase != "" {
+		fmt.Println("\nThe BIP39 passphrase is needed along with the mnemonic to recover the account.")
+	}
+	return err
+}
+
+// createdAccountResult is an account created from a mnemonic in the json and yaml outputs.
+type createdAccountResult struct {
+	Name      string `json:"name"`
+	Address   string `json:"address"`
+	PublicKey string `json:"public_key"`
+	Mnemonic  string `json:"mnemonic"`
 }
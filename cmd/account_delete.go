@@ -4,18 +4,25 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/trino-network/trino/pkg/cliquiz"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
 )
 
 func NewAccountDelete() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "delete [name]",
 		Short: "Delete an account by name",
-		Args:  cobra.ExactArgs(1),
-		RunE:  accountDeleteHandler,
+		Long: `Delete an account by name.
+
+The keys of a deleted account can only be recovered with its mnemonic or a backup, so the
+deletion is confirmed first unless --yes is set.`,
+		Args:              cobra.ExactArgs(1),
+		RunE:              accountDeleteHandler,
+		ValidArgsFunction: completeAccounts,
 	}
 
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetAccountHooks())
 
 	return c
 }
@@ -23,17 +30,36 @@ func NewAccountDelete() *cobra.Command {
 func accountDeleteHandler(cmd *cobra.Command, args []string) error {
 	name := args[0]
 
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	// the confirmation is answered with yes by the global --yes.
+	var confirmed bool
+	if err := cliquiz.Ask(cliquiz.NewQuestion(
+		fmt.Sprintf("Delete account %s? Its keys can only be recovered with its mnemonic or a backup", name),
+		&confirmed,
+		cliquiz.Confirm(),
+		cliquiz.Key("delete-account"),
+	)); err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Printf("Account %s is not deleted.\n", name)
+		return nil
+	}
+
+	hookOptions, err := getAccountHookOptions(cmd)
+	if err != nil {
+		return err
+	}
+
+	ca, err := cosmosaccount.New(append(getAccountRegistryOptions(cmd), hookOptions...)...)
 	if err != nil {
 		return err
 	}
 
-	if err := ca.DeleteByName(name); err != nil {
+	err = ca.DeleteByName(name)
+	if !hasAccountChanged(err) {
 		return err
 	}
 
 	fmt.Printf("Account %s deleted.\n", name)
-	return nil
+	return err
 }
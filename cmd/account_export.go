@@ -6,20 +6,29 @@ import (
 	"path/filepath"
 
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
 )
 
 func NewAccountExport() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "export [name]",
 		Short: "Export an account as a private key",
-		Args:  cobra.ExactArgs(1),
-		RunE:  accountExportHandler,
+		Long: `Export an account as a private key.
+
+The key is exported in one of these formats selected with --format:
+
+  armor     ASCII-armored key encrypted with the passphrase, it can be imported by chain binaries
+  hex       unencrypted, unarmored key in hex
+  keystore  keystore JSON encrypted with the passphrase, it can be imported by many wallets`,
+		Args:              cobra.ExactArgs(1),
+		RunE:              accountExportHandler,
+		ValidArgsFunction: completeAccounts,
 	}
 
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
 	c.Flags().AddFlagSet(flagSetAccountImportExport())
 	c.Flags().String(flagPath, "", "path to export private key. default: ./key_[name]")
+	c.Flags().String(flagKeyFormat, string(cosmosaccount.KeyFormatArmor), "Format of the private key: armor, hex or keystore")
 
 	return c
 }
@@ -30,32 +39,42 @@ func accountExportHandler(cmd *cobra.Command, args []string) error {
 		path = flagGetPath(cmd)
 	)
 
-	passphrase, err := getPassphrase(cmd)
+	formatName, _ := cmd.Flags().GetString(flagKeyFormat)
+	format, err := cosmosaccount.ParseKeyFormat(formatName)
 	if err != nil {
 		return err
 	}
 
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	// hex keys are not encrypted.
+	var passphrase string
+	if format != cosmosaccount.KeyFormatHex {
+		if passphrase, err = getPassphrase(cmd); err != nil {
+			return err
+		}
+	}
+
+	ca, err := cosmosaccount.New(getAccountRegistryOptions(cmd)...)
 	if err != nil {
 		return err
 	}
 
-	armored, err := ca.Export(name, passphrase)
+	key, err := ca.ExportAs(name, passphrase, format)
 	if err != nil {
 		return err
 	}
 
 	if path == "" {
 		path = fmt.Sprintf("./key_%s", name)
+		if format == cosmosaccount.KeyFormatKeystore {
+			path += ".json"
+		}
 	}
 	path, err = filepath.Abs(path)
 	if err != nil {
 		return err
 	}
 
-	if err := os.WriteFile(path, []byte(armored), 0644); err != nil {
+	if err := os.WriteFile(path, []byte(key), 0600); err != nil {
 		return err
 	}
 
@@ -0,0 +1,146 @@
+package starportcmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/goccy/go-yaml"
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/exec"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+	"github.com/trino-network/trino/pkg/cmdtrace"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+const flagNoHooks = "no-hooks"
+
+// accountHooksFile is the config file of the hooks that are run by account commands, it's kept
+// in the home of Starport's accounts.
+const accountHooksFile = "hooks.yml"
+
+// accountHooksConfig is the config of the hooks that are run by account commands.
+type accountHooksConfig struct {
+	Hooks []accountHookConfig `yaml:"hooks"`
+}
+
+// accountHookConfig is a shell command that's run on the account events in On, or on all events
+// when On is empty.
+type accountHookConfig struct {
+	Name          string                    `yaml:"name"`
+	On            []cosmosaccount.EventType `yaml:"on"`
+	Run           string                    `yaml:"run"`
+	AddressPrefix string                    `yaml:"address_prefix"`
+}
+
+func flagSetAccountHooks() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Bool(flagNoHooks, false, fmt.Sprintf("Do not run the hooks configured in %s", accountHooksPath()))
+	return fs
+}
+
+func accountHooksPath() string {
+	return filepath.Join(cosmosaccount.KeyringHome, accountHooksFile)
+}
+
+// getAccountHookOptions returns the registry options for the hooks configured for account
+// commands, unless they are disabled with a flag.
+func getAccountHookOptions(cmd *cobra.Command) ([]cosmosaccount.Option, error) {
+	if noHooks, _ := cmd.Flags().GetBool(flagNoHooks); noHooks {
+		return nil, nil
+	}
+
+	configs, err := loadAccountHooks(accountHooksPath())
+	if err != nil {
+		return nil, err
+	}
+
+	var hooks []cosmosaccount.Hook
+	for _, config := range configs {
+		hooks = append(hooks, newAccountCommandHook(cmd, config))
+	}
+
+	return []cosmosaccount.Option{cosmosaccount.WithHooks(hooks...)}, nil
+}
+
+// loadAccountHooks loads the hooks of the config file at path, there are no hooks when it
+// doesn't exist.
+func loadAccountHooks(path string) ([]accountHookConfig, error) {
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var config accountHooksConfig
+	if err := yaml.Unmarshal(b, &config); err != nil {
+		return nil, fmt.Errorf("invalid account hooks config %s: %w", path, err)
+	}
+
+	for i, hook := range config.Hooks {
+		if hook.Run == "" {
+			return nil, fmt.Errorf("hook #%d in %s has no command to run", i+1, path)
+		}
+		for _, eventType := range hook.On {
+			if !containsEventType(cosmosaccount.EventTypes, eventType) {
+				return nil, fmt.Errorf("hook #%d in %s has an unknown event %q, events are: %v", i+1, path, eventType, cosmosaccount.EventTypes)
+			}
+		}
+	}
+
+	return config.Hooks, nil
+}
+
+// newAccountCommandHook creates a hook that runs the shell command of config, the event is
+// passed to the command with env vars.
+func newAccountCommandHook(cmd *cobra.Command, config accountHookConfig) cosmosaccount.Hook {
+	return cosmosaccount.HookFunc(func(event cosmosaccount.Event) error {
+		if len(config.On) > 0 && !containsEventType(config.On, event.Type) {
+			return nil
+		}
+
+		prefix := config.AddressPrefix
+		if prefix == "" {
+			prefix = cosmosaccount.AccountPrefixCosmos
+		}
+
+		err := exec.Exec(
+			cmd.Context(),
+			[]string{"sh", "-c", config.Run},
+			exec.StepOption(step.Env(
+				cmdrunner.Env("ACCOUNT_EVENT", string(event.Type)),
+				cmdrunner.Env("ACCOUNT_NAME", event.Account.Name),
+				cmdrunner.Env("ACCOUNT_CHAIN", event.Namespace),
+				cmdrunner.Env("ACCOUNT_ADDRESS", event.Account.Address(prefix)),
+				cmdrunner.Env("ACCOUNT_PUBKEY", event.Account.PubKey()),
+			)),
+			exec.StepOption(cmdtrace.Step()),
+			exec.IncludeStdLogsToError(),
+		)
+		if err != nil && config.Name != "" {
+			return fmt.Errorf("%s: %w", config.Name, err)
+		}
+		return err
+	})
+}
+
+// hasAccountChanged checks if an account was created, imported or deleted by an operation
+// that returned err, it did when err is nil or when only a hook failed.
+func hasAccountChanged(err error) bool {
+	var hookErr *cosmosaccount.HookError
+	return err == nil || errors.As(err, &hookErr)
+}
+
+func containsEventType(eventTypes []cosmosaccount.EventType, eventType cosmosaccount.EventType) bool {
+	for _, t := range eventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -7,8 +7,8 @@ import (
 
 	"github.com/cosmos/go-bip39"
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/pkg/cliquiz"
-	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/trino-network/trino/pkg/cliquiz"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
 )
 
 const flagSecret = "secret"
@@ -17,13 +17,29 @@ func NewAccountImport() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "import [name]",
 		Short: "Import an account by using a mnemonic or a private key",
-		Args:  cobra.ExactArgs(1),
-		RunE:  accountImportHandler,
+		Long: `Import an account by using a mnemonic or a private key.
+
+Private keys are read from a file in any of the formats of "starport account export", which
+is detected from the content of the file: an ASCII-armored key exported by Starport or chain
+binaries, an unarmored key in hex or a keystore JSON of a wallet. The passphrase decrypts
+armored keys and keystores.
+
+Keys of mnemonics are derived from the HD path m/44'/118'/0'/0/0 by default, use --coin-type,
+--account-index and --address-index to recover keys of other paths, such as --coin-type 529
+for Secret Network. Coin type 60 of EVM chains is not supported, their accounts are eth_secp256k1
+keys. Mnemonics of 12, 15, 18, 21 and 24 words are supported, set --bip39-passphrase
+to recover accounts of mnemonics created with a BIP39 passphrase.`,
+		Args:        cobra.ExactArgs(1),
+		RunE:        accountImportHandler,
+		Annotations: resultAnnotations(),
 	}
 
-	c.Flags().String(flagSecret, "", "Your mnemonic or path to your private key (use interactive mode instead to securely pass your mnemonic)")
+	c.Flags().String(flagSecret, "", "Your mnemonic, your private key in hex or path to your private key (use interactive mode instead to securely pass your mnemonic)")
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetHDPath())
 	c.Flags().AddFlagSet(flagSetAccountImportExport())
+	c.Flags().AddFlagSet(flagSetAccountHooks())
+	c.Flags().String(flagBIP39Passphrase, "", "BIP39 passphrase of the mnemonic, also known as the 25th word")
 
 	return c
 }
@@ -36,17 +52,12 @@ func accountImportHandler(cmd *cobra.Command, args []string) error {
 
 	if secret == "" {
 		if err := cliquiz.Ask(
-			cliquiz.NewQuestion("Your mnemonic or path to your private key", &secret, cliquiz.Required())); err != nil {
+			cliquiz.NewQuestion("Your mnemonic or path to your private key", &secret, cliquiz.Hidden(), cliquiz.Required(), cliquiz.Key("mnemonic"))); err != nil {
 			return err
 		}
 	}
 
-	passphrase, err := getPassphrase(cmd)
-	if err != nil {
-		return err
-	}
-
-	if !bip39.IsMnemonicValid(secret) {
+	if !bip39.IsMnemonicValid(secret) && cosmosaccount.DetectKeyFormat(secret) != cosmosaccount.KeyFormatHex {
 		privKey, err := os.ReadFile(secret)
 		if os.IsNotExist(err) {
 			return errors.New("mnemonic is not valid or private key not found at path")
@@ -57,17 +68,49 @@ func accountImportHandler(cmd *cobra.Command, args []string) error {
 		secret = string(privKey)
 	}
 
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	var passphrase string
+	switch {
+	case bip39.IsMnemonicValid(secret):
+		// mnemonics are recovered with the BIP39 passphrase, --passphrase is kept as its
+		// fallback since it used to be the one of mnemonics.
+		passphrase, _ = cmd.Flags().GetString(flagBIP39Passphrase)
+		if passphrase == "" {
+			passphrase, _ = cmd.Flags().GetString(flagPassphrase)
+		}
+
+	// hex keys are not encrypted.
+	case cosmosaccount.DetectKeyFormat(secret) != cosmosaccount.KeyFormatHex:
+		var err error
+		if passphrase, err = getPassphrase(cmd); err != nil {
+			return err
+		}
+	}
+
+	hookOptions, err := getAccountHookOptions(cmd)
 	if err != nil {
 		return err
 	}
 
-	if _, err := ca.Import(name, secret, passphrase); err != nil {
+	options := append(getHDPathOptions(cmd), getAccountRegistryOptions(cmd)...)
+	options = append(options, hookOptions...)
+
+	ca, err := cosmosaccount.New(options...)
+	if err != nil {
+		return err
+	}
+
+	acc, err := ca.Import(name, secret, passphrase)
+	if !hasAccountChanged(err) {
+		return err
+	}
+
+	if isStructuredOutput(cmd) {
+		if perr := printAccount(cmd, acc); perr != nil {
+			return perr
+		}
 		return err
 	}
 
 	fmt.Printf("Account %q imported.\n", name)
-	return nil
+	return err
 }
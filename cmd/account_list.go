@@ -1,27 +1,49 @@
 package starportcmd
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+	"github.com/tendermint/starport/starport/pkg/xurl"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	conf "github.com/trino-network/trino/chainconf"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
 )
 
+const flagWithBalances = "with-balances"
+
 func NewAccountList() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "list",
 		Short: "Show a list of all accounts",
-		RunE:  accountListHandler,
+		Long: `Show a list of all accounts.
+
+With --with-balances, balances of the accounts are queried from the chains that are known
+to Starport: the chain of the app and its networks in config.yml, and the chains that are
+configured for the relayer. Accounts are shown once for each chain, with the address
+prefix of the chain.`,
+		RunE:        accountListHandler,
+		Annotations: resultAnnotations(),
 	}
 
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
 	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+	c.Flags().Bool(flagWithBalances, false, "Show the balances of the accounts on the configured chains")
 
 	return c
 }
 
 func accountListHandler(cmd *cobra.Command, args []string) error {
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	ca, err := cosmosaccount.New(getAccountRegistryOptions(cmd)...)
 	if err != nil {
 		return err
 	}
@@ -31,6 +53,174 @@ func accountListHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	printAccounts(cmd, accounts...)
-	return nil
+	if withBalances, _ := cmd.Flags().GetBool(flagWithBalances); withBalances {
+		return printAccountBalances(cmd, accounts)
+	}
+
+	return printAccounts(cmd, accounts...)
+}
+
+// accountNetwork is a running chain that balances of accounts are queried from.
+type accountNetwork struct {
+	chainID       string
+	addressPrefix string
+	client        client.Context
+}
+
+// networkEndpoint is the RPC address of a chain and the prefix of its addresses.
+type networkEndpoint struct {
+	rpcAddress    string
+	addressPrefix string
+}
+
+// accountNetworks returns the chains that are known to Starport and running, the chain of the
+// app and its networks in config.yml and the chains of the relayer.
+func accountNetworks(cmd *cobra.Command) ([]accountNetwork, error) {
+	var endpoints []networkEndpoint
+
+	appPath, err := filepath.Abs(flagGetPath(cmd))
+	if err != nil {
+		return nil, err
+	}
+	if configPath, err := conf.LocateDefault(appPath); err == nil {
+		config, err := conf.ParseFile(configPath)
+		if err != nil {
+			return nil, err
+		}
+
+		endpoints = append(endpoints, networkEndpoint{config.Host.RPC, getAddressPrefix(cmd)})
+
+		for _, network := range config.Networks {
+			prefix := network.AddressPrefix
+			if prefix == "" {
+				prefix = getAddressPrefix(cmd)
+			}
+			endpoints = append(endpoints, networkEndpoint{network.RPC, prefix})
+		}
+	}
+
+	relayerConfig, err := relayerconf.Get()
+	if err != nil {
+		return nil, err
+	}
+	for _, chain := range relayerConfig.Chains {
+		endpoints = append(endpoints, networkEndpoint{chain.RPCAddress, chain.AddressPrefix})
+	}
+
+	var (
+		networks []accountNetwork
+		seen     = make(map[string]bool)
+	)
+
+	for _, endpoint := range endpoints {
+		if endpoint.rpcAddress == "" {
+			continue
+		}
+
+		network, err := newAccountNetwork(cmd.Context(), endpoint)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Skipping %s, the chain cannot be reached: %s\n", endpoint.rpcAddress, err)
+			continue
+		}
+
+		if seen[network.chainID] {
+			continue
+		}
+		seen[network.chainID] = true
+
+		networks = append(networks, network)
+	}
+
+	return networks, nil
+}
+
+func newAccountNetwork(ctx context.Context, endpoint networkEndpoint) (accountNetwork, error) {
+	rpc, err := rpchttp.New(xurl.HTTP(endpoint.rpcAddress), "/websocket")
+	if err != nil {
+		return accountNetwork{}, err
+	}
+
+	status, err := rpc.Status(ctx)
+	if err != nil {
+		return accountNetwork{}, err
+	}
+
+	registry := codectypes.NewInterfaceRegistry()
+
+	return accountNetwork{
+		chainID:       status.NodeInfo.Network,
+		addressPrefix: endpoint.addressPrefix,
+		client: client.Context{}.
+			WithClient(rpc).
+			WithInterfaceRegistry(registry).
+			WithCodec(codec.NewProtoCodec(registry)),
+	}, nil
+}
+
+func printAccountBalances(cmd *cobra.Command, accounts []cosmosaccount.Account) error {
+	if len(accounts) == 0 {
+		return printAccounts(cmd)
+	}
+
+	networks, err := accountNetworks(cmd)
+	if err != nil {
+		return err
+	}
+	if len(networks) == 0 {
+		return fmt.Errorf("no running chains are found in config.yml or in the relayer's config")
+	}
+
+	var results []accountBalanceResult
+	for _, acc := range accounts {
+		for _, network := range networks {
+			result := accountBalanceResult{
+				Name:    acc.Name,
+				ChainID: network.chainID,
+				Address: acc.Address(network.addressPrefix),
+			}
+
+			res, err := banktypes.NewQueryClient(network.client).AllBalances(cmd.Context(), &banktypes.QueryAllBalancesRequest{
+				Address: result.Address,
+			})
+			if err != nil {
+				result.Error = err.Error()
+			} else if !res.Balances.Empty() {
+				result.Balances = res.Balances.String()
+			}
+
+			results = append(results, result)
+		}
+	}
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, results)
+	}
+
+	w := &tabwriter.Writer{}
+	w.Init(os.Stdout, 0, 8, 0, '\t', 0)
+
+	fmt.Fprintln(w, "name\tchain\taddress\tbalances")
+
+	for _, result := range results {
+		balances := "-"
+		if result.Error != "" {
+			balances = fmt.Sprintf("error: %s", result.Error)
+		} else if result.Balances != "" {
+			balances = result.Balances
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", result.Name, result.ChainID, result.Address, balances)
+	}
+
+	fmt.Fprintln(w)
+	return w.Flush()
+}
+
+// accountBalanceResult is the balances of an account on a chain in the json and yaml outputs.
+type accountBalanceResult struct {
+	Name     string `json:"name"`
+	ChainID  string `json:"chain_id"`
+	Address  string `json:"address"`
+	Balances string `json:"balances"`
+	Error    string `json:"error,omitempty"`
 }
@@ -0,0 +1,54 @@
+package starportcmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+func NewAccountMigrate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "migrate",
+		Short: "Move the accounts that are not scoped to a chain to the chain set with --chain",
+		Long: `Move the accounts that are not scoped to a chain to the chain set with --chain.
+
+Accounts are skipped when the chain already has an account with the same name. Ledger
+accounts are skipped too, add them again with "starport account create --ledger --chain".`,
+		Args: cobra.NoArgs,
+		RunE: accountMigrateHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func accountMigrateHandler(cmd *cobra.Command, args []string) error {
+	if chainID, _ := cmd.Flags().GetString(flagChain); chainID == "" {
+		return errors.New("set the chain to migrate the accounts to with --chain")
+	}
+
+	ca, err := cosmosaccount.New(getAccountRegistryOptions(cmd)...)
+	if err != nil {
+		return err
+	}
+
+	migrated, skipped, err := ca.MigrateToNamespace()
+	if len(migrated) > 0 {
+		fmt.Printf("Migrated accounts: %s\n", strings.Join(migrated, ", "))
+	}
+	if len(skipped) > 0 {
+		fmt.Printf("Skipped accounts: %s\n", strings.Join(skipped, ", "))
+	}
+	if err != nil {
+		return err
+	}
+	if len(migrated) == 0 && len(skipped) == 0 {
+		fmt.Println("There are no accounts to migrate.")
+	}
+
+	return nil
+}
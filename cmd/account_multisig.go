@@ -0,0 +1,88 @@
+package starportcmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+const (
+	flagThreshold = "threshold"
+	flagKeys      = "keys"
+	flagNoSort    = "no-sort"
+)
+
+// NewAccountMultisig returns a command that groups multisig account related sub commands.
+func NewAccountMultisig() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "multisig [command]",
+		Short: "Commands for managing multisig accounts",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewAccountMultisigCreate())
+
+	return c
+}
+
+func NewAccountMultisigCreate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "create [name]",
+		Short: "Create a multisig account from the public keys of other accounts",
+		Long: `Create a multisig account from the public keys of other accounts.
+
+Transactions of the multisig account need to be signed by at least --threshold of the
+accounts set with --keys, see "starport tx sign --multisig" and "starport tx multisign".
+
+Public keys are sorted by their addresses like chain binaries do, so the address of the
+multisig account doesn't depend on the order of --keys. Use --no-sort to keep the order.`,
+		Args:        cobra.ExactArgs(1),
+		RunE:        accountMultisigCreateHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+	c.Flags().AddFlagSet(flagSetAccountHooks())
+	c.Flags().Int(flagThreshold, 1, "Minimum number of signatures required to sign transactions")
+	c.Flags().StringSlice(flagKeys, nil, "Names of the accounts whose signatures can sign transactions")
+	c.Flags().Bool(flagNoSort, false, "Keep the public keys in the order of --keys")
+
+	return c
+}
+
+func accountMultisigCreateHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name         = args[0]
+		threshold, _ = cmd.Flags().GetInt(flagThreshold)
+		keys, _      = cmd.Flags().GetStringSlice(flagKeys)
+		noSort, _    = cmd.Flags().GetBool(flagNoSort)
+	)
+
+	if len(keys) == 0 {
+		return errors.New("accounts of the multisig account are required, set them with --keys")
+	}
+
+	hookOptions, err := getAccountHookOptions(cmd)
+	if err != nil {
+		return err
+	}
+
+	ca, err := cosmosaccount.New(append(getAccountRegistryOptions(cmd), hookOptions...)...)
+	if err != nil {
+		return err
+	}
+
+	acc, err := ca.CreateMultisig(name, threshold, keys, !noSort)
+	if !hasAccountChanged(err) {
+		return err
+	}
+
+	fmt.Printf("Multisig account %q created, %d of %d signatures are required:\n\n", name, threshold, len(keys))
+	if perr := printAccount(cmd, acc); perr != nil {
+		return perr
+	}
+	return err
+}
@@ -0,0 +1,54 @@
+package starportcmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+func NewAccountRestore() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "restore [file]",
+		Short: "Restore the accounts of a backup",
+		Long: `Restore the accounts of a backup made with "starport account backup".
+
+Accounts are restored with the chains they're scoped to, they're skipped when an account
+with the same name already exists.`,
+		Args: cobra.ExactArgs(1),
+		RunE: accountRestoreHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetAccountImportExport())
+
+	return c
+}
+
+func accountRestoreHandler(cmd *cobra.Command, args []string) error {
+	archive, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	passphrase, err := getPassphrase(cmd)
+	if err != nil {
+		return err
+	}
+
+	ca, err := cosmosaccount.New(getAccountRegistryOptions(cmd)...)
+	if err != nil {
+		return err
+	}
+
+	restored, skipped, err := ca.Restore(archive, passphrase)
+	if len(restored) > 0 {
+		fmt.Printf("Restored accounts: %s\n", strings.Join(restored, ", "))
+	}
+	if len(skipped) > 0 {
+		fmt.Printf("Skipped existing accounts: %s\n", strings.Join(skipped, ", "))
+	}
+	return err
+}
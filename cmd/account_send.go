@@ -0,0 +1,105 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+func NewAccountSend() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "send [from] [to] [amount]",
+		Short: "Send tokens from an account to a name or an address on a running chain",
+		Long: `Send tokens from an account to a name or an address on a running chain.
+
+The sender is the name of an account in the keyring and amount is a list of coins, for example:
+
+  starport account send alice bob 1000token,20stake`,
+		Args:        cobra.ExactArgs(3),
+		RunE:        accountSendHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().AddFlagSet(flagSetNode())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+
+	return c
+}
+
+// sendResult is the result of account send in the json and yaml outputs.
+type sendResult struct {
+	TxHash string `json:"txhash"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Amount string `json:"amount"`
+}
+
+func accountSendHandler(cmd *cobra.Command, args []string) error {
+	var (
+		fromName = args[0]
+		to       = args[1]
+		prefix   = getAddressPrefix(cmd)
+	)
+
+	amount, err := sdktypes.ParseCoinsNormalized(args[2])
+	if err != nil {
+		return err
+	}
+	if amount.Empty() {
+		return fmt.Errorf("amount %q has no coins to send", args[2])
+	}
+
+	s := clispinner.New().SetText("Sending tokens...")
+	defer s.Stop()
+
+	ca, err := cosmosaccount.New(getAccountRegistryOptions(cmd)...)
+	if err != nil {
+		return err
+	}
+
+	from, err := ca.GetByName(fromName)
+	if err != nil {
+		return err
+	}
+
+	client, err := newAccountClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	toAddress, err := resolveAccountAddress(cmd, to)
+	if err != nil {
+		return err
+	}
+
+	msg := &banktypes.MsgSend{
+		FromAddress: from.Address(prefix),
+		ToAddress:   toAddress,
+		Amount:      amount,
+	}
+
+	// the key of the account is named after its namespace in the keyring.
+	res, err := client.BroadcastTx(from.Info.GetName(), msg)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, sendResult{
+			TxHash: res.TxHash,
+			From:   from.Address(prefix),
+			To:     toAddress,
+			Amount: amount.String(),
+		})
+	}
+
+	fmt.Printf("🎉 Sent %s from %s to %s\nTransaction hash: %s\n", amount, from.Address(prefix), toAddress, res.TxHash)
+	return nil
+}
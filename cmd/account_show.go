@@ -2,15 +2,17 @@ package starportcmd
 
 import (
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
 )
 
 func NewAccountShow() *cobra.Command {
 	c := &cobra.Command{
-		Use:   "show [name]",
-		Short: "Show detailed information about a particular account",
-		Args:  cobra.ExactArgs(1),
-		RunE:  accountShowHandler,
+		Use:               "show [name]",
+		Short:             "Show detailed information about a particular account",
+		Args:              cobra.ExactArgs(1),
+		RunE:              accountShowHandler,
+		Annotations:       resultAnnotations(),
+		ValidArgsFunction: completeAccounts,
 	}
 
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
@@ -22,9 +24,7 @@ func NewAccountShow() *cobra.Command {
 func accountShowHandler(cmd *cobra.Command, args []string) error {
 	name := args[0]
 
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	ca, err := cosmosaccount.New(getAccountRegistryOptions(cmd)...)
 	if err != nil {
 		return err
 	}
@@ -34,6 +34,5 @@ func accountShowHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	printAccounts(cmd, acc)
-	return nil
+	return printAccount(cmd, acc)
 }
@@ -0,0 +1,53 @@
+package starportcmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+func NewAccountWatch() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "watch [name] [address or public key]",
+		Short: "Add a watch-only account by its address or public key",
+		Long: `Add a watch-only account by its address or public key.
+
+Watch-only accounts don't have a private key, their balances can be queried with
+"starport account balance" and their address can be used to generate unsigned
+transactions with the chain's binary, but transactions are signed elsewhere.
+
+The public key is either in the JSON format printed by "keys show --pubkey" of chain
+binaries or a secp256k1 public key in base64. The address can be a bech32 address of
+any prefix or a hex address.`,
+		Args:        cobra.ExactArgs(2),
+		RunE:        accountWatchHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+	c.Flags().AddFlagSet(flagSetAccountHooks())
+
+	return c
+}
+
+func accountWatchHandler(cmd *cobra.Command, args []string) error {
+	hookOptions, err := getAccountHookOptions(cmd)
+	if err != nil {
+		return err
+	}
+
+	ca, err := cosmosaccount.New(append(getAccountRegistryOptions(cmd), hookOptions...)...)
+	if err != nil {
+		return err
+	}
+
+	acc, err := ca.AddWatchOnly(args[0], args[1])
+	if !hasAccountChanged(err) {
+		return err
+	}
+
+	if perr := printAccount(cmd, acc); perr != nil {
+		return perr
+	}
+	return err
+}
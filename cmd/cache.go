@@ -0,0 +1,239 @@
+package starportcmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/exec"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/pkg/xfilepath"
+	"github.com/tendermint/starport/starport/services/scaffolder"
+	conf "github.com/trino-network/trino/chainconf"
+	"github.com/trino-network/trino/pkg/cmdtrace"
+	"github.com/trino-network/trino/pkg/cosmosgen"
+	"github.com/trino-network/trino/pkg/offline"
+	"github.com/trino-network/trino/pkg/pluginregistry"
+	"github.com/trino-network/trino/pkg/templatepack"
+	"github.com/trino-network/trino/services/chain"
+)
+
+// warmChainName is the name of the chain that's scaffolded and built to cache what new chains
+// need.
+const warmChainName = "github.com/starport/warm"
+
+// templateCachePath is the dir where template packs are cached for the offline mode.
+var templateCachePath = xfilepath.JoinFromHome(
+	xfilepath.Path(".starport"),
+	xfilepath.Path("cache"),
+	xfilepath.Path("templates"),
+)
+
+var nonAlphanumeric = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// NewCache creates a new cache command to cache what Starport downloads, for the offline mode.
+func NewCache() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "cache",
+		Short: "Cache the Go modules, protoc plugins and template packs to work offline",
+	}
+	c.AddCommand(NewCacheWarm())
+	return c
+}
+
+// NewCacheWarm creates a new command to cache what scaffolding, generating and building chains
+// download.
+func NewCacheWarm() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "warm",
+		Short: "Download what scaffolding, generating and building chains need, to do it offline",
+		Long: `Download what scaffolding, generating and building chains need, to do it offline.
+
+A chain is scaffolded and built in a temporary dir to cache the Go modules and the protoc
+plugins of new chains. Inside a chain, the Go modules of the chain and the protoc plugins of
+its config.yml are cached as well. Template packs to scaffold chains from are cached with
+--template, along with the index of the registry.
+
+Once the cache is warm, run Starport with --offline or with STARPORT_OFFLINE=1 to scaffold,
+generate and build without network access:
+
+  starport cache warm --template mycompany-chain@v1.0.0
+  starport scaffold chain github.com/mycompany/mars --template mycompany-chain@v1.0.0 --offline`,
+		Args:        cobra.NoArgs,
+		RunE:        cacheWarmHandler,
+		Annotations: resultAnnotations(),
+	}
+	c.Flags().StringSlice(flagTemplate, nil, "Template packs to cache")
+	c.Flags().String(flagRegistry, pluginregistry.DefaultURL, "Git repository of the registry of template packs")
+	return c
+}
+
+// cacheWarmResult is the result of cache warm in the json and yaml outputs.
+type cacheWarmResult struct {
+	ModCache  string   `json:"mod_cache"`
+	Chain     string   `json:"chain,omitempty"`
+	Templates []string `json:"templates,omitempty"`
+}
+
+func cacheWarmHandler(cmd *cobra.Command, _ []string) error {
+	if offline.IsEnabled() {
+		return fmt.Errorf("the cache cannot be warmed offline, run it without --offline and %s", offline.EnvOffline)
+	}
+
+	var (
+		ctx          = cmd.Context()
+		templates, _ = cmd.Flags().GetStringSlice(flagTemplate)
+		registry, _  = cmd.Flags().GetString(flagRegistry)
+		result       cacheWarmResult
+	)
+
+	s := clispinner.New().SetText("Caching what new chains need...")
+	defer s.Stop()
+
+	if err := warmNewChain(ctx); err != nil {
+		return fmt.Errorf("cannot cache what new chains need: %w", err)
+	}
+
+	if appPath, err := conf.LocateDir(flagGetPath(cmd)); err == nil {
+		s.SetText("Caching what the chain needs...")
+		if err := warmChain(ctx, appPath); err != nil {
+			return fmt.Errorf("cannot cache what the chain needs: %w", err)
+		}
+		result.Chain = appPath
+	}
+
+	if len(templates) > 0 {
+		s.SetText("Caching the index of the registry...")
+		if err := newPluginRegistry(registry).Update(ctx); err != nil {
+			return err
+		}
+	}
+	for _, template := range templates {
+		s.SetText(fmt.Sprintf("Caching the template pack %s...", template))
+		if err := cacheTemplatePack(ctx, template, registry); err != nil {
+			return err
+		}
+		result.Templates = append(result.Templates, template)
+	}
+
+	s.SetText("Indexing the Go module cache...")
+	modCache, err := offline.ModCache(ctx)
+	if err != nil {
+		return err
+	}
+	if err := offline.IndexModCache(modCache); err != nil {
+		return err
+	}
+	result.ModCache = modCache
+
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, result)
+	}
+
+	fmt.Printf("📦 The Go modules are cached in %s\n", modCache)
+	if result.Chain != "" {
+		fmt.Printf("📦 The Go modules and protoc plugins of the chain at %s are cached\n", result.Chain)
+	}
+	for _, template := range result.Templates {
+		fmt.Printf("📦 The template pack %s is cached\n", template)
+	}
+	fmt.Println("\nRun Starport with --offline to scaffold, generate and build without network access.")
+	return nil
+}
+
+// warmNewChain caches what new chains need by scaffolding and building one in a temporary dir.
+func warmNewChain(ctx context.Context) error {
+	tmp, err := os.MkdirTemp("", "starport-warm")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	appPath, err := scaffolder.Init(placeholder.New(), tmp, warmChainName, "cosmos", false)
+	if err != nil {
+		return err
+	}
+
+	c, err := chain.New(appPath)
+	if err != nil {
+		return err
+	}
+	_, err = c.Build(ctx, filepath.Join(tmp, "bin"))
+	return err
+}
+
+// warmChain caches the Go modules of the chain at appPath and the protoc plugins of its config.
+func warmChain(ctx context.Context, appPath string) error {
+	err := exec.Exec(ctx, []string{"go", "mod", "download"},
+		exec.StepOption(step.Workdir(appPath)),
+		exec.StepOption(cmdtrace.Step()),
+		exec.IncludeStdLogsToError(),
+	)
+	if err != nil {
+		return err
+	}
+
+	config, err := conf.ParseFile(filepath.Join(appPath, "config.yml"))
+	if err != nil {
+		return err
+	}
+	return cosmosgen.InstallPlugins(ctx, config.Build.Proto.Plugins)
+}
+
+// cacheTemplatePack clones the template pack of template into the cache, the cached pack is
+// replaced with the latest one.
+func cacheTemplatePack(ctx context.Context, template, registry string) error {
+	url, ref, err := resolveTemplatePack(ctx, template, registry)
+	if err != nil {
+		return err
+	}
+	dir, err := templatePackCachePath(url, ref)
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	_, err = templatepack.Clone(ctx, url, ref, dir)
+	return err
+}
+
+// templatePackCachePath returns the dir where the template pack at url with ref is cached.
+func templatePackCachePath(url, ref string) (string, error) {
+	root, err := templateCachePath()
+	if err != nil {
+		return "", err
+	}
+	name := strings.Trim(nonAlphanumeric.ReplaceAllString(url, "-"), "-")
+	if ref != "" {
+		name += "@" + ref
+	}
+	return filepath.Join(root, name), nil
+}
+
+// setOffline turns the offline mode on when it's set with a flag or with its env var.
+func setOffline(cmd *cobra.Command) error {
+	if !isOffline(cmd) {
+		return nil
+	}
+	modCache, err := offline.ModCache(cmd.Context())
+	if err != nil {
+		return err
+	}
+	return offline.Enable(modCache)
+}
+
+// isOffline checks if the offline mode is set with a flag or with its env var. the flag is read
+// from the root command since tx sign has its own --offline flag.
+func isOffline(cmd *cobra.Command) bool {
+	isSet, _ := cmd.Root().PersistentFlags().GetBool(flagOffline)
+	return isSet || offline.IsEnabled()
+}
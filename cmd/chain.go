@@ -13,11 +13,12 @@ func NewChain() *cobra.Command {
 		Args:    cobra.ExactArgs(1),
 	}
 
-	flagSetPath(c)
 	c.AddCommand(NewChainServe())
 	c.AddCommand(NewChainBuild())
 	c.AddCommand(NewChainInit())
 	c.AddCommand(NewChainFaucet())
+	c.AddCommand(NewChainRecord())
+	c.AddCommand(NewChainGraph())
 
 	return c
 }
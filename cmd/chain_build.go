@@ -2,15 +2,16 @@ package starportcmd
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/pkg/chaincmd"
-	"github.com/tendermint/starport/starport/services/chain"
+	"github.com/trino-network/trino/pkg/chaincmd"
+	"github.com/trino-network/trino/pkg/cliprogress"
+	"github.com/trino-network/trino/services/chain"
 )
 
 const (
-	flagOutput         = "output"
 	flagRelease        = "release"
 	flagReleaseTargets = "release.targets"
 	flagReleasePrefix  = "release.prefix"
@@ -41,7 +42,7 @@ Sample usages:
 	c.Flags().Bool(flagRelease, false, "build for a release")
 	c.Flags().StringSliceP(flagReleaseTargets, "t", []string{}, "release targets. Available only with --release flag")
 	c.Flags().String(flagReleasePrefix, "", "tarball prefix for each release target. Available only with --release flag")
-	c.Flags().StringP(flagOutput, "o", "", "binary output path")
+	addOutputPathFlag(c, flagOutputDir, "", "binary output path")
 	c.Flags().BoolP("verbose", "v", false, "Verbose output")
 
 	return c
@@ -52,7 +53,7 @@ func chainBuildHandler(cmd *cobra.Command, args []string) error {
 		isRelease, _      = cmd.Flags().GetBool(flagRelease)
 		releaseTargets, _ = cmd.Flags().GetStringSlice(flagReleaseTargets)
 		releasePrefix, _  = cmd.Flags().GetString(flagReleasePrefix)
-		output, _         = cmd.Flags().GetString(flagOutput)
+		output            = getOutputPath(cmd)
 	)
 
 	chainOption := []chain.Option{
@@ -64,6 +65,15 @@ func chainBuildHandler(cmd *cobra.Command, args []string) error {
 		chainOption = append(chainOption, chain.EnableThirdPartyModuleCodegen())
 	}
 
+	// builds generate code, install dependencies and compile, releases have a compilation for
+	// each target.
+	steps := 3
+	if isRelease {
+		steps = 0
+	}
+	progress := cliprogress.New(os.Stdout, steps)
+	chainOption = append(chainOption, chain.WithProgress(progress))
+
 	c, err := newChainWithHomeFlags(cmd, chainOption...)
 	if err != nil {
 		return err
@@ -72,8 +82,10 @@ func chainBuildHandler(cmd *cobra.Command, args []string) error {
 	if isRelease {
 		releasePath, err := c.BuildRelease(cmd.Context(), output, releasePrefix, releaseTargets...)
 		if err != nil {
+			progress.Fail()
 			return err
 		}
+		progress.Stop()
 
 		fmt.Printf("🗃  Release created: %s\n", infoColor(releasePath))
 
@@ -82,8 +94,10 @@ func chainBuildHandler(cmd *cobra.Command, args []string) error {
 
 	binaryName, err := c.Build(cmd.Context(), output)
 	if err != nil {
+		progress.Fail()
 		return err
 	}
+	progress.Stop()
 
 	if output == "" {
 		fmt.Printf("🗃  Installed. Use with: %s\n", infoColor(binaryName))
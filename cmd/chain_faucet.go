@@ -1,25 +1,37 @@
 package starportcmd
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/pkg/chaincmd"
 	"github.com/tendermint/starport/starport/pkg/cosmoscoin"
-	"github.com/tendermint/starport/starport/services/chain"
+	"github.com/trino-network/trino/pkg/chaincmd"
+	"github.com/trino-network/trino/services/chain"
 )
 
+const flagFeeGrant = "fee-grant"
+
 // NewChainFaucet creates a new faucet command to send coins to accounts.
 func NewChainFaucet() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "faucet [address] [coin<,...>]",
 		Short: "Send coins to an account",
-		Args:  cobra.ExactArgs(2),
-		RunE:  chainFaucetHandler,
+		Long: `Send coins to an account.
+
+When a network is selected with --network, amounts without a denom are sent in the network's denom.
+
+Use --fee-grant to issue the fee allowance configured in faucet.fee_grant of config.yml,
+coins are optional in that case.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: chainFaucetHandler,
 	}
 
 	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetNetwork())
+	c.Flags().Bool(flagFeeGrant, false, "Issue a fee allowance to the account")
 	c.Flags().BoolP("verbose", "v", false, "Verbose output")
 
 	return c
@@ -27,10 +39,18 @@ func NewChainFaucet() *cobra.Command {
 
 func chainFaucetHandler(cmd *cobra.Command, args []string) error {
 	var (
-		toAddress = args[0]
-		coins     = args[1]
+		toAddress     = args[0]
+		isFeeGrant, _ = cmd.Flags().GetBool(flagFeeGrant)
+		coins         string
 	)
 
+	if len(args) > 1 {
+		coins = args[1]
+	}
+	if coins == "" && !isFeeGrant {
+		return errors.New("coins are required unless --fee-grant is used")
+	}
+
 	chainOption := []chain.Option{
 		chain.LogLevel(logLevel(cmd)),
 		chain.KeyringBackend(chaincmd.KeyringBackendTest),
@@ -46,7 +66,29 @@ func chainFaucetHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if isFeeGrant {
+		if err := faucet.Grant(cmd.Context(), toAddress); err != nil {
+			return err
+		}
+
+		fmt.Println("🎟  Fee allowance granted.")
+	}
+
+	if coins == "" {
+		return nil
+	}
+
+	network, _, err := c.Network()
+	if err != nil {
+		return err
+	}
+
 	for _, coin := range strings.Split(coins, ",") {
+		// amounts without a denom are sent in the network's denom.
+		if _, err := strconv.ParseUint(coin, 10, 64); err == nil && network.Denom != "" {
+			coin += network.Denom
+		}
+
 		amount, denom, err := cosmoscoin.Parse(coin)
 		if err != nil {
 			return fmt.Errorf("%s: %s", err, coin)
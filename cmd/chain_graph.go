@@ -0,0 +1,79 @@
+package starportcmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/trino-network/trino/pkg/modulegraph"
+)
+
+const flagGraphFormat = "format"
+
+// NewChainGraph creates a new command to graph the dependencies between the modules of a chain.
+func NewChainGraph() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "graph",
+		Short: "Graph the dependencies between the modules and keepers of a chain",
+		Long: `Graph the dependencies between the modules and keepers of a chain in the DOT or Mermaid format.
+
+The graph is read from the app package of the chain: a keeper depends on the keepers that are
+passed to its constructor, to its app module and on the keepers whose staking hooks are set on it.
+The keepers of the modules of the chain are in bold.
+
+The dependencies that are scaffolded with --dep but still expected with an empty interface in
+types/expected_keepers.go are marked as unused, and the keepers that depend on each other are
+reported as cycles, so couplings can be removed before they become migration problems.`,
+		Example: `starport chain graph | dot -Tsvg > modules.svg
+starport chain graph --format mermaid -o modules.mmd`,
+		Args:        cobra.NoArgs,
+		RunE:        chainGraphHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().String(flagGraphFormat, string(modulegraph.FormatDOT), "Format of the graph: dot or mermaid")
+	c.Flags().StringP(flagOutputDocument, "o", "", "Write to the file instead of stdout")
+
+	return c
+}
+
+func chainGraphHandler(cmd *cobra.Command, args []string) error {
+	var (
+		format, _ = cmd.Flags().GetString(flagGraphFormat)
+		path, _   = cmd.Flags().GetString(flagOutputDocument)
+	)
+
+	g, err := modulegraph.Analyze(flagGetPath(cmd))
+	if err != nil {
+		return err
+	}
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, g)
+	}
+
+	var b bytes.Buffer
+	if err := modulegraph.Render(&b, g, modulegraph.Format(format)); err != nil {
+		return err
+	}
+	if path == "" {
+		fmt.Print(b.String())
+	} else if err := os.WriteFile(path, b.Bytes(), 0644); err != nil {
+		return err
+	}
+
+	// the warnings are printed to stderr so the graph can be piped.
+	for _, cycle := range g.Cycles {
+		fmt.Fprintf(os.Stderr, "⚠️  Cycle: %s depend on each other\n", strings.Join(cycle, ", "))
+	}
+	for _, e := range g.Edges {
+		if e.Unused {
+			fmt.Fprintf(os.Stderr, "⚠️  Unused: %s depends on %s but its expected keeper has no methods\n", e.From, e.To)
+		}
+	}
+	if path != "" {
+		fmt.Fprintf(os.Stderr, "Written to %s\n", path)
+	}
+	return nil
+}
@@ -4,8 +4,8 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/pkg/chaincmd"
-	"github.com/tendermint/starport/starport/services/chain"
+	"github.com/trino-network/trino/pkg/chaincmd"
+	"github.com/trino-network/trino/services/chain"
 )
 
 func NewChainInit() *cobra.Command {
@@ -0,0 +1,169 @@
+package starportcmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/trino-network/trino/pkg/explorer"
+	"github.com/trino-network/trino/pkg/txreplay"
+)
+
+const (
+	flagFromHeight = "from-height"
+	flagToHeight   = "to-height"
+)
+
+// NewChainRecord creates a new command to record the txs of a served chain as a Go test.
+func NewChainRecord() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "record [name]",
+		Short: "Record the txs sent to a served chain as a Go test that replays them",
+		Long: `Record the txs sent to a chain served with "starport chain serve" as a Go test that replays them.
+
+Txs are recorded from the next block until Enter is pressed, or from the indexed blocks between
+--from-height and --to-height. The test is written into tests/[name]_test.go: it replays the txs
+in an in-process network of the chain and checks that they have the same results and emit the
+same events, so manual testing becomes a regression test. Run it with "go test ./tests".
+
+The signers of the txs are replaced by new accounts that are funded with the recorded denoms, and
+the validator of the served chain by the validator of the network.`,
+		Example: `starport chain record bank-send
+starport chain record create-post --from-height 12 --to-height 20`,
+		Args:        cobra.ExactArgs(1),
+		RunE:        chainRecordHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().Int64(flagFromHeight, 0, "Record the txs of the indexed blocks from this height instead of waiting for new ones")
+	c.Flags().Int64(flagToHeight, 0, "Record the txs of the indexed blocks up to this height (default: the last indexed block)")
+	c.Flags().Bool(flagForce, false, "Overwrite the test of the recording if it exists")
+
+	return c
+}
+
+// chainRecordResult is the result of chain record in the json and yaml outputs.
+type chainRecordResult struct {
+	Path       string `json:"path"`
+	FromHeight int64  `json:"from_height"`
+	ToHeight   int64  `json:"to_height"`
+	Txs        int    `json:"txs"`
+}
+
+func chainRecordHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name     = args[0]
+		from, _  = cmd.Flags().GetInt64(flagFromHeight)
+		to, _    = cmd.Flags().GetInt64(flagToHeight)
+		force, _ = cmd.Flags().GetBool(flagForce)
+		ctx      = cmd.Context()
+	)
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	path, err := c.ReplayTestPath(name)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(path); err == nil && !force {
+		return fmt.Errorf("%s exists, use --%s to overwrite it", path, flagForce)
+	}
+
+	store, err := c.Explorer()
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	last, err := store.LastHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !cmd.Flags().Changed(flagFromHeight):
+		from = last + 1
+		if to, err = recordTxs(ctx, cmd, store, from); err != nil {
+			return err
+		}
+	case !cmd.Flags().Changed(flagToHeight):
+		to = last
+	}
+
+	txs, err := store.Range(ctx, from, to)
+	if err != nil {
+		return err
+	}
+	if path, err = c.WriteReplayTest(name, from, to, txs); err != nil {
+		return err
+	}
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, chainRecordResult{
+			Path:       path,
+			FromHeight: from,
+			ToHeight:   to,
+			Txs:        len(txs),
+		})
+	}
+
+	recorded := fmt.Sprintf("%d txs are", len(txs))
+	if len(txs) == 1 {
+		recorded = "1 tx is"
+	}
+	fmt.Printf("🧪 %s recorded into %s, run the test with go test ./%s in the chain\n", recorded, path, txreplay.Dir)
+	return nil
+}
+
+// recordTxs prints the txs of the blocks from height from as they're indexed, until Enter is
+// pressed. the height of the last indexed block is returned.
+func recordTxs(ctx context.Context, cmd *cobra.Command, store *explorer.Store, from int64) (to int64, err error) {
+	stop := make(chan struct{})
+	go func() {
+		bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+		close(stop)
+	}()
+
+	if !isStructuredOutput(cmd) {
+		fmt.Printf("⏺  Recording the txs sent to the chain from block %d, press Enter to stop\n", from)
+	}
+
+	ticker := time.NewTicker(explorer.DefaultInterval)
+	defer ticker.Stop()
+
+	next := from
+	for {
+		last, err := store.LastHeight(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if last >= next {
+			txs, err := store.Range(ctx, next, last)
+			if err != nil {
+				return 0, err
+			}
+			if !isStructuredOutput(cmd) {
+				for _, tx := range txs {
+					fmt.Printf("  %s %s\n", tx.Hash, strings.Join(tx.Messages, ", "))
+				}
+			}
+			next = last + 1
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-stop:
+			return store.LastHeight(ctx)
+		case <-ticker.C:
+		}
+	}
+}
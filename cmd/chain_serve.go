@@ -1,32 +1,45 @@
 package starportcmd
 
 import (
+	"context"
+	"errors"
+	"os"
+
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/services/chain"
+	"github.com/trino-network/trino/pkg/cliterm"
+	"github.com/trino-network/trino/pkg/servehook"
+	"github.com/trino-network/trino/services/chain"
 )
 
 const (
 	flagForceReset = "force-reset"
 	flagResetOnce  = "reset-once"
 	flagConfig     = "config"
+	flagTUI        = "tui"
+	flagMetrics    = "metrics"
 )
 
 // NewChainServe creates a new serve command to serve a blockchain.
 func NewChainServe() *cobra.Command {
 	c := &cobra.Command{
-		Use:   "serve",
-		Short: "Start a blockchain node in development",
-		Long:  "Start a blockchain node with automatic reloading",
-		Args:  cobra.ExactArgs(0),
-		RunE:  chainServeHandler,
+		Use:         "serve",
+		Short:       "Start a blockchain node in development",
+		Long:        "Start a blockchain node with automatic reloading",
+		Args:        cobra.ExactArgs(0),
+		RunE:        chainServeHandler,
+		Annotations: resultAnnotations(),
 	}
 
 	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetNetwork())
 	c.Flags().AddFlagSet(flagSetProto3rdParty(""))
 	c.Flags().BoolP("verbose", "v", false, "Verbose output")
 	c.Flags().BoolP(flagForceReset, "f", false, "Force reset of the app state on start and every source change")
 	c.Flags().BoolP(flagResetOnce, "r", false, "Reset of the app state on first start")
 	c.Flags().StringP(flagConfig, "c", "", "Starport config file (default: ./config.yml)")
+	c.Flags().Bool(flagNoHooks, false, "Do not notify the serve hooks of config.yml and of plugins")
+	c.Flags().Bool(flagTUI, false, "Show a dashboard of the build status, endpoints, recent blocks, faucet transfers and logs of the chain")
+	c.Flags().Bool(flagMetrics, false, "Serve the Prometheus metrics of the node, export a Grafana dashboard of them and print summaries of the activity of the chain")
 
 	return c
 }
@@ -49,6 +62,22 @@ func chainServeHandler(cmd *cobra.Command, args []string) error {
 		chainOption = append(chainOption, chain.ConfigFile(config))
 	}
 
+	// the dashboard shows the logs of the node, the output of serve is redirected to it before
+	// the chain is created.
+	var dashboard *serveDashboard
+	if tui, _ := cmd.Flags().GetBool(flagTUI); tui {
+		if isStructuredOutput(cmd) {
+			return errors.New("--tui cannot be used with the json and yaml outputs")
+		}
+		if !cliterm.IsTerminal(os.Stdout) {
+			return errors.New("--tui requires a terminal")
+		}
+		if dashboard, err = newServeDashboard(); err != nil {
+			return err
+		}
+		chainOption = append(chainOption, chain.LogLevel(chain.LogVerbose))
+	}
+
 	// create the chain
 	c, err := newChainWithHomeFlags(cmd, chainOption...)
 	if err != nil {
@@ -72,5 +101,30 @@ func chainServeHandler(cmd *cobra.Command, args []string) error {
 		serveOptions = append(serveOptions, chain.ServeResetOnce())
 	}
 
+	if metrics, _ := cmd.Flags().GetBool(flagMetrics); metrics {
+		serveOptions = append(serveOptions, chain.ServeMetrics())
+	}
+
+	if noHooks, _ := cmd.Flags().GetBool(flagNoHooks); !noHooks {
+		hooks, closeHooks, err := getServeHooks(cmd.Context(), c, flagGetPath(cmd))
+		if err != nil {
+			return err
+		}
+		defer closeHooks()
+		serveOptions = append(serveOptions, chain.ServeHooks(hooks...))
+	}
+
+	// the events are printed as the status of the chain in the json and yaml outputs.
+	if isStructuredOutput(cmd) {
+		serveOptions = append(serveOptions, chain.ServeHooks(servehook.HookFunc(
+			func(_ context.Context, event servehook.Event) error {
+				return printResult(cmd, event)
+			},
+		)))
+	}
+
+	if dashboard != nil {
+		return dashboard.run(cmd.Context(), c, serveOptions...)
+	}
 	return c.Serve(cmd.Context(), serveOptions...)
 }
@@ -0,0 +1,281 @@
+package starportcmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/fatih/color"
+	"github.com/tendermint/starport/starport/pkg/xurl"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	conf "github.com/trino-network/trino/chainconf"
+	"github.com/trino-network/trino/pkg/cliterm"
+	"github.com/trino-network/trino/pkg/cosmosfaucet"
+	"github.com/trino-network/trino/pkg/servehook"
+	"github.com/trino-network/trino/services/chain"
+)
+
+const (
+	// dashboardRefreshRate is how often the recent blocks are fetched from the node.
+	dashboardRefreshRate = time.Second * 2
+
+	// dashboardBlocks and dashboardTransfers are the number of recent blocks and faucet
+	// transfers that are shown.
+	dashboardBlocks    = 8
+	dashboardTransfers = 8
+
+	// dashboardLogs is the number of log lines that are kept.
+	dashboardLogs = 1000
+)
+
+// serveDashboard is the terminal UI of chain serve with panes for the build status, the
+// endpoints, the recent blocks and txs, the faucet activity and the logs of the chain. the
+// output of serve is shown in the logs pane while the dashboard is drawn on the terminal.
+type serveDashboard struct {
+	msgs chan tea.Msg
+	done chan struct{}
+
+	// terminal, stderr and colorOutput are the outputs that serve is started with, the output
+	// of the chain is shown in the logs pane instead while the dashboard runs.
+	terminal, stderr *os.File
+	colorOutput      io.Writer
+
+	mu  sync.Mutex
+	rpc string
+}
+
+// newServeDashboard creates a dashboard and redirects the output of serve to its logs pane,
+// the chain must be created afterwards so its logs are redirected too.
+func newServeDashboard() (*serveDashboard, error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+
+	d := &serveDashboard{
+		msgs:        make(chan tea.Msg, 100),
+		done:        make(chan struct{}),
+		terminal:    os.Stdout,
+		stderr:      os.Stderr,
+		colorOutput: color.Output,
+	}
+
+	os.Stdout, os.Stderr = w, w
+	plainOutput(w)
+
+	go d.readLogs(r)
+	return d, nil
+}
+
+// run serves c with options and draws the dashboard until it's quit or serving fails.
+func (d *serveDashboard) run(ctx context.Context, c *chain.Chain, options ...chain.ServeOption) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	options = append(options,
+		chain.ServeHooks(servehook.HookFunc(func(_ context.Context, event servehook.Event) error {
+			d.notify(c, event)
+			return nil
+		})),
+		chain.ServeFaucetTransfers(d.faucetTransfers),
+	)
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- c.Serve(ctx, options...)
+		d.send(serveDoneMsg{})
+	}()
+	go d.pollBlocks(ctx)
+
+	chainID, _ := c.ID()
+	p := tea.NewProgram(newServeModel(chainID, c, d.msgs), tea.WithOutput(d.terminal))
+	p.EnterAltScreen()
+	uiErr := p.Start()
+
+	// the logs of the chain while it stops, such as the saving of its state, are written to
+	// the terminal.
+	close(d.done)
+	os.Stdout, os.Stderr = d.terminal, d.stderr
+	color.Output = d.colorOutput
+	cancel()
+
+	if err := <-serveErr; err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+	return uiErr
+}
+
+// send sends msg to the dashboard unless it's quit.
+func (d *serveDashboard) send(msg tea.Msg) {
+	select {
+	case d.msgs <- msg:
+	case <-d.done:
+	}
+}
+
+// readLogs sends the lines of the output of serve to the logs pane, and writes them to the
+// terminal once the dashboard is quit.
+func (d *serveDashboard) readLogs(r *os.File) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := strings.ReplaceAll(cliterm.Strip(scanner.Text()), "\t", "    ")
+
+		select {
+		case <-d.done:
+			fmt.Fprintln(d.terminal, line)
+		default:
+			d.send(logMsg(line))
+		}
+	}
+}
+
+// notify sends the serve events to the dashboard, with the endpoints of the chain once it's
+// started.
+func (d *serveDashboard) notify(c *chain.Chain, event servehook.Event) {
+	msg := serveEventMsg{event: event}
+
+	if event.Type == servehook.EventChainStarted {
+		d.mu.Lock()
+		d.rpc = event.RPC
+		d.mu.Unlock()
+
+		msg.endpoints = []endpoint{
+			{"Tendermint node", event.RPC},
+			{"Blockchain API", event.API},
+		}
+		if config, err := c.Config(); err == nil {
+			msg.endpoints = append(msg.endpoints, endpoint{"gRPC-web", xurl.HTTP(config.Host.GRPCWeb)})
+			if config.Faucet.Name != nil {
+				msg.endpoints = append(msg.endpoints, endpoint{"Token faucet", xurl.HTTP(conf.FaucetHost(config))})
+			}
+		}
+	}
+
+	d.send(msg)
+}
+
+func (d *serveDashboard) faucetTransfers(address string, transfers []cosmosfaucet.Transfer) {
+	d.send(faucetMsg{
+		time:      time.Now(),
+		address:   address,
+		transfers: transfers,
+	})
+}
+
+// pollBlocks sends the recent blocks of the node to the dashboard until ctx is done.
+func (d *serveDashboard) pollBlocks(ctx context.Context) {
+	ticker := time.NewTicker(dashboardRefreshRate)
+	defer ticker.Stop()
+
+	var (
+		rpc    string
+		client *rpchttp.HTTP
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		d.mu.Lock()
+		address := d.rpc
+		d.mu.Unlock()
+		if address == "" {
+			continue
+		}
+
+		if client == nil || address != rpc {
+			var err error
+			if client, err = rpchttp.New(address, "/websocket"); err != nil {
+				d.send(blocksMsg{err: err})
+				continue
+			}
+			rpc = address
+		}
+
+		blocks, err := recentBlocks(ctx, client)
+		if ctx.Err() != nil {
+			return
+		}
+		d.send(blocksMsg{blocks: blocks, err: err})
+	}
+}
+
+// recentBlocks returns the recent blocks of the node with the hashes of their txs, the latest
+// first.
+func recentBlocks(ctx context.Context, client *rpchttp.HTTP) ([]blockInfo, error) {
+	status, err := client.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := status.SyncInfo.LatestBlockHeight
+	first := latest - dashboardBlocks + 1
+	if first < 1 {
+		first = 1
+	}
+	info, err := client.BlockchainInfo(ctx, first, latest)
+	if err != nil {
+		return nil, err
+	}
+
+	var blocks []blockInfo
+	for _, meta := range info.BlockMetas {
+		block := blockInfo{
+			height: meta.Header.Height,
+			time:   meta.Header.Time,
+			txs:    meta.NumTxs,
+		}
+		if meta.NumTxs > 0 {
+			height := meta.Header.Height
+			result, err := client.Block(ctx, &height)
+			if err != nil {
+				return nil, err
+			}
+			for _, tx := range result.Block.Txs {
+				block.hashes = append(block.hashes, fmt.Sprintf("%X", tx.Hash()))
+			}
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+type (
+	serveEventMsg struct {
+		event     servehook.Event
+		endpoints []endpoint
+	}
+	logMsg    string
+	faucetMsg struct {
+		time      time.Time
+		address   string
+		transfers []cosmosfaucet.Transfer
+	}
+	blocksMsg struct {
+		blocks []blockInfo
+		err    error
+	}
+	serveDoneMsg struct{}
+)
+
+type endpoint struct {
+	name, address string
+}
+
+type blockInfo struct {
+	height int64
+	time   time.Time
+	txs    int
+	hashes []string
+}
@@ -0,0 +1,162 @@
+package starportcmd
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/trino-network/trino/pkg/cliterm"
+	"github.com/trino-network/trino/pkg/servehook"
+)
+
+// serveController is the chain that's served, the dashboard rebuilds it and resets its state.
+type serveController interface {
+	Rebuild()
+	ResetState()
+}
+
+// serveModel is the state of the dashboard, it's updated by the messages of serve and the keys
+// that are pressed, and drawn by View.
+type serveModel struct {
+	chainID string
+	chain   serveController
+	msgs    <-chan tea.Msg
+
+	width, height int
+
+	status    string
+	endpoints []endpoint
+	blocks    []blockInfo
+	blocksErr error
+	transfers []faucetMsg
+	logs      []string
+}
+
+func newServeModel(chainID string, c serveController, msgs <-chan tea.Msg) serveModel {
+	return serveModel{
+		chainID: chainID,
+		chain:   c,
+		msgs:    msgs,
+		status:  "Starting...",
+	}
+}
+
+func (m serveModel) Init() tea.Cmd {
+	return m.waitForMsg
+}
+
+// waitForMsg waits for the next message from serve.
+func (m serveModel) waitForMsg() tea.Msg {
+	return <-m.msgs
+}
+
+func (m serveModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+
+	case tea.KeyMsg:
+		return m.updateKey(msg.String())
+
+	case serveEventMsg:
+		m.updateEvent(msg)
+
+	case logMsg:
+		m.addLog(string(msg))
+
+	case faucetMsg:
+		m.addTransfer(msg)
+
+	case blocksMsg:
+		m.updateBlocks(msg)
+
+	case serveDoneMsg:
+		return m, tea.Quit
+
+	default:
+		return m, nil
+	}
+
+	// the messages of serve are received one by one, the next one is waited once this one
+	// is handled.
+	return m, m.waitForMsg
+}
+
+// updateKey handles the key that's pressed.
+func (m serveModel) updateKey(key string) (tea.Model, tea.Cmd) {
+	switch key {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "b":
+		m.status = "Rebuilding..."
+		return m, func() tea.Msg {
+			m.chain.Rebuild()
+			return nil
+		}
+	case "r":
+		m.status = "Resetting the state..."
+		return m, func() tea.Msg {
+			m.chain.ResetState()
+			return nil
+		}
+	}
+	return m, nil
+}
+
+// updateEvent sets the status after a serve event, the endpoints are kept until the chain is
+// started again.
+func (m *serveModel) updateEvent(msg serveEventMsg) {
+	m.status = eventStatus(msg.event)
+	if msg.endpoints != nil {
+		m.endpoints = msg.endpoints
+	}
+}
+
+// addLog adds a line to the logs, only the last dashboardLogs lines are kept.
+func (m *serveModel) addLog(line string) {
+	m.logs = append(m.logs, line)
+	if len(m.logs) > dashboardLogs {
+		m.logs = m.logs[len(m.logs)-dashboardLogs:]
+	}
+}
+
+// addTransfer adds the transfers of a faucet request, the latest first, only the last
+// dashboardTransfers requests are kept.
+func (m *serveModel) addTransfer(msg faucetMsg) {
+	m.transfers = append([]faucetMsg{msg}, m.transfers...)
+	if len(m.transfers) > dashboardTransfers {
+		m.transfers = m.transfers[:dashboardTransfers]
+	}
+}
+
+// updateBlocks sets the recent blocks, the previous blocks are kept while the node is not
+// reachable.
+func (m *serveModel) updateBlocks(msg blocksMsg) {
+	m.blocksErr = msg.err
+	if msg.err == nil {
+		m.blocks = msg.blocks
+	}
+}
+
+// eventStatus returns the build status of the chain after event.
+func eventStatus(event servehook.Event) string {
+	at := event.Time.Format(cliterm.TimeFormat)
+
+	switch event.Type {
+	case servehook.EventBuildStarted:
+		return "🛠️  Building..."
+	case servehook.EventBuildFinished:
+		if event.Error != "" {
+			return fmt.Sprintf("✘ Build failed at %s: %s", at, strings.Join(strings.Fields(event.Error), " "))
+		}
+		return fmt.Sprintf("✔ Built at %s", at)
+	case servehook.EventCodegenFinished:
+		return fmt.Sprintf("✔ Code generated at %s", at)
+	case servehook.EventStateReset:
+		return fmt.Sprintf("🔄 State reset at %s", at)
+	case servehook.EventChainStarted:
+		return fmt.Sprintf("🌍 Started at %s", at)
+	}
+	return event.Text()
+}
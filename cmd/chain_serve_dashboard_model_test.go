@@ -0,0 +1,100 @@
+package starportcmd
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/require"
+	"github.com/trino-network/trino/pkg/cosmosfaucet"
+	"github.com/trino-network/trino/pkg/servehook"
+)
+
+type fakeServeController struct {
+	rebuilds, resets int
+}
+
+func (c *fakeServeController) Rebuild()    { c.rebuilds++ }
+func (c *fakeServeController) ResetState() { c.resets++ }
+
+// update updates m with msg and returns the model and the cmd it returns.
+func update(t *testing.T, m serveModel, msg tea.Msg) (serveModel, tea.Cmd) {
+	model, cmd := m.Update(msg)
+	require.IsType(t, serveModel{}, model)
+	return model.(serveModel), cmd
+}
+
+func TestServeModelKeys(t *testing.T) {
+	var c fakeServeController
+	m := newServeModel("mars", &c, nil)
+	require.Equal(t, "Starting...", m.status)
+
+	m, cmd := update(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	require.Equal(t, "Rebuilding...", m.status)
+	require.Nil(t, cmd())
+	require.Equal(t, 1, c.rebuilds)
+
+	m, cmd = update(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	require.Equal(t, "Resetting the state...", m.status)
+	require.Nil(t, cmd())
+	require.Equal(t, 1, c.resets)
+
+	_, cmd = update(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	require.Nil(t, cmd)
+
+	for _, key := range []tea.KeyMsg{{Type: tea.KeyRunes, Runes: []rune("q")}, {Type: tea.KeyCtrlC}} {
+		_, cmd = update(t, m, key)
+		require.Equal(t, tea.Quit(), cmd())
+	}
+}
+
+func TestServeModelMessages(t *testing.T) {
+	msgs := make(chan tea.Msg, 1)
+	m := newServeModel("mars", &fakeServeController{}, msgs)
+
+	m, cmd := update(t, m, tea.WindowSizeMsg{Width: 80, Height: 24})
+	require.Equal(t, 80, m.width)
+	require.Equal(t, 24, m.height)
+	require.Nil(t, cmd)
+
+	// the next message of serve is waited once a message of serve is handled.
+	at := time.Date(2021, 11, 4, 10, 30, 0, 0, time.Local)
+	m, cmd = update(t, m, serveEventMsg{
+		event:     servehook.Event{Type: servehook.EventChainStarted, Time: at},
+		endpoints: []endpoint{{"Tendermint node", "http://0.0.0.0:26657"}},
+	})
+	require.Equal(t, "🌍 Started at 10:30:00", m.status)
+	require.Len(t, m.endpoints, 1)
+	msgs <- serveDoneMsg{}
+	require.Equal(t, serveDoneMsg{}, cmd())
+
+	m, _ = update(t, m, serveEventMsg{event: servehook.Event{Type: servehook.EventBuildFinished, Time: at, Error: "cannot\n  build"}})
+	require.Equal(t, "✘ Build failed at 10:30:00: cannot build", m.status)
+	require.Len(t, m.endpoints, 1)
+
+	m, _ = update(t, m, blocksMsg{blocks: []blockInfo{{height: 2}, {height: 1}}})
+	require.Len(t, m.blocks, 2)
+	require.NoError(t, m.blocksErr)
+
+	m, _ = update(t, m, blocksMsg{err: errors.New("connection refused")})
+	require.Len(t, m.blocks, 2)
+	require.Error(t, m.blocksErr)
+
+	for i := 0; i < dashboardTransfers+2; i++ {
+		m, _ = update(t, m, faucetMsg{address: fmt.Sprintf("cosmos1%d", i), transfers: []cosmosfaucet.Transfer{{Coin: "5token"}}})
+	}
+	require.Len(t, m.transfers, dashboardTransfers)
+	require.Equal(t, fmt.Sprintf("cosmos1%d", dashboardTransfers+1), m.transfers[0].address)
+
+	for i := 0; i < dashboardLogs+5; i++ {
+		m, _ = update(t, m, logMsg(fmt.Sprint(i)))
+	}
+	require.Len(t, m.logs, dashboardLogs)
+	require.Equal(t, "5", m.logs[0])
+	require.Equal(t, fmt.Sprint(dashboardLogs+4), m.logs[dashboardLogs-1])
+
+	_, cmd = update(t, m, serveDoneMsg{})
+	require.Equal(t, tea.Quit(), cmd())
+}
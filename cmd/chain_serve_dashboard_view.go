@@ -0,0 +1,118 @@
+package starportcmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/trino-network/trino/pkg/cliterm"
+)
+
+// View draws the state of the dashboard to fill the terminal.
+func (m serveModel) View() string {
+	if m.width == 0 {
+		return ""
+	}
+
+	var lines []string
+	lines = append(lines, spread(
+		fmt.Sprintf(" %s · %s", m.chainID, m.status),
+		"b rebuild · r reset · q quit ",
+		m.width,
+	))
+
+	lines = append(lines, paneTitle("Endpoints", m.width))
+	if len(m.endpoints) == 0 {
+		lines = append(lines, " Waiting for the chain to start...")
+	}
+	for _, e := range m.endpoints {
+		lines = append(lines, fmt.Sprintf(" %-16s %s", e.name, e.address))
+	}
+
+	half := m.width / 2
+	lines = append(lines, paneTitle("Blocks", half)+paneTitle("Faucet", m.width-half))
+	blocks, transfers := m.blockLines(), m.transferLines()
+	for i := 0; i < dashboardBlocks; i++ {
+		var block, transfer string
+		if i < len(blocks) {
+			block = blocks[i]
+		}
+		if i < len(transfers) {
+			transfer = transfers[i]
+		}
+		lines = append(lines, fit(block, half)+transfer)
+	}
+
+	lines = append(lines, paneTitle("Logs", m.width))
+	if rows := m.height - len(lines); rows > 0 {
+		logs := m.logs
+		if len(logs) > rows {
+			logs = logs[len(logs)-rows:]
+		}
+		for _, line := range logs {
+			lines = append(lines, " "+line)
+		}
+	}
+
+	for i, line := range lines {
+		lines[i] = fit(line, m.width)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// blockLines returns the lines of the blocks pane, the txs of each block are listed after it.
+func (m serveModel) blockLines() []string {
+	if m.blocksErr != nil {
+		return []string{" Node is not reachable"}
+	}
+	if len(m.blocks) == 0 {
+		return []string{" No blocks yet"}
+	}
+
+	var lines []string
+	for _, block := range m.blocks {
+		lines = append(lines, fmt.Sprintf(" #%d  %s  %d txs", block.height, block.time.Local().Format(cliterm.TimeFormat), block.txs))
+		for _, hash := range block.hashes {
+			lines = append(lines, "   ↳ "+hash)
+		}
+	}
+	return lines
+}
+
+// transferLines returns the lines of the faucet pane.
+func (m serveModel) transferLines() []string {
+	if len(m.transfers) == 0 {
+		return []string{" No transfers yet"}
+	}
+
+	var lines []string
+	for _, msg := range m.transfers {
+		for _, t := range msg.transfers {
+			mark := "✔"
+			if t.Error != "" {
+				mark = "✘"
+			}
+			lines = append(lines, fmt.Sprintf(" %s %s %s → %s", msg.time.Format(cliterm.TimeFormat), mark, t.Coin, msg.address))
+		}
+	}
+	return lines
+}
+
+// paneTitle returns the title line of a pane that's width wide.
+func paneTitle(title string, width int) string {
+	return runewidth.Truncate("── "+title+" "+strings.Repeat("─", width), width, "")
+}
+
+// spread returns left and right at both ends of a line that's width wide.
+func spread(left, right string, width int) string {
+	space := width - runewidth.StringWidth(left) - runewidth.StringWidth(right)
+	if space < 1 {
+		return left
+	}
+	return left + strings.Repeat(" ", space) + right
+}
+
+// fit truncates or pads s to be width wide.
+func fit(s string, width int) string {
+	return runewidth.FillRight(runewidth.Truncate(s, width, "…"), width)
+}
@@ -3,6 +3,7 @@ package starportcmd
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"sort"
@@ -10,33 +11,50 @@ import (
 	"time"
 
 	"github.com/fatih/color"
+	gookitcolor "github.com/gookit/color"
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
-	"github.com/trino-network/trino/internal/version"
 	"github.com/tendermint/starport/starport/pkg/cosmosver"
 	"github.com/tendermint/starport/starport/pkg/gitpod"
 	"github.com/tendermint/starport/starport/pkg/goenv"
+	"github.com/tendermint/starport/starport/pkg/xfilepath"
 	"github.com/tendermint/starport/starport/pkg/xgenny"
-	"github.com/tendermint/starport/starport/services/chain"
 	"github.com/tendermint/starport/starport/services/scaffolder"
+	conf "github.com/trino-network/trino/chainconf"
+	"github.com/trino-network/trino/internal/version"
+	"github.com/trino-network/trino/pkg/cliquiz"
+	"github.com/trino-network/trino/pkg/cliterm"
+	"github.com/trino-network/trino/pkg/cmdtrace"
+	"github.com/trino-network/trino/pkg/toolchain"
+	"github.com/trino-network/trino/services/chain"
 )
 
 const (
 	flagPath          = "path"
 	flagHome          = "home"
+	flagNetwork       = "network"
 	flagProto3rdParty = "proto-all-modules"
+	flagAnswers       = "answers"
+	flagYes           = "yes"
+	flagQuiet         = "quiet"
+	flagTrace         = "trace"
+	flagOffline       = "offline"
 
 	checkVersionTimeout = time.Millisecond * 600
 )
 
 var infoColor = color.New(color.FgYellow).SprintFunc()
 
+// tracePath is the trace file of the external commands that are traced with --trace.
+var tracePath = xfilepath.JoinFromHome(
+	xfilepath.Path(".starport"),
+	xfilepath.Path("trace.log"),
+)
+
 // New creates a new root command for `starport` with its sub commands.
 func New(ctx context.Context) *cobra.Command {
 	cobra.EnableCommandSorting = false
 
-	checkNewVersion(ctx)
-
 	c := &cobra.Command{
 		Use:   "starport",
 		Short: "Starport offers everything you need to scaffold, test, build, and launch your blockchain",
@@ -50,23 +68,120 @@ starport scaffold chain github.com/cosmonaut/mars`,
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-			return goenv.ConfigurePath()
+			// plain output is set up first, --quiet and the json and yaml outputs discard it.
+			setPlainOutput()
+			if err := setQuiet(cmd); err != nil {
+				return err
+			}
+			if err := setOutput(cmd); err != nil {
+				return err
+			}
+			// the new version is announced once the output of the command is set up, so
+			// it's not announced with --quiet and in the json and yaml outputs.
+			checkNewVersion(cmd)
+			if err := setTrace(cmd); err != nil {
+				return err
+			}
+			if err := loadAnswers(cmd); err != nil {
+				return err
+			}
+			if yes, _ := cmd.Flags().GetBool(flagYes); yes {
+				cliquiz.AssumeYes()
+			}
+			if err := goenv.ConfigurePath(); err != nil {
+				return err
+			}
+			if err := toolchain.ConfigurePath(); err != nil {
+				return err
+			}
+			return setOffline(cmd)
 		},
 	}
 
+	c.PersistentFlags().String(flagAnswers, "", "YAML file with the answers to the questions of interactive commands, to run them unattended")
+	c.PersistentFlags().BoolP(flagYes, "y", false, "Accept the default answers of all questions and confirmations without asking")
+	c.PersistentFlags().BoolP(flagQuiet, "q", false, "Print errors only, without progress, spinners and emojis")
+	c.PersistentFlags().Bool(flagOffline, false, "Work without network access, from the Go modules, protoc plugins and template packs cached by the cache warm command")
+	c.PersistentFlags().Bool(flagTrace, false, "Trace the external commands, such as protoc, go build and node, with their args, durations and exit statuses into ~/.starport/trace.log")
+
 	c.AddCommand(NewScaffold())
 	c.AddCommand(NewChain())
 	c.AddCommand(NewGenerate())
+	c.AddCommand(NewProto())
 	c.AddCommand(NewAccount())
+	c.AddCommand(NewTx())
+	c.AddCommand(NewWasm())
 	c.AddCommand(NewRelayer())
+	c.AddCommand(NewIBC())
+	c.AddCommand(NewNetwork())
 	c.AddCommand(NewTools())
 	c.AddCommand(NewDocs())
 	c.AddCommand(NewVersion())
+	c.AddCommand(NewEnv())
+	c.AddCommand(NewUpgrade())
+	c.AddCommand(NewTelemetry())
+	c.AddCommand(NewCache())
+	c.AddCommand(NewPlugin())
 	c.AddCommand(deprecated()...)
+	addPluginCommands(c)
+	addOutputFlags(c)
+	addPathFlags(c)
+	registerCompletions(c)
 
 	return c
 }
 
+// loadAnswers loads the answer file set with a flag, the questions of interactive commands are
+// answered from it instead of being asked.
+func loadAnswers(cmd *cobra.Command) error {
+	path, _ := cmd.Flags().GetString(flagAnswers)
+	if path == "" {
+		return nil
+	}
+	return cliquiz.LoadAnswers(path)
+}
+
+// setTrace starts tracing the external commands into the trace file when it's set with a flag.
+func setTrace(cmd *cobra.Command) error {
+	if trace, _ := cmd.Flags().GetBool(flagTrace); !trace {
+		return nil
+	}
+	path, err := tracePath()
+	if err != nil {
+		return err
+	}
+	if err := cmdtrace.Enable(path, cmd.CommandPath()); err != nil {
+		return err
+	}
+	fmt.Printf("📝 Tracing the external commands into %s\n", path)
+	return nil
+}
+
+// setQuiet discards the output of commands and their spinners when it's silenced with a flag,
+// errors and questions are still printed.
+func setQuiet(cmd *cobra.Command) error {
+	if quiet, _ := cmd.Flags().GetBool(flagQuiet); !quiet {
+		return nil
+	}
+	return silence()
+}
+
+// setPlainOutput turns off colors and writes spinners as timestamped log lines when the output
+// is not a terminal or when it runs in CI, so logs are not full of control chars.
+func setPlainOutput() {
+	if cliterm.IsAnimated(os.Stdout) {
+		return
+	}
+	plainOutput(os.Stdout)
+}
+
+// plainOutput turns off colors and writes spinners as timestamped log lines to w.
+func plainOutput(w io.Writer) {
+	color.NoColor = true
+	gookitcolor.Disable()
+	color.Output = cliterm.NewSpinnerLog(w)
+}
+
 func logLevel(cmd *cobra.Command) chain.LogLvl {
 	verbose, _ := cmd.Flags().GetBool("verbose")
 	if verbose {
@@ -75,13 +190,44 @@ func logLevel(cmd *cobra.Command) chain.LogLvl {
 	return chain.LogRegular
 }
 
-func flagSetPath(cmd *cobra.Command) {
-	cmd.PersistentFlags().StringP(flagPath, "p", ".", "path of the app")
+// annotationAppPath marks the path flag that's the path of the chain, rather than the path flags
+// of the commands that write files somewhere else.
+const annotationAppPath = "app-path"
+
+// addPathFlags adds the path of the chain as a flag of c and its sub commands, except to the
+// commands whose path flag is the path of the files that they write.
+func addPathFlags(c *cobra.Command) {
+	if c.Runnable() && !c.DisableFlagParsing && c.Flags().Lookup(flagPath) == nil {
+		c.Flags().StringP(flagPath, "p", ".", "Path of the chain, or of one of its dirs, the nearest chain is found by its config.yml")
+		c.Flags().SetAnnotation(flagPath, annotationAppPath, []string{"true"})
+	}
+	for _, sub := range c.Commands() {
+		addPathFlags(sub)
+	}
 }
 
+// flagGetPath returns the path that's set with a flag. the path of the chain is the path of the
+// nearest chain from the dir that's set, so commands can be run from any dir of a chain and the
+// chains of a monorepo can be picked with a flag.
 func flagGetPath(cmd *cobra.Command) (path string) {
-	path, _ = cmd.Flags().GetString(flagPath)
-	return
+	f := cmd.Flags().Lookup(flagPath)
+	if f == nil {
+		return "."
+	}
+	path = f.Value.String()
+	if f.Annotations[annotationAppPath] == nil {
+		return path
+	}
+	return locateAppPath(path)
+}
+
+// locateAppPath returns the path of the nearest chain from path, path is kept as is out of
+// chains, such as before a chain is scaffolded.
+func locateAppPath(path string) string {
+	if dir, err := conf.LocateDir(path); err == nil {
+		return dir
+	}
+	return path
 }
 
 func flagSetHome() *flag.FlagSet {
@@ -95,6 +241,17 @@ func getHomeFlag(cmd *cobra.Command) (home string) {
 	return
 }
 
+func flagSetNetwork() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagNetwork, "", "Name of the network presets to use from config.yml (e.g. localnet, testnet)")
+	return fs
+}
+
+func flagGetNetwork(cmd *cobra.Command) (network string) {
+	network, _ = cmd.Flags().GetString(flagNetwork)
+	return
+}
+
 func flagSetProto3rdParty(additonalInfo string) *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -118,6 +275,11 @@ func newChainWithHomeFlags(cmd *cobra.Command, chainOption ...chain.Option) (*ch
 		chainOption = append(chainOption, chain.HomePath(home))
 	}
 
+	// Check if network presets are selected
+	if network := flagGetNetwork(cmd); network != "" {
+		chainOption = append(chainOption, chain.Network(network))
+	}
+
 	appPath := flagGetPath(cmd)
 	absPath, err := filepath.Abs(appPath)
 	if err != nil {
@@ -200,12 +362,14 @@ func relativePath(appPath string) (string, error) {
 	return path, nil
 }
 
-func checkNewVersion(ctx context.Context) {
-	if gitpod.IsOnGitpod() {
+func checkNewVersion(cmd *cobra.Command) {
+	// the version and upgrade commands check for the new version themselves, nothing is
+	// checked offline.
+	if gitpod.IsOnGitpod() || version.IsCheckDisabled() || isOffline(cmd) || cmd.Name() == "version" || cmd.Name() == "upgrade" {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, checkVersionTimeout)
+	ctx, cancel := context.WithTimeout(cmd.Context(), checkVersionTimeout)
 	defer cancel()
 
 	isAvailable, next, err := version.CheckNext(ctx)
@@ -216,7 +380,10 @@ func checkNewVersion(ctx context.Context) {
 	fmt.Printf(`·
 · 🛸 Starport %q is available!
 ·
-· If you're looking to upgrade check out the instructions: https://docs.starport.network/guide/install.html#upgrading-your-starport-installation
+· Run "starport upgrade" to install it, or check out the instructions:
+· https://docs.starport.network/guide/install.html#upgrading-your-starport-installation
+·
+· Set STARPORT_NO_UPDATE_CHECK=true to stop checking for new versions.
 ·
 ··
 
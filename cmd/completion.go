@@ -0,0 +1,205 @@
+package starportcmd
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/field"
+	"github.com/tendermint/starport/starport/pkg/gomodulepath"
+	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+// flagCompletions complete the values of flags from the keyring and the app, by flag names.
+var flagCompletions = map[string]func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective){
+	flagModule:        completeModules,
+	flagChain:         completeChains,
+	flagSourceAccount: completeRelayerAccounts,
+	flagTargetAccount: completeRelayerAccounts,
+}
+
+// registerCompletions registers the completions of the flags of c and its sub commands.
+func registerCompletions(c *cobra.Command) {
+	for name, complete := range flagCompletions {
+		if c.Flags().Lookup(name) != nil || c.PersistentFlags().Lookup(name) != nil {
+			c.RegisterFlagCompletionFunc(name, complete)
+		}
+	}
+	for _, sub := range c.Commands() {
+		registerCompletions(sub)
+	}
+}
+
+// completeAccounts completes the name of an account as the first arg, from the keyring and the
+// chain namespace set with flags.
+func completeAccounts(cmd *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	ca, err := cosmosaccount.New(getAccountRegistryOptions(cmd)...)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	accounts, err := ca.List()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	var names []string
+	for _, acc := range accounts {
+		names = append(names, acc.Name)
+	}
+	sort.Strings(names)
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeRelayerAccounts completes the accounts that can be used by the relayer.
+func completeRelayerAccounts(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	ca, err := cosmosaccount.New(cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)))
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	names, err := relayerAccountNames(ca)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeChains completes the chain IDs that have accounts in the keyring.
+func completeChains(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	ca, err := cosmosaccount.New(cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)))
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	namespaces, err := ca.Namespaces()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	return namespaces, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeRelayerPaths completes the ids of the configured relayer paths.
+func completeRelayerPaths(cmd *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+	ca, err := cosmosaccount.New(cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)))
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	paths, err := newRelayer(ca).ListPaths(cmd.Context())
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	picked := make(map[string]bool)
+	for _, id := range args {
+		picked[id] = true
+	}
+
+	var ids []string
+	for _, path := range paths {
+		if !picked[path.ID] {
+			ids = append(ids, path.ID)
+		}
+	}
+	return ids, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeModules completes the modules of the app at the path set with a flag.
+func completeModules(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	modules, err := appModules(flagGetPath(cmd))
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	return modules, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeFields completes the types of the fields of scaffolded types, messages, queries and
+// packets once their names are typed, such as title:string. fields can be of the static types
+// or of the types that are already scaffolded in the module.
+func completeFields(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	i := strings.Index(toComplete, field.TypeSeparator)
+	if len(args) == 0 || i < 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	types := []string{field.TypeString, field.TypeBool, field.TypeInt, field.TypeUint}
+	custom, err := customTypes(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	types = append(types, custom...)
+
+	var fields []string
+	for _, t := range types {
+		fields = append(fields, toComplete[:i+1]+t)
+	}
+	return fields, cobra.ShellCompDirectiveNoFileComp
+}
+
+// appModules returns the names of the modules of the app at appPath.
+func appModules(appPath string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(appPath, "x"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var modules []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			modules = append(modules, entry.Name())
+		}
+	}
+	return modules, nil
+}
+
+// customTypes returns the types that are scaffolded in the module set with a flag, the main
+// module of the app by default.
+func customTypes(cmd *cobra.Command) ([]string, error) {
+	appPath := flagGetPath(cmd)
+
+	module := flagGetModule(cmd)
+	if module == "" {
+		path, err := gomodulepath.ParseAt(appPath)
+		if err != nil {
+			return nil, err
+		}
+		module = path.Package
+	}
+
+	pkgs, err := protoanalysis.Parse(cmd.Context(), nil, filepath.Join(appPath, "proto", module))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var types []string
+	for _, pkg := range pkgs {
+		for _, msg := range pkg.Messages {
+			if isCustomType(msg.Name) {
+				types = append(types, msg.Name)
+			}
+		}
+	}
+	sort.Strings(types)
+	return types, nil
+}
+
+// isCustomType checks if the proto message with name is a scaffolded type, rather than a
+// message, a query or the genesis state of the module.
+func isCustomType(name string) bool {
+	for _, prefix := range []string{"Msg", "Query", "GenesisState"} {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+	return true
+}
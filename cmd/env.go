@@ -0,0 +1,95 @@
+package starportcmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	conf "github.com/trino-network/trino/chainconf"
+	"github.com/trino-network/trino/internal/version"
+	"github.com/trino-network/trino/pkg/cosmosgen"
+)
+
+// NewEnv creates a new env command to show the versions of the tools that Starport uses.
+func NewEnv() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "env",
+		Short: "Print the versions of Starport, its toolchain and the chain for bug reports",
+		Long: `Print the build information of Starport and the versions of the tools that it builds
+chains and generates code with: Go, protoc and its plugins, ts-proto, buf, node and npm.
+
+When it's run in a chain, the versions of the Cosmos SDK, IBC and Tendermint modules of the
+chain are printed too. The protoc plugins are printed with the versions that are set in the
+config of the chain.
+
+The text output can be pasted as is into bug reports.`,
+		Args:        cobra.NoArgs,
+		RunE:        envHandler,
+		Annotations: resultAnnotations(),
+	}
+	return c
+}
+
+// envResult is the environment in the json and yaml outputs.
+type envResult struct {
+	Starport  version.Info      `json:"starport"`
+	Toolchain version.Toolchain `json:"toolchain"`
+}
+
+func envHandler(cmd *cobra.Command, _ []string) error {
+	appPath := flagGetPath(cmd)
+
+	plugins, err := protocPluginVersions(appPath)
+	if err != nil {
+		return err
+	}
+
+	info, err := version.GetInfo(cmd.Context())
+	if err != nil {
+		return err
+	}
+	toolchain, err := version.GetToolchain(cmd.Context(), appPath, plugins)
+	if err != nil {
+		return err
+	}
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, envResult{
+			Starport:  info,
+			Toolchain: toolchain,
+		})
+	}
+
+	fmt.Printf("```\n%s\n%s```\n", info, toolchain)
+	return nil
+}
+
+// protocPluginVersions returns the versions of the protoc plugins by their names, with the
+// versions that are set in the config of the chain at appPath.
+func protocPluginVersions(appPath string) (map[string]string, error) {
+	var overrides map[string]string
+
+	path, err := conf.LocateDefault(appPath)
+	switch {
+	case errors.Is(err, conf.ErrCouldntLocateConfig):
+	case err != nil:
+		return nil, err
+	default:
+		config, err := conf.ParseFile(path)
+		if err != nil {
+			return nil, err
+		}
+		overrides = config.Build.Proto.Plugins
+	}
+
+	plugins, err := cosmosgen.ResolvePlugins(overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make(map[string]string)
+	for _, p := range plugins {
+		versions[p.Name] = p.Version
+	}
+	return versions, nil
+}
@@ -0,0 +1,82 @@
+package starportcmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/goccy/go-yaml"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/validation"
+	sperrors "github.com/trino-network/trino/errors"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+// errorResult is the error of a command in the json and yaml outputs.
+type errorResult struct {
+	Error string        `json:"error"`
+	Code  sperrors.Code `json:"code,omitempty"`
+	Hint  string        `json:"hint,omitempty"`
+}
+
+// PrintError prints err that cmd failed with to w. common failures are printed with a hint and
+// their error code, in the output format of cmd.
+func PrintError(w io.Writer, cmd *cobra.Command, err error) {
+	err = typedError(cmd, err)
+
+	var (
+		spErr         *sperrors.Error
+		validationErr validation.Error
+	)
+
+	if cmd != nil && isStructuredOutput(cmd) {
+		result := errorResult{Error: err.Error()}
+		if errors.As(err, &spErr) {
+			result.Error = spErr.Cause
+			result.Code = spErr.Code
+			result.Hint = spErr.Hint
+		}
+		printErrorResult(w, cmd, result)
+		return
+	}
+
+	switch {
+	case errors.As(err, &spErr):
+		fmt.Fprintln(w, spErr.Cause)
+		fmt.Fprintf(w, "\n💡 %s\n", spErr.Hint)
+		fmt.Fprintf(w, "Error code: %s\n", spErr.Code)
+	case errors.As(err, &validationErr):
+		fmt.Fprintln(w, validationErr.ValidationInfo())
+	default:
+		fmt.Fprintln(w, err)
+	}
+}
+
+// typedError returns err as an sperrors.Error when it's a common failure that's only known by
+// the flags of cmd, such as the backend of a locked keyring.
+func typedError(cmd *cobra.Command, err error) error {
+	var spErr *sperrors.Error
+	if errors.As(err, &spErr) {
+		return err
+	}
+
+	if cosmosaccount.IsKeyringLocked(err) {
+		backend := cosmosaccount.KeyringOS
+		if cmd != nil && cmd.Flags().Lookup(flagKeyringBackend) != nil {
+			backend = getKeyringBackend(cmd)
+		}
+		return sperrors.KeyringLocked(string(backend), err)
+	}
+	return err
+}
+
+func printErrorResult(w io.Writer, cmd *cobra.Command, result errorResult) {
+	switch getOutput(cmd) {
+	case outputJSON:
+		json.NewEncoder(w).Encode(result)
+	case outputYAML:
+		b, _ := yaml.Marshal(result)
+		fmt.Fprintf(w, "---\n%s", b)
+	}
+}
@@ -1,6 +1,20 @@
 package starportcmd
 
-import "github.com/spf13/cobra"
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/services/chain"
+)
+
+const (
+	flagWatch           = "watch"
+	flagGenerateModules = "module"
+)
 
 // NewGenerate returns a command that groups code generation related sub commands.
 func NewGenerate() *cobra.Command {
@@ -11,16 +25,79 @@ func NewGenerate() *cobra.Command {
 
 Such as compiling protocol buffer files into Go or implement particular functionality, for example, generating an OpenAPI spec.
 
-Produced source code can be regenerated by running a command again and is not meant to be edited by hand.`,
+Produced source code can be regenerated by running a command again and is not meant to be edited by hand.
+
+Run "starport generate --watch" to regenerate the Go code and the clients configured in config.yml
+every time proto files or config.yml change, without serving the chain.`,
 		Aliases: []string{"g"},
-		Args:    cobra.ExactArgs(1),
+		Args:    cobra.NoArgs,
+		RunE:    generateHandler,
 	}
 
-	flagSetPath(c)
+	c.PersistentFlags().AddFlagSet(flagSetNetwork())
+	c.Flags().Bool(flagWatch, false, "Regenerate the Go code and the clients configured in config.yml on proto file and config changes")
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetProto3rdParty(""))
 	c.AddCommand(NewGenerateGo())
+	c.AddCommand(NewGenerateGoClient())
 	c.AddCommand(NewGenerateVuex())
+	c.AddCommand(NewGenerateTSClient())
+	c.AddCommand(NewGenerateComposables())
+	c.AddCommand(NewGenerateHooks())
+	c.AddCommand(NewGeneratePinia())
 	c.AddCommand(NewGenerateDart())
+	c.AddCommand(NewGenerateKotlin())
+	c.AddCommand(NewGenerateSwift())
 	c.AddCommand(NewGenerateOpenAPI())
+	c.AddCommand(NewGenerateDocs())
 
 	return c
 }
+
+func generateHandler(cmd *cobra.Command, args []string) error {
+	if watch, _ := cmd.Flags().GetBool(flagWatch); !watch {
+		return cmd.Help()
+	}
+
+	var chainOption []chain.Option
+	if protoAll, _ := cmd.Flags().GetBool(flagProto3rdParty); protoAll {
+		chainOption = append(chainOption, chain.EnableThirdPartyModuleCodegen())
+	}
+
+	c, err := newChainWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("👀 Watching proto files and config.yml for changes...")
+
+	return c.WatchGenerate(cmd.Context(), func(ctx context.Context) error {
+		s := clispinner.New().SetText("Generating...")
+		err := c.GenerateGoAndClients(ctx)
+		s.Stop()
+
+		switch {
+		case errors.Is(err, context.Canceled):
+			return nil
+		case err != nil:
+			// keep watching and wait for a fix.
+			fmt.Printf("%s\n%s\n", err, infoColor("Waiting for a fix before regenerating..."))
+		default:
+			fmt.Println("⛏️  Generated Go code and clients.")
+		}
+
+		return nil
+	})
+}
+
+func flagSetGenerateModules() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.StringSlice(flagGenerateModules, []string{}, "Only regenerate the code of these modules, code of other modules is left as is")
+	return fs
+}
+
+// generateModulesTarget limits generation to the modules set with the module flag.
+func generateModulesTarget(cmd *cobra.Command) chain.GenerateTarget {
+	modules, _ := cmd.Flags().GetStringSlice(flagGenerateModules)
+	return chain.GenerateModules(modules...)
+}
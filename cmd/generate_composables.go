@@ -0,0 +1,64 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/services/chain"
+)
+
+func NewGenerateComposables() *cobra.Command {
+	return &cobra.Command{
+		Use:   "composables",
+		Short: "Generate Vue 3 composables for module queries on top of the TypeScript client",
+		RunE:  generateComposablesHandler,
+	}
+}
+
+func NewGenerateHooks() *cobra.Command {
+	return &cobra.Command{
+		Use:   "hooks",
+		Short: "Generate React Query hooks for module queries on top of the TypeScript client",
+		RunE:  generateHooksHandler,
+	}
+}
+
+func NewGeneratePinia() *cobra.Command {
+	return &cobra.Command{
+		Use:   "pinia",
+		Short: "Generate Pinia stores for modules on top of the TypeScript client",
+		RunE:  generatePiniaHandler,
+	}
+}
+
+func generateComposablesHandler(cmd *cobra.Command, args []string) error {
+	return generateQueryWrappers(cmd, chain.GenerateComposables(), "⛏️  Generated Vue 3 composables.")
+}
+
+func generateHooksHandler(cmd *cobra.Command, args []string) error {
+	return generateQueryWrappers(cmd, chain.GenerateHooks(), "⛏️  Generated React hooks.")
+}
+
+func generatePiniaHandler(cmd *cobra.Command, args []string) error {
+	return generateQueryWrappers(cmd, chain.GeneratePinia(), "⛏️  Generated Pinia stores.")
+}
+
+func generateQueryWrappers(cmd *cobra.Command, target chain.GenerateTarget, successMessage string) error {
+	s := clispinner.New().SetText("Generating...")
+	defer s.Stop()
+
+	c, err := newChainWithHomeFlags(cmd, chain.EnableThirdPartyModuleCodegen())
+	if err != nil {
+		return err
+	}
+
+	if err := c.Generate(cmd.Context(), target); err != nil {
+		return err
+	}
+
+	s.Stop()
+	fmt.Println(successMessage)
+
+	return nil
+}
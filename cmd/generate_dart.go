@@ -5,15 +5,18 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tendermint/starport/starport/pkg/clispinner"
-	"github.com/tendermint/starport/starport/services/chain"
+	"github.com/trino-network/trino/services/chain"
 )
 
 func NewGenerateDart() *cobra.Command {
 	c := &cobra.Command{
-		Hidden: true,
-		Use:    "dart",
-		Short:  "Generate a Dart client",
-		RunE:   generateDartHandler,
+		Use:   "dart",
+		Short: "Generate a Dart client for the Flutter app of your chain",
+		Long: `Generate a Dart client for the Flutter app of your chain.
+
+The client has proto types and gRPC query clients of all modules and a transaction
+signer that works with the wallets of the app scaffolded by "scaffold flutter".`,
+		RunE: generateDartHandler,
 	}
 	return c
 }
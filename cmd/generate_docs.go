@@ -0,0 +1,36 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/services/chain"
+)
+
+func NewGenerateDocs() *cobra.Command {
+	return &cobra.Command{
+		Use:   "docs",
+		Short: "Generate a static reference docs site from the comments, messages and services of your proto files",
+		RunE:  generateDocsHandler,
+	}
+}
+
+func generateDocsHandler(cmd *cobra.Command, args []string) error {
+	s := clispinner.New().SetText("Generating...")
+	defer s.Stop()
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Generate(cmd.Context(), chain.GenerateDocs()); err != nil {
+		return err
+	}
+
+	s.Stop()
+	fmt.Println("⛏️  Generated API docs.")
+
+	return nil
+}
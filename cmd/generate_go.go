@@ -2,34 +2,37 @@ package starportcmd
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/pkg/clispinner"
-	"github.com/tendermint/starport/starport/services/chain"
+	"github.com/trino-network/trino/pkg/cliprogress"
+	"github.com/trino-network/trino/services/chain"
 )
 
 func NewGenerateGo() *cobra.Command {
-	return &cobra.Command{
+	c := &cobra.Command{
 		Use:   "proto-go",
 		Short: "Generate proto based Go code needed for the app's source code",
 		RunE:  generateGoHandler,
 	}
+	c.Flags().AddFlagSet(flagSetGenerateModules())
+	return c
 }
 
 func generateGoHandler(cmd *cobra.Command, args []string) error {
-	s := clispinner.New().SetText("Generating...")
-	defer s.Stop()
+	progress := cliprogress.New(os.Stdout, 0)
 
-	c, err := newChainWithHomeFlags(cmd)
+	c, err := newChainWithHomeFlags(cmd, chain.WithProgress(progress))
 	if err != nil {
 		return err
 	}
 
-	if err := c.Generate(cmd.Context(), chain.GenerateGo()); err != nil {
+	if err := c.Generate(cmd.Context(), chain.GenerateGo(), generateModulesTarget(cmd)); err != nil {
+		progress.Fail()
 		return err
 	}
 
-	s.Stop()
+	progress.Stop()
 	fmt.Println("⛏️  Generated go code.")
 
 	return nil
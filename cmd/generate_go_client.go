@@ -0,0 +1,40 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/services/chain"
+)
+
+func NewGenerateGoClient() *cobra.Command {
+	return &cobra.Command{
+		Use:   "go-client",
+		Short: "Generate a standalone Go module with typed clients for your chain's modules",
+		Long: `Generate a standalone Go module with typed clients for your chain's modules.
+
+The module has typed query and tx clients for all custom modules built on top of
+cosmosclient, so backend services can integrate with the chain without importing its source code.`,
+		RunE: generateGoClientHandler,
+	}
+}
+
+func generateGoClientHandler(cmd *cobra.Command, args []string) error {
+	s := clispinner.New().SetText("Generating...")
+	defer s.Stop()
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Generate(cmd.Context(), chain.GenerateGoClient()); err != nil {
+		return err
+	}
+
+	s.Stop()
+	fmt.Println("⛏️  Generated Go client.")
+
+	return nil
+}
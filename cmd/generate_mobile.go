@@ -0,0 +1,57 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/services/chain"
+)
+
+func NewGenerateKotlin() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "kotlin",
+		Short: "Generate a Kotlin client for Android apps of your chain",
+		Long: `Generate a Kotlin client for Android apps of your chain.
+
+The client is an Android library with protobuf models and query clients of all modules,
+and a transaction client that signs with a key store implemented by the app.`,
+		RunE: generateMobileHandler(chain.GenerateKotlin(), "Kotlin"),
+	}
+	return c
+}
+
+func NewGenerateSwift() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "swift",
+		Short: "Generate a Swift client for iOS apps of your chain",
+		Long: `Generate a Swift client for iOS apps of your chain.
+
+The client is a Swift package with protobuf models and query clients of all modules,
+and a transaction client that signs with a key store implemented by the app.
+Requires protoc-gen-swift of swift-protobuf to be installed.`,
+		RunE: generateMobileHandler(chain.GenerateSwift(), "Swift"),
+	}
+	return c
+}
+
+func generateMobileHandler(target chain.GenerateTarget, name string) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		s := clispinner.New().SetText("Generating...")
+		defer s.Stop()
+
+		c, err := newChainWithHomeFlags(cmd, chain.EnableThirdPartyModuleCodegen())
+		if err != nil {
+			return err
+		}
+
+		if err := c.Generate(cmd.Context(), target); err != nil {
+			return err
+		}
+
+		s.Stop()
+		fmt.Printf("⛏️  Generated %s client.\n", name)
+
+		return nil
+	}
+}
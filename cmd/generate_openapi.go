@@ -5,7 +5,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tendermint/starport/starport/pkg/clispinner"
-	"github.com/tendermint/starport/starport/services/chain"
+	"github.com/trino-network/trino/services/chain"
 )
 
 func NewGenerateOpenAPI() *cobra.Command {
@@ -0,0 +1,42 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/services/chain"
+)
+
+func NewGenerateTSClient() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "ts-client",
+		Short: "Generate a standalone TypeScript client for your chain to use in any JS project",
+		Long: `Generate a standalone TypeScript client for your chain to use in any JS project.
+
+The client is a package with typed message composers, a signing client for Keplr and
+mnemonic wallets, typed query endpoints and Tendermint event subscriptions for all modules.`,
+		RunE: generateTSClientHandler,
+	}
+	c.Flags().AddFlagSet(flagSetGenerateModules())
+	return c
+}
+
+func generateTSClientHandler(cmd *cobra.Command, args []string) error {
+	s := clispinner.New().SetText("Generating...")
+	defer s.Stop()
+
+	c, err := newChainWithHomeFlags(cmd, chain.EnableThirdPartyModuleCodegen())
+	if err != nil {
+		return err
+	}
+
+	if err := c.Generate(cmd.Context(), chain.GenerateTSClient(), generateModulesTarget(cmd)); err != nil {
+		return err
+	}
+
+	s.Stop()
+	fmt.Println("⛏️  Generated TypeScript client.")
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package starportcmd
+
+import (
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	"github.com/spf13/cobra"
+	starportaccount "github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/pkg/cosmosclient"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+// NewIBC returns a command that groups the helpers to debug IBC transfers.
+func NewIBC() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "ibc [command]",
+		Short: "Trace IBC denoms and send test transfers over the paths of the relayer",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	c.PersistentFlags().AddFlagSet(flagSetKeyringBackend())
+
+	c.AddCommand(NewIBCDenomTrace())
+	c.AddCommand(NewIBCTransfer())
+
+	return c
+}
+
+// newIBCClient creates a client for the node at address of a chain whose addresses have prefix,
+// it signs transactions with the keyring of Starport's accounts.
+func newIBCClient(cmd *cobra.Command, address, prefix string) (cosmosclient.Client, error) {
+	backend, err := getKeyringBackend(cmd).SDKBackend()
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	client, err := cosmosclient.New(
+		cmd.Context(),
+		cosmosclient.WithNodeAddress(address),
+		cosmosclient.WithAddressPrefix(prefix),
+		cosmosclient.WithKeyringBackend(starportaccount.KeyringBackend(backend)),
+		cosmosclient.WithKeyringServiceName(sdktypes.KeyringServiceName()),
+		cosmosclient.WithHome(cosmosaccount.KeyringHome),
+	)
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	transfertypes.RegisterInterfaces(client.Context.InterfaceRegistry)
+	return client, nil
+}
@@ -0,0 +1,110 @@
+package starportcmd
+
+import (
+	"fmt"
+	"strings"
+
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+)
+
+func NewIBCDenomTrace() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "denom-trace [hash]",
+		Short: "Resolve an IBC denom to the path of channels it was transferred over and its base denom",
+		Long: `Resolve an IBC denom, such as ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2,
+to the path of channels it was transferred over and its base denom, from the denom traces of the
+transfer module of the chain.
+
+The channel that the tokens were received over is matched with the paths configured with
+"starport relayer configure" to show the chain they were sent from.`,
+		Example:     "starport ibc denom-trace ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		Args:        cobra.ExactArgs(1),
+		RunE:        ibcDenomTraceHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().AddFlagSet(flagSetNode())
+
+	return c
+}
+
+// ibcDenomTraceResult is the result of ibc denom-trace in the json and yaml outputs.
+type ibcDenomTraceResult struct {
+	Denom     string `json:"denom"`
+	Path      string `json:"path"`
+	BaseDenom string `json:"base_denom"`
+
+	// SourceChain and RelayerPath are the chain that the tokens were received from and the path of
+	// the relayer that connects it, when the path is configured.
+	SourceChain string `json:"source_chain,omitempty"`
+	RelayerPath string `json:"relayer_path,omitempty"`
+}
+
+func ibcDenomTraceHandler(cmd *cobra.Command, args []string) error {
+	hash := strings.TrimPrefix(args[0], transfertypes.DenomPrefix+"/")
+	if _, err := transfertypes.ParseHexHash(hash); err != nil {
+		return fmt.Errorf("%q is not the hash of an IBC denom: %w", args[0], err)
+	}
+
+	s := clispinner.New().SetText("Querying the denom trace...")
+	defer s.Stop()
+
+	client, err := newIBCClient(cmd, getNode(cmd), "")
+	if err != nil {
+		return err
+	}
+
+	res, err := transfertypes.NewQueryClient(client.Context).DenomTrace(cmd.Context(), &transfertypes.QueryDenomTraceRequest{
+		Hash: hash,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	trace := res.DenomTrace
+	result := ibcDenomTraceResult{
+		Denom:     trace.IBCDenom(),
+		Path:      trace.Path,
+		BaseDenom: trace.BaseDenom,
+	}
+
+	// the first hop of the path is the port and the channel that the chain received the tokens on.
+	// the chain is only resolved when the relayer is configured.
+	if hops := strings.Split(trace.Path, "/"); len(hops) >= 2 {
+		if conf, err := relayerconf.Get(); err == nil {
+			if path, src, ok := findRelayerPath(conf, client.Context.ChainID, hops[1]); ok {
+				result.SourceChain = src.ChainID
+				result.RelayerPath = path.ID
+			}
+		}
+	}
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, result)
+	}
+
+	fmt.Printf("%s\n\nPath: %s\nBase denom: %s\n", result.Denom, result.Path, result.BaseDenom)
+	if result.SourceChain != "" {
+		fmt.Printf("Received from: %s over the %s path of the relayer\n", result.SourceChain, result.RelayerPath)
+	}
+	return nil
+}
+
+// findRelayerPath returns the path of the relayer that has channel on the chain with chainID and
+// the end of the path on the other chain.
+func findRelayerPath(conf relayerconf.Config, chainID, channel string) (path relayerconf.Path, counterparty relayerconf.PathEnd, found bool) {
+	for _, path := range conf.Paths {
+		switch {
+		case path.Src.ChainID == chainID && path.Src.ChannelID == channel:
+			return path, path.Dst, true
+		case path.Dst.ChainID == chainID && path.Dst.ChannelID == channel:
+			return path, path.Src, true
+		}
+	}
+	return relayerconf.Path{}, relayerconf.PathEnd{}, false
+}
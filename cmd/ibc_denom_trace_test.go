@@ -0,0 +1,45 @@
+package starportcmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindRelayerPath(t *testing.T) {
+	tests := []struct {
+		name         string
+		chainID      string
+		channel      string
+		path         string
+		counterparty string
+	}{
+		{
+			name:         "source of the path",
+			chainID:      "mars",
+			channel:      "channel-1",
+			path:         "mars-earth",
+			counterparty: "earth",
+		},
+		{
+			name:         "destination of the path",
+			chainID:      "venus",
+			channel:      "channel-1",
+			path:         "mars-venus-blog",
+			counterparty: "mars",
+		},
+		{
+			name:    "channel of another chain",
+			chainID: "venus",
+			channel: "channel-3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, counterparty, found := findRelayerPath(testRelayerConfig, tt.chainID, tt.channel)
+			require.Equal(t, tt.path != "", found)
+			require.Equal(t, tt.path, path.ID)
+			require.Equal(t, tt.counterparty, counterparty.ChainID)
+		})
+	}
+}
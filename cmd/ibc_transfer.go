@@ -0,0 +1,212 @@
+package starportcmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+const (
+	flagChannel = "channel"
+	flagTo      = "to"
+	flagTimeout = "timeout"
+)
+
+func NewIBCTransfer() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "transfer [amount]",
+		Short: "Send a test transfer over a channel of the paths of the relayer",
+		Long: `Send a test transfer of amount, such as 10token, over a channel of the paths configured with
+"starport relayer configure".
+
+The transfer is sent from the chain that the channel is on, by the account of the relayer on that
+chain unless --from is set, to the same account on the chain at the other end of the path unless
+--to is set. Use --chain when the channel id is on both chains of a path.
+
+The tokens are received once the packet is relayed, with "starport relayer connect".`,
+		Example: `starport ibc transfer 10token --channel channel-0
+starport ibc transfer 10token --channel channel-0 --chain mars --from alice --to bob`,
+		Args:        cobra.ExactArgs(1),
+		RunE:        ibcTransferHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().String(flagChannel, "", "ID of the channel to send the transfer over")
+	c.Flags().String(flagChain, "", "ID of the chain that the channel is on")
+	c.Flags().String(flagFrom, "", "Name of the account to send from (default: the account of the relayer)")
+	c.Flags().String(flagTo, "", "Name or address of the account to send to (default: the account that sends)")
+	c.Flags().Duration(flagTimeout, 10*time.Minute, "Time after which the transfer is refunded if it's not relayed")
+	c.MarkFlagRequired(flagChannel)
+
+	return c
+}
+
+// ibcTransferResult is the result of ibc transfer in the json and yaml outputs.
+type ibcTransferResult struct {
+	TxHash   string `json:"txhash"`
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+
+	// Denom is the denom of the tokens on the receiving chain, when they're not IBC tokens.
+	Denom string `json:"denom,omitempty"`
+}
+
+func ibcTransferHandler(cmd *cobra.Command, args []string) (err error) {
+	defer func() {
+		err = handleRelayerAccountErr(err)
+	}()
+
+	var (
+		channel, _ = cmd.Flags().GetString(flagChannel)
+		chainID, _ = cmd.Flags().GetString(flagChain)
+		from, _    = cmd.Flags().GetString(flagFrom)
+		to, _      = cmd.Flags().GetString(flagTo)
+		timeout, _ = cmd.Flags().GetDuration(flagTimeout)
+	)
+
+	amount, err := sdktypes.ParseCoinNormalized(args[0])
+	if err != nil {
+		return err
+	}
+
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return err
+	}
+	src, dst, err := findTransferChannel(conf, chainID, channel)
+	if err != nil {
+		return err
+	}
+	srcChain, err := conf.ChainByID(src.ChainID)
+	if err != nil {
+		return err
+	}
+	dstChain, err := conf.ChainByID(dst.ChainID)
+	if err != nil {
+		return err
+	}
+
+	ca, err := cosmosaccount.New(cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)))
+	if err != nil {
+		return err
+	}
+	if from == "" {
+		from = srcChain.Account
+	}
+	sender, err := ca.GetByName(from)
+	if err != nil {
+		return err
+	}
+	receiver := sender.Address(dstChain.AddressPrefix)
+	if to != "" {
+		if receiver, err = resolveTransferReceiver(ca, to, dstChain.AddressPrefix); err != nil {
+			return err
+		}
+	}
+
+	s := clispinner.New().SetText("Sending the transfer...")
+	defer s.Stop()
+
+	client, err := newIBCClient(cmd, srcChain.RPCAddress, srcChain.AddressPrefix)
+	if err != nil {
+		return err
+	}
+
+	msg := transfertypes.NewMsgTransfer(
+		src.PortID,
+		src.ChannelID,
+		amount,
+		sender.Address(srcChain.AddressPrefix),
+		receiver,
+		clienttypes.ZeroHeight(),
+		uint64(time.Now().Add(timeout).UnixNano()),
+	)
+
+	// the key of the account is named after its namespace in the keyring.
+	res, err := client.BroadcastTx(sender.Info.GetName(), msg)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	result := ibcTransferResult{
+		TxHash:   res.TxHash,
+		Sender:   msg.Sender,
+		Receiver: receiver,
+	}
+	// IBC tokens that are sent back over their channel are unwrapped instead.
+	if !strings.HasPrefix(amount.Denom, transfertypes.DenomPrefix+"/") {
+		result.Denom = transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(dst.PortID, dst.ChannelID, amount.Denom)).IBCDenom()
+	}
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, result)
+	}
+
+	fmt.Printf("🎉 Sent %s from %s on %s to %s on %s over %s\nTransaction hash: %s\n",
+		amount, result.Sender, src.ChainID, receiver, dst.ChainID, src.ChannelID, res.TxHash)
+	if result.Denom != "" {
+		fmt.Printf("The tokens are received as %s once the packet is relayed with starport relayer connect\n", result.Denom)
+	}
+	return nil
+}
+
+// findTransferChannel returns the end of the path of the relayer with channel on the chain with
+// chainID and the end on the other chain. the chain can be empty when the channel id is only on
+// one chain.
+func findTransferChannel(conf relayerconf.Config, chainID, channel string) (src, dst relayerconf.PathEnd, err error) {
+	var found [][2]relayerconf.PathEnd
+	for _, path := range conf.Paths {
+		for _, ends := range [][2]relayerconf.PathEnd{{path.Src, path.Dst}, {path.Dst, path.Src}} {
+			if ends[0].ChannelID == channel && (chainID == "" || ends[0].ChainID == chainID) {
+				found = append(found, ends)
+			}
+		}
+	}
+
+	switch {
+	case len(found) == 0 && chainID != "":
+		return src, dst, fmt.Errorf("channel %s of %s is not in the paths of the relayer, connect the chains with starport relayer configure and connect", channel, chainID)
+	case len(found) == 0:
+		return src, dst, fmt.Errorf("channel %s is not in the paths of the relayer, connect the chains with starport relayer configure and connect", channel)
+	case len(found) > 1:
+		var chains []string
+		for _, ends := range found {
+			chains = append(chains, ends[0].ChainID)
+		}
+		return src, dst, fmt.Errorf("channel %s is on the %s chains, select one with --%s", channel, strings.Join(chains, ", "), flagChain)
+	}
+
+	src, dst = found[0][0], found[0][1]
+	if src.PortID != transfertypes.PortID {
+		return src, dst, fmt.Errorf("channel %s is on the %s port, tokens are transferred over the %s port", channel, src.PortID, transfertypes.PortID)
+	}
+	return src, dst, nil
+}
+
+// resolveTransferReceiver returns the address of the account with nameOrAddress on the receiving
+// chain whose addresses have prefix, or nameOrAddress itself when it's an address.
+func resolveTransferReceiver(ca cosmosaccount.Registry, nameOrAddress, prefix string) (string, error) {
+	if acc, err := ca.GetByName(nameOrAddress); err == nil {
+		return acc.Address(prefix), nil
+	}
+
+	hrp, _, err := bech32.DecodeAndConvert(nameOrAddress)
+	if err != nil {
+		return "", fmt.Errorf("%q is neither an account nor an address", nameOrAddress)
+	}
+	if hrp != prefix {
+		return "", fmt.Errorf("address %s doesn't have the %q prefix of the receiving chain", nameOrAddress, prefix)
+	}
+	return nameOrAddress, nil
+}
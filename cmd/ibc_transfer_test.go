@@ -0,0 +1,139 @@
+package starportcmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+// testRelayerConfig connects mars to venus twice, once over the transfer port and once over the
+// port of a blog module, and mars to earth over the transfer port.
+var testRelayerConfig = relayerconf.Config{
+	Paths: []relayerconf.Path{
+		{
+			ID:  "mars-venus",
+			Src: relayerconf.PathEnd{ChainID: "mars", ChannelID: "channel-0", PortID: "transfer"},
+			Dst: relayerconf.PathEnd{ChainID: "venus", ChannelID: "channel-0", PortID: "transfer"},
+		},
+		{
+			ID:  "mars-earth",
+			Src: relayerconf.PathEnd{ChainID: "mars", ChannelID: "channel-1", PortID: "transfer"},
+			Dst: relayerconf.PathEnd{ChainID: "earth", ChannelID: "channel-3", PortID: "transfer"},
+		},
+		{
+			ID:  "mars-venus-blog",
+			Src: relayerconf.PathEnd{ChainID: "mars", ChannelID: "channel-2", PortID: "blog"},
+			Dst: relayerconf.PathEnd{ChainID: "venus", ChannelID: "channel-1", PortID: "blog"},
+		},
+	},
+}
+
+func TestFindTransferChannel(t *testing.T) {
+	tests := []struct {
+		name    string
+		chainID string
+		channel string
+		src     string
+		dst     string
+		err     string
+	}{
+		{
+			name:    "channel on one chain",
+			channel: "channel-3",
+			src:     "earth",
+			dst:     "mars",
+		},
+		{
+			name:    "channel of the chain",
+			chainID: "venus",
+			channel: "channel-0",
+			src:     "venus",
+			dst:     "mars",
+		},
+		{
+			name:    "ambiguous channel",
+			channel: "channel-0",
+			err:     "channel channel-0 is on the mars, venus chains, select one with --chain",
+		},
+		{
+			name:    "non-transfer port",
+			chainID: "mars",
+			channel: "channel-2",
+			err:     "channel channel-2 is on the blog port, tokens are transferred over the transfer port",
+		},
+		{
+			name:    "unknown channel",
+			channel: "channel-9",
+			err:     "channel channel-9 is not in the paths of the relayer",
+		},
+		{
+			name:    "unknown channel of the chain",
+			chainID: "earth",
+			channel: "channel-0",
+			err:     "channel channel-0 of earth is not in the paths of the relayer",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src, dst, err := findTransferChannel(testRelayerConfig, tt.chainID, tt.channel)
+			if tt.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.src, src.ChainID)
+			require.Equal(t, tt.channel, src.ChannelID)
+			require.Equal(t, tt.dst, dst.ChainID)
+		})
+	}
+}
+
+func TestResolveTransferReceiver(t *testing.T) {
+	ca, err := cosmosaccount.New(cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringMemory), cosmosaccount.WithHome(t.TempDir()))
+	require.NoError(t, err)
+	alice, _, err := ca.Create("alice")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		nameOrAddress string
+		address       string
+		err           string
+	}{
+		{
+			name:          "account",
+			nameOrAddress: "alice",
+			address:       alice.Address("venus"),
+		},
+		{
+			name:          "address",
+			nameOrAddress: alice.Address("venus"),
+			address:       alice.Address("venus"),
+		},
+		{
+			name:          "prefix mismatch",
+			nameOrAddress: alice.Address("mars"),
+			err:           `doesn't have the "venus" prefix of the receiving chain`,
+		},
+		{
+			name:          "unknown account",
+			nameOrAddress: "bob",
+			err:           `"bob" is neither an account nor an address`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			address, err := resolveTransferReceiver(ca, tt.nameOrAddress, "venus")
+			if tt.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.address, address)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package starportcmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+	"github.com/trino-network/trino/services/network"
+)
+
+const (
+	flagCoordinator = "coordinator"
+)
+
+// NewNetwork creates a new network command that holds some other sub commands
+// related to launching chains with a coordinator.
+func NewNetwork() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "network [command]",
+		Short: "Launch a blockchain with validators that are coordinated by a launch coordinator",
+		Long: `Launch a blockchain with validators that are coordinated by a launch coordinator.
+
+A coordinator publishes the launch of a chain to a launch coordinator service, validator
+candidates discover the launch and prepare their nodes to start the chain at its launch time.
+
+Public chains that are already running are joined with full nodes from a chain registry.`,
+		Aliases: []string{"n"},
+		Args:    cobra.ExactArgs(1),
+	}
+
+	c.PersistentFlags().AddFlagSet(flagSetCoordinator())
+
+	c.AddCommand(NewNetworkChain())
+	c.AddCommand(NewNetworkCampaign())
+	c.AddCommand(NewNetworkRewards())
+	c.AddCommand(NewNetworkFaucet())
+	c.AddCommand(NewNetworkValidator())
+	c.AddCommand(NewNetworkRequest())
+	c.AddCommand(NewNetworkJoin())
+
+	return c
+}
+
+func flagSetCoordinator() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagCoordinator, "", "Address of the launch coordinator service")
+	return fs
+}
+
+func flagSetNetworkAccount() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagFrom, cosmosaccount.DefaultAccount, "Account that signs the requests to the coordinator")
+	fs.AddFlagSet(flagSetKeyringBackend())
+	return fs
+}
+
+// newNetworkClient creates a client of the coordinator set with flags, requests are signed by the
+// account set with flags when the command has the flags of accounts.
+func newNetworkClient(cmd *cobra.Command) (network.Client, error) {
+	address, _ := cmd.Flags().GetString(flagCoordinator)
+	if address == "" {
+		return network.Client{}, fmt.Errorf("address of the launch coordinator is required, set it with --%s", flagCoordinator)
+	}
+
+	var options []network.Option
+
+	if cmd.Flags().Lookup(flagFrom) != nil {
+		ca, err := cosmosaccount.New(getAccountRegistryOptions(cmd)...)
+		if err != nil {
+			return network.Client{}, err
+		}
+
+		from, _ := cmd.Flags().GetString(flagFrom)
+		options = append(options, network.WithAccount(ca, from))
+	}
+
+	return network.New(address, options...), nil
+}
+
+// parseCampaignID parses the ID of a campaign from an argument.
+func parseCampaignID(arg string) (uint64, error) {
+	id, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, errors.New("campaign ID must be a positive number")
+	}
+	return id, nil
+}
+
+// parseLaunchID parses the ID of a launch from an argument.
+func parseLaunchID(arg string) (uint64, error) {
+	id, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, errors.New("launch ID must be a positive number")
+	}
+	return id, nil
+}
@@ -0,0 +1,82 @@
+package starportcmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+	"github.com/trino-network/trino/services/network"
+)
+
+const (
+	flagTotalShares     = "total-shares"
+	flagRewardShares    = "reward-shares"
+	flagVestingCliff    = "vesting-cliff"
+	flagVestingDuration = "vesting-duration"
+	flagMinUptime       = "min-uptime"
+)
+
+// NewNetworkCampaign creates a new campaign command that holds some other sub commands
+// related to campaigns of incentivized testnets.
+func NewNetworkCampaign() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "campaign [command]",
+		Short: "Create and update campaigns of incentivized testnets",
+		Long: `Create and update campaigns of incentivized testnets.
+
+The shares of the mainnet of a campaign are allocated to the campaign, a part of them is
+distributed as rewards to the validators of the testnets that are published for the campaign
+with "starport network chain publish --campaign". Rewards are vested linearly over the vesting
+duration after the vesting cliff. Only the validators that sign the min uptime percentage of
+blocks are rewarded.`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewNetworkCampaignCreate())
+	c.AddCommand(NewNetworkCampaignUpdate())
+	c.AddCommand(NewNetworkCampaignList())
+	c.AddCommand(NewNetworkCampaignShow())
+
+	return c
+}
+
+func flagSetCampaign() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagTotalShares, "", "Shares of the mainnet that are allocated to the campaign, such as 1000000uatom")
+	fs.String(flagRewardShares, "", "Part of the total shares that are distributed as rewards to validators")
+	fs.String(flagVestingCliff, "", "Duration after the launch that rewards start vesting at, such as 720h")
+	fs.String(flagVestingDuration, "", "Duration that rewards are vested linearly over, rewards are not vested by default")
+	fs.Float64(flagMinUptime, 0, "Min percentage of blocks that validators must sign to be rewarded")
+	return fs
+}
+
+// printCampaign prints the details of campaign.
+func printCampaign(campaign network.Campaign) {
+	w := &tabwriter.Writer{}
+	w.Init(os.Stdout, 0, 8, 0, '\t', 0)
+
+	vesting := "not vested"
+	if campaign.Vesting.Duration != "" {
+		vesting = fmt.Sprintf("over %s", campaign.Vesting.Duration)
+		if campaign.Vesting.Cliff != "" {
+			vesting += fmt.Sprintf(" after a cliff of %s", campaign.Vesting.Cliff)
+		}
+	}
+
+	rewards := campaign.RewardShares
+	if rewards == "" {
+		rewards = "-"
+	}
+
+	fmt.Fprintf(w, "Campaign ID:\t%d\n", campaign.ID)
+	fmt.Fprintf(w, "Name:\t%s\n", campaign.Name)
+	fmt.Fprintf(w, "Coordinator:\t%s\n", campaign.Coordinator)
+	fmt.Fprintf(w, "Total shares:\t%s\n", campaign.TotalShares)
+	fmt.Fprintf(w, "Reward shares:\t%s\n", rewards)
+	fmt.Fprintf(w, "Vesting:\t%s\n", vesting)
+	fmt.Fprintf(w, "Min uptime:\t%v%%\n", campaign.MinUptime)
+
+	w.Flush()
+}
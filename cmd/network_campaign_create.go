@@ -0,0 +1,71 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/services/network"
+)
+
+// NewNetworkCampaignCreate returns a new command to create a campaign.
+func NewNetworkCampaignCreate() *cobra.Command {
+	c := &cobra.Command{
+		Use:         "create [name]",
+		Short:       "Create a campaign with the coordinator",
+		Args:        cobra.ExactArgs(1),
+		RunE:        networkCampaignCreateHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().AddFlagSet(flagSetNetworkAccount())
+	c.Flags().AddFlagSet(flagSetCampaign())
+
+	return c
+}
+
+func networkCampaignCreateHandler(cmd *cobra.Command, args []string) error {
+	var (
+		totalShares, _     = cmd.Flags().GetString(flagTotalShares)
+		rewardShares, _    = cmd.Flags().GetString(flagRewardShares)
+		vestingCliff, _    = cmd.Flags().GetString(flagVestingCliff)
+		vestingDuration, _ = cmd.Flags().GetString(flagVestingDuration)
+		minUptime, _       = cmd.Flags().GetFloat64(flagMinUptime)
+	)
+
+	campaign := network.Campaign{
+		Name:         args[0],
+		TotalShares:  totalShares,
+		RewardShares: rewardShares,
+		Vesting: network.Vesting{
+			Cliff:    vestingCliff,
+			Duration: vestingDuration,
+		},
+		MinUptime: minUptime,
+	}
+	if err := campaign.Validate(); err != nil {
+		return err
+	}
+
+	nc, err := newNetworkClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Creating the campaign...")
+	defer s.Stop()
+
+	created, err := nc.CreateCampaign(cmd.Context(), campaign)
+	if err != nil {
+		return err
+	}
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, created)
+	}
+
+	fmt.Printf("🏆 Campaign #%d %s created\n\n", created.ID, created.Name)
+	printCampaign(created)
+	return nil
+}
@@ -0,0 +1,59 @@
+package starportcmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/services/network"
+)
+
+// NewNetworkCampaignList returns a new command to list the campaigns of the coordinator.
+func NewNetworkCampaignList() *cobra.Command {
+	c := &cobra.Command{
+		Use:         "list",
+		Short:       "List the campaigns created with the coordinator",
+		Args:        cobra.NoArgs,
+		RunE:        networkCampaignListHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	return c
+}
+
+func networkCampaignListHandler(cmd *cobra.Command, args []string) error {
+	nc, err := newNetworkClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Fetching campaigns...")
+	defer s.Stop()
+
+	campaigns, err := nc.Campaigns(cmd.Context())
+	if err != nil {
+		return err
+	}
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, append([]network.Campaign{}, campaigns...))
+	}
+
+	if len(campaigns) == 0 {
+		fmt.Println("No campaigns are created yet.")
+		return nil
+	}
+
+	w := &tabwriter.Writer{}
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+
+	fmt.Fprintln(w, "campaign id\tname\ttotal shares\treward shares")
+	for _, campaign := range campaigns {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", campaign.ID, campaign.Name, campaign.TotalShares, campaign.RewardShares)
+	}
+
+	return w.Flush()
+}
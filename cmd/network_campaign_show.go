@@ -0,0 +1,54 @@
+package starportcmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/services/network"
+)
+
+// NewNetworkCampaignShow returns a new command to show a campaign of the coordinator.
+func NewNetworkCampaignShow() *cobra.Command {
+	c := &cobra.Command{
+		Use:         "show [campaign-id]",
+		Short:       "Show the details of a campaign",
+		Args:        cobra.ExactArgs(1),
+		RunE:        networkCampaignShowHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	return c
+}
+
+func networkCampaignShowHandler(cmd *cobra.Command, args []string) error {
+	id, err := parseCampaignID(args[0])
+	if err != nil {
+		return err
+	}
+
+	nc, err := newNetworkClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Fetching the campaign...")
+	defer s.Stop()
+
+	campaign, err := nc.Campaign(cmd.Context(), id)
+	if errors.Is(err, network.ErrNotFound) {
+		return fmt.Errorf("campaign #%d is not found", id)
+	}
+	if err != nil {
+		return err
+	}
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, campaign)
+	}
+
+	printCampaign(campaign)
+	return nil
+}
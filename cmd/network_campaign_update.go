@@ -0,0 +1,94 @@
+package starportcmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/services/network"
+)
+
+const (
+	flagName = "name"
+)
+
+// NewNetworkCampaignUpdate returns a new command to update a campaign.
+func NewNetworkCampaignUpdate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "update [campaign-id]",
+		Short: "Update the name, the shares, the vesting or the min uptime of a campaign",
+		Long: `Update the name, the shares, the vesting or the min uptime of a campaign.
+
+Only the values that are set with flags are updated, a value is unset by setting its flag to
+an empty value such as --vesting-cliff "". Only the coordinator of the campaign can update it.`,
+		Args:        cobra.ExactArgs(1),
+		RunE:        networkCampaignUpdateHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().AddFlagSet(flagSetNetworkAccount())
+	c.Flags().AddFlagSet(flagSetCampaign())
+	c.Flags().String(flagName, "", "Name of the campaign")
+
+	return c
+}
+
+func networkCampaignUpdateHandler(cmd *cobra.Command, args []string) error {
+	id, err := parseCampaignID(args[0])
+	if err != nil {
+		return err
+	}
+
+	nc, err := newNetworkClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Fetching the campaign...")
+	defer s.Stop()
+
+	campaign, err := nc.Campaign(cmd.Context(), id)
+	if errors.Is(err, network.ErrNotFound) {
+		return fmt.Errorf("campaign #%d is not found", id)
+	}
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	for name, value := range map[string]*string{
+		flagName:            &campaign.Name,
+		flagTotalShares:     &campaign.TotalShares,
+		flagRewardShares:    &campaign.RewardShares,
+		flagVestingCliff:    &campaign.Vesting.Cliff,
+		flagVestingDuration: &campaign.Vesting.Duration,
+	} {
+		if cmd.Flags().Changed(name) {
+			*value, _ = cmd.Flags().GetString(name)
+			changed = true
+		}
+	}
+	if cmd.Flags().Changed(flagMinUptime) {
+		campaign.MinUptime, _ = cmd.Flags().GetFloat64(flagMinUptime)
+		changed = true
+	}
+	if !changed {
+		return errors.New("nothing to update, set the values to update with flags")
+	}
+
+	s.SetText("Updating the campaign...")
+	updated, err := nc.UpdateCampaign(cmd.Context(), campaign)
+	if err != nil {
+		return err
+	}
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, updated)
+	}
+
+	fmt.Printf("🏆 Campaign #%d %s updated\n\n", updated.ID, updated.Name)
+	printCampaign(updated)
+	return nil
+}
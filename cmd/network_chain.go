@@ -0,0 +1,60 @@
+package starportcmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/trino-network/trino/services/network"
+)
+
+// NewNetworkChain creates a new chain command that holds some other sub commands
+// related to launches of chains.
+func NewNetworkChain() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "chain [command]",
+		Short: "Publish and discover chain launches",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewNetworkChainPublish())
+	c.AddCommand(NewNetworkChainList())
+	c.AddCommand(NewNetworkChainShow())
+	c.AddCommand(NewNetworkChainJoin())
+	c.AddCommand(NewNetworkChainPrepare())
+	c.AddCommand(NewNetworkChainStatus())
+
+	return c
+}
+
+// printLaunch prints the details of launch.
+func printLaunch(launch network.Launch) {
+	w := &tabwriter.Writer{}
+	w.Init(os.Stdout, 0, 8, 0, '\t', 0)
+
+	genesis := "generated by the chain's binary"
+	if launch.GenesisURL != "" {
+		genesis = fmt.Sprintf("%s (sha256 %s)", launch.GenesisURL, launch.GenesisHash)
+	}
+
+	fmt.Fprintf(w, "Launch ID:\t%d\n", launch.ID)
+	fmt.Fprintf(w, "Chain ID:\t%s\n", launch.ChainID)
+	fmt.Fprintf(w, "Coordinator:\t%s\n", launch.Coordinator)
+	fmt.Fprintf(w, "Source:\t%s@%s\n", launch.SourceURL, launch.SourceHash)
+	fmt.Fprintf(w, "Genesis:\t%s\n", genesis)
+	if launch.CampaignID != 0 {
+		fmt.Fprintf(w, "Campaign:\t#%d\n", launch.CampaignID)
+	}
+	fmt.Fprintf(w, "Launch time:\t%s\n", formatLaunchTime(launch))
+
+	w.Flush()
+}
+
+func formatLaunchTime(launch network.Launch) string {
+	if !launch.IsScheduled() {
+		return "not scheduled"
+	}
+	return launch.LaunchTime.Local().Format(time.RFC1123)
+}
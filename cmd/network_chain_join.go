@@ -0,0 +1,214 @@
+package starportcmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+	"github.com/trino-network/trino/services/chain"
+	"github.com/trino-network/trino/services/network"
+)
+
+const (
+	flagSelfDelegation = "self-delegation"
+	flagAmount         = "amount"
+	flagPeerAddress    = "peer-address"
+	flagMoniker        = "moniker"
+	flagValidatorFile  = "validator-file"
+)
+
+// NewNetworkChainJoin returns a new command to join a chain launch as a validator.
+func NewNetworkChainJoin() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "join [launch-id]",
+		Short: "Request to join a chain launch as a validator",
+		Long: `Request to join a chain launch as a validator.
+
+The source of the chain is cloned and its binary is built and installed, then the node of
+the validator is initialized in ~/.starport/network/<launch-id>/home with its node key and
+a gentx that's signed by the account set with --from.
+
+The request is sent to the coordinator with the gentx, the account of the validator with
+--amount coins for the genesis and the address of the node that other validators connect to,
+set with --peer-address. The coordinator of the launch approves or rejects the request.
+
+The account of the validator doesn't need funds from a faucet to join: its coins, including
+the self-delegation, are added to the genesis with the request. Faucets of the chain are for
+the accounts that join after the launch, find them with "starport network faucet list".
+
+A validator that's already initialized with "starport network validator init" joins with the
+validator file that it generated, set with --validator-file.`,
+		Args:        cobra.ExactArgs(1),
+		RunE:        networkChainJoinHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().AddFlagSet(flagSetNetworkAccount())
+	c.Flags().AddFlagSet(flagSetValidator())
+	c.Flags().String(flagValidatorFile, "", "Validator file generated by \"starport network validator init\" to join with")
+
+	return c
+}
+
+func flagSetValidator() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagSelfDelegation, "", "Amount of coins that the validator delegates to itself, such as 95000000stake")
+	fs.String(flagAmount, "", "Coins of the validator's account in the genesis, the self-delegation by default")
+	fs.String(flagPeerAddress, "", "Public address of the validator's node in the <host>:<port> format")
+	fs.String(flagMoniker, "", "Moniker of the validator, the name of the account by default")
+	return fs
+}
+
+// getValidator returns the validator set with flags, its account is the one set with --from.
+func getValidator(cmd *cobra.Command) (validator chain.Validator, amount, peerAddress string, err error) {
+	var (
+		from, _           = cmd.Flags().GetString(flagFrom)
+		selfDelegation, _ = cmd.Flags().GetString(flagSelfDelegation)
+		moniker, _        = cmd.Flags().GetString(flagMoniker)
+	)
+	amount, _ = cmd.Flags().GetString(flagAmount)
+	peerAddress, _ = cmd.Flags().GetString(flagPeerAddress)
+
+	if selfDelegation == "" {
+		return chain.Validator{}, "", "", fmt.Errorf("self-delegation of the validator is required, set it with --%s", flagSelfDelegation)
+	}
+	if _, err := sdktypes.ParseCoinNormalized(selfDelegation); err != nil {
+		return chain.Validator{}, "", "", fmt.Errorf("invalid self-delegation: %w", err)
+	}
+	if amount == "" {
+		amount = selfDelegation
+	}
+	if _, err := sdktypes.ParseCoinsNormalized(amount); err != nil {
+		return chain.Validator{}, "", "", fmt.Errorf("invalid amount: %w", err)
+	}
+	if peerAddress == "" {
+		return chain.Validator{}, "", "", fmt.Errorf("public address of the node is required, set it with --%s", flagPeerAddress)
+	}
+	if moniker == "" {
+		moniker = from
+	}
+
+	return chain.Validator{
+		Name:          from,
+		Moniker:       moniker,
+		StakingAmount: selfDelegation,
+	}, amount, peerAddress, nil
+}
+
+func networkChainJoinHandler(cmd *cobra.Command, args []string) error {
+	validatorFile, _ := cmd.Flags().GetString(flagValidatorFile)
+
+	id, err := parseLaunchID(args[0])
+	if err != nil {
+		return err
+	}
+
+	nc, err := newNetworkClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Fetching the launch...")
+	defer s.Stop()
+
+	launch, err := nc.Launch(cmd.Context(), id)
+	if errors.Is(err, network.ErrNotFound) {
+		return fmt.Errorf("launch #%d is not found", id)
+	}
+	if err != nil {
+		return err
+	}
+
+	var validator network.Validator
+	if validatorFile != "" {
+		if validator, err = readValidatorFile(validatorFile); err != nil {
+			return err
+		}
+	} else {
+		c, binaryName, v, err := initValidator(cmd, s, launch)
+		if err != nil {
+			return err
+		}
+		validator = v
+
+		home, err := c.Home()
+		if err != nil {
+			return err
+		}
+		s.Stop()
+		fmt.Printf("🌍 %s is installed and its node is initialized in %s\n", binaryName, home)
+	}
+
+	s.SetText("Sending the request...").Start()
+	request, err := nc.SendRequest(cmd.Context(), launch.ID, validator)
+	if err != nil {
+		return err
+	}
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, request)
+	}
+
+	fmt.Printf("✋ Request #%d to join %s as validator %s is sent\n", request.ID, launch.ChainID, validator.Address)
+	return nil
+}
+
+// initValidator builds the chain of launch and initializes the node of the validator set with
+// flags, it returns the validator to join the launch with.
+func initValidator(cmd *cobra.Command, s *clispinner.Spinner, launch network.Launch) (
+	c *network.Chain,
+	binaryName string,
+	validator network.Validator,
+	err error,
+) {
+	v, amount, peerAddress, err := getValidator(cmd)
+	if err != nil {
+		return nil, "", network.Validator{}, err
+	}
+
+	ca, err := cosmosaccount.New(getAccountRegistryOptions(cmd)...)
+	if err != nil {
+		return nil, "", network.Validator{}, err
+	}
+
+	s.SetText("Cloning the source of the chain...")
+	if c, err = network.NewChain(cmd.Context(), launch); err != nil {
+		return nil, "", network.Validator{}, err
+	}
+
+	s.SetText("Building the chain...")
+	if binaryName, err = c.Build(cmd.Context()); err != nil {
+		return nil, "", network.Validator{}, err
+	}
+
+	s.SetText("Initializing the validator...")
+	if validator, err = c.InitValidator(cmd.Context(), ca, v, amount, peerAddress); err != nil {
+		return nil, "", network.Validator{}, err
+	}
+
+	return c, binaryName, validator, nil
+}
+
+// readValidatorFile reads the validator generated by "starport network validator init".
+func readValidatorFile(path string) (network.Validator, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return network.Validator{}, err
+	}
+
+	var validator network.Validator
+	if err := json.Unmarshal(content, &validator); err != nil {
+		return network.Validator{}, fmt.Errorf("invalid validator file: %w", err)
+	}
+	if validator.Address == "" || len(validator.Gentx) == 0 || validator.Peer == "" {
+		return network.Validator{}, fmt.Errorf("validator file %s is incomplete", path)
+	}
+	return validator, nil
+}
@@ -0,0 +1,59 @@
+package starportcmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/services/network"
+)
+
+// NewNetworkChainList returns a new command to list the chain launches of the coordinator.
+func NewNetworkChainList() *cobra.Command {
+	c := &cobra.Command{
+		Use:         "list",
+		Short:       "List the chain launches published with the coordinator",
+		Args:        cobra.NoArgs,
+		RunE:        networkChainListHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	return c
+}
+
+func networkChainListHandler(cmd *cobra.Command, args []string) error {
+	nc, err := newNetworkClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Fetching launches...")
+	defer s.Stop()
+
+	launches, err := nc.Launches(cmd.Context())
+	if err != nil {
+		return err
+	}
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, append([]network.Launch{}, launches...))
+	}
+
+	if len(launches) == 0 {
+		fmt.Println("No chain launches are published yet.")
+		return nil
+	}
+
+	w := &tabwriter.Writer{}
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+
+	fmt.Fprintln(w, "launch id\tchain id\tsource\tlaunch time")
+	for _, launch := range launches {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", launch.ID, launch.ChainID, launch.SourceURL, formatLaunchTime(launch))
+	}
+
+	return w.Flush()
+}
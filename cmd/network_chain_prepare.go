@@ -0,0 +1,123 @@
+package starportcmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/services/network"
+)
+
+// NewNetworkChainPrepare returns a new command to prepare the node of a chain launch.
+func NewNetworkChainPrepare() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "prepare [launch-id]",
+		Short: "Assemble the genesis of a chain launch and prepare the node to start the chain",
+		Long: `Assemble the genesis of a chain launch and prepare the node to start the chain.
+
+The final genesis is assembled from the accounts and gentxs of the approved validators in the
+order of their requests, with the launch time as its genesis time. Every participant assembles
+the same genesis, its hash is reported to the coordinator and verified against the hashes
+reported by the other participants.
+
+The node is configured with the nodes of the validators as its persistent peers. The keys of
+a node that's initialized with "starport network chain join" are kept.`,
+		Args:        cobra.ExactArgs(1),
+		RunE:        networkChainPrepareHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().AddFlagSet(flagSetNetworkAccount())
+
+	return c
+}
+
+// chainPrepareResult is the result of network chain prepare in the json and yaml outputs.
+type chainPrepareResult struct {
+	LaunchID     uint64 `json:"launch_id"`
+	ChainID      string `json:"chain_id"`
+	GenesisHash  string `json:"genesis_hash"`
+	Participants int    `json:"participants"`
+	Binary       string `json:"binary"`
+	Home         string `json:"home"`
+}
+
+func networkChainPrepareHandler(cmd *cobra.Command, args []string) error {
+	id, err := parseLaunchID(args[0])
+	if err != nil {
+		return err
+	}
+
+	nc, err := newNetworkClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Fetching the launch...")
+	defer s.Stop()
+
+	launch, err := nc.Launch(cmd.Context(), id)
+	if errors.Is(err, network.ErrNotFound) {
+		return fmt.Errorf("launch #%d is not found", id)
+	}
+	if err != nil {
+		return err
+	}
+
+	requests, err := nc.Requests(cmd.Context(), id)
+	if err != nil {
+		return err
+	}
+
+	s.SetText("Cloning the source of the chain...")
+	c, err := network.NewChain(cmd.Context(), launch)
+	if err != nil {
+		return err
+	}
+
+	s.SetText("Building the chain...")
+	binaryName, err := c.Build(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	s.SetText("Assembling the genesis...")
+	genesisHash, err := c.Prepare(cmd.Context(), requests)
+	if err != nil {
+		return err
+	}
+
+	s.SetText("Verifying the genesis...")
+	if err := nc.ReportGenesis(cmd.Context(), id, genesisHash); err != nil {
+		return err
+	}
+	reports, err := nc.GenesisReports(cmd.Context(), id)
+	if err != nil {
+		return err
+	}
+	if err := network.VerifyGenesisHash(genesisHash, reports); err != nil {
+		return err
+	}
+	s.Stop()
+
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, chainPrepareResult{
+			LaunchID:     launch.ID,
+			ChainID:      launch.ChainID,
+			GenesisHash:  genesisHash,
+			Participants: len(reports),
+			Binary:       binaryName,
+			Home:         home,
+		})
+	}
+
+	fmt.Printf("✅ Genesis %s of %s matches the genesis reported by %d participants\n", genesisHash, launch.ChainID, len(reports))
+	fmt.Printf("🚀 Start the node before %s with:\n\n\t%s start --home %s\n", formatLaunchTime(launch), binaryName, home)
+	return nil
+}
@@ -0,0 +1,181 @@
+package starportcmd
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/services/network"
+)
+
+const (
+	flagBranch     = "branch"
+	flagTag        = "tag"
+	flagHash       = "hash"
+	flagGenesis    = "genesis"
+	flagChainID    = "chain-id"
+	flagLaunchTime = "launch-time"
+	flagCampaign   = "campaign"
+	flagFaucet     = "faucet"
+)
+
+// NewNetworkChainPublish returns a new command to publish a chain launch.
+func NewNetworkChainPublish() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "publish [source-url]",
+		Short: "Publish the launch of a chain with the coordinator",
+		Long: `Publish the launch of a chain with the coordinator.
+
+The binary of the chain is built from the commit of the git repository at source-url that
+--branch, --tag or --hash point to, the latest commit of the default branch by default.
+
+The genesis of the chain is the one generated by the chain's binary unless a genesis
+template is set with --genesis, its hash is published with the launch so all validators
+start from the same genesis. --launch-time sets the genesis time of the chain.
+
+A launch of an incentivized testnet is published for a campaign with --campaign, its
+validators are rewarded with the reward shares of the campaign.
+
+The faucet that the chain will have is registered with --faucet, so it's discovered by
+"starport network join" and "starport relayer configure" after the launch.`,
+		Args:        cobra.ExactArgs(1),
+		RunE:        networkChainPublishHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().AddFlagSet(flagSetNetworkAccount())
+	c.Flags().String(flagBranch, "", "Branch of the repository to build the chain from")
+	c.Flags().String(flagTag, "", "Tag of the repository to build the chain from")
+	c.Flags().String(flagHash, "", "Commit hash of the repository to build the chain from")
+	c.Flags().String(flagGenesis, "", "URL of a genesis template of the chain")
+	c.Flags().String(flagChainID, "", "Chain ID of the chain, the name of the repository with a -1 suffix by default")
+	c.Flags().String(flagLaunchTime, "", "Genesis time of the chain in RFC3339, such as 2021-12-01T15:00:00Z")
+	c.Flags().Uint64(flagCampaign, 0, "ID of the campaign that the chain is launched for")
+	c.Flags().String(flagFaucet, "", "Address of the faucet of the chain to register with the coordinator")
+
+	return c
+}
+
+func networkChainPublishHandler(cmd *cobra.Command, args []string) error {
+	var (
+		sourceURL        = args[0]
+		branch, _        = cmd.Flags().GetString(flagBranch)
+		tag, _           = cmd.Flags().GetString(flagTag)
+		hash, _          = cmd.Flags().GetString(flagHash)
+		genesisURL, _    = cmd.Flags().GetString(flagGenesis)
+		chainID, _       = cmd.Flags().GetString(flagChainID)
+		launchTimeArg, _ = cmd.Flags().GetString(flagLaunchTime)
+		campaignID, _    = cmd.Flags().GetUint64(flagCampaign)
+		faucet, _        = cmd.Flags().GetString(flagFaucet)
+	)
+
+	refs := 0
+	for _, ref := range []string{branch, tag, hash} {
+		if ref != "" {
+			refs++
+		}
+	}
+	if refs > 1 {
+		return fmt.Errorf("only one of --%s, --%s and --%s can be set", flagBranch, flagTag, flagHash)
+	}
+
+	launch := network.Launch{
+		ChainID:    chainID,
+		SourceURL:  sourceURL,
+		SourceHash: hash,
+		GenesisURL: genesisURL,
+		CampaignID: campaignID,
+	}
+
+	if launchTimeArg != "" {
+		launchTime, err := time.Parse(time.RFC3339, launchTimeArg)
+		if err != nil {
+			return fmt.Errorf("invalid launch time, use RFC3339 such as 2021-12-01T15:00:00Z: %w", err)
+		}
+		if launchTime.Before(time.Now()) {
+			return errors.New("launch time must be in the future")
+		}
+		launch.LaunchTime = launchTime.UTC()
+	}
+
+	if launch.ChainID == "" {
+		launch.ChainID = defaultLaunchChainID(sourceURL)
+	}
+
+	if faucet != "" {
+		if u, err := url.Parse(faucet); err != nil || u.Host == "" {
+			return fmt.Errorf("invalid faucet address %q", faucet)
+		}
+	}
+
+	nc, err := newNetworkClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New()
+	defer s.Stop()
+
+	if launch.SourceHash == "" {
+		ref := branch
+		if ref == "" {
+			ref = tag
+		}
+
+		s.SetText("Resolving the source of the chain...")
+		if launch.SourceHash, err = network.ResolveSourceHash(sourceURL, ref); err != nil {
+			return err
+		}
+	}
+
+	if launch.GenesisURL != "" {
+		s.SetText("Downloading the genesis template...")
+		if launch.GenesisHash, err = network.GenesisHash(cmd.Context(), launch.GenesisURL); err != nil {
+			return err
+		}
+	}
+
+	if launch.CampaignID != 0 {
+		s.SetText("Fetching the campaign...")
+		if _, err := nc.Campaign(cmd.Context(), launch.CampaignID); err != nil {
+			if errors.Is(err, network.ErrNotFound) {
+				return fmt.Errorf("campaign #%d is not found", launch.CampaignID)
+			}
+			return err
+		}
+	}
+
+	s.SetText("Publishing the launch...")
+	published, err := nc.PublishLaunch(cmd.Context(), launch)
+	if err != nil {
+		return err
+	}
+
+	if faucet != "" {
+		s.SetText("Registering the faucet...")
+		if _, err := nc.RegisterFaucet(cmd.Context(), published.ChainID, faucet); err != nil {
+			return fmt.Errorf("launch #%d is published but its faucet cannot be registered: %w", published.ID, err)
+		}
+	}
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, published)
+	}
+
+	fmt.Printf("🚀 Launch #%d of %s published\n\n", published.ID, published.ChainID)
+	printLaunch(published)
+	return nil
+}
+
+// defaultLaunchChainID returns the chain ID of the chain at sourceURL, it's the name of its
+// repository with a -1 suffix.
+func defaultLaunchChainID(sourceURL string) string {
+	name := strings.TrimSuffix(path.Base(strings.TrimSuffix(sourceURL, "/")), ".git")
+	return strings.ToLower(name) + "-1"
+}
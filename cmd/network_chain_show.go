@@ -0,0 +1,54 @@
+package starportcmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/services/network"
+)
+
+// NewNetworkChainShow returns a new command to show a chain launch of the coordinator.
+func NewNetworkChainShow() *cobra.Command {
+	c := &cobra.Command{
+		Use:         "show [launch-id]",
+		Short:       "Show the details of a chain launch",
+		Args:        cobra.ExactArgs(1),
+		RunE:        networkChainShowHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	return c
+}
+
+func networkChainShowHandler(cmd *cobra.Command, args []string) error {
+	id, err := parseLaunchID(args[0])
+	if err != nil {
+		return err
+	}
+
+	nc, err := newNetworkClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Fetching the launch...")
+	defer s.Stop()
+
+	launch, err := nc.Launch(cmd.Context(), id)
+	if errors.Is(err, network.ErrNotFound) {
+		return fmt.Errorf("launch #%d is not found", id)
+	}
+	if err != nil {
+		return err
+	}
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, launch)
+	}
+
+	printLaunch(launch)
+	return nil
+}
@@ -0,0 +1,260 @@
+package starportcmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/services/network"
+)
+
+const (
+	flagRefresh = "refresh"
+	flagOnce    = "once"
+
+	// clearScreen moves the cursor to the top of the terminal and clears it.
+	clearScreen = "\033[H\033[2J"
+)
+
+// NewNetworkChainStatus returns a new command to monitor the readiness of a chain launch.
+func NewNetworkChainStatus() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "status [launch-id]",
+		Short: "Monitor the readiness of the validators of a chain launch",
+		Long: `Monitor the readiness of the validators of a chain launch.
+
+The approved validators are shown with their bonded power, whether their nodes accept
+connections and whether they assembled the genesis of the launch with "starport network chain
+prepare". A validator is ready when both are true, more than 2/3 of the bonded power must be
+ready for the chain to produce blocks at its launch time.
+
+The status and the countdown to the launch time are refreshed live until Ctrl+C is pressed.`,
+		Args:        cobra.ExactArgs(1),
+		RunE:        networkChainStatusHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().Duration(flagRefresh, 10*time.Second, "Interval of fetching the status of the launch")
+	c.Flags().Bool(flagOnce, false, "Print the status once without refreshing it")
+
+	return c
+}
+
+func networkChainStatusHandler(cmd *cobra.Command, args []string) error {
+	var (
+		refresh, _ = cmd.Flags().GetDuration(flagRefresh)
+		once, _    = cmd.Flags().GetBool(flagOnce)
+	)
+
+	id, err := parseLaunchID(args[0])
+	if err != nil {
+		return err
+	}
+	if refresh < time.Second {
+		return errors.New("refresh interval must be one second at least")
+	}
+
+	nc, err := newNetworkClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Fetching the status of the launch...")
+	defer s.Stop()
+
+	fetch := func() (network.LaunchStatus, error) {
+		status, err := nc.LaunchStatus(cmd.Context(), id)
+		if errors.Is(err, network.ErrNotFound) {
+			return status, fmt.Errorf("launch #%d is not found", id)
+		}
+		return status, err
+	}
+
+	status, err := fetch()
+	if err != nil {
+		return err
+	}
+	s.Stop()
+
+	// the status is printed once in the json and yaml outputs.
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, newLaunchStatusResult(status))
+	}
+	if once {
+		return printLaunchStatus(os.Stdout, status, time.Now())
+	}
+
+	var (
+		tick      = time.NewTicker(time.Second)
+		updatedAt = time.Now()
+		fetchErr  error
+	)
+	defer tick.Stop()
+
+	for {
+		var b bytes.Buffer
+		if err := printLaunchStatus(&b, status, time.Now()); err != nil {
+			return err
+		}
+		fmt.Fprintf(&b, "\nUpdated at %s, press Ctrl+C to exit\n", updatedAt.Format("15:04:05"))
+		if fetchErr != nil {
+			fmt.Fprintf(&b, "⚠️  Cannot fetch the status: %s\n", fetchErr)
+		}
+		fmt.Print(clearScreen, b.String())
+
+		select {
+		case <-cmd.Context().Done():
+			return cmd.Context().Err()
+		case now := <-tick.C:
+			if now.Sub(updatedAt) < refresh {
+				continue
+			}
+			// the last status is kept when the coordinator cannot be reached.
+			var latest network.LaunchStatus
+			if latest, fetchErr = fetch(); fetchErr == nil {
+				status = latest
+			}
+			updatedAt = now
+		}
+	}
+}
+
+// launchStatusResult is the result of network chain status in the json and yaml outputs.
+type launchStatusResult struct {
+	Launch      network.Launch          `json:"launch"`
+	GenesisHash string                  `json:"genesis_hash"`
+	Power       int64                   `json:"power"`
+	ReadyPower  int64                   `json:"ready_power"`
+	Ready       bool                    `json:"ready"`
+	Validators  []validatorStatusResult `json:"validators"`
+}
+
+type validatorStatusResult struct {
+	RequestID      uint64 `json:"request_id"`
+	Address        string `json:"address"`
+	SelfDelegation string `json:"self_delegation"`
+	Power          int64  `json:"power"`
+	PeerReachable  bool   `json:"peer_reachable"`
+	GenesisHash    string `json:"genesis_hash"`
+	Ready          bool   `json:"ready"`
+}
+
+func newLaunchStatusResult(status network.LaunchStatus) launchStatusResult {
+	power, readyPower := status.Power()
+	result := launchStatusResult{
+		Launch:      status.Launch,
+		GenesisHash: status.GenesisHash,
+		Power:       power,
+		ReadyPower:  readyPower,
+		Ready:       status.IsReady(),
+		Validators:  []validatorStatusResult{},
+	}
+	for _, validator := range status.Validators {
+		result.Validators = append(result.Validators, validatorStatusResult{
+			RequestID:      validator.Request.ID,
+			Address:        validator.Request.Validator.Address,
+			SelfDelegation: validator.SelfDelegation.String(),
+			Power:          validator.Power(),
+			PeerReachable:  validator.PeerReachable,
+			GenesisHash:    validator.GenesisHash,
+			Ready:          validator.IsReady(status.GenesisHash),
+		})
+	}
+	return result
+}
+
+// printLaunchStatus prints the readiness of the validators of a launch and the countdown to
+// its launch time at now.
+func printLaunchStatus(out io.Writer, status network.LaunchStatus, now time.Time) error {
+	launch := status.Launch
+
+	countdown := "not scheduled"
+	switch {
+	case !launch.IsScheduled():
+	case launch.LaunchTime.After(now):
+		countdown = fmt.Sprintf("%s (in %s)", formatLaunchTime(launch), launch.LaunchTime.Sub(now).Round(time.Second))
+	default:
+		countdown = fmt.Sprintf("%s (launched %s ago)", formatLaunchTime(launch), now.Sub(launch.LaunchTime).Round(time.Second))
+	}
+
+	genesis := "not assembled yet"
+	if status.GenesisHash != "" {
+		genesis = status.GenesisHash
+	}
+
+	fmt.Fprintf(out, "🚀 Launch #%d of %s\n\n", launch.ID, launch.ChainID)
+
+	w := &tabwriter.Writer{}
+	w.Init(out, 0, 8, 0, '\t', 0)
+	fmt.Fprintf(w, "Launch time:\t%s\n", countdown)
+	fmt.Fprintf(w, "Genesis:\t%s\n", genesis)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintln(out)
+
+	if len(status.Validators) == 0 {
+		fmt.Fprintln(out, "No validators are approved yet.")
+		return nil
+	}
+
+	w.Init(out, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "request\tvalidator\tself-delegation\tpower\tpeer\tgenesis\tready")
+	for _, validator := range status.Validators {
+		peer := "unreachable"
+		if validator.PeerReachable {
+			peer = "reachable"
+		}
+
+		genesis := "-"
+		switch {
+		case validator.GenesisHash == "":
+		case validator.GenesisHash == status.GenesisHash:
+			genesis = "verified"
+		default:
+			genesis = "mismatch"
+		}
+
+		ready := "no"
+		if validator.IsReady(status.GenesisHash) {
+			ready = "yes"
+		}
+
+		fmt.Fprintf(w, "#%d\t%s\t%s\t%d\t%s\t%s\t%s\n",
+			validator.Request.ID,
+			validator.Request.Validator.Address,
+			validator.SelfDelegation,
+			validator.Power(),
+			peer,
+			genesis,
+			ready,
+		)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	total, ready := status.Power()
+	readiness := "❌ Less than 2/3 of the power is ready, the chain cannot produce blocks yet"
+	if status.IsReady() {
+		readiness = "✅ More than 2/3 of the power is ready to produce blocks"
+	}
+
+	fmt.Fprintf(out, "\nBonded power: %d (%d validators)\n", total, len(status.Validators))
+	fmt.Fprintf(out, "Ready power:  %d (%d%%)\n", ready, percent(ready, total))
+	fmt.Fprintln(out, readiness)
+	return nil
+}
+
+func percent(part, total int64) int64 {
+	if total == 0 {
+		return 0
+	}
+	return part * 100 / total
+}
@@ -0,0 +1,25 @@
+package starportcmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewNetworkFaucet creates a new faucet command that holds some other sub commands
+// related to faucets of chains that are registered with the coordinator.
+func NewNetworkFaucet() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "faucet [command]",
+		Short: "Register and discover the faucets of chains",
+		Long: `Register and discover the faucets of chains.
+
+Anyone can register the faucet of a chain with the coordinator, the faucets of a chain are
+discovered by "starport network join" and "starport relayer configure --coordinator" when
+they are not set with flags. Only the faucets that serve the chain are used.`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewNetworkFaucetRegister())
+	c.AddCommand(NewNetworkFaucetList())
+
+	return c
+}
@@ -0,0 +1,60 @@
+package starportcmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/services/network"
+)
+
+// NewNetworkFaucetList returns a new command to list the faucets of a chain.
+func NewNetworkFaucetList() *cobra.Command {
+	c := &cobra.Command{
+		Use:         "list [chain-id]",
+		Short:       "List the faucets of a chain that are registered with the coordinator",
+		Args:        cobra.ExactArgs(1),
+		RunE:        networkFaucetListHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	return c
+}
+
+func networkFaucetListHandler(cmd *cobra.Command, args []string) error {
+	nc, err := newNetworkClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Fetching faucets...")
+	defer s.Stop()
+
+	faucets, err := nc.Faucets(cmd.Context(), args[0])
+	if err != nil && !errors.Is(err, network.ErrNotFound) {
+		return err
+	}
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, append([]network.Faucet{}, faucets...))
+	}
+
+	if len(faucets) == 0 {
+		fmt.Printf("No faucets of %s are registered yet.\n", args[0])
+		return nil
+	}
+
+	w := &tabwriter.Writer{}
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+
+	fmt.Fprintln(w, "faucet\tregistrant")
+	for _, faucet := range faucets {
+		fmt.Fprintf(w, "%s\t%s\n", faucet.Address, faucet.Registrant)
+	}
+
+	return w.Flush()
+}
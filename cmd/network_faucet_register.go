@@ -0,0 +1,46 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+)
+
+// NewNetworkFaucetRegister returns a new command to register the faucet of a chain.
+func NewNetworkFaucetRegister() *cobra.Command {
+	c := &cobra.Command{
+		Use:         "register [chain-id] [faucet-url]",
+		Short:       "Register the faucet of a chain with the coordinator",
+		Args:        cobra.ExactArgs(2),
+		RunE:        networkFaucetRegisterHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().AddFlagSet(flagSetNetworkAccount())
+
+	return c
+}
+
+func networkFaucetRegisterHandler(cmd *cobra.Command, args []string) error {
+	nc, err := newNetworkClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Registering the faucet...")
+	defer s.Stop()
+
+	faucet, err := nc.RegisterFaucet(cmd.Context(), args[0], args[1])
+	if err != nil {
+		return err
+	}
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, faucet)
+	}
+
+	fmt.Printf("💧 Faucet %s of %s registered\n", faucet.Address, faucet.ChainID)
+	return nil
+}
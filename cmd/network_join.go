@@ -0,0 +1,169 @@
+package starportcmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/pkg/chainregistry"
+	"github.com/trino-network/trino/services/network"
+)
+
+const (
+	flagRegistry    = "registry"
+	flagNoStateSync = "no-state-sync"
+	flagNoStart     = "no-start"
+)
+
+// NewNetworkJoin returns a new command to join a public chain with a full node.
+func NewNetworkJoin() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "join [chain-id]",
+		Short: "Join a public chain that's in a chain registry with a full node",
+		Long: `Join a public chain that's in a chain registry with a full node.
+
+The chain is looked up in a registry in the format of the cosmos chain-registry by its chain
+ID or its name. The source of the chain is cloned at its recommended version, its binary is
+built and installed, and the node is initialized in ~/.starport/network/nodes/<chain-id>/home
+with the genesis of the chain.
+
+The node connects to the seeds of the chain and to its persistent peers that accept
+connections. It syncs from the state of the chain that's served by its healthy RPC endpoints
+unless --no-state-sync is set, then the node is started. State sync needs two distinct healthy
+RPC endpoints that the state is verified with.
+
+The faucet of the chain is discovered from the coordinator when --coordinator is set.`,
+		Args:        cobra.ExactArgs(1),
+		RunE:        networkJoinHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().String(flagRegistry, chainregistry.DefaultURL, "Address or local path of the chain registry")
+	c.Flags().String(flagMoniker, "", "Moniker of the node, the hostname by default")
+	c.Flags().Bool(flagNoStateSync, false, "Replay the blocks of the chain instead of syncing from its state")
+	c.Flags().Bool(flagNoStart, false, "Initialize the node without starting it")
+
+	return c
+}
+
+// networkJoinResult is the result of network join in the json and yaml outputs, it's printed
+// before the node is started.
+type networkJoinResult struct {
+	ChainID         string `json:"chain_id"`
+	Version         string `json:"version"`
+	Binary          string `json:"binary"`
+	Home            string `json:"home"`
+	Seeds           int    `json:"seeds"`
+	Peers           int    `json:"peers"`
+	StateSyncHeight int64  `json:"state_sync_height,omitempty"`
+	Faucet          string `json:"faucet,omitempty"`
+}
+
+func networkJoinHandler(cmd *cobra.Command, args []string) error {
+	var (
+		registry, _    = cmd.Flags().GetString(flagRegistry)
+		moniker, _     = cmd.Flags().GetString(flagMoniker)
+		noStateSync, _ = cmd.Flags().GetBool(flagNoStateSync)
+		noStart, _     = cmd.Flags().GetBool(flagNoStart)
+	)
+
+	if moniker == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return err
+		}
+		moniker = hostname
+	}
+
+	s := clispinner.New().SetText("Looking up the chain...")
+	defer s.Stop()
+
+	chain, err := chainregistry.New(registry).Lookup(cmd.Context(), args[0])
+	if errors.Is(err, chainregistry.ErrNotFound) {
+		return fmt.Errorf("%s is not in the registry %s", args[0], registry)
+	}
+	if err != nil {
+		return err
+	}
+
+	s.SetText("Checking the peers...")
+	peers := network.HealthyPeers(cmd.Context(), chain.Peers.PersistentPeers)
+	if len(peers) == 0 && len(chain.Peers.Seeds) == 0 {
+		return fmt.Errorf("none of the peers of %s accepts connections", chain.ChainID)
+	}
+
+	var stateSync *network.StateSync
+	if !noStateSync {
+		s.SetText("Checking the state sync providers...")
+		ss, err := network.FindStateSync(cmd.Context(), chain.ChainID, chain.APIs.RPC)
+		if err != nil {
+			return fmt.Errorf("cannot sync from the state of the chain, join with --%s to replay its blocks: %w", flagNoStateSync, err)
+		}
+		stateSync = &ss
+	}
+
+	s.SetText(fmt.Sprintf("Cloning the source of %s...", chain.Codebase.RecommendedVersion))
+	node, err := network.NewNode(cmd.Context(), chain)
+	if err != nil {
+		return err
+	}
+
+	s.SetText("Building the chain...")
+	binaryName, err := node.Build(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	s.SetText("Initializing the node...")
+	if err := node.Init(cmd.Context(), moniker, peers, stateSync); err != nil {
+		return err
+	}
+
+	var faucet string
+	if coordinator, _ := cmd.Flags().GetString(flagCoordinator); coordinator != "" {
+		s.SetText("Discovering the faucet...")
+		faucet, err = network.New(coordinator).DiscoverFaucet(cmd.Context(), chain.ChainID)
+		if err != nil && !errors.Is(err, network.ErrNotFound) {
+			return err
+		}
+	}
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		result := networkJoinResult{
+			ChainID: chain.ChainID,
+			Version: chain.Codebase.RecommendedVersion,
+			Binary:  binaryName,
+			Home:    node.Home(),
+			Seeds:   len(chain.Peers.Seeds),
+			Peers:   len(peers),
+			Faucet:  faucet,
+		}
+		if stateSync != nil {
+			result.StateSyncHeight = stateSync.TrustHeight
+		}
+		if err := printResult(cmd, result); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("🌍 %s %s is installed and the node of %s is initialized in %s\n",
+		binaryName, chain.Codebase.RecommendedVersion, chain.ChainID, node.Home())
+	fmt.Printf("🔗 Connecting to %d seeds and %d peers\n", len(chain.Peers.Seeds), len(peers))
+	if stateSync != nil {
+		fmt.Printf("⚡ Syncing from the state at height %d served by %s\n", stateSync.TrustHeight, stateSync.RPCServers[0])
+	}
+	if faucet != "" {
+		fmt.Printf("💧 Faucet of the chain: %s\n", faucet)
+	}
+
+	if noStart {
+		fmt.Printf("🚀 Start the node with:\n\n\t%s start --home %s\n", binaryName, node.Home())
+		return nil
+	}
+
+	fmt.Printf("🚀 Starting the node...\n\n")
+	return node.Start(cmd.Context(), os.Stdout, os.Stderr)
+}
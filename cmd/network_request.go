@@ -0,0 +1,168 @@
+package starportcmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/services/network"
+)
+
+// NewNetworkRequest creates a new request command that holds some other sub commands
+// related to the requests to join launches.
+func NewNetworkRequest() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "request [command]",
+		Short: "Review the requests of validators to join a chain launch",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewNetworkRequestList())
+	c.AddCommand(NewNetworkRequestApprove())
+	c.AddCommand(NewNetworkRequestReject())
+
+	return c
+}
+
+// NewNetworkRequestList returns a new command to list the requests to join a launch.
+func NewNetworkRequestList() *cobra.Command {
+	c := &cobra.Command{
+		Use:         "list [launch-id]",
+		Short:       "List the requests to join a chain launch",
+		Args:        cobra.ExactArgs(1),
+		RunE:        networkRequestListHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	return c
+}
+
+// NewNetworkRequestApprove returns a new command to approve requests to join a launch.
+func NewNetworkRequestApprove() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "approve [launch-id] [request-id]...",
+		Short: "Approve requests to join a chain launch",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return networkRequestReviewHandler(cmd, args, network.Client.ApproveRequest)
+		},
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().AddFlagSet(flagSetNetworkAccount())
+
+	return c
+}
+
+// NewNetworkRequestReject returns a new command to reject requests to join a launch.
+func NewNetworkRequestReject() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "reject [launch-id] [request-id]...",
+		Short: "Reject requests to join a chain launch",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return networkRequestReviewHandler(cmd, args, network.Client.RejectRequest)
+		},
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().AddFlagSet(flagSetNetworkAccount())
+
+	return c
+}
+
+func networkRequestListHandler(cmd *cobra.Command, args []string) error {
+	launchID, err := parseLaunchID(args[0])
+	if err != nil {
+		return err
+	}
+
+	nc, err := newNetworkClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Fetching requests...")
+	defer s.Stop()
+
+	requests, err := nc.Requests(cmd.Context(), launchID)
+	if err != nil {
+		return err
+	}
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, append([]network.Request{}, requests...))
+	}
+
+	if len(requests) == 0 {
+		fmt.Printf("No requests to join launch #%d yet.\n", launchID)
+		return nil
+	}
+
+	printRequests(requests)
+	return nil
+}
+
+func networkRequestReviewHandler(
+	cmd *cobra.Command,
+	args []string,
+	review func(network.Client, context.Context, uint64, uint64) (network.Request, error),
+) error {
+	launchID, err := parseLaunchID(args[0])
+	if err != nil {
+		return err
+	}
+
+	var ids []uint64
+	for _, arg := range args[1:] {
+		id, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return fmt.Errorf("request ID %q must be a positive number", arg)
+		}
+		ids = append(ids, id)
+	}
+
+	nc, err := newNetworkClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	var requests []network.Request
+	for _, id := range ids {
+		request, err := review(nc, cmd.Context(), launchID, id)
+		if err != nil {
+			return fmt.Errorf("cannot review request #%d: %w", id, err)
+		}
+		requests = append(requests, request)
+	}
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, requests)
+	}
+
+	printRequests(requests)
+	return nil
+}
+
+func printRequests(requests []network.Request) {
+	w := &tabwriter.Writer{}
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+
+	fmt.Fprintln(w, "request id\tstatus\tvalidator\tcoins\tpeer")
+	for _, request := range requests {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n",
+			request.ID,
+			request.Status,
+			request.Validator.Address,
+			request.Validator.Coins,
+			request.Validator.Peer,
+		)
+	}
+
+	w.Flush()
+}
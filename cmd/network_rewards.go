@@ -0,0 +1,19 @@
+package starportcmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewNetworkRewards creates a new rewards command that holds some other sub commands
+// related to the rewards of campaigns.
+func NewNetworkRewards() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "rewards [command]",
+		Short: "Track the rewards of the validators of incentivized testnets",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewNetworkRewardsStatus())
+
+	return c
+}
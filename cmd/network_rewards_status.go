@@ -0,0 +1,163 @@
+package starportcmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/services/network"
+)
+
+const (
+	flagBlocks = "blocks"
+)
+
+// NewNetworkRewardsStatus returns a new command to track the uptime and the rewards of the
+// validators of a launched chain.
+func NewNetworkRewardsStatus() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "status [launch-id]",
+		Short: "Track the uptime of the validators of a launched chain and preview their rewards",
+		Long: `Track the uptime of the validators of a launched chain and preview their rewards.
+
+The blocks signed by the approved validators of the launch are counted over the last blocks
+of the chain, that are fetched from the RPC endpoint of a node of the chain set with --node.
+
+The reward shares of the campaign of the launch are distributed to the validators that
+signed the min uptime percentage of the campaign, the rewards of each validator are
+proportional to the blocks signed by it.`,
+		Args:        cobra.ExactArgs(1),
+		RunE:        networkRewardsStatusHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().AddFlagSet(flagSetNode())
+	c.Flags().Int64(flagBlocks, 100, "Number of the last blocks to track the uptime over")
+
+	return c
+}
+
+// rewardsStatusResult is the result of network rewards status in the json and yaml outputs.
+type rewardsStatusResult struct {
+	LaunchID     uint64                   `json:"launch_id"`
+	ChainID      string                   `json:"chain_id"`
+	CampaignID   uint64                   `json:"campaign_id"`
+	RewardShares string                   `json:"reward_shares"`
+	MinUptime    float64                  `json:"min_uptime"`
+	FromHeight   int64                    `json:"from_height"`
+	ToHeight     int64                    `json:"to_height"`
+	Validators   []validatorRewardsResult `json:"validators"`
+}
+
+type validatorRewardsResult struct {
+	RequestID uint64  `json:"request_id"`
+	Address   string  `json:"address"`
+	Signed    int64   `json:"signed"`
+	Blocks    int64   `json:"blocks"`
+	Uptime    float64 `json:"uptime"`
+	Eligible  bool    `json:"eligible"`
+	Rewards   string  `json:"rewards"`
+}
+
+func networkRewardsStatusHandler(cmd *cobra.Command, args []string) error {
+	var (
+		node      = getNode(cmd)
+		blocks, _ = cmd.Flags().GetInt64(flagBlocks)
+	)
+
+	id, err := parseLaunchID(args[0])
+	if err != nil {
+		return err
+	}
+	if blocks < 1 {
+		return errors.New("number of blocks must be positive")
+	}
+
+	nc, err := newNetworkClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Tracking the signed blocks...")
+	defer s.Stop()
+
+	status, err := nc.RewardsStatus(cmd.Context(), id, node, blocks)
+	if errors.Is(err, network.ErrNotFound) {
+		return fmt.Errorf("launch #%d or its campaign is not found", id)
+	}
+	if err != nil {
+		return err
+	}
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		result := rewardsStatusResult{
+			LaunchID:     status.Launch.ID,
+			ChainID:      status.Launch.ChainID,
+			CampaignID:   status.Campaign.ID,
+			RewardShares: status.Campaign.RewardShares,
+			MinUptime:    status.Campaign.MinUptime,
+			FromHeight:   status.FromHeight,
+			ToHeight:     status.ToHeight,
+			Validators:   []validatorRewardsResult{},
+		}
+		for _, validator := range status.Validators {
+			result.Validators = append(result.Validators, validatorRewardsResult{
+				RequestID: validator.Request.ID,
+				Address:   validator.Request.Validator.Address,
+				Signed:    validator.Signed,
+				Blocks:    validator.Blocks,
+				Uptime:    validator.Uptime(),
+				Eligible:  validator.Eligible,
+				Rewards:   validator.Rewards.String(),
+			})
+		}
+		return printResult(cmd, result)
+	}
+
+	fmt.Printf("🏆 Campaign #%d %s of launch #%d of %s\n\n", status.Campaign.ID, status.Campaign.Name, status.Launch.ID, status.Launch.ChainID)
+
+	w := &tabwriter.Writer{}
+	w.Init(os.Stdout, 0, 8, 0, '\t', 0)
+	fmt.Fprintf(w, "Blocks:\t%d to %d\n", status.FromHeight, status.ToHeight)
+	fmt.Fprintf(w, "Reward shares:\t%s\n", status.Campaign.RewardShares)
+	fmt.Fprintf(w, "Min uptime:\t%v%%\n", status.Campaign.MinUptime)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Println()
+
+	if len(status.Validators) == 0 {
+		fmt.Println("No validators are approved for the launch.")
+		return nil
+	}
+
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "request\tvalidator\tsigned\tuptime\teligible\trewards")
+	for _, validator := range status.Validators {
+		eligible := "no"
+		if validator.Eligible {
+			eligible = "yes"
+		}
+
+		rewards := validator.Rewards.String()
+		if rewards == "" {
+			rewards = "-"
+		}
+
+		fmt.Fprintf(w, "#%d\t%s\t%d/%d\t%.2f%%\t%s\t%s\n",
+			validator.Request.ID,
+			validator.Request.Validator.Address,
+			validator.Signed,
+			validator.Blocks,
+			validator.Uptime(),
+			eligible,
+			rewards,
+		)
+	}
+
+	return w.Flush()
+}
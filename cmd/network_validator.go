@@ -0,0 +1,19 @@
+package starportcmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewNetworkValidator creates a new validator command that holds some other sub commands
+// related to validators of chain launches.
+func NewNetworkValidator() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "validator [command]",
+		Short: "Prepare the keys and the gentx of a validator of a chain launch",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewNetworkValidatorInit())
+
+	return c
+}
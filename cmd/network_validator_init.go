@@ -0,0 +1,191 @@
+package starportcmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/services/network"
+)
+
+const (
+	flagRemoteSigner = "remote-signer"
+	flagKMSHome      = "kms-home"
+)
+
+// NewNetworkValidatorInit returns a new command to initialize the validator of a chain launch.
+func NewNetworkValidatorInit() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "init [launch-id]",
+		Short: "Generate the consensus key and the gentx of a validator of a chain launch",
+		Long: `Generate the consensus key and the gentx of a validator of a chain launch.
+
+The source of the chain is cloned and its binary is built and installed, then the node of
+the validator is initialized in ~/.starport/network/<launch-id>/home with its node key and
+consensus key, that are kept when the node is initialized again. The gentx is signed by the
+account set with --from and its self-delegation is checked against the bond denom of the
+launch.
+
+The validator is written to ~/.starport/network/<launch-id>/validator.json with the gentx,
+the account and the peer address, to join the launch with:
+
+	starport network chain join [launch-id] --validator-file ~/.starport/network/<launch-id>/validator.json
+
+With --remote-signer, the node listens for a remote signer such as tmkms at the address
+instead of signing blocks with its consensus key, and a tmkms.toml to import the consensus
+key to tmkms is generated next to the validator file.`,
+		Args:        cobra.ExactArgs(1),
+		RunE:        networkValidatorInitHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().AddFlagSet(flagSetNetworkAccount())
+	c.Flags().AddFlagSet(flagSetValidator())
+	c.Flags().String(flagRemoteSigner, "", "Address that the node listens for a remote signer at, such as tcp://0.0.0.0:26659")
+	c.Flags().String(flagKMSHome, os.ExpandEnv("$HOME/.tmkms"), "Home of tmkms on the remote signer's machine")
+	addOutputPathFlag(c, flagOutputDocument, "", "Path of the validator file")
+
+	return c
+}
+
+// validatorInitResult is the result of network validator init in the json and yaml outputs.
+type validatorInitResult struct {
+	Address       string `json:"address"`
+	Binary        string `json:"binary"`
+	Home          string `json:"home"`
+	ConsensusKey  string `json:"consensus_key"`
+	ValidatorFile string `json:"validator_file"`
+	TMKMSConfig   string `json:"tmkms_config,omitempty"`
+}
+
+func networkValidatorInitHandler(cmd *cobra.Command, args []string) error {
+	var (
+		remoteSigner, _ = cmd.Flags().GetString(flagRemoteSigner)
+		kmsHome, _      = cmd.Flags().GetString(flagKMSHome)
+		output          = getOutputPath(cmd)
+		peerAddress, _  = cmd.Flags().GetString(flagPeerAddress)
+	)
+
+	id, err := parseLaunchID(args[0])
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		output = filepath.Join(network.ChainPath(id), "validator.json")
+	}
+
+	var signerAddress string
+	if remoteSigner != "" {
+		if signerAddress, err = remoteSignerAddress(remoteSigner, peerAddress); err != nil {
+			return err
+		}
+	}
+
+	nc, err := newNetworkClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Fetching the launch...")
+	defer s.Stop()
+
+	launch, err := nc.Launch(cmd.Context(), id)
+	if errors.Is(err, network.ErrNotFound) {
+		return fmt.Errorf("launch #%d is not found", id)
+	}
+	if err != nil {
+		return err
+	}
+
+	c, binaryName, validator, err := initValidator(cmd, s, launch)
+	if err != nil {
+		return err
+	}
+
+	validatorJSON, err := json.MarshalIndent(validator, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(output), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(output, validatorJSON, 0644); err != nil {
+		return err
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+
+	var tmkmsConfigPath string
+	if remoteSigner != "" {
+		if err := c.ConfigureRemoteSigner(remoteSigner); err != nil {
+			return err
+		}
+
+		tmkmsConfig, err := c.TMKMSConfig(signerAddress, kmsHome, validator.Address)
+		if err != nil {
+			return err
+		}
+		tmkmsConfigPath = filepath.Join(filepath.Dir(output), "tmkms.toml")
+		if err := os.WriteFile(tmkmsConfigPath, []byte(tmkmsConfig), 0644); err != nil {
+			return err
+		}
+	}
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, validatorInitResult{
+			Address:       validator.Address,
+			Binary:        binaryName,
+			Home:          home,
+			ConsensusKey:  filepath.Join(home, "config/priv_validator_key.json"),
+			ValidatorFile: output,
+			TMKMSConfig:   tmkmsConfigPath,
+		})
+	}
+
+	fmt.Printf("🌍 %s is installed and the node of validator %s is initialized in %s\n", binaryName, validator.Address, home)
+	fmt.Printf("🔑 Consensus key: %s\n", filepath.Join(home, "config/priv_validator_key.json"))
+	fmt.Printf("📄 Validator file: %s\n", output)
+
+	if tmkmsConfigPath != "" {
+		fmt.Printf(`
+🔐 The node listens for a remote signer at %[1]s, configure tmkms with %[2]s and
+   import the consensus key to it, then remove the consensus key from the node:
+
+	tmkms softsign import %[3]s %[4]s
+`,
+			remoteSigner,
+			tmkmsConfigPath,
+			filepath.Join(home, "config/priv_validator_key.json"),
+			filepath.Join(kmsHome, "secrets", launch.ChainID+"-consensus.key"),
+		)
+	}
+
+	fmt.Printf("\n✋ Join the launch with:\n\n\tstarport network chain join %d --validator-file %s\n", id, output)
+	return nil
+}
+
+// remoteSignerAddress returns the address that a remote signer connects to the node at, when
+// the node listens for it at laddr and the node is reachable at peerAddress.
+func remoteSignerAddress(laddr, peerAddress string) (string, error) {
+	u, err := url.Parse(laddr)
+	if err != nil || u.Scheme != "tcp" || u.Port() == "" {
+		return "", fmt.Errorf("remote signer address must be in the tcp://<host>:<port> format, not %q", laddr)
+	}
+
+	host, _, err := net.SplitHostPort(peerAddress)
+	if err != nil {
+		return "", fmt.Errorf("public address of the node must be in the <host>:<port> format: %w", err)
+	}
+
+	return fmt.Sprintf("tcp://%s", net.JoinHostPort(host, u.Port())), nil
+}
@@ -0,0 +1,215 @@
+package starportcmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"github.com/fatih/color"
+	"github.com/goccy/go-yaml"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+)
+
+const (
+	flagOutput = "output"
+
+	// flagOutputDir and flagOutputDocument are the paths that commands write to.
+	flagOutputDir      = "output-dir"
+	flagOutputDocument = "output-document"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+	outputYAML = "yaml"
+)
+
+// resultWriter is where the results of commands are written in the json and yaml outputs, the
+// stdout that starport is started with since the output of commands is discarded.
+var resultWriter io.Writer = os.Stdout
+
+// annotationOutputFormat marks the output flags that are the output format of commands.
+const annotationOutputFormat = "output-format"
+
+// annotationOutputPath marks the commands whose output flag was the path that they write to, it's
+// the name of their flag of the path now.
+const annotationOutputPath = "output-path"
+
+// annotationResult marks the commands that print a result in the json and yaml outputs.
+const annotationResult = "result"
+
+// resultAnnotations are the annotations of the commands that print a result in the json and yaml
+// outputs, the output flag is only added to them.
+func resultAnnotations() map[string]string {
+	return map[string]string{annotationResult: "true"}
+}
+
+// hasResult checks if cmd prints a result in the json and yaml outputs.
+func hasResult(cmd *cobra.Command) bool {
+	return cmd.Annotations[annotationResult] != ""
+}
+
+// addOutputPathFlag adds the flag of the path that c writes to with the -o shorthand that was the
+// one of its output flag. paths set with the output flag are still accepted with a warning.
+func addOutputPathFlag(c *cobra.Command, name, value, usage string) {
+	c.Flags().StringP(name, "o", value, usage)
+	if c.Annotations == nil {
+		c.Annotations = make(map[string]string)
+	}
+	c.Annotations[annotationOutputPath] = name
+}
+
+// getOutputPath returns the path that cmd writes to, the one set with the output flag when it's
+// not an output format.
+func getOutputPath(cmd *cobra.Command) string {
+	if path, ok := deprecatedOutputPath(cmd); ok {
+		return path
+	}
+	path, _ := cmd.Flags().GetString(cmd.Annotations[annotationOutputPath])
+	return path
+}
+
+// deprecatedOutputPath returns the path that's set with the output flag of the commands whose
+// output flag was the path that they write to.
+func deprecatedOutputPath(cmd *cobra.Command) (string, bool) {
+	if cmd.Annotations[annotationOutputPath] == "" {
+		return "", false
+	}
+	flag := cmd.Flags().Lookup(flagOutput)
+	if flag == nil || !flag.Changed || isOutputFormat(flag.Value.String()) {
+		return "", false
+	}
+	return flag.Value.String(), true
+}
+
+func isOutputFormat(output string) bool {
+	return output == outputText || output == outputJSON || output == outputYAML
+}
+
+// addOutputFlags adds the output format flag to c and its sub commands that print a result,
+// except to the commands that have their own output flag. the commands whose output flag was the
+// path that they write to keep it for the paths that are still set with it.
+func addOutputFlags(c *cobra.Command) {
+	outputs := hasResult(c) || c.Annotations[annotationOutputPath] != ""
+	if outputs && c.Runnable() && !c.DisableFlagParsing && c.Flags().Lookup(flagOutput) == nil {
+		c.Flags().String(flagOutput, outputText, "Output format of the results (text|json|yaml)")
+		c.Flags().SetAnnotation(flagOutput, annotationOutputFormat, []string{"true"})
+	}
+	for _, sub := range c.Commands() {
+		addOutputFlags(sub)
+	}
+}
+
+// getOutput returns the output format that's set with a flag.
+func getOutput(cmd *cobra.Command) string {
+	flag := cmd.Flags().Lookup(flagOutput)
+	if flag == nil || flag.Annotations[annotationOutputFormat] == nil || flag.Value.String() == "" {
+		return outputText
+	}
+	if _, ok := deprecatedOutputPath(cmd); ok {
+		return outputText
+	}
+	return flag.Value.String()
+}
+
+// isStructuredOutput checks if the results of commands are printed in json or yaml.
+func isStructuredOutput(cmd *cobra.Command) bool {
+	output := getOutput(cmd)
+	return output == outputJSON || output == outputYAML
+}
+
+// setOutput validates the output format that's set with a flag, the output of commands is
+// discarded with the json and yaml outputs so only their results are printed.
+func setOutput(cmd *cobra.Command) error {
+	if path, ok := deprecatedOutputPath(cmd); ok {
+		fmt.Fprintf(os.Stderr, "--%s %s is deprecated, use --%s %s instead\n", flagOutput, path, cmd.Annotations[annotationOutputPath], path)
+		return nil
+	}
+
+	switch output := getOutput(cmd); output {
+	case outputText:
+		return nil
+	case outputJSON, outputYAML:
+		// the commands without results would print nothing.
+		if !hasResult(cmd) {
+			return fmt.Errorf("--%s %s is not supported by %s", flagOutput, output, cmd.CommandPath())
+		}
+		return silence()
+	default:
+		return fmt.Errorf("invalid output %q, it must be %s, %s or %s", getOutput(cmd), outputText, outputJSON, outputYAML)
+	}
+}
+
+// silence discards the output of commands and their spinners, errors and questions are still
+// printed.
+func silence() error {
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	os.Stdout = devNull
+	color.Output = ioutil.Discard
+	return nil
+}
+
+// printResult prints the result of a command in the json or yaml output. results are not
+// printed in the text output, commands print them their own way.
+// results that are printed over time, such as events, are printed one per line in json and as
+// separate documents in yaml.
+func printResult(cmd *cobra.Command, result interface{}) error {
+	switch getOutput(cmd) {
+	case outputJSON:
+		return json.NewEncoder(resultWriter).Encode(result)
+	case outputYAML:
+		b, err := yaml.Marshal(result)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(resultWriter, "---\n%s", b)
+		return err
+	}
+	return nil
+}
+
+// scaffoldResult is the result of scaffold commands.
+type scaffoldResult struct {
+	Name     string   `json:"name"`
+	Created  []string `json:"created"`
+	Modified []string `json:"modified"`
+}
+
+// printScaffoldResult prints the files of sm that are scaffolded for name, relative to the
+// current dir.
+func printScaffoldResult(cmd *cobra.Command, name string, sm xgenny.SourceModification) error {
+	if !isStructuredOutput(cmd) {
+		return nil
+	}
+
+	result := scaffoldResult{
+		Name:     name,
+		Created:  []string{},
+		Modified: []string{},
+	}
+	for _, file := range sm.CreatedFiles() {
+		path, err := relativePath(file)
+		if err != nil {
+			return err
+		}
+		result.Created = append(result.Created, path)
+	}
+	for _, file := range sm.ModifiedFiles() {
+		path, err := relativePath(file)
+		if err != nil {
+			return err
+		}
+		result.Modified = append(result.Modified, path)
+	}
+	sort.Strings(result.Created)
+	sort.Strings(result.Modified)
+
+	return printResult(cmd, result)
+}
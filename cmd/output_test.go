@@ -0,0 +1,149 @@
+package starportcmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+func newOutputCommand(t *testing.T, args ...string) *cobra.Command {
+	root := &cobra.Command{Use: "starport"}
+	group := &cobra.Command{Use: "chain"}
+	c := &cobra.Command{Use: "build", Run: func(*cobra.Command, []string) {}}
+	addOutputPathFlag(c, flagOutputDir, "", "binary output path")
+	group.AddCommand(c)
+	root.AddCommand(group)
+
+	addOutputFlags(root)
+	require.Nil(t, group.Flags().Lookup(flagOutput))
+	require.NoError(t, c.ParseFlags(args))
+	return c
+}
+
+func TestOutputFlags(t *testing.T) {
+	c := &cobra.Command{Use: "list", Run: func(*cobra.Command, []string) {}, Annotations: resultAnnotations()}
+	addOutputFlags(c)
+	require.Equal(t, outputText, getOutput(c))
+	require.False(t, isStructuredOutput(c))
+
+	require.NoError(t, c.ParseFlags([]string{"--output", "yaml"}))
+	require.Equal(t, outputYAML, getOutput(c))
+	require.True(t, isStructuredOutput(c))
+
+	require.NoError(t, c.ParseFlags([]string{"--output", "xml"}))
+	err := setOutput(c)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `invalid output "xml"`)
+
+	// the commands without results don't have the output flag.
+	c = &cobra.Command{Use: "send", Run: func(*cobra.Command, []string) {}}
+	addOutputFlags(c)
+	require.Nil(t, c.Flags().Lookup(flagOutput))
+	require.Error(t, c.ParseFlags([]string{"--output", "json"}))
+
+	// the output flags of commands, such as the ones of plugins, are not the output format.
+	c = &cobra.Command{Use: "deploy", Run: func(*cobra.Command, []string) {}, Annotations: resultAnnotations()}
+	c.Flags().String(flagOutput, "", "")
+	addOutputFlags(c)
+	require.NoError(t, c.ParseFlags([]string{"--output", "json"}))
+	require.Equal(t, outputText, getOutput(c))
+}
+
+func TestOutputPath(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		output string
+		path   string
+	}{
+		{
+			name:   "default",
+			output: outputText,
+		},
+		{
+			name:   "path flag",
+			args:   []string{"--output-dir", "bin"},
+			output: outputText,
+			path:   "bin",
+		},
+		{
+			name:   "shorthand of the path flag",
+			args:   []string{"-o", "bin", "--output", "json"},
+			output: outputJSON,
+			path:   "bin",
+		},
+		{
+			name:   "deprecated path of the output flag",
+			args:   []string{"--output", "bin", "--output-dir", "dist"},
+			output: outputText,
+			path:   "bin",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newOutputCommand(t, tt.args...)
+			require.Equal(t, tt.output, getOutput(c))
+			require.Equal(t, tt.path, getOutputPath(c))
+			if tt.output == outputText {
+				require.NoError(t, setOutput(c))
+			}
+		})
+	}
+
+	// the commands whose output flag was their path don't print results.
+	err := setOutput(newOutputCommand(t, "--output", "json"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "--output json is not supported by starport chain build")
+}
+
+func TestPrintResult(t *testing.T) {
+	var buf bytes.Buffer
+	w := resultWriter
+	resultWriter = &buf
+	defer func() { resultWriter = w }()
+
+	result := scaffoldResult{Name: "blog", Created: []string{"x/blog"}, Modified: []string{}}
+
+	c := newOutputCommand(t, "--output", "json")
+	require.NoError(t, printResult(c, result))
+	require.Equal(t, `{"name":"blog","created":["x/blog"],"modified":[]}`+"\n", buf.String())
+
+	buf.Reset()
+	c = newOutputCommand(t, "--output", "yaml")
+	require.NoError(t, printResult(c, result))
+	require.Equal(t, "---\nname: blog\ncreated:\n- x/blog\nmodified: []\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, printResult(newOutputCommand(t), result))
+	require.Empty(t, buf.String())
+}
+
+func TestAccountConvertResult(t *testing.T) {
+	var buf bytes.Buffer
+	w := resultWriter
+	resultWriter = &buf
+	defer func() { resultWriter = w }()
+
+	c := NewAccountConvert()
+	addOutputFlags(c)
+	c.SetArgs([]string{"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", "--prefix", "osmo", "--output", "json"})
+	require.NoError(t, c.Execute())
+
+	forms, err := cosmosaccount.ConvertAddressForms("cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", "osmo")
+	require.NoError(t, err)
+
+	var result convertedAddressResult
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &result))
+	require.Equal(t, convertedAddressResult{
+		Account:   forms.Account,
+		Validator: forms.Validator,
+		Consensus: forms.Consensus,
+		Hex:       forms.Hex,
+	}, result)
+	require.True(t, strings.HasPrefix(result.Account, "osmo1"))
+}
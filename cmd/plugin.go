@@ -0,0 +1,372 @@
+package starportcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	osexec "os/exec"
+	"path/filepath"
+
+	"github.com/goccy/go-yaml"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/exec"
+	"github.com/tendermint/starport/starport/pkg/gocmd"
+	conf "github.com/trino-network/trino/chainconf"
+	"github.com/trino-network/trino/pkg/cmdtrace"
+	"github.com/trino-network/trino/pkg/plugin"
+	"github.com/trino-network/trino/pkg/pluginregistry"
+)
+
+// pluginsFile is the config file of the plugins that are available everywhere, it's kept in
+// the home of plugins.
+const pluginsFile = "plugins.yml"
+
+const flagGlobal = "global"
+
+// pluginsConfig is the config of plugins, in the global config file and in config.yml.
+type pluginsConfig struct {
+	Plugins []conf.Plugin `yaml:"plugins"`
+}
+
+// configuredPlugin is a plugin with the config file that it's declared in.
+type configuredPlugin struct {
+	conf.Plugin
+	ConfigPath string
+}
+
+// NewPlugin creates a new plugin command that holds some other sub commands related to
+// plugins.
+func NewPlugin() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "plugin [command]",
+		Short: "Manage the plugins that add commands to Starport",
+		Long: fmt.Sprintf(`Manage the plugins that add commands to Starport.
+
+Plugins are declared in the plugins section of %s for all chains, and in the
+config.yml of a chain for the commands that are run in the chain's dir:
+
+plugins:
+  - name: mycompany
+    path: ./plugins/mycompany
+    with:
+      region: eu
+
+The commands of a plugin are added under its name, such as "starport mycompany deploy". The
+path of a plugin is the path of its executable or the dir of its Go main package that's built
+by Starport. A plugin of a chain replaces a global plugin with the same name.
+
+Plugins of registries are declared with a version instead of a path and are installed
+automatically when they are used, see "starport plugin install".`, globalPluginsPath()),
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewPluginList())
+	c.AddCommand(NewPluginSearch())
+	c.AddCommand(NewPluginInstall())
+	c.AddCommand(NewPluginUpdate())
+	c.AddCommand(NewPluginRemove())
+
+	return c
+}
+
+func globalPluginsPath() string {
+	return filepath.Join(plugin.Home, pluginsFile)
+}
+
+func flagSetGlobal(cmd *cobra.Command) {
+	cmd.Flags().Bool(flagGlobal, false, fmt.Sprintf("Edit the plugins of %s instead of the config.yml of the chain", globalPluginsPath()))
+}
+
+// pluginsConfigPath returns the config file whose plugins are edited by cmd.
+func pluginsConfigPath(cmd *cobra.Command) (string, error) {
+	if global, _ := cmd.Flags().GetBool(flagGlobal); global {
+		return globalPluginsPath(), nil
+	}
+
+	path, err := conf.LocateDefault(flagGetPath(cmd))
+	if errors.Is(err, conf.ErrCouldntLocateConfig) {
+		return "", fmt.Errorf("%w, use --%s to edit the plugins of all chains", err, flagGlobal)
+	}
+	return path, err
+}
+
+// addPluginCommands adds the commands of the configured plugins to c, plugins are only started
+// when their commands are run. the plugins of the nearest chain from the current dir are added,
+// since commands are added before the path flag is parsed. invalid configs and plugins with the names of commands of
+// Starport are reported without failing the other commands.
+func addPluginCommands(c *cobra.Command) {
+	plugins, err := loadPlugins(locateAppPath("."))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  %s\n", err)
+	}
+
+	for _, p := range plugins {
+		if existing, _, err := c.Find([]string{p.Name}); err == nil && existing != c {
+			fmt.Fprintf(os.Stderr, "⚠️  plugin %q in %s is skipped, it has the name of a command of Starport\n", p.Name, p.ConfigPath)
+			continue
+		}
+		c.AddCommand(newPluginCommand(p))
+	}
+}
+
+// loadPlugins loads the global plugins and the plugins of the chain at appPath, plugins of
+// invalid config files are skipped.
+func loadPlugins(appPath string) ([]configuredPlugin, error) {
+	var errs []error
+
+	plugins, err := loadPluginsConfig(globalPluginsPath())
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if path, err := conf.LocateDefault(appPath); err == nil {
+		chainPlugins, err := loadPluginsConfig(path)
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		for _, chainPlugin := range chainPlugins {
+			replaced := false
+			for i := range plugins {
+				if plugins[i].Name == chainPlugin.Name {
+					plugins[i], replaced = chainPlugin, true
+				}
+			}
+			if !replaced {
+				plugins = append(plugins, chainPlugin)
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return plugins, errs[0]
+	}
+	return plugins, nil
+}
+
+// loadPluginsConfig loads the plugins of the config file at path, there are no plugins when it
+// doesn't exist. relative paths of plugins are resolved from the dir of the config file.
+func loadPluginsConfig(path string) ([]configuredPlugin, error) {
+	config, err := readPluginsConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+
+	var plugins []configuredPlugin
+	for _, p := range config {
+		if p.Path != "" && !filepath.IsAbs(p.Path) {
+			p.Path = filepath.Join(dir, p.Path)
+		}
+		plugins = append(plugins, configuredPlugin{Plugin: p, ConfigPath: path})
+	}
+	return plugins, nil
+}
+
+// readPluginsConfig reads the plugins of the config file at path as they are declared.
+func readPluginsConfig(path string) ([]conf.Plugin, error) {
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var config pluginsConfig
+	if err := yaml.Unmarshal(b, &config); err != nil {
+		return nil, fmt.Errorf("invalid plugins in %s: %w", path, err)
+	}
+	if err := conf.ValidatePlugins(config.Plugins); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return config.Plugins, nil
+}
+
+// newPluginCommand creates the command of plugin p, the commands of the plugin are fetched from
+// it when the command is run.
+func newPluginCommand(p configuredPlugin) *cobra.Command {
+	return &cobra.Command{
+		Use:                p.Name + " [command]",
+		Short:              fmt.Sprintf("Commands of the %s plugin", p.Name),
+		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := startPlugin(cmd.Context(), p)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			commands, err := client.Commands()
+			if err != nil {
+				return fmt.Errorf("cannot get the commands of plugin %s: %w", p.Name, err)
+			}
+
+			// the commands of the plugin are run under a copy of the command so their usage
+			// shows their full path.
+			root := &cobra.Command{
+				Use:           cmd.Root().Name(),
+				SilenceUsage:  true,
+				SilenceErrors: true,
+			}
+			pc := &cobra.Command{
+				Use:   p.Name + " [command]",
+				Short: cmd.Short,
+				Args:  cobra.ExactArgs(1),
+			}
+			for _, command := range commands {
+				pc.AddCommand(newPluginSubCommand(client, p, nil, command))
+			}
+			root.AddCommand(pc)
+
+			root.SetArgs(append([]string{p.Name}, args...))
+			return root.ExecuteContext(cmd.Context())
+		},
+	}
+}
+
+// newPluginSubCommand creates the command of a plugin at path, commands without sub commands
+// are executed by the plugin.
+func newPluginSubCommand(client *plugin.Client, p configuredPlugin, path []string, command plugin.Command) *cobra.Command {
+	c := &cobra.Command{
+		Use:     command.Use,
+		Aliases: command.Aliases,
+		Short:   command.Short,
+		Long:    command.Long,
+	}
+	path = append(append([]string{}, path...), c.Name())
+
+	for _, flag := range command.Flags {
+		switch flag.Type {
+		case plugin.FlagTypeBool:
+			c.Flags().BoolP(flag.Name, flag.Shorthand, flag.Default == "true", flag.Usage)
+		case plugin.FlagTypeInt:
+			var value int
+			fmt.Sscan(flag.Default, &value)
+			c.Flags().IntP(flag.Name, flag.Shorthand, value, flag.Usage)
+		default:
+			c.Flags().StringP(flag.Name, flag.Shorthand, flag.Default, flag.Usage)
+		}
+	}
+
+	if len(command.Commands) > 0 {
+		c.Args = cobra.ExactArgs(1)
+		for _, sub := range command.Commands {
+			c.AddCommand(newPluginSubCommand(client, p, path, sub))
+		}
+		return c
+	}
+
+	c.RunE = func(cmd *cobra.Command, args []string) error {
+		flags := make(map[string]string)
+		for _, flag := range command.Flags {
+			flags[flag.Name] = cmd.Flags().Lookup(flag.Name).Value.String()
+		}
+
+		return client.Execute(cmd.Context(), plugin.ExecutedCommand{
+			Path:  path,
+			Args:  args,
+			Flags: flags,
+			With:  p.With,
+		})
+	}
+	return c
+}
+
+// startPlugin starts plugin p.
+func startPlugin(ctx context.Context, p configuredPlugin) (*plugin.Client, error) {
+	binary, err := buildPlugin(ctx, p.Plugin)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := plugin.Start(osexec.CommandContext(ctx, binary), pluginHost{ctx})
+	if err != nil {
+		return nil, fmt.Errorf("cannot start plugin %s: %w", p.Name, err)
+	}
+	return client, nil
+}
+
+// buildPlugin returns the executable of plugin p, plugins of registries are fetched and plugins
+// in Go source are built first.
+func buildPlugin(ctx context.Context, p conf.Plugin) (string, error) {
+	path := p.Path
+	if p.Version != "" {
+		s := clispinner.New().SetText(fmt.Sprintf("Installing the %s plugin %s...", p.Name, p.Version))
+		var err error
+		path, _, err = fetchPlugin(ctx, p)
+		s.Stop()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("plugin %s: %w", p.Name, err)
+	}
+	if !info.IsDir() {
+		return path, nil
+	}
+
+	s := clispinner.New().SetText(fmt.Sprintf("Building the %s plugin...", p.Name))
+	defer s.Stop()
+
+	output := filepath.Join(plugin.Home, "bin")
+	if err := gocmd.BuildPath(ctx, output, p.Name, path, nil, exec.StepOption(cmdtrace.Step()), exec.IncludeStdLogsToError()); err != nil {
+		return "", fmt.Errorf("cannot build plugin %s: %w", p.Name, err)
+	}
+	return filepath.Join(output, p.Name), nil
+}
+
+// fetchPlugin fetches the source of plugin p from its registry unless it's already fetched, it
+// returns the dir of its main package and the commit of its version.
+func fetchPlugin(ctx context.Context, p conf.Plugin) (path, commit string, err error) {
+	r := newPluginRegistry(p.Registry)
+
+	entry, err := r.Plugin(ctx, p.Name)
+	if errors.Is(err, pluginregistry.ErrNotFound) {
+		// the plugin may be added to the registry after its index is cloned.
+		if err := r.Update(ctx); err != nil {
+			return "", "", err
+		}
+		entry, err = r.Plugin(ctx, p.Name)
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	return r.Fetch(ctx, entry, p.Version, p.Commit)
+}
+
+// pinPluginCommit fetches the version of plugin p and pins its commit.
+func pinPluginCommit(ctx context.Context, p *conf.Plugin) (err error) {
+	p.Commit = ""
+	_, p.Commit, err = fetchPlugin(ctx, *p)
+	return err
+}
+
+// newPluginRegistry returns the registry of plugins at url, the default registry when it's empty.
+func newPluginRegistry(url string) pluginregistry.Registry {
+	if url == "" {
+		url = pluginregistry.DefaultURL
+	}
+	return pluginregistry.New(url, plugin.Home)
+}
+
+// pluginSource returns where plugin p is installed from.
+func pluginSource(p conf.Plugin) string {
+	if p.Version == "" {
+		return p.Path
+	}
+	if p.Registry == "" {
+		return fmt.Sprintf("%s@%s", p.Name, p.Version)
+	}
+	return fmt.Sprintf("%s@%s (%s)", p.Name, p.Version, p.Registry)
+}
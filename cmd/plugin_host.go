@@ -0,0 +1,142 @@
+package starportcmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/services/scaffolder"
+	conf "github.com/trino-network/trino/chainconf"
+	"github.com/trino-network/trino/pkg/plugin"
+	"github.com/trino-network/trino/pkg/scaffoldhook"
+)
+
+// pluginHost serves the API of Starport to plugins. scaffolding by plugins runs the scaffold
+// hooks of config.yml, but not the hooks of plugins so plugins don't trigger themselves.
+type pluginHost struct {
+	ctx context.Context
+}
+
+// ChainConfig returns the config.yml of the chain at appPath, the current dir by default.
+func (h pluginHost) ChainConfig(appPath string) (conf.Config, error) {
+	path, err := conf.LocateDefault(pluginAppPath(appPath))
+	if err != nil {
+		return conf.Config{}, err
+	}
+	return conf.ParseFile(path)
+}
+
+// ScaffoldModule scaffolds a module like "starport scaffold module".
+func (h pluginHost) ScaffoldModule(req plugin.ScaffoldModuleRequest) (plugin.SourceModification, error) {
+	var options []scaffolder.ModuleCreationOption
+
+	if req.IBC {
+		ordering := req.IBCOrdering
+		if ordering == "" {
+			ordering = "none"
+		}
+		options = append(options, scaffolder.WithIBCChannelOrdering(ordering), scaffolder.WithIBC())
+	}
+	if len(req.Dependencies) > 0 {
+		dependencies, err := parseModuleDependencies(req.Dependencies)
+		if err != nil {
+			return plugin.SourceModification{}, err
+		}
+		options = append(options, scaffolder.WithDependencies(dependencies))
+	}
+
+	appPath := pluginAppPath(req.AppPath)
+	sc, err := newApp(appPath)
+	if err != nil {
+		return plugin.SourceModification{}, err
+	}
+
+	hooks, err := loadScaffoldCommandHooks(appPath)
+	if err != nil {
+		return plugin.SourceModification{}, err
+	}
+
+	sm, err := scaffoldWithHooks(h.ctx, hooks, scaffoldhook.Event{
+		Target:  scaffoldhook.TargetModule,
+		AppPath: appPath,
+		Module:  req.Name,
+		Name:    req.Name,
+	}, func() (xgenny.SourceModification, error) {
+		return sc.CreateModule(placeholder.New(), req.Name, options...)
+	})
+	if err != nil {
+		return plugin.SourceModification{}, err
+	}
+	return pluginSourceModification(sm), nil
+}
+
+// ScaffoldType scaffolds a type like "starport scaffold list|map|single|type".
+func (h pluginHost) ScaffoldType(req plugin.ScaffoldTypeRequest) (plugin.SourceModification, error) {
+	var kind scaffolder.AddTypeKind
+	switch req.Kind {
+	case plugin.TypeKindList:
+		kind = scaffolder.ListType()
+	case plugin.TypeKindMap:
+		kind = scaffolder.MapType(req.Indexes...)
+	case plugin.TypeKindSingle:
+		kind = scaffolder.SingletonType()
+	case plugin.TypeKindType, "":
+		kind, req.Kind = scaffolder.DryType(), plugin.TypeKindType
+	default:
+		return plugin.SourceModification{}, fmt.Errorf("unknown type kind %q", req.Kind)
+	}
+
+	var options []scaffolder.AddTypeOption
+	if len(req.Fields) > 0 {
+		options = append(options, scaffolder.TypeWithFields(req.Fields...))
+	}
+	if req.Module != "" {
+		options = append(options, scaffolder.TypeWithModule(req.Module))
+	}
+	if req.NoMessage {
+		options = append(options, scaffolder.TypeWithoutMessage())
+	}
+	if req.Signer != "" {
+		options = append(options, scaffolder.TypeWithSigner(req.Signer))
+	}
+
+	appPath := pluginAppPath(req.AppPath)
+	sc, err := newApp(appPath)
+	if err != nil {
+		return plugin.SourceModification{}, err
+	}
+
+	hooks, err := loadScaffoldCommandHooks(appPath)
+	if err != nil {
+		return plugin.SourceModification{}, err
+	}
+
+	sm, err := scaffoldWithHooks(h.ctx, hooks, scaffoldhook.Event{
+		Target:  scaffoldhook.TargetType,
+		AppPath: appPath,
+		Module:  req.Module,
+		Name:    req.Name,
+		Kind:    req.Kind,
+	}, func() (xgenny.SourceModification, error) {
+		return sc.AddType(h.ctx, req.Name, placeholder.New(), kind, options...)
+	})
+	if err != nil {
+		return plugin.SourceModification{}, err
+	}
+	return pluginSourceModification(sm), nil
+}
+
+func pluginAppPath(appPath string) string {
+	if appPath == "" {
+		return "."
+	}
+	return appPath
+}
+
+func pluginSourceModification(sm xgenny.SourceModification) plugin.SourceModification {
+	return plugin.SourceModification{
+		Created:  sm.CreatedFiles(),
+		Modified: sm.ModifiedFiles(),
+	}
+}
@@ -0,0 +1,143 @@
+package starportcmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	conf "github.com/trino-network/trino/chainconf"
+	"github.com/trino-network/trino/pkg/pluginregistry"
+)
+
+// NewPluginInstall returns a new command to install plugins of a registry.
+func NewPluginInstall() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "install [name[@version]]...",
+		Short: "Install plugins of a registry",
+		Long: fmt.Sprintf(`Install plugins of a registry and pin their versions in the config.yml of the chain,
+or in %s with --global.
+
+The latest version of a plugin is installed when there is no version. The pinned plugins of
+config.yml are installed for everyone that works on the chain: without arguments, the
+plugins of the configs are installed, and plugins that are not installed yet are installed
+when they are used.`, globalPluginsPath()),
+		RunE: pluginInstallHandler,
+	}
+	flagSetGlobal(c)
+	c.Flags().String(flagRegistry, pluginregistry.DefaultURL, "Git repository of the registry")
+	return c
+}
+
+func pluginInstallHandler(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return installConfiguredPlugins(cmd)
+	}
+
+	path, err := pluginsConfigPath(cmd)
+	if err != nil {
+		return err
+	}
+	plugins, err := readPluginsConfig(path)
+	if err != nil {
+		return err
+	}
+
+	registry, _ := cmd.Flags().GetString(flagRegistry)
+	r := newPluginRegistry(registry)
+	if registry == pluginregistry.DefaultURL {
+		registry = ""
+	}
+
+	s := clispinner.New().SetText("Updating the registry...")
+	err = r.Update(cmd.Context())
+	s.Stop()
+	if err != nil {
+		return err
+	}
+
+	for _, arg := range args {
+		name, version := parsePluginArg(arg)
+
+		entry, err := r.Plugin(cmd.Context(), name)
+		if err != nil {
+			return err
+		}
+		if version == "" {
+			if version, err = pluginregistry.LatestVersion(cmd.Context(), entry); err != nil {
+				return err
+			}
+		}
+
+		p := conf.Plugin{Name: name, Version: version, Registry: registry}
+		i := pluginIndex(plugins, name)
+		if i >= 0 {
+			// the config of the plugin is kept.
+			p.With, p.Hooks = plugins[i].With, plugins[i].Hooks
+		}
+
+		if err := pinPluginCommit(cmd.Context(), &p); err != nil {
+			return err
+		}
+		if _, err := buildPlugin(cmd.Context(), p); err != nil {
+			return err
+		}
+
+		if i >= 0 {
+			plugins[i] = p
+		} else {
+			plugins = append(plugins, p)
+		}
+		fmt.Printf("✅ %s %s is installed\n", name, version)
+	}
+
+	if err := conf.SetPlugins(path, plugins); err != nil {
+		return err
+	}
+	fmt.Printf("📝 Pinned in %s\n", path)
+	return nil
+}
+
+// installConfiguredPlugins installs the plugins of registries of the global config and of the
+// config.yml of the chain.
+func installConfiguredPlugins(cmd *cobra.Command) error {
+	plugins, err := loadPlugins(flagGetPath(cmd))
+	if err != nil {
+		return err
+	}
+
+	var installed int
+	for _, p := range plugins {
+		if p.Version == "" {
+			continue
+		}
+		if _, err := buildPlugin(cmd.Context(), p.Plugin); err != nil {
+			return err
+		}
+		fmt.Printf("✅ %s %s is installed\n", p.Name, p.Version)
+		installed++
+	}
+
+	if installed == 0 {
+		fmt.Println("No plugins of registries to install")
+	}
+	return nil
+}
+
+// parsePluginArg parses a plugin argument in the name[@version] format.
+func parsePluginArg(arg string) (name, version string) {
+	if i := strings.LastIndex(arg, "@"); i >= 0 {
+		return arg[:i], arg[i+1:]
+	}
+	return arg, ""
+}
+
+// pluginIndex returns the index of the plugin with name in plugins, -1 when there is none.
+func pluginIndex(plugins []conf.Plugin, name string) int {
+	for i, p := range plugins {
+		if p.Name == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,62 @@
+package starportcmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+// NewPluginList returns a new command to list the configured plugins.
+func NewPluginList() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "list",
+		Short: "List the plugins and their commands",
+		Long: `List the plugins and their commands.
+
+The plugins are started to list their commands, so the plugins in Go source are built.`,
+		Args: cobra.NoArgs,
+		RunE: pluginListHandler,
+	}
+	return c
+}
+
+func pluginListHandler(cmd *cobra.Command, args []string) error {
+	plugins, err := loadPlugins(flagGetPath(cmd))
+	if err != nil {
+		return err
+	}
+	if len(plugins) == 0 {
+		fmt.Printf("No plugins, declare them in %s or in the config.yml of a chain\n", globalPluginsPath())
+		return nil
+	}
+
+	w := &tabwriter.Writer{}
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "Name\tSource\tConfig\tCommands")
+
+	for _, p := range plugins {
+		commands := "-"
+
+		client, err := startPlugin(cmd.Context(), p)
+		if err == nil {
+			pluginCommands, cerr := client.Commands()
+			client.Close()
+
+			var names []string
+			for _, command := range pluginCommands {
+				names = append(names, strings.Fields(command.Use)[0])
+			}
+			commands, err = strings.Join(names, ", "), cerr
+		}
+		if err != nil {
+			commands = fmt.Sprintf("error: %s", err)
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", p.Name, pluginSource(p.Plugin), p.ConfigPath, commands)
+	}
+
+	return w.Flush()
+}
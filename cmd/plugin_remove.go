@@ -0,0 +1,50 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	conf "github.com/trino-network/trino/chainconf"
+)
+
+// NewPluginRemove returns a new command to remove plugins.
+func NewPluginRemove() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "remove [name]...",
+		Short: "Remove plugins from the config",
+		Long: `Remove plugins from the config.yml of the chain, or from the global config with --global.
+
+The installed sources of the plugins are kept for the other chains that use them.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: pluginRemoveHandler,
+	}
+	flagSetGlobal(c)
+	return c
+}
+
+func pluginRemoveHandler(cmd *cobra.Command, args []string) error {
+	path, err := pluginsConfigPath(cmd)
+	if err != nil {
+		return err
+	}
+	plugins, err := readPluginsConfig(path)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range args {
+		i := pluginIndex(plugins, name)
+		if i < 0 {
+			return fmt.Errorf("plugin %s is not in %s", name, path)
+		}
+		plugins = append(plugins[:i], plugins[i+1:]...)
+	}
+
+	if err := conf.SetPlugins(path, plugins); err != nil {
+		return err
+	}
+	for _, name := range args {
+		fmt.Printf("🗑️  %s is removed from %s\n", name, path)
+	}
+	return nil
+}
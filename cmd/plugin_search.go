@@ -0,0 +1,62 @@
+package starportcmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/pkg/pluginregistry"
+)
+
+// NewPluginSearch returns a new command to search the plugins of a registry.
+func NewPluginSearch() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "search [query]",
+		Short: "Search the plugins of a registry",
+		Long: `Search the plugins of a registry by their names and descriptions.
+
+A registry is a git repository with an index.yml that lists plugins. All the plugins of the
+registry are listed when there is no query.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: pluginSearchHandler,
+	}
+	c.Flags().String(flagRegistry, pluginregistry.DefaultURL, "Git repository of the registry")
+	return c
+}
+
+func pluginSearchHandler(cmd *cobra.Command, args []string) error {
+	var query string
+	if len(args) > 0 {
+		query = args[0]
+	}
+	url, _ := cmd.Flags().GetString(flagRegistry)
+	r := newPluginRegistry(url)
+
+	s := clispinner.New().SetText("Updating the registry...")
+	err := r.Update(cmd.Context())
+	if err != nil {
+		s.Stop()
+		return err
+	}
+	plugins, err := r.Plugins(cmd.Context())
+	s.Stop()
+	if err != nil {
+		return err
+	}
+
+	found := pluginregistry.Search(plugins, query)
+	if len(found) == 0 {
+		fmt.Printf("No plugins match %q in %s\n", query, r.URL())
+		return nil
+	}
+
+	w := &tabwriter.Writer{}
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "Name\tDescription\tRepository")
+	for _, p := range found {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", p.Name, p.Description, p.Repository)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,101 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	conf "github.com/trino-network/trino/chainconf"
+	"github.com/trino-network/trino/pkg/pluginregistry"
+)
+
+// NewPluginUpdate returns a new command to update plugins of registries.
+func NewPluginUpdate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "update [name]...",
+		Short: "Update plugins of registries to their latest versions",
+		Long: `Update plugins of registries to their latest versions and pin them in the config.yml of the
+chain, or in the global config with --global.
+
+All the plugins of registries of the config are updated when there are no names.`,
+		RunE: pluginUpdateHandler,
+	}
+	flagSetGlobal(c)
+	return c
+}
+
+func pluginUpdateHandler(cmd *cobra.Command, args []string) error {
+	path, err := pluginsConfigPath(cmd)
+	if err != nil {
+		return err
+	}
+	plugins, err := readPluginsConfig(path)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]bool)
+	for _, name := range args {
+		names[name] = true
+		i := pluginIndex(plugins, name)
+		if i < 0 {
+			return fmt.Errorf("plugin %s is not in %s", name, path)
+		}
+		if plugins[i].Version == "" {
+			return fmt.Errorf("plugin %s is not from a registry", name)
+		}
+	}
+
+	var (
+		updated    bool
+		registries = make(map[string]bool)
+	)
+	for i, p := range plugins {
+		if p.Version == "" || (len(names) > 0 && !names[p.Name]) {
+			continue
+		}
+
+		r := newPluginRegistry(p.Registry)
+		if !registries[r.URL()] {
+			s := clispinner.New().SetText("Updating the registry...")
+			err := r.Update(cmd.Context())
+			s.Stop()
+			if err != nil {
+				return err
+			}
+			registries[r.URL()] = true
+		}
+
+		entry, err := r.Plugin(cmd.Context(), p.Name)
+		if err != nil {
+			return err
+		}
+		latest, err := pluginregistry.LatestVersion(cmd.Context(), entry)
+		if err != nil {
+			return err
+		}
+		if latest == p.Version {
+			fmt.Printf("✅ %s %s is up to date\n", p.Name, p.Version)
+			continue
+		}
+
+		plugins[i].Version = latest
+		if err := pinPluginCommit(cmd.Context(), &plugins[i]); err != nil {
+			return err
+		}
+		if _, err := buildPlugin(cmd.Context(), plugins[i]); err != nil {
+			return err
+		}
+		fmt.Printf("⬆️  %s %s → %s\n", p.Name, p.Version, latest)
+		updated = true
+	}
+
+	if !updated {
+		return nil
+	}
+	if err := conf.SetPlugins(path, plugins); err != nil {
+		return err
+	}
+	fmt.Printf("📝 Pinned in %s\n", path)
+	return nil
+}
@@ -0,0 +1,130 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+)
+
+const flagAgainst = "against"
+
+// NewProto returns a command that groups sub commands to manage proto files with buf.
+func NewProto() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "proto [command]",
+		Short: "Lint proto files and detect breaking changes with buf",
+		Long: `Lint proto files and detect breaking changes with buf.
+
+Commands require the buf CLI to be installed and a buf.yaml config in the proto dir of your chain,
+which can be created with "starport proto init".`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewProtoInit())
+	c.AddCommand(NewProtoLint())
+	c.AddCommand(NewProtoBreaking())
+
+	return c
+}
+
+// NewProtoInit returns a command that creates the buf config of a chain.
+func NewProtoInit() *cobra.Command {
+	return &cobra.Command{
+		Use:   "init",
+		Short: "Create a buf config in the proto dir and pin its dependencies",
+		Long: `Create a buf config in the proto dir and pin its dependencies.
+
+The config depends on the Cosmos SDK, cosmos-proto, gogoproto and googleapis proto files on
+the buf registry, so they don't need to be vendored in the chain. Set "build.proto.buf" in
+config.yml to resolve them while generating code. If there is a config already, only its
+dependencies are updated.`,
+		Args: cobra.NoArgs,
+		RunE: protoInitHandler,
+	}
+}
+
+// NewProtoLint returns a command that lints proto files of a chain.
+func NewProtoLint() *cobra.Command {
+	return &cobra.Command{
+		Use:   "lint",
+		Short: "Lint proto files",
+		Args:  cobra.NoArgs,
+		RunE:  protoLintHandler,
+	}
+}
+
+// NewProtoBreaking returns a command that detects breaking changes in proto files of a chain.
+func NewProtoBreaking() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "breaking",
+		Short: "Detect wire breaking changes in proto files against a git ref",
+		Example: `starport proto breaking --against main
+starport proto breaking --against v0.1.0`,
+		Args: cobra.NoArgs,
+		RunE: protoBreakingHandler,
+	}
+
+	c.Flags().String(flagAgainst, "main", "Git branch, tag or commit to compare proto files against")
+
+	return c
+}
+
+func protoInitHandler(cmd *cobra.Command, args []string) error {
+	s := clispinner.New().SetText("Initializing...")
+	defer s.Stop()
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := c.InitProto(cmd.Context()); err != nil {
+		return err
+	}
+
+	s.Stop()
+	fmt.Println("⛏️  Created buf config.")
+
+	return nil
+}
+
+func protoLintHandler(cmd *cobra.Command, args []string) error {
+	s := clispinner.New().SetText("Linting...")
+	defer s.Stop()
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := c.LintProto(cmd.Context()); err != nil {
+		return err
+	}
+
+	s.Stop()
+	fmt.Println("✅ No lint issues found.")
+
+	return nil
+}
+
+func protoBreakingHandler(cmd *cobra.Command, args []string) error {
+	against, _ := cmd.Flags().GetString(flagAgainst)
+
+	s := clispinner.New().SetText("Checking...")
+	defer s.Stop()
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := c.CheckProtoBreaking(cmd.Context(), against); err != nil {
+		return err
+	}
+
+	s.Stop()
+	fmt.Printf("✅ No breaking changes found against %s.\n", against)
+
+	return nil
+}
@@ -1,9 +1,14 @@
 package starportcmd
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	starportaccount "github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/pkg/relayer"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
 )
 
 // NewRelayer returns a new relayer command.
@@ -28,3 +33,51 @@ func handleRelayerAccountErr(err error) error {
 
 	return errors.Wrap(accountErr, `make sure to create or import your account through "starport account" commands`)
 }
+
+// newRelayer returns a relayer that uses the accounts of ca.
+func newRelayer(ca cosmosaccount.Registry) relayer.Relayer {
+	return relayer.New(starportaccount.Registry{Keyring: ca.Keyring})
+}
+
+// ensureRelayerAccounts makes sure that accounts can be used by the relayer.
+func ensureRelayerAccounts(ca cosmosaccount.Registry, names ...string) error {
+	for _, name := range names {
+		acc, err := ca.GetByName(name)
+		if err != nil {
+			return err
+		}
+		if err := checkRelayerAccount(acc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkRelayerAccount checks that the relayer can sign with acc. the relayer signs transactions
+// with keys exported from the keyring, Ledger signing is not supported.
+func checkRelayerAccount(acc cosmosaccount.Account) error {
+	switch {
+	case acc.IsLedger():
+		return fmt.Errorf("account %q is a Ledger account, the relayer doesn't support Ledger signing, use an account of the keyring", acc.Name)
+	case acc.IsWatchOnly(), acc.IsMultisig():
+		return fmt.Errorf("account %q has no private key in the keyring, the relayer cannot sign with it", acc.Name)
+	}
+	return nil
+}
+
+// relayerAccountNames returns the names of the accounts of ca that can be used by the relayer.
+func relayerAccountNames(ca cosmosaccount.Registry) ([]string, error) {
+	accounts, err := ca.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, acc := range accounts {
+		if checkRelayerAccount(acc) == nil {
+			names = append(names, acc.Name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
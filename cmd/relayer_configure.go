@@ -2,15 +2,21 @@ package starportcmd
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/briandowns/spinner"
 	"github.com/gookit/color"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/pkg/cliquiz"
 	"github.com/tendermint/starport/starport/pkg/clispinner"
-	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
 	"github.com/tendermint/starport/starport/pkg/relayer"
+	conf "github.com/trino-network/trino/chainconf"
+	sperrors "github.com/trino-network/trino/errors"
+	"github.com/trino-network/trino/pkg/chainregistry"
+	"github.com/trino-network/trino/pkg/cliquiz"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+	"github.com/trino-network/trino/services/chain"
+	"github.com/trino-network/trino/services/network"
 )
 
 const (
@@ -32,6 +38,7 @@ const (
 	flagSourceAddressPrefix = "source-prefix"
 	flagTargetAddressPrefix = "target-prefix"
 	flagOrdered             = "ordered"
+	flagTarget              = "target"
 
 	relayerSource = "source"
 	relayerTarget = "target"
@@ -52,10 +59,11 @@ const (
 // when not provided. even if auto retrieving coins fails, connect command will complete with success.
 func NewRelayerConfigure() *cobra.Command {
 	c := &cobra.Command{
-		Use:     "configure",
-		Short:   "Configure source and target chains for relaying",
-		Aliases: []string{"conf"},
-		RunE:    relayerConfigureHandler,
+		Use:         "configure",
+		Short:       "Configure source and target chains for relaying",
+		Aliases:     []string{"conf"},
+		RunE:        relayerConfigureHandler,
+		Annotations: resultAnnotations(),
 	}
 	c.Flags().BoolP(flagAdvanced, "a", false, "Advanced configuration options for custom IBC modules")
 	c.Flags().String(flagSourceRPC, "", "RPC address of the source chain")
@@ -75,7 +83,12 @@ func NewRelayerConfigure() *cobra.Command {
 	c.Flags().String(flagSourceAccount, "", "Source Account")
 	c.Flags().String(flagTargetAccount, "", "Target Account")
 	c.Flags().Bool(flagOrdered, false, "Set the channel as ordered")
+	c.Flags().String(flagTarget, "", "Name of the target chain in the chain registry to use its RPC, gas price and address prefix")
+	c.Flags().String(flagRegistry, chainregistry.DefaultURL, "Address or local path of the chain registry")
+	c.Flags().AddFlagSet(flagSetCoordinator())
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetNetwork())
+	c.Flags().StringP(flagConfig, "c", "", "Starport config file to read the network presets from (default: ./config.yml)")
 
 	return c
 }
@@ -85,8 +98,30 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 		err = handleRelayerAccountErr(err)
 	}()
 
+	s := clispinner.New().Stop()
+	defer s.Stop()
+
+	printSection("Setting up chains")
+
+	sourceAccount, err := cmd.Flags().GetString(flagSourceAccount)
+	if err != nil {
+		return err
+	}
+	targetAccount, err := cmd.Flags().GetString(flagTargetAccount)
+	if err != nil {
+		return err
+	}
+
+	// the keyring backend is picked when the accounts are picked from it.
+	keyringBackend := getKeyringBackend(cmd)
+	if !cmd.Flags().Changed(flagKeyringBackend) && (sourceAccount == "" || targetAccount == "") {
+		if keyringBackend, err = askKeyringBackend(keyringBackend); err != nil {
+			return err
+		}
+	}
+
 	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+		cosmosaccount.WithKeyringBackend(keyringBackend),
 	)
 	if err != nil {
 		return err
@@ -96,15 +131,13 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
-	s := clispinner.New().Stop()
-	defer s.Stop()
-
-	printSection("Setting up chains")
+	accounts, err := relayerAccountNames(ca)
+	if err != nil {
+		return err
+	}
 
 	// basic configuration
 	var (
-		sourceAccount       string
-		targetAccount       string
 		sourceRPCAddress    string
 		targetRPCAddress    string
 		sourceFaucetAddress string
@@ -131,12 +164,14 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 			"Source Account",
 			&sourceAccount,
 			cliquiz.DefaultAnswer(cosmosaccount.DefaultAccount),
+			cliquiz.Select(accounts...),
 			cliquiz.Required(),
 		)
 		questionTargetAccount = cliquiz.NewQuestion(
 			"Target Account",
 			&targetAccount,
 			cliquiz.DefaultAnswer(cosmosaccount.DefaultAccount),
+			cliquiz.Select(accounts...),
 			cliquiz.Required(),
 		)
 		questionSourceRPCAddress = cliquiz.NewQuestion(
@@ -144,20 +179,24 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 			&sourceRPCAddress,
 			cliquiz.DefaultAnswer(defaultSourceRPCAddress),
 			cliquiz.Required(),
+			cliquiz.Validate(cliquiz.URL),
 		)
 		questionSourceFaucet = cliquiz.NewQuestion(
 			"Source Faucet",
 			&sourceFaucetAddress,
+			cliquiz.Validate(cliquiz.URL),
 		)
 		questionTargetRPCAddress = cliquiz.NewQuestion(
 			"Target RPC",
 			&targetRPCAddress,
 			cliquiz.DefaultAnswer(defaultTargetRPCAddress),
 			cliquiz.Required(),
+			cliquiz.Validate(cliquiz.URL),
 		)
 		questionTargetFaucet = cliquiz.NewQuestion(
 			"Target Faucet",
 			&targetFaucetAddress,
+			cliquiz.Validate(cliquiz.URL),
 		)
 		questionSourcePort = cliquiz.NewQuestion(
 			"Source Port",
@@ -188,24 +227,28 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 			&sourceGasPrice,
 			cliquiz.DefaultAnswer(defautSourceGasPrice),
 			cliquiz.Required(),
+			cliquiz.Validate(cliquiz.DecCoin),
 		)
 		questionTargetGasPrice = cliquiz.NewQuestion(
 			"Target Gas Price",
 			&targetGasPrice,
 			cliquiz.DefaultAnswer(defautTargetGasPrice),
 			cliquiz.Required(),
+			cliquiz.Validate(cliquiz.DecCoin),
 		)
 		questionSourceGasLimit = cliquiz.NewQuestion(
 			"Source Gas Limit",
 			&sourceGasLimit,
 			cliquiz.DefaultAnswer(defautSourceGasLimit),
 			cliquiz.Required(),
+			cliquiz.Validate(cliquiz.IntRange(1, math.MaxInt64)),
 		)
 		questionTargetGasLimit = cliquiz.NewQuestion(
 			"Target Gas Limit",
 			&targetGasLimit,
 			cliquiz.DefaultAnswer(defautTargetGasLimit),
 			cliquiz.Required(),
+			cliquiz.Validate(cliquiz.IntRange(1, math.MaxInt64)),
 		)
 		questionSourceAddressPrefix = cliquiz.NewQuestion(
 			"Source Address Prefix",
@@ -226,14 +269,6 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 	if err != nil {
 		return err
 	}
-	sourceAccount, err = cmd.Flags().GetString(flagSourceAccount)
-	if err != nil {
-		return err
-	}
-	targetAccount, err = cmd.Flags().GetString(flagTargetAccount)
-	if err != nil {
-		return err
-	}
 	sourceRPCAddress, err = cmd.Flags().GetString(flagSourceRPC)
 	if err != nil {
 		return err
@@ -295,6 +330,55 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
+	// use the selected network presets for the source chain when not provided by flags
+	network, found, err := relayerNetwork(cmd)
+	if err != nil {
+		return err
+	}
+	if found {
+		if sourceRPCAddress == "" {
+			sourceRPCAddress = network.RPC
+		}
+		if sourceFaucetAddress == "" {
+			sourceFaucetAddress = network.Faucet
+		}
+		if sourceGasPrice == "" {
+			sourceGasPrice = network.GasPrice
+		}
+		if sourceAddressPrefix == "" {
+			sourceAddressPrefix = network.AddressPrefix
+		}
+	}
+
+	// use the registry defaults for the target chain when not provided by flags
+	target, found, err := relayerTargetChain(cmd)
+	if err != nil {
+		return err
+	}
+	if found {
+		if targetRPCAddress == "" {
+			targetRPCAddress, _ = target.RPCAddress()
+		}
+		if targetGasPrice == "" {
+			targetGasPrice, _ = target.GasPrice()
+		}
+		if targetAddressPrefix == "" {
+			targetAddressPrefix = target.Bech32Prefix
+		}
+	}
+
+	// the values of flags and presets are validated like the answers to the questions.
+	if err := validateRelayerValues(
+		relayerValue{"source RPC", sourceRPCAddress, cliquiz.URL},
+		relayerValue{"target RPC", targetRPCAddress, cliquiz.URL},
+		relayerValue{"source faucet", sourceFaucetAddress, cliquiz.URL},
+		relayerValue{"target faucet", targetFaucetAddress, cliquiz.URL},
+		relayerValue{"source gas price", sourceGasPrice, cliquiz.DecCoin},
+		relayerValue{"target gas price", targetGasPrice, cliquiz.DecCoin},
+	); err != nil {
+		return err
+	}
+
 	var questions []cliquiz.Question
 
 	// get information from prompt if flag not provided
@@ -307,13 +391,15 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 	if sourceRPCAddress == "" {
 		questions = append(questions, questionSourceRPCAddress)
 	}
-	if sourceFaucetAddress == "" {
+	// faucets are discovered from the coordinator after the RPC addresses are known.
+	coordinator, _ := cmd.Flags().GetString(flagCoordinator)
+	if sourceFaucetAddress == "" && coordinator == "" {
 		questions = append(questions, questionSourceFaucet)
 	}
 	if targetRPCAddress == "" {
 		questions = append(questions, questionTargetRPCAddress)
 	}
-	if targetFaucetAddress == "" {
+	if targetFaucetAddress == "" && coordinator == "" {
 		questions = append(questions, questionTargetFaucet)
 	}
 	if sourceGasPrice == "" {
@@ -356,13 +442,45 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 		}
 	}
 
-	r := relayer.New(ca)
+	// discover the faucets from the coordinator when not provided by flags
+	if coordinator != "" {
+		var faucetQuestions []cliquiz.Question
+
+		if sourceFaucetAddress == "" {
+			if sourceFaucetAddress, err = discoverRelayerFaucet(cmd, sourceRPCAddress); err != nil {
+				return err
+			}
+			if sourceFaucetAddress == "" {
+				faucetQuestions = append(faucetQuestions, questionSourceFaucet)
+			}
+		}
+		if targetFaucetAddress == "" {
+			if targetFaucetAddress, err = discoverRelayerFaucet(cmd, targetRPCAddress); err != nil {
+				return err
+			}
+			if targetFaucetAddress == "" {
+				faucetQuestions = append(faucetQuestions, questionTargetFaucet)
+			}
+		}
+
+		if len(faucetQuestions) > 0 {
+			if err := cliquiz.Ask(faucetQuestions...); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := ensureRelayerAccounts(ca, sourceAccount, targetAccount); err != nil {
+		return err
+	}
+
+	r := newRelayer(ca)
 
 	fmt.Println()
 	s.SetText("Fetching chain info...")
 
 	// initialize the chains
-	sourceChain, err := initChain(
+	sourceChain, sourceResult, err := initChain(
 		cmd,
 		r,
 		s,
@@ -378,7 +496,7 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
-	targetChain, err := initChain(
+	targetChain, targetResult, err := initChain(
 		cmd,
 		r,
 		s,
@@ -419,11 +537,58 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 
 	s.Stop()
 
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, relayerConfigureResult{
+			Path:   id,
+			Source: sourceResult,
+			Target: targetResult,
+		})
+	}
+
 	fmt.Printf("⛓  Configured chains: %s\n\n", color.Green.Sprint(id))
 
 	return nil
 }
 
+// relayerConfigureResult is the result of relayer configure in the json and yaml outputs.
+type relayerConfigureResult struct {
+	// Path is the id of the path of the configured chains.
+	Path   string             `json:"path"`
+	Source relayerChainResult `json:"source"`
+	Target relayerChainResult `json:"target"`
+}
+
+// relayerChainResult is a chain that's configured for the relayer.
+type relayerChainResult struct {
+	ChainID string `json:"chain_id"`
+	Account string `json:"account"`
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+
+	// FaucetError is why tokens cannot be received from the faucet of the chain.
+	FaucetError string `json:"faucet_error,omitempty"`
+}
+
+// relayerValue is a value of relayer configure that's set with a flag or a preset.
+type relayerValue struct {
+	name     string
+	value    string
+	validate func(string) error
+}
+
+// validateRelayerValues validates the values that are set, the values that are not are asked.
+func validateRelayerValues(values ...relayerValue) error {
+	for _, v := range values {
+		if v.value == "" {
+			continue
+		}
+		if err := v.validate(v.value); err != nil {
+			return fmt.Errorf("invalid %s: %w", v.name, err)
+		}
+	}
+	return nil
+}
+
 // initChain initializes chain information for the relayer connection
 func initChain(
 	cmd *cobra.Command,
@@ -436,7 +601,7 @@ func initChain(
 	gasPrice string,
 	gasLimit int64,
 	addressPrefix string,
-) (*relayer.Chain, error) {
+) (*relayer.Chain, relayerChainResult, error) {
 	defer s.Stop()
 	s.SetText("Initializing chain...").Start()
 
@@ -450,7 +615,7 @@ func initChain(
 		relayer.WithAddressPrefix(addressPrefix),
 	)
 	if err != nil {
-		return nil, errors.Wrapf(err, "cannot resolve %s", name)
+		return nil, relayerChainResult{}, errors.Wrapf(err, "cannot resolve %s", name)
 	}
 
 	s.Stop()
@@ -470,7 +635,9 @@ func initChain(
 
 	fmt.Print(" |· ")
 	if err != nil {
-		fmt.Println(color.Yellow.Sprintf(err.Error()))
+		faucetErr := sperrors.FaucetUnreachable(c.ID, err)
+		fmt.Println(color.Yellow.Sprintf("%s: %s", faucetErr.Cause, err))
+		fmt.Printf(" |· 💡 %s\n", faucetErr.Hint)
 	} else {
 		fmt.Println(color.Green.Sprintf("received coins from a faucet"))
 	}
@@ -481,7 +648,85 @@ func initChain(
 	}
 	fmt.Printf(" |· (balance: %s)\n\n", balance)
 
-	return c, nil
+	result := relayerChainResult{
+		ChainID: c.ID,
+		Account: accountName,
+		Address: accountAddr,
+		Balance: coins.String(),
+	}
+	if err != nil {
+		result.FaucetError = err.Error()
+	}
+	return c, result, nil
+}
+
+// relayerNetwork returns the network presets selected with the --network flag.
+func relayerNetwork(cmd *cobra.Command) (network conf.Network, found bool, err error) {
+	name := flagGetNetwork(cmd)
+	if name == "" {
+		return conf.Network{}, false, nil
+	}
+
+	configPath, err := cmd.Flags().GetString(flagConfig)
+	if err != nil {
+		return conf.Network{}, false, err
+	}
+	if configPath == "" {
+		if configPath, err = conf.LocateDefault(flagGetPath(cmd)); err != nil {
+			return conf.Network{}, false, err
+		}
+	}
+
+	config, err := conf.ParseFile(configPath)
+	if err != nil {
+		return conf.Network{}, false, err
+	}
+
+	network, found = config.NetworkByName(name)
+	if !found {
+		return conf.Network{}, false, errors.Wrapf(chain.ErrNetworkNotFound, "%q", name)
+	}
+
+	return network, true, nil
+}
+
+// relayerTargetChain returns the target chain selected with the --target flag from the
+// chain registry.
+func relayerTargetChain(cmd *cobra.Command) (chain chainregistry.Chain, found bool, err error) {
+	name, _ := cmd.Flags().GetString(flagTarget)
+	if name == "" {
+		return chainregistry.Chain{}, false, nil
+	}
+
+	registry, _ := cmd.Flags().GetString(flagRegistry)
+
+	chain, err = chainregistry.New(registry).Lookup(cmd.Context(), name)
+	if err != nil {
+		return chainregistry.Chain{}, false, errors.Wrapf(err, "cannot find the target chain in %s", registry)
+	}
+
+	return chain, true, nil
+}
+
+// discoverRelayerFaucet returns the address of a faucet of the chain with the RPC endpoint at
+// rpcAddress from the coordinator, it's empty when the chain has no faucets or it cannot be
+// reached.
+func discoverRelayerFaucet(cmd *cobra.Command, rpcAddress string) (string, error) {
+	chainID, err := network.NodeChainID(cmd.Context(), rpcAddress)
+	if err != nil {
+		return "", nil
+	}
+
+	nc, err := newNetworkClient(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	faucet, err := nc.DiscoverFaucet(cmd.Context(), chainID)
+	if errors.Is(err, network.ErrNotFound) {
+		return "", nil
+	}
+	return faucet, err
 }
 
 func printSection(title string) {
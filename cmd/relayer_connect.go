@@ -7,8 +7,8 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tendermint/starport/starport/pkg/clispinner"
-	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
-	"github.com/tendermint/starport/starport/pkg/relayer"
+	"github.com/trino-network/trino/pkg/cliprogress"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
 )
 
 // NewRelayerConnect returns a new relayer connect command to link all or some relayer paths and start
@@ -16,9 +16,10 @@ import (
 // if not paths are specified, all paths are linked.
 func NewRelayerConnect() *cobra.Command {
 	c := &cobra.Command{
-		Use:   "connect [<path>,...]",
-		Short: "Link chains associated with paths and start relaying tx packets in between",
-		RunE:  relayerConnectHandler,
+		Use:               "connect [<path>,...]",
+		Short:             "Link chains associated with paths and start relaying tx packets in between",
+		RunE:              relayerConnectHandler,
+		ValidArgsFunction: completeRelayerPaths,
 	}
 
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
@@ -49,7 +50,7 @@ func relayerConnectHandler(cmd *cobra.Command, args []string) (err error) {
 
 	var use []string
 
-	r := relayer.New(ca)
+	r := newRelayer(ca)
 
 	all, err := r.ListPaths(cmd.Context())
 	if err != nil {
@@ -82,13 +83,18 @@ func relayerConnectHandler(cmd *cobra.Command, args []string) (err error) {
 		return nil
 	}
 
-	s.SetText("Creating links between chains...")
+	s.Stop()
 
-	if err := r.Link(cmd.Context(), use...); err != nil {
-		return err
+	// the handshake of each path is a step since it can take minutes.
+	progress := cliprogress.New(os.Stdout, len(use))
+	for _, id := range use {
+		progress.Step(fmt.Sprintf("Linking the chains of %s", id), 0)
+		if err := r.Link(cmd.Context(), id); err != nil {
+			progress.Fail()
+			return err
+		}
 	}
-
-	s.Stop()
+	progress.Stop()
 
 	printSection("Paths")
 
@@ -0,0 +1,40 @@
+package starportcmd
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/stretchr/testify/require"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+// ledgerInfo is the info of a Ledger account, which cannot be added without a device.
+type ledgerInfo struct {
+	keyring.Info
+}
+
+func (ledgerInfo) GetType() keyring.KeyType { return keyring.TypeLedger }
+
+func TestRelayerAccounts(t *testing.T) {
+	ca, err := cosmosaccount.New(cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringMemory), cosmosaccount.WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	alice, _, err := ca.Create("alice")
+	require.NoError(t, err)
+	_, err = ca.AddWatchOnly("bob", alice.Address(""))
+	require.NoError(t, err)
+
+	require.NoError(t, ensureRelayerAccounts(ca, "alice"))
+
+	err = ensureRelayerAccounts(ca, "alice", "bob")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `account "bob" has no private key`)
+
+	err = checkRelayerAccount(cosmosaccount.Account{Name: "carol", Info: ledgerInfo{alice.Info}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "doesn't support Ledger signing")
+
+	names, err := relayerAccountNames(ca)
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice"}, names)
+}
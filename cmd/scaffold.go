@@ -7,7 +7,9 @@ import (
 	flag "github.com/spf13/pflag"
 	"github.com/tendermint/starport/starport/pkg/clispinner"
 	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
 	"github.com/tendermint/starport/starport/services/scaffolder"
+	"github.com/trino-network/trino/pkg/scaffoldhook"
 )
 
 // flags related to component scaffolding
@@ -39,6 +41,7 @@ CRUD stands for "create, read, update, delete".`,
 	c.AddCommand(NewScaffoldMessage())
 	c.AddCommand(NewScaffoldQuery())
 	c.AddCommand(NewScaffoldPacket())
+	c.AddCommand(NewScaffoldIBCTests())
 	c.AddCommand(NewScaffoldBandchain())
 	c.AddCommand(NewScaffoldVue())
 	c.AddCommand(NewScaffoldFlutter())
@@ -84,13 +87,30 @@ func scaffoldType(
 		return err
 	}
 
-	sm, err := sc.AddType(cmd.Context(), typeName, placeholder.New(), kind, options...)
+	hooks, err := getScaffoldHooks(cmd, appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := scaffoldWithHooks(cmd.Context(), hooks, scaffoldhook.Event{
+		Target:  scaffoldhook.TargetType,
+		AppPath: appPath,
+		Module:  moduleName,
+		Name:    typeName,
+		Kind:    cmd.Name(),
+	}, func() (xgenny.SourceModification, error) {
+		return sc.AddType(cmd.Context(), typeName, placeholder.New(), kind, options...)
+	})
 	if err != nil {
 		return err
 	}
 
 	s.Stop()
 
+	if err := printScaffoldResult(cmd, typeName, sm); err != nil {
+		return err
+	}
+
 	modificationsStr, err := sourceModificationToString(sm)
 	if err != nil {
 		return err
@@ -107,6 +127,7 @@ func flagSetScaffoldType() *flag.FlagSet {
 	f.String(flagModule, "", "Module to add into. Default is app's main module")
 	f.Bool(flagNoMessage, false, "Disable CRUD interaction messages scaffolding")
 	f.String(flagSigner, "", "Label for the message signer (default: creator)")
+	f.AddFlagSet(flagSetScaffoldHooks())
 	return f
 }
 
@@ -13,14 +13,14 @@ import (
 // NewScaffoldBandchain creates a new BandChain oracle in the module
 func NewScaffoldBandchain() *cobra.Command {
 	c := &cobra.Command{
-		Use:   "band [queryName] --module [moduleName]",
-		Short: "Scaffold an IBC BandChain query oracle to request real-time data",
-		Long:  "Scaffold an IBC BandChain query oracle to request real-time data from BandChain scripts in a specific IBC-enabled Cosmos SDK module",
-		Args:  cobra.MinimumNArgs(1),
-		RunE:  createBandchainHandler,
+		Use:         "band [queryName] --module [moduleName]",
+		Short:       "Scaffold an IBC BandChain query oracle to request real-time data",
+		Long:        "Scaffold an IBC BandChain query oracle to request real-time data from BandChain scripts in a specific IBC-enabled Cosmos SDK module",
+		Args:        cobra.MinimumNArgs(1),
+		RunE:        createBandchainHandler,
+		Annotations: resultAnnotations(),
 	}
 
-	flagSetPath(c)
 	c.Flags().String(flagModule, "", "IBC Module to add the packet into")
 	c.Flags().String(flagSigner, "", "Label for the message signer (default: creator)")
 
@@ -62,6 +62,10 @@ func createBandchainHandler(cmd *cobra.Command, args []string) error {
 
 	s.Stop()
 
+	if err := printScaffoldResult(cmd, oracle, sm); err != nil {
+		return err
+	}
+
 	modificationsStr, err := sourceModificationToString(sm)
 	if err != nil {
 		return err
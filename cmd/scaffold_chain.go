@@ -7,10 +7,13 @@ import (
 	"github.com/tendermint/starport/starport/pkg/clispinner"
 	"github.com/tendermint/starport/starport/pkg/placeholder"
 	"github.com/tendermint/starport/starport/services/scaffolder"
+	"github.com/trino-network/trino/pkg/pluginregistry"
+	"github.com/trino-network/trino/pkg/templatepack"
 )
 
 const (
 	flagNoDefaultModule = "no-module"
+	flagTemplate        = "template"
 )
 
 // NewScaffoldChain creates new command to scaffold a Comos-SDK based blockchain.
@@ -18,14 +21,24 @@ func NewScaffoldChain() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "chain [github.com/org/repo]",
 		Short: "Fully-featured Cosmos SDK blockchain",
-		Long:  "Scaffold a new Cosmos SDK blockchain with a default directory structure",
-		Args:  cobra.ExactArgs(1),
-		RunE:  scaffoldChainHandler,
+		Long: `Scaffold a new Cosmos SDK blockchain with a default directory structure.
+
+With --template, the chain is scaffolded from a template pack: a git repository with a
+template.yml manifest that declares the modules of the chain and files such as CI and
+frontend configs that are added to it. A template pack is given by the url or the path of
+its repository, or by its name in the registry, followed by an optional @tag or @branch:
+
+  starport scaffold chain github.com/mycompany/mars --template mycompany-chain@v1.0.0`,
+		Args:        cobra.ExactArgs(1),
+		RunE:        scaffoldChainHandler,
+		Annotations: resultAnnotations(),
 	}
 
 	c.Flags().StringP(flagPath, "p", ".", "path to scaffold the chain")
 	c.Flags().String(flagAddressPrefix, "cosmos", "Address prefix")
 	c.Flags().Bool(flagNoDefaultModule, false, "Prevent scaffolding a default module in the app")
+	c.Flags().String(flagTemplate, "", "Template pack to scaffold the chain from")
+	c.Flags().String(flagRegistry, pluginregistry.DefaultURL, "Git repository of the registry of template packs")
 
 	return c
 }
@@ -39,13 +52,41 @@ func scaffoldChainHandler(cmd *cobra.Command, args []string) error {
 		addressPrefix, _   = cmd.Flags().GetString(flagAddressPrefix)
 		noDefaultModule, _ = cmd.Flags().GetBool(flagNoDefaultModule)
 		appPath            = flagGetPath(cmd)
+		template, _        = cmd.Flags().GetString(flagTemplate)
+		registry, _        = cmd.Flags().GetString(flagRegistry)
 	)
 
+	var pack *templatepack.Pack
+	if template != "" {
+		s.SetText("Fetching the template pack...")
+
+		p, cleanup, err := fetchTemplatePack(cmd.Context(), template, registry)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+		pack = &p
+
+		if !cmd.Flags().Changed(flagAddressPrefix) && p.Manifest.AddressPrefix != "" {
+			addressPrefix = p.Manifest.AddressPrefix
+		}
+		noDefaultModule = noDefaultModule || p.Manifest.NoDefaultModule
+
+		s.SetText("Scaffolding...")
+	}
+
 	appdir, err := scaffolder.Init(placeholder.New(), appPath, name, addressPrefix, noDefaultModule)
 	if err != nil {
 		return err
 	}
 
+	if pack != nil {
+		s.SetText(fmt.Sprintf("Applying the %s template pack...", pack.Manifest.Name))
+		if err := applyTemplatePack(*pack, appdir, name, addressPrefix); err != nil {
+			return err
+		}
+	}
+
 	s.Stop()
 
 	path, err := relativePath(appdir)
@@ -53,6 +94,10 @@ func scaffoldChainHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, scaffoldChainResult{Name: name, Path: path})
+	}
+
 	message := `
 ⭐️ Successfully created a new blockchain '%[1]v'.
 👉 Get started with the following commands:
@@ -66,3 +111,11 @@ Documentation: https://docs.starport.network
 
 	return nil
 }
+
+// scaffoldChainResult is the result of scaffold chain in the json and yaml outputs.
+type scaffoldChainResult struct {
+	Name string `json:"name"`
+
+	// Path is the dir of the chain, relative to the current dir.
+	Path string `json:"path"`
+}
@@ -0,0 +1,147 @@
+package starportcmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/tendermint/starport/starport/pkg/gomodulepath"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/services/scaffolder"
+	"github.com/trino-network/trino/pkg/offline"
+	"github.com/trino-network/trino/pkg/templatepack"
+)
+
+// fetchTemplatePack fetches the template pack of template, that's the path of a local pack or
+// the url of its git repository or its name in the registry, with an optional @ref suffix.
+// cleanup removes the fetched pack.
+func fetchTemplatePack(ctx context.Context, template, registry string) (pack templatepack.Pack, cleanup func(), err error) {
+	cleanup = func() {}
+
+	if info, err := os.Stat(template); err == nil && info.IsDir() {
+		pack, err := templatepack.Load(template)
+		return pack, cleanup, err
+	}
+
+	errNotCached := fmt.Errorf("template pack %s is not cached, run `starport cache warm --template %s` while online", template, template)
+
+	url, ref, err := resolveTemplatePack(ctx, template, registry)
+	if err != nil {
+		if offline.IsEnabled() {
+			return pack, cleanup, errNotCached
+		}
+		return pack, cleanup, err
+	}
+
+	// template packs are loaded from the cache in offline mode.
+	if offline.IsEnabled() {
+		dir, err := templatePackCachePath(url, ref)
+		if err != nil {
+			return pack, cleanup, err
+		}
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			return pack, cleanup, errNotCached
+		}
+		pack, err := templatepack.Load(dir)
+		return pack, cleanup, err
+	}
+
+	dir, err := os.MkdirTemp("", "starport-template")
+	if err != nil {
+		return pack, cleanup, err
+	}
+	cleanup = func() { os.RemoveAll(dir) }
+
+	pack, err = templatepack.Clone(ctx, url, ref, filepath.Join(dir, "pack"))
+	if err != nil {
+		cleanup()
+		return pack, func() {}, err
+	}
+	return pack, cleanup, nil
+}
+
+// resolveTemplatePack returns the url and the ref of the git repository of the template pack of
+// template, its url is looked up in the registry when template is a name.
+func resolveTemplatePack(ctx context.Context, template, registry string) (url, ref string, err error) {
+	url, ref = splitTemplateRef(template)
+	if !strings.ContainsAny(url, "/:") {
+		entry, err := newPluginRegistry(registry).Template(ctx, url)
+		if err != nil {
+			return "", "", err
+		}
+		url = entry.Repository
+	}
+	return url, ref, nil
+}
+
+// splitTemplateRef splits the ref of template from its url, the @ of urls like
+// git@github.com:org/repo isn't a ref.
+func splitTemplateRef(template string) (url, ref string) {
+	i := strings.LastIndex(template, "@")
+	if i <= 0 || strings.ContainsAny(template[i+1:], "/:") {
+		return template, ""
+	}
+	return template[:i], template[i+1:]
+}
+
+// applyTemplatePack scaffolds the modules of pack in the chain at appPath with the module path
+// modulePath, writes the files of pack and commits them.
+func applyTemplatePack(pack templatepack.Pack, appPath, modulePath, addressPrefix string) error {
+	sc, err := scaffolder.App(appPath)
+	if err != nil {
+		return err
+	}
+
+	for _, module := range pack.Manifest.Modules {
+		var options []scaffolder.ModuleCreationOption
+		if module.IBC {
+			options = append(options, scaffolder.WithIBC())
+		}
+		if len(module.Dependencies) > 0 {
+			dependencies, err := parseModuleDependencies(module.Dependencies)
+			if err != nil {
+				return fmt.Errorf("module %s: %w", module.Name, err)
+			}
+			options = append(options, scaffolder.WithDependencies(dependencies))
+		}
+
+		if _, err := sc.CreateModule(placeholder.New(), module.Name, options...); err != nil {
+			return fmt.Errorf("cannot scaffold module %s: %w", module.Name, err)
+		}
+	}
+
+	pathInfo, err := gomodulepath.Parse(modulePath)
+	if err != nil {
+		return err
+	}
+	if _, err := pack.Apply(appPath, templatepack.Data{
+		ModulePath:       pathInfo.RawPath,
+		AppName:          pathInfo.Package,
+		BinaryNamePrefix: pathInfo.Root,
+		AddressPrefix:    addressPrefix,
+	}); err != nil {
+		return err
+	}
+
+	repo, err := git.PlainOpen(appPath)
+	if err != nil {
+		return err
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+	if _, err := wt.Add("."); err != nil {
+		return err
+	}
+	_, err = wt.Commit(fmt.Sprintf("Applied the %s template pack", pack.Manifest.Name), &git.CommitOptions{
+		All:    true,
+		Author: &object.Signature{Name: "Starport", Email: "hello@tendermint.com", When: time.Now()},
+	})
+	return err
+}
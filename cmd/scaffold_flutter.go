@@ -11,11 +11,10 @@ import (
 // NewScaffoldFlutter scaffolds a Flutter app for a chain.
 func NewScaffoldFlutter() *cobra.Command {
 	c := &cobra.Command{
-		Hidden: true,
-		Use:    "flutter",
-		Short:  "A Flutter app for your chain",
-		Args:   cobra.NoArgs,
-		RunE:   scaffoldFlutterHandler,
+		Use:   "flutter",
+		Short: "A Flutter app for your chain",
+		Args:  cobra.NoArgs,
+		RunE:  scaffoldFlutterHandler,
 	}
 
 	c.Flags().StringP(flagPath, "p", "./flutter", "path to scaffold content of the Flutter app")
@@ -0,0 +1,214 @@
+package starportcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/exec"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+	"github.com/tendermint/starport/starport/pkg/gomodulepath"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	conf "github.com/trino-network/trino/chainconf"
+	sperrors "github.com/trino-network/trino/errors"
+	"github.com/trino-network/trino/pkg/cmdtrace"
+	"github.com/trino-network/trino/pkg/scaffoldhook"
+)
+
+// scaffoldHooksConfig is the config of the scaffold hooks in config.yml.
+type scaffoldHooksConfig struct {
+	Scaffold conf.Scaffold `yaml:"scaffold"`
+}
+
+func flagSetScaffoldHooks() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Bool(flagNoHooks, false, "Do not run the scaffold hooks of config.yml and of plugins")
+	return fs
+}
+
+// getScaffoldHooks returns the scaffold hooks of the chain at appPath and of its plugins,
+// unless they are disabled with a flag.
+func getScaffoldHooks(cmd *cobra.Command, appPath string) ([]scaffoldhook.Hook, error) {
+	if noHooks, _ := cmd.Flags().GetBool(flagNoHooks); noHooks {
+		return nil, nil
+	}
+
+	hooks, err := loadScaffoldCommandHooks(appPath)
+	if err != nil {
+		return nil, err
+	}
+
+	plugins, err := loadPlugins(appPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range plugins {
+		if len(p.Hooks) > 0 {
+			hooks = append(hooks, newScaffoldPluginHook(p))
+		}
+	}
+
+	return hooks, nil
+}
+
+// loadScaffoldCommandHooks loads the scaffold hooks of the config.yml of the chain at appPath,
+// there are none when the chain has no config.yml.
+func loadScaffoldCommandHooks(appPath string) ([]scaffoldhook.Hook, error) {
+	path, err := conf.LocateDefault(appPath)
+	if errors.Is(err, conf.ErrCouldntLocateConfig) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	configs, err := loadScaffoldHooksConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var hooks []scaffoldhook.Hook
+	for _, config := range configs {
+		hooks = append(hooks, newScaffoldCommandHook(config))
+	}
+	return hooks, nil
+}
+
+// loadScaffoldHooksConfig loads the scaffold hooks of the config file at path.
+func loadScaffoldHooksConfig(path string) ([]conf.ScaffoldHook, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var config scaffoldHooksConfig
+	if err := yaml.Unmarshal(b, &config); err != nil {
+		return nil, fmt.Errorf("invalid scaffold hooks in %s: %w", path, err)
+	}
+	if err := conf.ValidateScaffoldHooks(config.Scaffold.Hooks); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return config.Scaffold.Hooks, nil
+}
+
+// newScaffoldCommandHook creates a hook that runs the shell command of config in the app's dir,
+// the event is passed to the command with env vars.
+func newScaffoldCommandHook(config conf.ScaffoldHook) scaffoldhook.Hook {
+	return scaffoldhook.HookFunc(func(ctx context.Context, event scaffoldhook.Event) error {
+		if len(config.On) > 0 && !containsScaffoldEventType(config.On, event.Type()) {
+			return nil
+		}
+
+		err := exec.Exec(
+			ctx,
+			[]string{"sh", "-c", config.Run},
+			exec.StepOption(step.Workdir(event.AppPath)),
+			exec.StepOption(step.Env(
+				cmdrunner.Env("SCAFFOLD_EVENT", string(event.Type())),
+				cmdrunner.Env("SCAFFOLD_APP_PATH", event.AppPath),
+				cmdrunner.Env("SCAFFOLD_MODULE", event.Module),
+				cmdrunner.Env("SCAFFOLD_NAME", event.Name),
+				cmdrunner.Env("SCAFFOLD_KIND", event.Kind),
+				cmdrunner.Env("SCAFFOLD_CREATED_FILES", strings.Join(event.Created, "\n")),
+				cmdrunner.Env("SCAFFOLD_MODIFIED_FILES", strings.Join(event.Modified, "\n")),
+			)),
+			exec.StepOption(cmdtrace.Step()),
+			exec.IncludeStdLogsToError(),
+		)
+		if err != nil && config.Name != "" {
+			return fmt.Errorf("%s: %w", config.Name, err)
+		}
+		return err
+	})
+}
+
+// newScaffoldPluginHook creates a hook that notifies plugin p of the scaffold events that it's
+// subscribed to.
+func newScaffoldPluginHook(p configuredPlugin) scaffoldhook.Hook {
+	return scaffoldhook.HookFunc(func(ctx context.Context, event scaffoldhook.Event) error {
+		if !containsScaffoldEventType(p.Hooks, event.Type()) {
+			return nil
+		}
+
+		client, err := startPlugin(ctx, p)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		if err := client.ScaffoldHook(ctx, event); err != nil {
+			return fmt.Errorf("plugin %s: %w", p.Name, err)
+		}
+		return nil
+	})
+}
+
+// scaffoldWithHooks runs the pre hooks of event, then scaffolds with scaffold and runs the post
+// hooks of event with the scaffolded files.
+func scaffoldWithHooks(
+	ctx context.Context,
+	hooks []scaffoldhook.Hook,
+	event scaffoldhook.Event,
+	scaffold func() (xgenny.SourceModification, error),
+) (xgenny.SourceModification, error) {
+	if len(hooks) == 0 {
+		sm, err := scaffold()
+		return sm, placeholderMissingError(err)
+	}
+
+	appPath, err := filepath.Abs(event.AppPath)
+	if err != nil {
+		return xgenny.SourceModification{}, err
+	}
+	event.AppPath = appPath
+
+	if event.Module == "" {
+		path, _, err := gomodulepath.Find(appPath)
+		if err != nil {
+			return xgenny.SourceModification{}, err
+		}
+		event.Module = path.Package
+	}
+
+	event.Stage = scaffoldhook.StagePre
+	if err := scaffoldhook.Run(ctx, hooks, event); err != nil {
+		return xgenny.SourceModification{}, err
+	}
+
+	sm, err := scaffold()
+	if err != nil {
+		return sm, placeholderMissingError(err)
+	}
+
+	event.Stage = scaffoldhook.StagePost
+	event.Created = sm.CreatedFiles()
+	event.Modified = sm.ModifiedFiles()
+	return sm, scaffoldhook.Run(ctx, hooks, event)
+}
+
+// placeholderMissingError returns err as an sperrors.Error when it's caused by missing
+// placeholders, so it's printed with a hint to restore them.
+func placeholderMissingError(err error) error {
+	var missingErr *placeholder.MissingPlaceholdersError
+	if errors.As(err, &missingErr) {
+		return sperrors.PlaceholderMissing(err)
+	}
+	return err
+}
+
+func containsScaffoldEventType(eventTypes []scaffoldhook.EventType, eventType scaffoldhook.EventType) bool {
+	for _, t := range eventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package starportcmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/exec"
+	"github.com/tendermint/starport/starport/pkg/gocmd"
+	"github.com/tendermint/starport/starport/pkg/gomodule"
+	"github.com/trino-network/trino/pkg/cmdtrace"
+	"github.com/trino-network/trino/pkg/ibctests"
+	"github.com/trino-network/trino/pkg/txreplay"
+)
+
+// NewScaffoldIBCTests scaffolds the ibctesting tests of the IBC modules of a chain.
+func NewScaffoldIBCTests() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "ibc-tests",
+		Short: "IBC tests of the handshake, packet round trips and timeouts of the IBC modules",
+		Long: `Scaffold the tests of the IBC modules of a chain, the modules scaffolded with --ibc.
+
+The tests are written into tests/ibc_[module]_test.go and run with "go test ./tests". They connect
+two in-process chains of the chain with ibctesting and test:
+
+- the handshake of a channel between the modules
+- the round trip of each packet of the module: it's sent, received and its acknowledgement is relayed back
+- the timeout of each packet of the module
+
+The packets are sent empty, set their fields in the tests to test how they're handled. The tests
+that exist are kept unless --force is used, only the helpers in tests/ibc_test.go are updated.`,
+		Args: cobra.NoArgs,
+		RunE: scaffoldIBCTestsHandler,
+	}
+
+	c.Flags().Bool(flagForce, false, "Overwrite the tests of the modules if they exist")
+
+	return c
+}
+
+// scaffoldIBCTestsResult is the result of scaffold ibc-tests in the json and yaml outputs.
+type scaffoldIBCTestsResult struct {
+	Tests []scaffoldIBCTest `json:"tests"`
+}
+
+type scaffoldIBCTest struct {
+	Module  string   `json:"module"`
+	Path    string   `json:"path"`
+	Order   string   `json:"order"`
+	Packets []string `json:"packets"`
+	Skipped bool     `json:"skipped"`
+}
+
+func scaffoldIBCTestsHandler(cmd *cobra.Command, args []string) error {
+	var (
+		force, _ = cmd.Flags().GetBool(flagForce)
+		appPath  = flagGetPath(cmd)
+	)
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	gomod, err := gomodule.ParseAt(appPath)
+	if err != nil {
+		return err
+	}
+	tests, err := ibctests.Write(appPath, gomod.Module.Mod.Path, force)
+	if err != nil {
+		return err
+	}
+
+	// ibctesting is a package of ibc-go whose deps may not be in the go.sum of the chain yet.
+	s.SetText("Installing dependencies...")
+	if err := gocmd.ModTidy(cmd.Context(), appPath, exec.StepOption(cmdtrace.Step())); err != nil {
+		return err
+	}
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		var result scaffoldIBCTestsResult
+		for _, t := range tests {
+			result.Tests = append(result.Tests, scaffoldIBCTest{
+				Module:  t.Module.Name.Original,
+				Path:    t.Path,
+				Order:   t.Module.Order,
+				Packets: t.Module.Packets,
+				Skipped: t.Skipped,
+			})
+		}
+		return printResult(cmd, result)
+	}
+
+	for _, t := range tests {
+		if t.Skipped {
+			fmt.Printf("⏭  %s exists, use --%s to overwrite it\n", t.Path, flagForce)
+			continue
+		}
+		if len(t.Module.Packets) == 0 {
+			fmt.Printf("🧪 %s tests the channel handshake of the %s module, it has no packets yet\n", t.Path, t.Module.Name.Original)
+			continue
+		}
+		fmt.Printf("🧪 %s tests the %s module and its packets: %s\n", t.Path, t.Module.Name.Original, strings.Join(t.Module.Packets, ", "))
+	}
+	fmt.Printf("\n🎉 Scaffolded the IBC tests, run them with go test ./%s in the chain\n", txreplay.Dir)
+	return nil
+}
@@ -8,13 +8,14 @@ import (
 // NewScaffoldList returns a new command to scaffold a list.
 func NewScaffoldList() *cobra.Command {
 	c := &cobra.Command{
-		Use:   "list NAME [field]...",
-		Short: "CRUD for data stored as an array",
-		Args:  cobra.MinimumNArgs(1),
-		RunE:  scaffoldListHandler,
+		Use:               "list NAME [field]...",
+		Short:             "CRUD for data stored as an array",
+		Args:              cobra.MinimumNArgs(1),
+		RunE:              scaffoldListHandler,
+		Annotations:       resultAnnotations(),
+		ValidArgsFunction: completeFields,
 	}
 
-	flagSetPath(c)
 	c.Flags().AddFlagSet(flagSetScaffoldType())
 
 	return c
@@ -6,7 +6,9 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/tendermint/starport/starport/pkg/clispinner"
 	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
 	"github.com/tendermint/starport/starport/services/scaffolder"
+	"github.com/trino-network/trino/pkg/scaffoldhook"
 )
 
 const flagSigner = "signer"
@@ -14,13 +16,15 @@ const flagSigner = "signer"
 // NewScaffoldMessage returns the command to scaffold messages
 func NewScaffoldMessage() *cobra.Command {
 	c := &cobra.Command{
-		Use:   "message [name] [field1] [field2] ...",
-		Short: "Message to perform state transition on the blockchain",
-		Args:  cobra.MinimumNArgs(1),
-		RunE:  messageHandler,
+		Use:               "message [name] [field1] [field2] ...",
+		Short:             "Message to perform state transition on the blockchain",
+		Args:              cobra.MinimumNArgs(1),
+		RunE:              messageHandler,
+		Annotations:       resultAnnotations(),
+		ValidArgsFunction: completeFields,
 	}
 
-	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetScaffoldHooks())
 	c.Flags().String(flagModule, "", "Module to add the message into. Default: app's main module")
 	c.Flags().StringSliceP(flagResponse, "r", []string{}, "Response fields")
 	c.Flags().StringP(flagDescription, "d", "", "Description of the command")
@@ -58,13 +62,29 @@ func messageHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	sm, err := sc.AddMessage(cmd.Context(), placeholder.New(), module, args[0], args[1:], resFields, options...)
+	hooks, err := getScaffoldHooks(cmd, appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := scaffoldWithHooks(cmd.Context(), hooks, scaffoldhook.Event{
+		Target:  scaffoldhook.TargetMessage,
+		AppPath: appPath,
+		Module:  module,
+		Name:    args[0],
+	}, func() (xgenny.SourceModification, error) {
+		return sc.AddMessage(cmd.Context(), placeholder.New(), module, args[0], args[1:], resFields, options...)
+	})
 	if err != nil {
 		return err
 	}
 
 	s.Stop()
 
+	if err := printScaffoldResult(cmd, args[0], sm); err != nil {
+		return err
+	}
+
 	modificationsStr, err := sourceModificationToString(sm)
 	if err != nil {
 		return err
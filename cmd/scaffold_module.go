@@ -11,8 +11,10 @@ import (
 	"github.com/tendermint/starport/starport/pkg/clispinner"
 	"github.com/tendermint/starport/starport/pkg/placeholder"
 	"github.com/tendermint/starport/starport/pkg/validation"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
 	"github.com/tendermint/starport/starport/services/scaffolder"
 	modulecreate "github.com/tendermint/starport/starport/templates/module/create"
+	"github.com/trino-network/trino/pkg/scaffoldhook"
 )
 
 const (
@@ -25,14 +27,15 @@ const (
 // NewScaffoldModule returns the command to scaffold a Cosmos SDK module
 func NewScaffoldModule() *cobra.Command {
 	c := &cobra.Command{
-		Use:   "module [name]",
-		Short: "Scaffold a Cosmos SDK module",
-		Long:  "Scaffold a new Cosmos SDK module in the `x` directory",
-		Args:  cobra.MinimumNArgs(1),
-		RunE:  scaffoldModuleHandler,
+		Use:         "module [name]",
+		Short:       "Scaffold a Cosmos SDK module",
+		Long:        "Scaffold a new Cosmos SDK module in the `x` directory",
+		Args:        cobra.MinimumNArgs(1),
+		RunE:        scaffoldModuleHandler,
+		Annotations: resultAnnotations(),
 	}
 
-	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetScaffoldHooks())
 	c.Flags().StringSlice(flagDep, []string{}, "module dependencies (e.g. --dep account,bank)")
 	c.Flags().Bool(flagIBC, false, "scaffold an IBC module")
 	c.Flags().String(flagIBCOrdering, "none", "channel ordering of the IBC module [none|ordered|unordered]")
@@ -76,22 +79,9 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 	if len(dependencies) > 0 {
-		var formattedDependencies []modulecreate.Dependency
-
-		// Parse the provided dependencies
-		for _, dependency := range dependencies {
-			var formattedDependency modulecreate.Dependency
-
-			splitted := strings.Split(dependency, ":")
-			switch len(splitted) {
-			case 1:
-				formattedDependency = modulecreate.NewDependency(splitted[0], "")
-			case 2:
-				formattedDependency = modulecreate.NewDependency(splitted[0], splitted[1])
-			default:
-				return fmt.Errorf("dependency %s is invalid, must have <depName> or <depName>.<depKeeperName>", dependency)
-			}
-			formattedDependencies = append(formattedDependencies, formattedDependency)
+		formattedDependencies, err := parseModuleDependencies(dependencies)
+		if err != nil {
+			return err
 		}
 		options = append(options, scaffolder.WithDependencies(formattedDependencies))
 	}
@@ -104,7 +94,19 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	sm, err := sc.CreateModule(placeholder.New(), name, options...)
+	hooks, err := getScaffoldHooks(cmd, appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := scaffoldWithHooks(cmd.Context(), hooks, scaffoldhook.Event{
+		Target:  scaffoldhook.TargetModule,
+		AppPath: appPath,
+		Module:  name,
+		Name:    name,
+	}, func() (xgenny.SourceModification, error) {
+		return sc.CreateModule(placeholder.New(), name, options...)
+	})
 	s.Stop()
 	if err != nil {
 		var validationErr validation.Error
@@ -115,6 +117,10 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 			return err
 		}
 	} else {
+		if err := printScaffoldResult(cmd, name, sm); err != nil {
+			return err
+		}
+
 		modificationsStr, err := sourceModificationToString(sm)
 		if err != nil {
 			return err
@@ -131,6 +137,28 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// parseModuleDependencies parses the dependencies of a module in the <depName>[:<depKeeperName>] format.
+func parseModuleDependencies(dependencies []string) ([]modulecreate.Dependency, error) {
+	var formattedDependencies []modulecreate.Dependency
+
+	for _, dependency := range dependencies {
+		var formattedDependency modulecreate.Dependency
+
+		splitted := strings.Split(dependency, ":")
+		switch len(splitted) {
+		case 1:
+			formattedDependency = modulecreate.NewDependency(splitted[0], "")
+		case 2:
+			formattedDependency = modulecreate.NewDependency(splitted[0], splitted[1])
+		default:
+			return nil, fmt.Errorf("dependency %s is invalid, must have <depName> or <depName>.<depKeeperName>", dependency)
+		}
+		formattedDependencies = append(formattedDependencies, formattedDependency)
+	}
+
+	return formattedDependencies, nil
+}
+
 // in previously scaffolded apps gov keeper is defined below the scaffolded module keeper definition
 // therefore we must warn the user to manually move the definition if it's the case
 // https://github.com/tendermint/starport/issues/818#issuecomment-865736052
@@ -10,15 +10,14 @@ import (
 
 func NewScaffoldWasm() *cobra.Command {
 	c := &cobra.Command{
-		Use:   "wasm",
-		Short: "Import the wasm module to your app",
-		Long:  "Add support for WebAssembly smart contracts to your blockchain",
-		Args:  cobra.NoArgs,
-		RunE:  scaffoldWasmHandler,
+		Use:         "wasm",
+		Short:       "Import the wasm module to your app",
+		Long:        "Add support for WebAssembly smart contracts to your blockchain",
+		Args:        cobra.NoArgs,
+		RunE:        scaffoldWasmHandler,
+		Annotations: resultAnnotations(),
 	}
 
-	flagSetPath(c)
-
 	return c
 }
 
@@ -40,6 +39,10 @@ func scaffoldWasmHandler(cmd *cobra.Command, args []string) error {
 
 	s.Stop()
 
+	if err := printScaffoldResult(cmd, "wasm", sm); err != nil {
+		return err
+	}
+
 	modificationsStr, err := sourceModificationToString(sm)
 	if err != nil {
 		return err
@@ -7,7 +7,9 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/tendermint/starport/starport/pkg/clispinner"
 	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
 	"github.com/tendermint/starport/starport/services/scaffolder"
+	"github.com/trino-network/trino/pkg/scaffoldhook"
 )
 
 const (
@@ -17,14 +19,16 @@ const (
 // NewScaffoldPacket creates a new packet in the module
 func NewScaffoldPacket() *cobra.Command {
 	c := &cobra.Command{
-		Use:   "packet [packetName] [field1] [field2] ... --module [moduleName]",
-		Short: "Message for sending an IBC packet",
-		Long:  "Scaffold an IBC packet in a specific IBC-enabled Cosmos SDK module",
-		Args:  cobra.MinimumNArgs(1),
-		RunE:  createPacketHandler,
+		Use:               "packet [packetName] [field1] [field2] ... --module [moduleName]",
+		Short:             "Message for sending an IBC packet",
+		Long:              "Scaffold an IBC packet in a specific IBC-enabled Cosmos SDK module",
+		Args:              cobra.MinimumNArgs(1),
+		RunE:              createPacketHandler,
+		Annotations:       resultAnnotations(),
+		ValidArgsFunction: completeFields,
 	}
 
-	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetScaffoldHooks())
 	c.Flags().StringSlice(flagAck, []string{}, "Custom acknowledgment type (field1,field2,...)")
 	c.Flags().String(flagModule, "", "IBC Module to add the packet into")
 	c.Flags().String(flagSigner, "", "Label for the message signer (default: creator)")
@@ -75,13 +79,29 @@ func createPacketHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	sm, err := sc.AddPacket(cmd.Context(), placeholder.New(), module, packet, packetFields, ackFields, options...)
+	hooks, err := getScaffoldHooks(cmd, appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := scaffoldWithHooks(cmd.Context(), hooks, scaffoldhook.Event{
+		Target:  scaffoldhook.TargetPacket,
+		AppPath: appPath,
+		Module:  module,
+		Name:    packet,
+	}, func() (xgenny.SourceModification, error) {
+		return sc.AddPacket(cmd.Context(), placeholder.New(), module, packet, packetFields, ackFields, options...)
+	})
 	if err != nil {
 		return err
 	}
 
 	s.Stop()
 
+	if err := printScaffoldResult(cmd, args[0], sm); err != nil {
+		return err
+	}
+
 	modificationsStr, err := sourceModificationToString(sm)
 	if err != nil {
 		return err
@@ -6,6 +6,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/tendermint/starport/starport/pkg/clispinner"
 	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/trino-network/trino/pkg/scaffoldhook"
 )
 
 const (
@@ -15,13 +17,15 @@ const (
 // NewScaffoldQuery command creates a new type command to scaffold queries
 func NewScaffoldQuery() *cobra.Command {
 	c := &cobra.Command{
-		Use:   "query [name] [request_field1] [request_field2] ...",
-		Short: "Query to get data from the blockchain",
-		Args:  cobra.MinimumNArgs(1),
-		RunE:  queryHandler,
+		Use:               "query [name] [request_field1] [request_field2] ...",
+		Short:             "Query to get data from the blockchain",
+		Args:              cobra.MinimumNArgs(1),
+		RunE:              queryHandler,
+		Annotations:       resultAnnotations(),
+		ValidArgsFunction: completeFields,
 	}
 
-	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetScaffoldHooks())
 	c.Flags().String(flagModule, "", "Module to add the query into. Default: app's main module")
 	c.Flags().StringSliceP(flagResponse, "r", []string{}, "Response fields")
 	c.Flags().StringP(flagDescription, "d", "", "Description of the command")
@@ -68,13 +72,29 @@ func queryHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	sm, err := sc.AddQuery(cmd.Context(), placeholder.New(), module, args[0], desc, args[1:], resFields, paginated)
+	hooks, err := getScaffoldHooks(cmd, appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := scaffoldWithHooks(cmd.Context(), hooks, scaffoldhook.Event{
+		Target:  scaffoldhook.TargetQuery,
+		AppPath: appPath,
+		Module:  module,
+		Name:    args[0],
+	}, func() (xgenny.SourceModification, error) {
+		return sc.AddQuery(cmd.Context(), placeholder.New(), module, args[0], desc, args[1:], resFields, paginated)
+	})
 	if err != nil {
 		return err
 	}
 
 	s.Stop()
 
+	if err := printScaffoldResult(cmd, args[0], sm); err != nil {
+		return err
+	}
+
 	modificationsStr, err := sourceModificationToString(sm)
 	if err != nil {
 		return err
@@ -8,13 +8,14 @@ import (
 // NewScaffoldSingle returns a new command to scaffold a singleton.
 func NewScaffoldSingle() *cobra.Command {
 	c := &cobra.Command{
-		Use:   "single NAME [field]...",
-		Short: "CRUD for data stored in a single location",
-		Args:  cobra.MinimumNArgs(1),
-		RunE:  scaffoldSingleHandler,
+		Use:               "single NAME [field]...",
+		Short:             "CRUD for data stored in a single location",
+		Args:              cobra.MinimumNArgs(1),
+		RunE:              scaffoldSingleHandler,
+		Annotations:       resultAnnotations(),
+		ValidArgsFunction: completeFields,
 	}
 
-	flagSetPath(c)
 	c.Flags().AddFlagSet(flagSetScaffoldType())
 
 	return c
@@ -8,13 +8,14 @@ import (
 // NewScaffoldType returns a new command to scaffold a type.
 func NewScaffoldType() *cobra.Command {
 	c := &cobra.Command{
-		Use:   "type NAME [field]...",
-		Short: "Scaffold only a type definition",
-		Args:  cobra.MinimumNArgs(1),
-		RunE:  scaffoldTypeHandler,
+		Use:               "type NAME [field]...",
+		Short:             "Scaffold only a type definition",
+		Args:              cobra.MinimumNArgs(1),
+		RunE:              scaffoldTypeHandler,
+		Annotations:       resultAnnotations(),
+		ValidArgsFunction: completeFields,
 	}
 
-	flagSetPath(c)
 	c.Flags().AddFlagSet(flagSetScaffoldType())
 
 	return c
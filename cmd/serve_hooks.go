@@ -0,0 +1,142 @@
+package starportcmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/tendermint/starport/starport/pkg/cmdrunner"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/exec"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+	conf "github.com/trino-network/trino/chainconf"
+	"github.com/trino-network/trino/pkg/cmdtrace"
+	"github.com/trino-network/trino/pkg/plugin"
+	"github.com/trino-network/trino/pkg/servehook"
+	"github.com/trino-network/trino/services/chain"
+)
+
+// getServeHooks returns the serve hooks of the config of c and of the plugins of the chain at
+// appPath. the started plugins are stopped with close.
+func getServeHooks(ctx context.Context, c *chain.Chain, appPath string) (hooks []servehook.Hook, close func(), err error) {
+	config, err := c.Config()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, hook := range config.Serve.Hooks {
+		hooks = append(hooks, newServeConfigHook(hook))
+	}
+
+	plugins, err := loadPlugins(appPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pluginHooks []*servePluginHook
+	for _, p := range plugins {
+		if len(p.ServeHooks) > 0 {
+			hook := &servePluginHook{ctx: ctx, plugin: p}
+			hooks = append(hooks, hook)
+			pluginHooks = append(pluginHooks, hook)
+		}
+	}
+
+	return hooks, func() {
+		for _, hook := range pluginHooks {
+			hook.close()
+		}
+	}, nil
+}
+
+// newServeConfigHook creates a hook of config.yml that posts the events to a webhook or runs a
+// shell command in the app's dir with the event in env vars.
+func newServeConfigHook(config conf.ServeHook) servehook.Hook {
+	var hook servehook.Hook
+	if config.URL != "" {
+		headers := make(map[string]string)
+		for key, value := range config.Headers {
+			headers[key] = os.ExpandEnv(value)
+		}
+		hook = servehook.NewWebhook(os.ExpandEnv(config.URL), headers)
+	} else {
+		hook = servehook.HookFunc(func(ctx context.Context, event servehook.Event) error {
+			return exec.Exec(
+				ctx,
+				[]string{"sh", "-c", config.Run},
+				exec.StepOption(step.Workdir(event.AppPath)),
+				exec.StepOption(step.Env(
+					cmdrunner.Env("SERVE_EVENT", string(event.Type)),
+					cmdrunner.Env("SERVE_APP_PATH", event.AppPath),
+					cmdrunner.Env("SERVE_CHAIN_ID", event.ChainID),
+					cmdrunner.Env("SERVE_ERROR", event.Error),
+					cmdrunner.Env("SERVE_RPC", event.RPC),
+					cmdrunner.Env("SERVE_API", event.API),
+				)),
+				exec.StepOption(cmdtrace.Step()),
+				exec.IncludeStdLogsToError(),
+			)
+		})
+	}
+
+	return servehook.HookFunc(func(ctx context.Context, event servehook.Event) error {
+		if len(config.On) > 0 && !containsServeEventType(config.On, event.Type) {
+			return nil
+		}
+		err := hook.Notify(ctx, event)
+		if err != nil && config.Name != "" {
+			return fmt.Errorf("%s: %w", config.Name, err)
+		}
+		return err
+	})
+}
+
+// servePluginHook notifies a plugin of the serve events that it's subscribed to. the plugin is
+// started on the first event and kept running while the chain is served.
+type servePluginHook struct {
+	ctx    context.Context
+	plugin configuredPlugin
+
+	mu     sync.Mutex
+	client *plugin.Client
+}
+
+func (h *servePluginHook) Notify(ctx context.Context, event servehook.Event) error {
+	if !containsServeEventType(h.plugin.ServeHooks, event.Type) {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.client == nil {
+		client, err := startPlugin(h.ctx, h.plugin)
+		if err != nil {
+			return err
+		}
+		h.client = client
+	}
+
+	if err := h.client.ServeHook(ctx, event); err != nil {
+		return fmt.Errorf("plugin %s: %w", h.plugin.Name, err)
+	}
+	return nil
+}
+
+func (h *servePluginHook) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.client != nil {
+		h.client.Close()
+		h.client = nil
+	}
+}
+
+func containsServeEventType(eventTypes []servehook.EventType, eventType servehook.EventType) bool {
+	for _, t := range eventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
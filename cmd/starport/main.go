@@ -2,34 +2,32 @@ package main
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"os"
+	"time"
 
-	starportcmd "github.com/tendermint/starport/starport/cmd"
 	"github.com/tendermint/starport/starport/pkg/clictx"
-	"github.com/tendermint/starport/starport/pkg/validation"
+	starportcmd "github.com/trino-network/trino/cmd"
 )
 
 func main() {
 	ctx := clictx.From(context.Background())
 
-	err := starportcmd.New(ctx).ExecuteContext(ctx)
+	// errors are printed to the stdout that starport is started with, commands discard their
+	// output with --quiet.
+	stdout := os.Stdout
+
+	start := time.Now()
+	cmd, err := starportcmd.New(ctx).ExecuteContextC(ctx)
+	starportcmd.RecordTelemetry(cmd, start, err)
 
 	if ctx.Err() == context.Canceled || err == context.Canceled {
-		fmt.Println("aborted")
+		fmt.Fprintln(stdout, "aborted")
 		return
 	}
 
 	if err != nil {
-		var validationErr validation.Error
-
-		if errors.As(err, &validationErr) {
-			fmt.Println(validationErr.ValidationInfo())
-		} else {
-			fmt.Println(err)
-		}
-
+		starportcmd.PrintError(stdout, cmd, err)
 		os.Exit(1)
 	}
 }
@@ -0,0 +1,240 @@
+package starportcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/tendermint/starport/starport/pkg/validation"
+	"github.com/tendermint/starport/starport/pkg/xfilepath"
+	conf "github.com/trino-network/trino/chainconf"
+	sperrors "github.com/trino-network/trino/errors"
+	"github.com/trino-network/trino/internal/version"
+	"github.com/trino-network/trino/pkg/offline"
+	"github.com/trino-network/trino/pkg/telemetry"
+	"github.com/trino-network/trino/services/chain"
+)
+
+const (
+	sendTelemetryTimeout = time.Millisecond * 600
+
+	// error categories of events for the errors of Starport, in addition to the ones of the
+	// telemetry package.
+	errorCategoryBuild      = "build"
+	errorCategoryStart      = "start"
+	errorCategoryConfig     = "config"
+	errorCategoryValidation = "validation"
+)
+
+var (
+	// telemetryPath is the dir where the telemetry config and event log are kept.
+	telemetryPath = xfilepath.JoinFromHome(
+		xfilepath.Path(".starport"),
+		xfilepath.Path("telemetry"),
+	)
+
+	// telemetryEndpoint is where events are sent when telemetry is on, it's set by release
+	// builds. events are only recorded in the event log when it's empty.
+	telemetryEndpoint = ""
+)
+
+// NewTelemetry creates a new telemetry command to turn the usage telemetry on and off.
+func NewTelemetry() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "telemetry",
+		Short: "Turn the anonymous usage telemetry on and off",
+		Long: `Turn the anonymous usage telemetry on and off, it's off until you turn it on.
+
+When telemetry is on, the commands that you run are recorded with the names of the flags that
+you set, how long they take and the category of their errors, such as network or build errors.
+The args of commands, the values of flags, error messages and the contents of your projects are
+never recorded.
+
+Events are written to a local event log that you can inspect any time, and release builds send
+them to the maintainers of Starport so they can prioritize features. Each event holds a random id that is
+forgotten when telemetry is turned off. DO_NOT_TRACK=1 turns telemetry off regardless.`,
+	}
+	c.AddCommand(NewTelemetryOn())
+	c.AddCommand(NewTelemetryOff())
+	c.AddCommand(NewTelemetryStatus())
+	return c
+}
+
+// NewTelemetryOn creates a new command to turn telemetry on.
+func NewTelemetryOn() *cobra.Command {
+	return &cobra.Command{
+		Use:   "on",
+		Short: "Turn the anonymous usage telemetry on",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			t, err := newTelemetry()
+			if err != nil {
+				return err
+			}
+			if err := t.Enable(); err != nil {
+				return err
+			}
+			fmt.Printf("Telemetry is on, thank you! The events are written to %s\n", t.LogPath())
+			return nil
+		},
+	}
+}
+
+// NewTelemetryOff creates a new command to turn telemetry off.
+func NewTelemetryOff() *cobra.Command {
+	return &cobra.Command{
+		Use:   "off",
+		Short: "Turn the anonymous usage telemetry off",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			t, err := newTelemetry()
+			if err != nil {
+				return err
+			}
+			if err := t.Disable(); err != nil {
+				return err
+			}
+			fmt.Println("Telemetry is off")
+			return nil
+		},
+	}
+}
+
+// telemetryStatus is the status of telemetry in the json and yaml outputs.
+type telemetryStatus struct {
+	Enabled    bool   `json:"enabled"`
+	DoNotTrack bool   `json:"do_not_track"`
+	ID         string `json:"id,omitempty"`
+	EventLog   string `json:"event_log"`
+	Events     int    `json:"events"`
+	Endpoint   string `json:"endpoint,omitempty"`
+}
+
+// NewTelemetryStatus creates a new command to show the status of telemetry.
+func NewTelemetryStatus() *cobra.Command {
+	return &cobra.Command{
+		Use:         "status",
+		Short:       "Show if the anonymous usage telemetry is on and where its events are written",
+		Annotations: resultAnnotations(),
+		Args:        cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			t, err := newTelemetry()
+			if err != nil {
+				return err
+			}
+			events, err := t.Events()
+			if err != nil {
+				return err
+			}
+
+			status := telemetryStatus{
+				Enabled:    t.IsEnabled(),
+				DoNotTrack: t.IsDoNotTrack(),
+				ID:         t.ID(),
+				EventLog:   t.LogPath(),
+				Events:     len(events),
+				Endpoint:   telemetryEndpoint,
+			}
+			if isStructuredOutput(cmd) {
+				return printResult(cmd, status)
+			}
+
+			switch {
+			case status.DoNotTrack:
+				fmt.Println("Telemetry is off, DO_NOT_TRACK is set")
+			case status.Enabled:
+				fmt.Printf("Telemetry is on, the anonymous id is %s\n", status.ID)
+			default:
+				fmt.Println("Telemetry is off, run `starport telemetry on` to turn it on")
+			}
+			fmt.Printf("The event log is %s, it has %d events\n", status.EventLog, status.Events)
+			if status.Endpoint == "" {
+				fmt.Println("This build of Starport has no telemetry endpoint, events are only written to the event log")
+			}
+			return nil
+		},
+	}
+}
+
+func newTelemetry() (*telemetry.Telemetry, error) {
+	path, err := telemetryPath()
+	if err != nil {
+		return nil, err
+	}
+	return telemetry.New(path)
+}
+
+// RecordTelemetry records the usage of cmd that's started at start and failed with err, if
+// telemetry is on. errors of telemetry are ignored so they never fail commands.
+func RecordTelemetry(cmd *cobra.Command, start time.Time, err error) {
+	// hidden commands, such as the ones of shell completions, are not recorded.
+	if cmd == nil || cmd.Hidden {
+		return
+	}
+
+	t, terr := newTelemetry()
+	if terr != nil || !t.IsEnabled() {
+		return
+	}
+
+	event := telemetry.Event{
+		Time:     start.UTC(),
+		Command:  strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" "),
+		Flags:    changedFlags(cmd),
+		Duration: time.Since(start),
+		Error:    errorCategory(err),
+		Version:  version.Version,
+		OS:       runtime.GOOS,
+		Arch:     runtime.GOARCH,
+	}
+	// events are only recorded in the event log offline.
+	if terr := t.Record(event); terr != nil || telemetryEndpoint == "" || offline.IsEnabled() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sendTelemetryTimeout)
+	defer cancel()
+	t.Send(ctx, telemetryEndpoint, event)
+}
+
+// changedFlags returns the names of the flags of cmd that are set, sorted.
+func changedFlags(cmd *cobra.Command) []string {
+	var names []string
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		names = append(names, f.Name)
+	})
+	sort.Strings(names)
+	return names
+}
+
+// errorCategory returns the category of err for telemetry events.
+func errorCategory(err error) string {
+	var (
+		buildErr      *chain.CannotBuildAppError
+		startErr      *chain.CannotStartAppError
+		configErr     *conf.ValidationError
+		validationErr validation.Error
+		spErr         *sperrors.Error
+	)
+
+	switch {
+	case errors.As(err, &spErr):
+		return string(spErr.Code)
+	case errors.As(err, &buildErr):
+		return errorCategoryBuild
+	case errors.As(err, &startErr):
+		return errorCategoryStart
+	case errors.As(err, &configErr):
+		return errorCategoryConfig
+	case errors.As(err, &validationErr):
+		return errorCategoryValidation
+	default:
+		return telemetry.ErrorCategory(err)
+	}
+}
@@ -8,7 +8,8 @@ import (
 	"github.com/tendermint/starport/starport/pkg/cmdrunner"
 	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
 	"github.com/tendermint/starport/starport/pkg/nodetime"
-	"github.com/tendermint/starport/starport/pkg/protoc"
+	"github.com/trino-network/trino/pkg/cmdtrace"
+	"github.com/trino-network/trino/pkg/toolchain"
 )
 
 // NewTools returns a command where various tools (binaries) are attached as sub commands
@@ -18,6 +19,7 @@ func NewTools() *cobra.Command {
 		Use:   "tools",
 		Short: "Tools for advanced users",
 	}
+	c.AddCommand(NewToolsInstall())
 	c.AddCommand(NewToolsIBCSetup())
 	c.AddCommand(NewToolsIBCRelayer())
 	c.AddCommand(NewToolsProtoc())
@@ -67,7 +69,7 @@ func toolsNodetimeProxy(c nodetime.CommandName) func(cmd *cobra.Command, args []
 }
 
 func toolsProtocProxy(cmd *cobra.Command, args []string) error {
-	command, cleanup, err := protoc.Command()
+	command, cleanup, err := toolchain.ProtocCommand()
 	if err != nil {
 		return err
 	}
@@ -83,6 +85,7 @@ func toolsProxy(ctx context.Context, command []string) error {
 			step.Exec(command[0], command[1:]...),
 			step.Stdout(os.Stdout),
 			step.Stderr(os.Stderr),
+			cmdtrace.Step(),
 		),
 	)
 }
@@ -98,6 +101,9 @@ func NewToolsCompletions() *cobra.Command {
 				system. Since most Unix-like operating systems come with bash-completion by default, bash-completion 
 				is probably already installed and operational.
 
+Besides commands and flags, account names, the chain IDs of --chain, the modules of --module, relayer
+paths and the types of scaffolded fields, such as title:string, are completed from the keyring and the app.
+
 Bash:
 
   $ source <(starport  tools completions bash)
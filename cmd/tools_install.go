@@ -0,0 +1,103 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/pkg/offline"
+	"github.com/trino-network/trino/pkg/toolchain"
+)
+
+const flagForce = "force"
+
+// NewToolsInstall creates a new command to install the pinned versions of the external tools.
+func NewToolsInstall() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "install [tool]...",
+		Short: "Install the pinned versions of node, protoc and buf",
+		Long: `Install the pinned versions of node, protoc and buf into ~/.starport/toolchain.
+
+Installed tools are run instead of the ones in PATH, and the installed protoc instead of the one
+bundled with Starport, so code is generated the same way on every machine and node doesn't
+need to be installed beforehand. The archives of the tools are verified with the sha256 digests
+that are pinned with their versions. All tools are installed when none is given.`,
+		Example: `starport tools install
+starport tools install node buf`,
+		ValidArgs:   toolNames(),
+		RunE:        toolsInstallHandler,
+		Annotations: resultAnnotations(),
+	}
+	c.Flags().Bool(flagForce, false, "Install the tools again even if they're installed")
+	return c
+}
+
+// toolsInstallResult is the result of tools install in the json and yaml outputs.
+type toolsInstallResult struct {
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	Path      string `json:"path"`
+	Installed bool   `json:"installed"`
+}
+
+func toolsInstallHandler(cmd *cobra.Command, args []string) error {
+	if offline.IsEnabled() {
+		return fmt.Errorf("tools cannot be installed offline, run it without --offline and %s", offline.EnvOffline)
+	}
+
+	tools := toolchain.Tools
+	if len(args) > 0 {
+		tools = nil
+		for _, name := range args {
+			tool, err := toolchain.Find(name)
+			if err != nil {
+				return err
+			}
+			tools = append(tools, tool)
+		}
+	}
+
+	force, _ := cmd.Flags().GetBool(flagForce)
+
+	s := clispinner.New()
+	defer s.Stop()
+
+	var results []toolsInstallResult
+	for _, tool := range tools {
+		result := toolsInstallResult{
+			Name:    tool.Name,
+			Version: tool.Version,
+			Path:    tool.BinDir(),
+		}
+		if force || !tool.IsInstalled() {
+			s.SetText(fmt.Sprintf("Installing %s %s...", tool.Name, tool.Version))
+			if err := tool.Install(cmd.Context()); err != nil {
+				return fmt.Errorf("cannot install %s: %w", tool.Name, err)
+			}
+			result.Installed = true
+		}
+		results = append(results, result)
+	}
+
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, results)
+	}
+
+	for _, r := range results {
+		if r.Installed {
+			fmt.Printf("📦 %s %s is installed in %s\n", r.Name, r.Version, r.Path)
+		} else {
+			fmt.Printf("📦 %s %s is already installed in %s\n", r.Name, r.Version, r.Path)
+		}
+	}
+	return nil
+}
+
+func toolNames() (names []string) {
+	for _, tool := range toolchain.Tools {
+		names = append(names, tool.Name)
+	}
+	return names
+}
@@ -0,0 +1,107 @@
+package starportcmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/trino-network/trino/pkg/chaincmd"
+	chaincmdrunner "github.com/trino-network/trino/pkg/chaincmd/runner"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+const flagBinary = "binary"
+
+// NewTx returns a command that groups transaction related sub commands.
+func NewTx() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "tx [command]",
+		Short: "Sign transactions of your chain with your accounts",
+		Long: `Sign transactions of your chain with your accounts.
+
+Transactions are signed by the binary of the chain with the accounts managed by
+"starport account" commands, such as multisig accounts of the admins of the chain.
+
+Signing with --offline doesn't need access to a node, so keys can stay on an air-gapped
+machine while the signed transaction is broadcasted from another one with "starport tx broadcast".
+
+The commands use the binary and the home of the chain in the current dir by default. On
+machines without the source of the chain, set the binary with --binary, its home with --home
+and the chain ID with --chain-id.`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.PersistentFlags().AddFlagSet(flagSetHome())
+	c.PersistentFlags().AddFlagSet(flagSetKeyringBackend())
+	c.PersistentFlags().String(flagBinary, "", "Binary of the chain, such as marsd, to use instead of the one of the chain in the current dir")
+	c.PersistentFlags().String(flagChainID, "", "Chain ID of the transactions, the one of the chain in the current dir by default")
+
+	c.AddCommand(NewTxSign())
+	c.AddCommand(NewTxMultiSign())
+	c.AddCommand(NewTxBroadcast())
+
+	return c
+}
+
+// newTxCommands returns the commands of the chain that sign transactions with the accounts of Starport.
+func newTxCommands(cmd *cobra.Command, options ...chaincmd.Option) (chaincmdrunner.Runner, error) {
+	cc, err := newTxChainCmd(cmd)
+	if err != nil {
+		return chaincmdrunner.Runner{}, err
+	}
+
+	backend, err := getKeyringBackend(cmd).SDKBackend()
+	if err != nil {
+		return chaincmdrunner.Runner{}, err
+	}
+
+	options = append([]chaincmd.Option{
+		chaincmd.WithKeyringBackend(chaincmd.KeyringBackend(backend)),
+		chaincmd.WithKeyringDir(cosmosaccount.KeyringHome),
+	}, options...)
+	if chainID, _ := cmd.Flags().GetString(flagChainID); chainID != "" {
+		options = append(options, chaincmd.WithChainID(chainID))
+	}
+
+	return chaincmdrunner.New(cmd.Context(), cc.Copy(options...))
+}
+
+// newTxChainCmd returns the commands of the binary that's set with a flag, or of the chain in
+// the current dir.
+func newTxChainCmd(cmd *cobra.Command) (chaincmd.ChainCmd, error) {
+	if binary, _ := cmd.Flags().GetString(flagBinary); binary != "" {
+		var options []chaincmd.Option
+		if home := getHomeFlag(cmd); home != "" {
+			options = append(options, chaincmd.WithHome(home))
+		}
+		return chaincmd.New(binary, options...), nil
+	}
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return chaincmd.ChainCmd{}, err
+	}
+
+	commands, err := c.Commands(cmd.Context())
+	if err != nil {
+		return chaincmd.ChainCmd{}, err
+	}
+	return commands.Cmd(), nil
+}
+
+// writeTxOutput writes a signed transaction or signature to the output document, or to stdout
+// when it's not set.
+func writeTxOutput(cmd *cobra.Command, out []byte) error {
+	path, _ := cmd.Flags().GetString(flagOutputDocument)
+	if path == "" {
+		fmt.Println(string(out))
+		return nil
+	}
+
+	if err := os.WriteFile(path, out, 0644); err != nil {
+		return err
+	}
+
+	fmt.Printf("Written to %s\n", path)
+	return nil
+}
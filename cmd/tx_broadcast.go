@@ -0,0 +1,51 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/trino-network/trino/pkg/chaincmd"
+)
+
+func NewTxBroadcast() *cobra.Command {
+	c := &cobra.Command{
+		Use:         "broadcast [file]",
+		Short:       "Broadcast a signed transaction to a node of the chain",
+		Args:        cobra.ExactArgs(1),
+		RunE:        txBroadcastHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().String(flagNode, "", "RPC address of the node to broadcast to, defaults to the node of the chain's config")
+
+	return c
+}
+
+// txBroadcastResult is the result of tx broadcast in the json and yaml outputs.
+type txBroadcastResult struct {
+	TxHash string `json:"txhash"`
+}
+
+func txBroadcastHandler(cmd *cobra.Command, args []string) error {
+	var options []chaincmd.Option
+	if node, _ := cmd.Flags().GetString(flagNode); node != "" {
+		options = append(options, chaincmd.WithNodeAddress(node))
+	}
+
+	commands, err := newTxCommands(cmd, options...)
+	if err != nil {
+		return err
+	}
+
+	txHash, err := commands.BroadcastTx(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, txBroadcastResult{TxHash: txHash})
+	}
+
+	fmt.Printf("🎉 Transaction broadcasted\nTransaction hash: %s\n", txHash)
+	return nil
+}
@@ -0,0 +1,36 @@
+package starportcmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewTxMultiSign() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "multisign [file] [multisig-name] [signature-file]...",
+		Short: "Combine the signatures of a multisig account's signers into a transaction",
+		Long: `Combine the signatures of a multisig account's signers into a transaction.
+
+Signatures are the ones written by "starport tx sign --multisig". The signed transaction
+can be broadcasted once the signatures reach the threshold of the multisig account.`,
+		Args: cobra.MinimumNArgs(3),
+		RunE: txMultiSignHandler,
+	}
+
+	c.Flags().StringP(flagOutputDocument, "o", "", "Write to the file instead of stdout")
+
+	return c
+}
+
+func txMultiSignHandler(cmd *cobra.Command, args []string) error {
+	commands, err := newTxCommands(cmd)
+	if err != nil {
+		return err
+	}
+
+	out, err := commands.MultiSignTx(cmd.Context(), args[0], args[1], args[2:]...)
+	if err != nil {
+		return err
+	}
+
+	return writeTxOutput(cmd, out)
+}
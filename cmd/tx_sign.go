@@ -0,0 +1,76 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/trino-network/trino/pkg/chaincmd"
+)
+
+const (
+	flagFrom          = "from"
+	flagMultisig      = "multisig"
+	flagAccountNumber = "account-number"
+	flagSequence      = "sequence"
+)
+
+func NewTxSign() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "sign [file]",
+		Short: "Sign a transaction generated with --generate-only",
+		Long: `Sign a transaction generated with --generate-only.
+
+When --multisig is set, only the signature made on behalf of the multisig account is
+written. Combine the signatures of the multisig account's signers into the transaction
+with "starport tx multisign".
+
+When --offline is set, the transaction is signed without querying a node, the account number
+and the sequence of the signer must be provided instead.`,
+		Args: cobra.ExactArgs(1),
+		RunE: txSignHandler,
+	}
+
+	c.Flags().String(flagFrom, "", "Name of the account to sign with")
+	c.Flags().String(flagMultisig, "", "Name or address of the multisig account to sign on behalf of")
+	c.Flags().Bool(flagOffline, false, "Sign without querying a node, requires --account-number and --sequence")
+	c.Flags().Uint64(flagAccountNumber, 0, "Account number of the signer, used with --offline")
+	c.Flags().Uint64(flagSequence, 0, "Sequence of the signer, used with --offline")
+	c.Flags().StringP(flagOutputDocument, "o", "", "Write to the file instead of stdout")
+	c.MarkFlagRequired(flagFrom)
+
+	return c
+}
+
+func txSignHandler(cmd *cobra.Command, args []string) error {
+	var (
+		from, _     = cmd.Flags().GetString(flagFrom)
+		multisig, _ = cmd.Flags().GetString(flagMultisig)
+		offline, _  = cmd.Flags().GetBool(flagOffline)
+		options     = []chaincmd.SignTxOption{chaincmd.SignTxWithMultisig(multisig)}
+	)
+
+	if offline {
+		if !cmd.Flags().Changed(flagAccountNumber) || !cmd.Flags().Changed(flagSequence) {
+			return fmt.Errorf("--%s and --%s are required to sign with --%s", flagAccountNumber, flagSequence, flagOffline)
+		}
+
+		var (
+			accountNumber, _ = cmd.Flags().GetUint64(flagAccountNumber)
+			sequence, _      = cmd.Flags().GetUint64(flagSequence)
+		)
+
+		options = append(options, chaincmd.SignTxOffline(accountNumber, sequence))
+	}
+
+	commands, err := newTxCommands(cmd)
+	if err != nil {
+		return err
+	}
+
+	out, err := commands.SignTx(cmd.Context(), args[0], from, options...)
+	if err != nil {
+		return err
+	}
+
+	return writeTxOutput(cmd, out)
+}
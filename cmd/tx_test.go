@@ -0,0 +1,82 @@
+package starportcmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTxBinary writes its args to a file and prints what the tx commands of chains print.
+const fakeTxBinary = `#!/bin/sh
+echo "$*" > "%s"
+case "$2" in
+sign)
+	echo '{"body":{"messages":[]},"signatures":["c2ln"]}'
+	;;
+broadcast)
+	echo '{"txhash":"ABC","code":0}'
+	;;
+esac
+`
+
+func TestTxWithBinary(t *testing.T) {
+	// the commands are run out of the source of the chain.
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	defer os.Chdir(wd)
+
+	var (
+		binary   = filepath.Join(dir, "marsd")
+		argsPath = filepath.Join(dir, "args")
+		signed   = filepath.Join(dir, "signed.json")
+	)
+	require.NoError(t, os.WriteFile(binary, []byte(fmt.Sprintf(fakeTxBinary, argsPath)), 0755))
+
+	run := func(args ...string) []string {
+		c := NewTx()
+		c.SetArgs(append(args, "--binary", binary, "--home", "/mars", "--chain-id", "mars-1"))
+		require.NoError(t, c.Execute())
+
+		b, err := os.ReadFile(argsPath)
+		require.NoError(t, err)
+		return strings.Fields(string(b))
+	}
+
+	args := run("sign", "tx.json", "--from", "alice", "--offline", "--account-number", "3", "--sequence", "7", "-o", signed)
+	require.Equal(t, []string{"tx", "sign", "tx.json", "--from", "alice"}, args[:5])
+	require.Contains(t, strings.Join(args, " "), "--offline --account-number 3 --sequence 7 --chain-id mars-1")
+	require.Equal(t, []string{"--home", "/mars"}, args[len(args)-2:])
+
+	b, err := os.ReadFile(signed)
+	require.NoError(t, err)
+	require.Contains(t, string(b), `"signatures":["c2ln"]`)
+
+	args = run("broadcast", signed, "--node", "tcp://node:26657")
+	require.Equal(t, []string{"tx", "broadcast", signed}, args[:3])
+	require.Contains(t, strings.Join(args, " "), "--chain-id mars-1 --node tcp://node:26657 --home /mars")
+
+	// the hash of the broadcasted tx is the result of broadcast.
+	var result bytes.Buffer
+	w := resultWriter
+	resultWriter = &result
+	defer func() { resultWriter = w }()
+
+	c := NewTx()
+	addOutputFlags(c)
+	c.SetArgs([]string{"broadcast", signed, "--binary", binary, "--output", "json"})
+	require.NoError(t, c.Execute())
+	require.Equal(t, `{"txhash":"ABC"}`+"\n", result.String())
+
+	c = NewTx()
+	c.SetArgs([]string{"sign", "tx.json", "--from", "alice", "--offline", "--binary", binary})
+	err = c.Execute()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "--account-number and --sequence are required")
+}
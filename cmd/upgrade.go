@@ -0,0 +1,153 @@
+package starportcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/internal/version"
+	"github.com/trino-network/trino/pkg/selfupdate"
+)
+
+const (
+	releaseChecksums = "checksums.txt"
+	releaseSignature = "checksums.txt.sig"
+)
+
+// NewUpgrade creates a new upgrade command to replace starport with its latest release.
+func NewUpgrade() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade Starport to the latest release",
+		Long: `Upgrade Starport to the latest release.
+
+The release archive for your OS and arch is downloaded along with the checksums of the release.
+The checksums are verified with the signature of the release and the archive with its checksum,
+then the starport binary that is running is replaced with the one of the release.`,
+		Args:        cobra.NoArgs,
+		RunE:        upgradeHandler,
+		Annotations: resultAnnotations(),
+	}
+	return c
+}
+
+// upgradeResult is the result of the upgrade in the json and yaml outputs.
+type upgradeResult struct {
+	PreviousVersion string `json:"previous_version"`
+	Version         string `json:"version"`
+	Path            string `json:"path"`
+}
+
+func upgradeHandler(cmd *cobra.Command, _ []string) error {
+	if version.IsDevelopment() {
+		return errors.New("development builds of Starport cannot be upgraded, build it from source instead")
+	}
+	if version.ReleasePublicKey == "" {
+		return errors.New("this build of Starport has no release key to verify releases with, install the latest release instead")
+	}
+
+	binaryPath, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	if binaryPath, err = filepath.EvalSymlinks(binaryPath); err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Checking the latest release...")
+	defer s.Stop()
+
+	release, err := version.LatestRelease(cmd.Context())
+	if err != nil {
+		return err
+	}
+	isNewer, err := version.IsNewer(release.Version)
+	if err != nil {
+		return err
+	}
+	if !isNewer {
+		s.Stop()
+		if isStructuredOutput(cmd) {
+			return printResult(cmd, upgradeResult{
+				PreviousVersion: version.Version,
+				Version:         version.Version,
+				Path:            binaryPath,
+			})
+		}
+		fmt.Printf("Starport %s is the latest release.\n", version.Version)
+		return nil
+	}
+
+	s.SetText(fmt.Sprintf("Downloading Starport %s...", release.Version))
+
+	archiveName := version.ArchiveName(release.Version)
+	files := make(map[string][]byte)
+	for _, name := range []string{archiveName, releaseChecksums, releaseSignature} {
+		url, ok := release.Assets[name]
+		if !ok {
+			return fmt.Errorf("the release %s has no %s", release.Version, name)
+		}
+		if files[name], err = download(cmd.Context(), url); err != nil {
+			return err
+		}
+	}
+
+	s.SetText("Verifying the release...")
+
+	if err := selfupdate.VerifyChecksums(files[releaseChecksums], files[releaseSignature], version.ReleasePublicKey); err != nil {
+		return err
+	}
+	checksum, err := selfupdate.Checksum(files[releaseChecksums], archiveName)
+	if err != nil {
+		return err
+	}
+	if err := selfupdate.VerifyArchive(files[archiveName], checksum); err != nil {
+		return err
+	}
+	binary, err := selfupdate.ExtractBinary(files[archiveName], "starport")
+	if err != nil {
+		return err
+	}
+
+	if err := selfupdate.Replace(binaryPath, binary); err != nil {
+		if os.IsPermission(err) {
+			return fmt.Errorf("cannot replace %s, run the upgrade with a user that can write it: %w", binaryPath, err)
+		}
+		return err
+	}
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, upgradeResult{
+			PreviousVersion: version.Version,
+			Version:         release.Version,
+			Path:            binaryPath,
+		})
+	}
+	fmt.Printf("🛸 Starport is upgraded from %s to %s at %s\n", version.Version, release.Version, binaryPath)
+	return nil
+}
+
+// download returns the file at url.
+func download(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot download %s: %s", url, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
@@ -1,20 +1,76 @@
 package starportcmd
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/trino-network/trino/internal/version"
 )
 
+const (
+	flagNoUpdateCheck = "no-update-check"
+
+	latestReleaseTimeout = time.Second * 5
+)
+
 // NewVersion creates a new version command to show starport's version.
 func NewVersion() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "version",
 		Short: "Print the current build information",
-		Run: func(cmd *cobra.Command, _ []string) {
-			fmt.Println(version.Long(cmd.Context()))
-		},
+		Long: `Print the current build information and whether a new version of Starport is available.
+
+Checking for new versions can be turned off with --no-update-check, or for all commands by
+setting STARPORT_NO_UPDATE_CHECK=true.`,
+		Args:        cobra.NoArgs,
+		RunE:        versionHandler,
+		Annotations: resultAnnotations(),
 	}
+	c.Flags().Bool(flagNoUpdateCheck, false, "Don't check if a new version of Starport is available")
 	return c
 }
+
+// versionResult is the build information in the json and yaml outputs.
+type versionResult struct {
+	version.Info    `json:",inline" yaml:",inline"`
+	LatestVersion   string `json:"latest_version,omitempty"`
+	UpdateAvailable bool   `json:"update_available"`
+}
+
+func versionHandler(cmd *cobra.Command, _ []string) error {
+	info, err := version.GetInfo(cmd.Context())
+	if err != nil {
+		return err
+	}
+	result := versionResult{Info: info}
+
+	noUpdateCheck, _ := cmd.Flags().GetBool(flagNoUpdateCheck)
+	checkUpdate := !noUpdateCheck && !version.IsCheckDisabled() && !version.IsDevelopment()
+
+	var checkErr error
+	if checkUpdate {
+		ctx, cancel := context.WithTimeout(cmd.Context(), latestReleaseTimeout)
+		defer cancel()
+
+		result.UpdateAvailable, result.LatestVersion, checkErr = version.CheckNext(ctx)
+	}
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, result)
+	}
+
+	fmt.Println(info)
+
+	switch {
+	case !checkUpdate:
+	case checkErr != nil:
+		fmt.Printf("Could not check for a new version: %s\n", checkErr)
+	case result.UpdateAvailable:
+		fmt.Printf("🛸 Starport %s is available, run %s to install it.\n", result.LatestVersion, infoColor("starport upgrade"))
+	default:
+		fmt.Println("Starport is up to date.")
+	}
+	return nil
+}
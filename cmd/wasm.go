@@ -0,0 +1,51 @@
+package starportcmd
+
+import (
+	"github.com/spf13/cobra"
+	chaincmdrunner "github.com/trino-network/trino/pkg/chaincmd/runner"
+	"github.com/trino-network/trino/pkg/cosmwasm"
+)
+
+// NewWasm returns a command that groups the commands to deploy and use CosmWasm contracts.
+func NewWasm() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "wasm [command]",
+		Short: "Deploy and use CosmWasm contracts on a served chain",
+		Long: `Deploy and use CosmWasm contracts on a chain that has the wasm module of wasmd and that
+is served with "starport chain serve".
+
+Contracts are compiled with rust-optimizer in Docker, and their txs are signed with the accounts
+managed by "starport account" commands.`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.PersistentFlags().AddFlagSet(flagSetHome())
+	c.PersistentFlags().AddFlagSet(flagSetKeyringBackend())
+
+	c.AddCommand(NewWasmStore())
+	c.AddCommand(NewWasmInstantiate())
+	c.AddCommand(NewWasmExecute())
+	c.AddCommand(NewWasmQuery())
+
+	return c
+}
+
+// newWasmCommands returns the commands of the chain that use contracts, the chain must have the
+// wasm module.
+func newWasmCommands(cmd *cobra.Command) (chaincmdrunner.Runner, error) {
+	enabled, err := cosmwasm.IsEnabled(flagGetPath(cmd))
+	if err != nil {
+		return chaincmdrunner.Runner{}, err
+	}
+	if !enabled {
+		return chaincmdrunner.Runner{}, cosmwasm.ErrNotEnabled
+	}
+	return newTxCommands(cmd)
+}
+
+// wasmTxResult is the result of the wasm commands that broadcast a tx in the json and yaml outputs.
+type wasmTxResult struct {
+	TxHash   string `json:"txhash"`
+	CodeID   uint64 `json:"code_id,omitempty"`
+	Contract string `json:"contract,omitempty"`
+}
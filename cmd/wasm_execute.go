@@ -0,0 +1,57 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+)
+
+func NewWasmExecute() *cobra.Command {
+	c := &cobra.Command{
+		Use:         "execute [contract] [msg]",
+		Short:       "Execute a message on a contract",
+		Long:        "Execute a message on a contract, the msg is the JSON execute message of the contract.",
+		Example:     `starport wasm execute mars14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr '{"increment": {}}' --from alice`,
+		Args:        cobra.ExactArgs(2),
+		RunE:        wasmExecuteHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().String(flagFrom, "", "Name of the account to sign with")
+	c.Flags().String(flagAmount, "", "Coins to send to the contract")
+	c.MarkFlagRequired(flagFrom)
+
+	return c
+}
+
+func wasmExecuteHandler(cmd *cobra.Command, args []string) error {
+	var (
+		contract  = args[0]
+		msg       = args[1]
+		from, _   = cmd.Flags().GetString(flagFrom)
+		amount, _ = cmd.Flags().GetString(flagAmount)
+	)
+
+	commands, err := newWasmCommands(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Executing the contract...")
+	defer s.Stop()
+
+	tx, err := commands.WasmExecute(cmd.Context(), contract, msg, from, amount)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, wasmTxResult{TxHash: tx.TxHash, Contract: contract})
+	}
+
+	fmt.Printf("🎉 Executed %s\nTransaction hash: %s\n", contract, tx.TxHash)
+	return nil
+}
@@ -0,0 +1,77 @@
+package starportcmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+)
+
+const (
+	flagLabel = "label"
+	flagAdmin = "admin"
+)
+
+func NewWasmInstantiate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "instantiate [code-id] [msg]",
+		Short: "Instantiate a contract from its uploaded code",
+		Long: `Instantiate a contract from its code uploaded with "starport wasm store".
+
+The msg is the JSON instantiate message of the contract. The address of the contract is printed,
+use it to execute and query the contract. The contract cannot be migrated unless --admin is set.`,
+		Example:     `starport wasm instantiate 1 '{"count": 0}' --from alice --label counter`,
+		Args:        cobra.ExactArgs(2),
+		RunE:        wasmInstantiateHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().String(flagFrom, "", "Name of the account to sign with")
+	c.Flags().String(flagLabel, "", "Human readable name of the contract (default: code-[code-id])")
+	c.Flags().String(flagAdmin, "", "Address of the account that can migrate the contract")
+	c.Flags().String(flagAmount, "", "Coins to send to the contract")
+	c.MarkFlagRequired(flagFrom)
+
+	return c
+}
+
+func wasmInstantiateHandler(cmd *cobra.Command, args []string) error {
+	var (
+		msg       = args[1]
+		from, _   = cmd.Flags().GetString(flagFrom)
+		label, _  = cmd.Flags().GetString(flagLabel)
+		admin, _  = cmd.Flags().GetString(flagAdmin)
+		amount, _ = cmd.Flags().GetString(flagAmount)
+	)
+
+	codeID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("code id %q is not a number", args[0])
+	}
+	if label == "" {
+		label = fmt.Sprintf("code-%d", codeID)
+	}
+
+	commands, err := newWasmCommands(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Instantiating the contract...")
+	defer s.Stop()
+
+	contract, tx, err := commands.WasmInstantiate(cmd.Context(), codeID, msg, label, from, admin, amount)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, wasmTxResult{TxHash: tx.TxHash, CodeID: codeID, Contract: contract})
+	}
+
+	fmt.Printf("🎉 Instantiated code %d as %s\nTransaction hash: %s\n", codeID, contract, tx.TxHash)
+	return nil
+}
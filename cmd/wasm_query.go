@@ -0,0 +1,50 @@
+package starportcmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func NewWasmQuery() *cobra.Command {
+	c := &cobra.Command{
+		Use:         "query [contract] [query]",
+		Short:       "Query a contract",
+		Long:        "Query a contract with a smart query, the query is the JSON query message of the contract.",
+		Example:     `starport wasm query mars14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr '{"get_count": {}}'`,
+		Args:        cobra.ExactArgs(2),
+		RunE:        wasmQueryHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	return c
+}
+
+func wasmQueryHandler(cmd *cobra.Command, args []string) error {
+	commands, err := newWasmCommands(cmd)
+	if err != nil {
+		return err
+	}
+
+	data, err := commands.WasmQuery(cmd.Context(), args[0], args[1])
+	if err != nil {
+		return err
+	}
+
+	if isStructuredOutput(cmd) {
+		// the response is decoded so it's printed as yaml too.
+		var result interface{}
+		if err := json.Unmarshal(data, &result); err != nil {
+			return err
+		}
+		return printResult(cmd, result)
+	}
+
+	out, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
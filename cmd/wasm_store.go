@@ -0,0 +1,69 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/trino-network/trino/pkg/cosmwasm"
+)
+
+func NewWasmStore() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "store [contract]",
+		Short: "Compile a contract and upload its code to the chain",
+		Long: `Compile a contract and upload its code to the chain.
+
+The contract is the dir of a contract crate, which is compiled with rust-optimizer in Docker into
+its artifacts dir, or a compiled wasm file. The code id of the uploaded code is printed, use it
+to instantiate the contract with "starport wasm instantiate".`,
+		Example: `starport wasm store ./contracts/counter --from alice
+starport wasm store counter.wasm --from alice`,
+		Args:        cobra.ExactArgs(1),
+		RunE:        wasmStoreHandler,
+		Annotations: resultAnnotations(),
+	}
+
+	c.Flags().String(flagFrom, "", "Name of the account to sign with")
+	c.MarkFlagRequired(flagFrom)
+
+	return c
+}
+
+func wasmStoreHandler(cmd *cobra.Command, args []string) error {
+	var (
+		wasmFile = args[0]
+		from, _  = cmd.Flags().GetString(flagFrom)
+		ctx      = cmd.Context()
+	)
+
+	commands, err := newWasmCommands(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New()
+	defer s.Stop()
+
+	if cosmwasm.IsContract(wasmFile) {
+		s.SetText("Compiling the contract with rust-optimizer...")
+		if wasmFile, err = cosmwasm.Optimize(ctx, wasmFile); err != nil {
+			return err
+		}
+	}
+
+	s.SetText("Uploading the contract code...")
+	codeID, tx, err := commands.WasmStore(ctx, wasmFile, from)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	if isStructuredOutput(cmd) {
+		return printResult(cmd, wasmTxResult{TxHash: tx.TxHash, CodeID: codeID})
+	}
+
+	fmt.Printf("📦 Uploaded %s as code %d\nTransaction hash: %s\n", wasmFile, codeID, tx.TxHash)
+	return nil
+}
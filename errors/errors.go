@@ -1,9 +1,102 @@
 // Package sperrors holds starport spesific errors.
 package sperrors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/tendermint/starport/starport/pkg/validation"
+)
 
 var (
 	// ErrOnlyStargateSupported is returned when underlying chain is not a stargate chain.
 	ErrOnlyStargateSupported = errors.New("this version of Cosmos SDK is no longer supported")
 )
+
+// Code is the code of an Error, codes don't change across versions so scripts can rely on them.
+type Code string
+
+const (
+	// CodePlaceholderMissing is the code of the errors of scaffolding into code that has no
+	// placeholders anymore.
+	CodePlaceholderMissing Code = "placeholder_missing"
+
+	// CodePortInUse is the code of the errors of starting a chain on addresses that are in use.
+	CodePortInUse Code = "port_in_use"
+
+	// CodeKeyringLocked is the code of the errors of using a keyring that cannot be unlocked.
+	CodeKeyringLocked Code = "keyring_locked"
+
+	// CodeFaucetUnreachable is the code of the errors of receiving tokens from faucets.
+	CodeFaucetUnreachable Code = "faucet_unreachable"
+)
+
+// Error is a common failure of Starport, with a short cause and a suggested fix.
+type Error struct {
+	// Code identifies the failure.
+	Code Code
+
+	// Cause is a short description of the failure.
+	Cause string
+
+	// Hint is the suggested fix.
+	Hint string
+
+	// Err is the error that caused the failure.
+	Err error
+}
+
+func (e *Error) Error() string {
+	return e.Cause
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// PlaceholderMissing returns the error of scaffolding into code whose placeholders are removed.
+func PlaceholderMissing(err error) *Error {
+	// the missing placeholders are listed with the files to add them to by the validation info.
+	cause := err.Error()
+	var validationErr validation.Error
+	if errors.As(err, &validationErr) {
+		cause = validationErr.ValidationInfo()
+	}
+
+	return &Error{
+		Code:  CodePlaceholderMissing,
+		Cause: cause,
+		Hint:  "add the missing placeholders back to the code of the chain, they're comments such as \"// this line is used by starport scaffolding # 1\"",
+		Err:   err,
+	}
+}
+
+// PortInUse returns the error of starting a chain on address when it's in use.
+func PortInUse(address string, err error) *Error {
+	return &Error{
+		Code:  CodePortInUse,
+		Cause: fmt.Sprintf("%s is already in use", address),
+		Hint:  "stop the process that listens on it, such as another chain, or set other addresses in the host section of config.yml",
+		Err:   err,
+	}
+}
+
+// KeyringLocked returns the error of using the keyring with backend when it cannot be unlocked.
+func KeyringLocked(backend string, err error) *Error {
+	return &Error{
+		Code:  CodeKeyringLocked,
+		Cause: fmt.Sprintf("the %s keyring is locked", backend),
+		Hint:  "unlock the keyring with its passphrase, or use --keyring-backend test for development accounts",
+		Err:   err,
+	}
+}
+
+// FaucetUnreachable returns the error of receiving tokens from the faucet of chainID.
+func FaucetUnreachable(chainID string, err error) *Error {
+	return &Error{
+		Code:  CodeFaucetUnreachable,
+		Cause: fmt.Sprintf("the faucet of %s is unreachable", chainID),
+		Hint:  "check that the faucet of the chain is running, or send tokens to the account from another account",
+		Err:   err,
+	}
+}
@@ -0,0 +1,20 @@
+package sperrors
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+)
+
+func TestPlaceholderMissing(t *testing.T) {
+	tracer := placeholder.New(placeholder.WithAdditionalInfo("add them to app/app.go"))
+	tracer.Replace("package app", "// this line is used by starport scaffolding # 1", "")
+
+	err := PlaceholderMissing(fmt.Errorf("cannot scaffold the hook: %w", tracer.Err()))
+	require.Equal(t, CodePlaceholderMissing, err.Code)
+	require.Contains(t, err.Cause, "Missing placeholders:")
+	require.Contains(t, err.Cause, "// this line is used by starport scaffolding # 1")
+	require.Contains(t, err.Cause, "add them to app/app.go")
+}
@@ -3,19 +3,43 @@ module github.com/trino-network/trino
 go 1.16
 
 require (
+	github.com/99designs/keyring v1.1.6
+	github.com/AlecAivazis/survey/v2 v2.1.1
 	github.com/blang/semver v3.5.1+incompatible
 	github.com/briandowns/spinner v1.11.1
+	github.com/btcsuite/btcd v0.22.0-beta
+	github.com/charmbracelet/bubbletea v0.13.1
+	github.com/cosmos/cosmos-sdk v0.44.3
 	github.com/cosmos/go-bip39 v1.0.0
+	github.com/cosmos/ibc-go v1.2.2
+	github.com/docker/docker v20.10.7+incompatible
 	github.com/fatih/color v1.12.0
+	github.com/ghodss/yaml v1.0.0
+	github.com/go-git/go-git/v5 v5.1.0
 	github.com/goccy/go-yaml v1.9.2
 	github.com/google/go-github/v37 v37.0.0
 	github.com/gookit/color v1.4.2
+	github.com/gorilla/mux v1.8.0
+	github.com/iancoleman/strcase v0.1.3
 	github.com/imdario/mergo v0.3.12
+	github.com/mattn/go-isatty v0.0.14
+	github.com/mattn/go-runewidth v0.0.10
+	github.com/mattn/go-sqlite3 v1.9.0
+	github.com/mattn/go-zglob v0.0.3
+	github.com/otiai10/copy v1.6.0
+	github.com/pelletier/go-toml v1.9.3
 	github.com/pkg/errors v0.9.1
+	github.com/rs/cors v1.7.0
 	github.com/spf13/cobra v1.2.1
 	github.com/spf13/pflag v1.0.5
 	github.com/stretchr/testify v1.7.0
+	github.com/tendermint/spm v0.1.8
 	github.com/tendermint/starport v0.18.6
+	github.com/tendermint/tendermint v0.34.14
+	golang.org/x/crypto v0.0.0-20210513164829-c07d793c2f9a
+	golang.org/x/mod v0.4.2
+	golang.org/x/sync v0.0.0-20210220032951-036812b2e83c
+	google.golang.org/protobuf v1.27.1
 )
 
 replace (
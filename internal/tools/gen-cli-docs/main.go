@@ -16,7 +16,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/cobra/doc"
-	starportcmd "github.com/tendermint/starport/starport/cmd"
+	starportcmd "github.com/trino-network/trino/cmd"
 )
 
 const head = `---
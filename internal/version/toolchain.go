@@ -0,0 +1,234 @@
+package version
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/exec"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+	"github.com/tendermint/starport/starport/pkg/gomodule"
+	"github.com/tendermint/starport/starport/pkg/xexec"
+	"github.com/trino-network/trino/pkg/toolchain"
+	"golang.org/x/mod/module"
+)
+
+const (
+	// TSProtoVersion is the version of ts-proto that is bundled with Starport.
+	TSProtoVersion = "1.68.0"
+
+	notInstalled = "not installed"
+
+	modulePathCosmosSDK  = "github.com/cosmos/cosmos-sdk"
+	modulePathIBCGo      = "github.com/cosmos/ibc-go"
+	modulePathTendermint = "github.com/tendermint/tendermint"
+)
+
+// Toolchain is the versions of the tools that Starport generates code with, and of the chain
+// that Starport is run for. the version of Go is part of Info.
+type Toolchain struct {
+	// Build is the versions of Go and of the modules that Starport is built with.
+	Build map[string]string `json:"build"`
+
+	Protoc        string            `json:"protoc"`
+	ProtocPlugins map[string]string `json:"protoc_plugins"`
+	TSProto       string            `json:"ts_proto"`
+	Buf           string            `json:"buf"`
+	Node          string            `json:"node"`
+	NPM           string            `json:"npm"`
+
+	// Chain is the versions of the chain at the app path, if there is one.
+	Chain *ChainVersions `json:"chain,omitempty"`
+}
+
+// ChainVersions is the versions of the Cosmos SDK modules that a chain depends on.
+type ChainVersions struct {
+	Module     string `json:"module"`
+	CosmosSDK  string `json:"cosmos_sdk"`
+	IBCGo      string `json:"ibc_go,omitempty"`
+	Tendermint string `json:"tendermint"`
+}
+
+// GetToolchain returns the versions of the tools that are installed and bundled with Starport,
+// protocPlugins are the versions of the protoc plugins by their names and appPath is the path
+// of the chain.
+func GetToolchain(ctx context.Context, appPath string, protocPlugins map[string]string) (Toolchain, error) {
+	t := Toolchain{
+		Build:         buildVersions(),
+		ProtocPlugins: protocPlugins,
+		TSProto:       TSProtoVersion,
+		Buf:           toolVersion(ctx, "buf", "--version"),
+		Node:          toolVersion(ctx, "node", "--version"),
+		NPM:           toolVersion(ctx, "npm", "--version"),
+	}
+
+	protocVersion, err := bundledProtocVersion(ctx)
+	if err != nil {
+		return t, err
+	}
+	t.Protoc = protocVersion
+
+	chain, err := chainVersions(appPath)
+	if err != nil {
+		return t, err
+	}
+	t.Chain = chain
+
+	return t, nil
+}
+
+// String returns the versions as a table.
+func (t Toolchain) String() string {
+	var (
+		w = &tabwriter.Writer{}
+		b = &bytes.Buffer{}
+	)
+
+	write := func(k string, v interface{}) {
+		fmt.Fprintf(w, "%s:\t%v\n", k, v)
+	}
+
+	w.Init(b, 0, 8, 1, '\t', 0)
+
+	for _, name := range sortedKeys(t.Build) {
+		write("Built with "+name, t.Build[name])
+	}
+
+	write("protoc", t.Protoc)
+	for _, name := range sortedKeys(t.ProtocPlugins) {
+		write(name, t.ProtocPlugins[name])
+	}
+	write("ts-proto", t.TSProto)
+	write("buf", t.Buf)
+	write("node", t.Node)
+	write("npm", t.NPM)
+
+	if t.Chain != nil {
+		write("Chain module", t.Chain.Module)
+		write("Chain cosmos-sdk", t.Chain.CosmosSDK)
+		if t.Chain.IBCGo != "" {
+			write("Chain ibc-go", t.Chain.IBCGo)
+		}
+		write("Chain tendermint", t.Chain.Tendermint)
+	}
+
+	w.Flush()
+
+	return b.String()
+}
+
+// buildVersions returns the versions of Go and of the Cosmos SDK modules that are embedded in the
+// binary of Starport.
+func buildVersions() map[string]string {
+	versions := map[string]string{"go": runtime.Version()}
+
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return versions
+	}
+	for _, dep := range info.Deps {
+		if dep.Replace != nil {
+			dep = dep.Replace
+		}
+		switch dep.Path {
+		case modulePathCosmosSDK:
+			versions["cosmos-sdk"] = dep.Version
+		case modulePathTendermint:
+			versions["tendermint"] = dep.Version
+		}
+	}
+	return versions
+}
+
+// toolVersion returns the version that the command prints with args, or notInstalled when the
+// command is not in PATH.
+func toolVersion(ctx context.Context, command string, args ...string) string {
+	if !xexec.IsCommandAvailable(command) {
+		return notInstalled
+	}
+
+	// some tools, such as buf, print their versions to stderr.
+	out := &bytes.Buffer{}
+	err := exec.Exec(ctx, append([]string{command}, args...), exec.StepOption(step.Stdout(out)), exec.StepOption(step.Stderr(out)))
+	if err != nil {
+		return "unknown"
+	}
+	return strings.TrimSpace(out.String())
+}
+
+// bundledProtocVersion returns the version of the protoc that code is generated with, the one
+// installed by the toolchain or the one bundled with Starport.
+func bundledProtocVersion(ctx context.Context) (string, error) {
+	command, cleanup, err := toolchain.ProtocCommand()
+	if err != nil {
+		return "", err
+	}
+	defer cleanup()
+
+	out := &bytes.Buffer{}
+	err = exec.Exec(ctx, []string{command.Command[0], "--version"}, exec.StepOption(step.Stdout(out)))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out.String()), nil
+}
+
+// chainVersions returns the versions of the chain at appPath, or nil if it's not a Go module.
+func chainVersions(appPath string) (*ChainVersions, error) {
+	gomod, err := gomodule.ParseAt(appPath)
+	if err == gomodule.ErrGoModNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	chain := &ChainVersions{
+		Module:     gomod.Module.Mod.Path,
+		CosmosSDK:  notInstalled,
+		Tendermint: notInstalled,
+	}
+	for _, req := range gomod.Require {
+		version := req.Mod.Version
+
+		// replaced modules are reported with the module that replaces them, such as a fork.
+		for _, rep := range gomod.Replace {
+			if rep.Old.Path == req.Mod.Path {
+				version = fmt.Sprintf("%s (replaced by %s %s)", version, rep.New.Path, rep.New.Version)
+			}
+		}
+
+		switch {
+		case req.Mod.Path == modulePathCosmosSDK:
+			chain.CosmosSDK = version
+		case req.Mod.Path == modulePathTendermint:
+			chain.Tendermint = version
+		case isModulePath(req.Mod.Path, modulePathIBCGo):
+			chain.IBCGo = version
+		}
+	}
+	return chain, nil
+}
+
+// isModulePath checks if path is the module path base or one of its major versions.
+func isModulePath(path, base string) bool {
+	if path == base {
+		return true
+	}
+	prefix, _, ok := module.SplitPathVersion(path)
+	return ok && prefix == base
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
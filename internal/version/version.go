@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 
@@ -29,69 +30,129 @@ var (
 
 	// Head is the HEAD of the current branch.
 	Head = "-"
+
+	// ReleasePublicKey is the base64 encoded ed25519 key that signs the checksums of the
+	// releases of Starport, it's set by release builds.
+	ReleasePublicKey = ""
 )
 
-// CheckNext checks whether there is a new version of Starport.
-func CheckNext(ctx context.Context) (isAvailable bool, version string, err error) {
-	if Version == versionDev {
-		return false, "", nil
-	}
+// envNoUpdateCheck is the env var to opt out of checking for new versions of Starport.
+const envNoUpdateCheck = "STARPORT_NO_UPDATE_CHECK"
+
+// Release is a release of Starport.
+type Release struct {
+	// Version is the tag of the release.
+	Version string
+
+	// Assets are the download URLs of the files of the release, by file names.
+	Assets map[string]string
+}
+
+// IsDevelopment checks if Starport is a development build rather than a release.
+func IsDevelopment() bool {
+	return Version == versionDev
+}
 
+// IsCheckDisabled checks if checking for new versions is opted out with an env var.
+func IsCheckDisabled() bool {
+	disabled, _ := strconv.ParseBool(os.Getenv(envNoUpdateCheck))
+	return disabled
+}
+
+// ArchiveName returns the name of the release archive of version for the current OS and arch.
+func ArchiveName(version string) string {
+	return fmt.Sprintf("starport_%s_%s_%s.tar.gz", strings.TrimPrefix(version, prefix), runtime.GOOS, runtime.GOARCH)
+}
+
+// LatestRelease returns the latest release of Starport.
+func LatestRelease(ctx context.Context) (Release, error) {
 	latest, _, err := github.
 		NewClient(nil).
 		Repositories.
 		GetLatestRelease(ctx, "trino-network", "trino")
-
 	if err != nil {
-		return false, "", err
+		return Release{}, err
 	}
 
-	if latest.TagName == nil {
-		return false, "", nil
+	release := Release{
+		Version: latest.GetTagName(),
+		Assets:  make(map[string]string),
 	}
+	for _, asset := range latest.Assets {
+		release.Assets[asset.GetName()] = asset.GetBrowserDownloadURL()
+	}
+	return release, nil
+}
 
+// IsNewer checks if version is newer than the current version of Starport.
+func IsNewer(version string) (bool, error) {
 	currentVersion, err := semver.Parse(strings.TrimPrefix(Version, prefix))
 	if err != nil {
-		return false, "", err
+		return false, err
 	}
 
-	latestVersion, err := semver.Parse(strings.TrimPrefix(*latest.TagName, prefix))
+	nextVersion, err := semver.Parse(strings.TrimPrefix(version, prefix))
 	if err != nil {
-		return false, "", err
+		return false, err
 	}
 
-	isAvailable = latestVersion.GT(currentVersion)
-
-	return isAvailable, *latest.TagName, nil
+	return nextVersion.GT(currentVersion), nil
 }
 
-// Long generates a detailed version info.
-func Long(ctx context.Context) string {
-	var (
-		w = &tabwriter.Writer{}
-		b = &bytes.Buffer{}
-	)
+// CheckNext checks whether there is a new version of Starport.
+func CheckNext(ctx context.Context) (isAvailable bool, version string, err error) {
+	if IsDevelopment() {
+		return false, "", nil
+	}
 
-	write := func(k string, v interface{}) {
-		fmt.Fprintf(w, "%s:\t%v\n", k, v)
+	latest, err := LatestRelease(ctx)
+	if err != nil {
+		return false, "", err
 	}
 
-	w.Init(b, 0, 8, 0, '\t', 0)
+	if latest.Version == "" {
+		return false, "", nil
+	}
+
+	isAvailable, err = IsNewer(latest.Version)
+	if err != nil {
+		return false, "", err
+	}
 
-	write("Starport version", Version)
-	write("Starport build date", Date)
-	write("Starport source hash", Head)
+	return isAvailable, latest.Version, nil
+}
 
-	write("Your OS", runtime.GOOS)
-	write("Your arch", runtime.GOARCH)
+// Info is the build information of Starport and the environment that it runs in.
+type Info struct {
+	Version    string `json:"version"`
+	BuildDate  string `json:"build_date"`
+	SourceHash string `json:"source_hash"`
+	OS         string `json:"os"`
+	Arch       string `json:"arch"`
+	GoVersion  string `json:"go_version"`
+	Uname      string `json:"uname,omitempty"`
+	Cwd        string `json:"cwd,omitempty"`
+	IsOnGitpod bool   `json:"is_on_gitpod"`
+}
+
+// GetInfo returns the build information of Starport.
+func GetInfo(ctx context.Context) (Info, error) {
+	info := Info{
+		Version:    Version,
+		BuildDate:  Date,
+		SourceHash: Head,
+		OS:         runtime.GOOS,
+		Arch:       runtime.GOARCH,
+		IsOnGitpod: gitpod.IsOnGitpod(),
+	}
 
 	cmdOut := &bytes.Buffer{}
 
 	err := exec.Exec(ctx, []string{"go", "version"}, exec.StepOption(step.Stdout(cmdOut)))
 	if err != nil {
-		panic(err)
+		return info, err
 	}
-	write("Your go version", strings.TrimSpace(cmdOut.String()))
+	info.GoVersion = strings.TrimSpace(cmdOut.String())
 
 	unameCmd := "uname"
 	if xexec.IsCommandAvailable(unameCmd) {
@@ -99,15 +160,56 @@ func Long(ctx context.Context) string {
 
 		err := exec.Exec(ctx, []string{unameCmd, "-a"}, exec.StepOption(step.Stdout(cmdOut)))
 		if err == nil {
-			write("Your uname -a", strings.TrimSpace(cmdOut.String()))
+			info.Uname = strings.TrimSpace(cmdOut.String())
 		}
 	}
 
 	if cwd, err := os.Getwd(); err == nil {
-		write("Your cwd", cwd)
+		info.Cwd = cwd
+	}
+
+	return info, nil
+}
+
+// Long generates a detailed version info.
+func Long(ctx context.Context) string {
+	info, err := GetInfo(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return info.String()
+}
+
+// String returns the build information as a table.
+func (info Info) String() string {
+	var (
+		w = &tabwriter.Writer{}
+		b = &bytes.Buffer{}
+	)
+
+	write := func(k string, v interface{}) {
+		fmt.Fprintf(w, "%s:\t%v\n", k, v)
+	}
+
+	w.Init(b, 0, 8, 0, '\t', 0)
+
+	write("Starport version", info.Version)
+	write("Starport build date", info.BuildDate)
+	write("Starport source hash", info.SourceHash)
+
+	write("Your OS", info.OS)
+	write("Your arch", info.Arch)
+	write("Your go version", info.GoVersion)
+
+	if info.Uname != "" {
+		write("Your uname -a", info.Uname)
+	}
+
+	if info.Cwd != "" {
+		write("Your cwd", info.Cwd)
 	}
 
-	write("Is on Gitpod", gitpod.IsOnGitpod())
+	write("Is on Gitpod", info.IsOnGitpod)
 
 	w.Flush()
 
@@ -0,0 +1,193 @@
+// Package buf runs the buf CLI to lint proto files, detect breaking changes
+// and resolve proto dependencies hosted on a buf registry.
+package buf
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+	"github.com/trino-network/trino/pkg/cmdtrace"
+)
+
+const (
+	// ConfigFile is the name of the buf config file placed in the proto dir.
+	ConfigFile = "buf.yaml"
+
+	// LockFile is the name of the file that pins the dependencies of the buf config.
+	LockFile = "buf.lock"
+
+	binaryName = "buf"
+)
+
+var (
+	// ErrNotInstalled is returned when the buf binary cannot be found in PATH.
+	ErrNotInstalled = errors.New("buf is not installed, run `starport tools install buf` or see https://docs.buf.build/installation")
+
+	// ErrNotConfigured is returned when there is no buf config in the proto dir.
+	ErrNotConfigured = fmt.Errorf("%s cannot be found in the proto dir, run `starport proto init` to create one", ConfigFile)
+)
+
+// defaultConfig is the buf config created for a chain. lint rules that conflict with
+// the layout of the proto files of a scaffolded chain are turned off.
+const defaultConfig = `version: v1
+deps:
+  - buf.build/cosmos/cosmos-sdk
+  - buf.build/cosmos/cosmos-proto
+  - buf.build/cosmos/gogo-proto
+  - buf.build/googleapis/googleapis
+breaking:
+  use:
+    - FILE
+lint:
+  use:
+    - DEFAULT
+  except:
+    - PACKAGE_DIRECTORY_MATCH
+    - PACKAGE_VERSION_SUFFIX
+    - RPC_REQUEST_STANDARD_NAME
+    - RPC_RESPONSE_STANDARD_NAME
+    - SERVICE_SUFFIX
+    - UNARY_RPC
+`
+
+// Dependency is a module pinned in the buf lock file.
+type Dependency struct {
+	Remote     string `yaml:"remote"`
+	Owner      string `yaml:"owner"`
+	Repository string `yaml:"repository"`
+	Commit     string `yaml:"commit"`
+}
+
+// Name returns the name of the module without its commit.
+func (d Dependency) Name() string {
+	return fmt.Sprintf("%s/%s/%s", d.Remote, d.Owner, d.Repository)
+}
+
+// Ref returns the reference of the module pinned to its commit.
+func (d Dependency) Ref() string {
+	return fmt.Sprintf("%s:%s", d.Name(), d.Commit)
+}
+
+// IsConfigured checks if there is a buf config in protoPath.
+func IsConfigured(protoPath string) bool {
+	_, err := os.Stat(filepath.Join(protoPath, ConfigFile))
+	return err == nil
+}
+
+// Init creates the default buf config in protoPath if there isn't one already
+// and pins its dependencies.
+func Init(ctx context.Context, protoPath string) error {
+	if !IsConfigured(protoPath) {
+		if err := os.WriteFile(filepath.Join(protoPath, ConfigFile), []byte(defaultConfig), 0644); err != nil {
+			return err
+		}
+	}
+
+	return run(ctx, protoPath, "mod", "update")
+}
+
+// Lint lints the proto files in protoPath with the rules of its buf config.
+func Lint(ctx context.Context, protoPath string) error {
+	if !IsConfigured(protoPath) {
+		return ErrNotConfigured
+	}
+
+	return run(ctx, protoPath, "lint")
+}
+
+// Breaking checks the proto files in protoPath for breaking changes against their
+// version at the git ref. protoPath must be inside the git repository at repoPath.
+func Breaking(ctx context.Context, repoPath, protoPath, ref string) error {
+	if !IsConfigured(protoPath) {
+		return ErrNotConfigured
+	}
+
+	subdir, err := filepath.Rel(repoPath, protoPath)
+	if err != nil {
+		return err
+	}
+	subdir = filepath.ToSlash(subdir)
+
+	against := fmt.Sprintf(".git#ref=%s,subdir=%s", ref, subdir)
+	return run(ctx, repoPath, "breaking", subdir, "--against", against)
+}
+
+// Dependencies returns the dependencies pinned in the buf lock file in protoPath.
+func Dependencies(protoPath string) ([]Dependency, error) {
+	if !IsConfigured(protoPath) {
+		return nil, ErrNotConfigured
+	}
+
+	content, err := os.ReadFile(filepath.Join(protoPath, LockFile))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%s cannot be found in the proto dir, run `starport proto init` to pin the dependencies", LockFile)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var lock struct {
+		Deps []Dependency `yaml:"deps"`
+	}
+	if err := yaml.Unmarshal(content, &lock); err != nil {
+		return nil, errors.Wrapf(err, "cannot parse %s", LockFile)
+	}
+
+	return lock.Deps, nil
+}
+
+// Export exports the proto files of dep into out. since dependencies are pinned to a commit,
+// the export is skipped when out already exists.
+func Export(ctx context.Context, dep Dependency, out string) error {
+	if _, err := os.Stat(out); err == nil {
+		return nil
+	}
+
+	// export into a temporary dir first to not leave a partial export behind on failures.
+	tmp := out + ".tmp"
+	if err := os.RemoveAll(tmp); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(out), 0755); err != nil {
+		return err
+	}
+
+	if err := run(ctx, "", "export", dep.Ref(), "--output", tmp); err != nil {
+		os.RemoveAll(tmp)
+		return errors.Wrapf(err, "cannot export %s", dep.Name())
+	}
+
+	return os.Rename(tmp, out)
+}
+
+// run runs buf with args inside workdir.
+func run(ctx context.Context, workdir string, args ...string) error {
+	if _, err := exec.LookPath(binaryName); err != nil {
+		return ErrNotInstalled
+	}
+
+	var (
+		output  = &bytes.Buffer{}
+		options = []cmdrunner.Option{
+			cmdrunner.DefaultStdout(output),
+			cmdrunner.DefaultStderr(output),
+		}
+	)
+	if workdir != "" {
+		options = append(options, cmdrunner.DefaultWorkdir(workdir))
+	}
+
+	err := cmdrunner.New(options...).Run(ctx, step.New(step.Exec(binaryName, args...), cmdtrace.Step()))
+	if err != nil && output.Len() > 0 {
+		return errors.New(string(bytes.TrimSpace(output.Bytes())))
+	}
+	return err
+}
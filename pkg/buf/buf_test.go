@@ -0,0 +1,30 @@
+package buf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDependencies(t *testing.T) {
+	protoPath := t.TempDir()
+
+	_, err := Dependencies(protoPath)
+	require.Equal(t, ErrNotConfigured, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(protoPath, ConfigFile), []byte(defaultConfig), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(protoPath, LockFile), []byte(`version: v1
+deps:
+  - remote: buf.build
+    owner: cosmos
+    repository: gogo-proto
+    commit: bee5511075b7499da6178d9e4aaa628b
+`), 0644))
+
+	deps, err := Dependencies(protoPath)
+	require.NoError(t, err)
+	require.Len(t, deps, 1)
+	require.Equal(t, "buf.build/cosmos/gogo-proto:bee5511075b7499da6178d9e4aaa628b", deps[0].Ref())
+}
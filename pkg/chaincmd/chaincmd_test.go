@@ -0,0 +1,64 @@
+package chaincmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+func TestSignTxCommand(t *testing.T) {
+	c := New("marsd", WithChainID("mars-1"), WithHome("/mars"), WithKeyringDir("/keys"), WithNodeAddress("tcp://node:26657"))
+
+	s := step.New(c.SignTxCommand("tx.json", "alice", SignTxOffline(3, 7)))
+	require.Equal(t, "marsd", s.Exec.Command)
+	require.Equal(t, []string{
+		"tx", "sign", "tx.json", "--from", "alice",
+		"--offline", "--account-number", "3", "--sequence", "7",
+		"--chain-id", "mars-1",
+		"--keyring-dir", "/keys",
+		"--node", "tcp://node:26657",
+		"--home", "/mars",
+	}, s.Exec.Args)
+
+	s = step.New(c.SignTxCommand("tx.json", "alice", SignTxWithMultisig("admins"), SignTxOffline(0, 0)))
+	require.Equal(t, []string{
+		"tx", "sign", "tx.json", "--from", "alice",
+		"--multisig", "admins", "--signature-only",
+		"--offline", "--account-number", "0", "--sequence", "0",
+		"--chain-id", "mars-1",
+		"--keyring-dir", "/keys",
+		"--node", "tcp://node:26657",
+		"--home", "/mars",
+	}, s.Exec.Args)
+}
+
+func TestBroadcastTxCommand(t *testing.T) {
+	s := step.New(New("marsd").BroadcastTxCommand("signed.json"))
+	require.Equal(t, []string{"tx", "broadcast", "signed.json", "--broadcast-mode", "sync", "--output", "json"}, s.Exec.Args)
+
+	s = step.New(New("marsd", WithChainID("mars-1"), WithHome("/mars"), WithNodeAddress("tcp://node:26657")).BroadcastTxCommand("signed.json"))
+	require.Equal(t, []string{
+		"tx", "broadcast", "signed.json", "--broadcast-mode", "sync", "--output", "json",
+		"--chain-id", "mars-1",
+		"--node", "tcp://node:26657",
+		"--home", "/mars",
+	}, s.Exec.Args)
+}
+
+func TestFeeGrantCommand(t *testing.T) {
+	s := step.New(New("marsd").FeeGrantCommand("cosmos1faucet", "cosmos1grantee", "", time.Time{}))
+	require.Equal(t, []string{
+		"tx", "feegrant", "grant", "cosmos1faucet", "cosmos1grantee",
+		"--broadcast-mode", "block", "--yes", "--output", "json",
+	}, s.Exec.Args)
+
+	expiration := time.Date(2021, 12, 1, 15, 0, 0, 0, time.UTC)
+	s = step.New(New("marsd").FeeGrantCommand("cosmos1faucet", "cosmos1grantee", "100stake", expiration))
+	require.Equal(t, []string{
+		"tx", "feegrant", "grant", "cosmos1faucet", "cosmos1grantee",
+		"--spend-limit", "100stake", "--expiration", "2021-12-01T15:00:00Z",
+		"--broadcast-mode", "block", "--yes", "--output", "json",
+	}, s.Exec.Args)
+}
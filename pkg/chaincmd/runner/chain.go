@@ -0,0 +1,441 @@
+package chaincmdrunner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+	"github.com/tendermint/starport/starport/pkg/cosmosver"
+	"github.com/trino-network/trino/pkg/chaincmd"
+)
+
+// Start starts the blockchain.
+func (r Runner) Start(ctx context.Context, args ...string) error {
+	return r.run(
+		ctx,
+		runOptions{wrappedStdErrMaxLen: 50000},
+		r.chainCmd.StartCommand(args...),
+	)
+}
+
+// LaunchpadStartRestServer start launchpad rest server.
+func (r Runner) LaunchpadStartRestServer(ctx context.Context, apiAddress, rpcAddress string) error {
+	return r.run(
+		ctx,
+		runOptions{wrappedStdErrMaxLen: 50000},
+		r.chainCmd.LaunchpadRestServerCommand(apiAddress, rpcAddress),
+	)
+}
+
+// Init inits the blockchain.
+func (r Runner) Init(ctx context.Context, moniker string) error {
+	return r.run(ctx, runOptions{}, r.chainCmd.InitCommand(moniker))
+}
+
+// KV holds a key, value pair.
+type KV struct {
+	key   string
+	value string
+}
+
+// NewKV returns a new key, value pair.
+func NewKV(key, value string) KV {
+	return KV{key, value}
+}
+
+// LaunchpadSetConfigs updates configurations for a launchpad app.
+func (r Runner) LaunchpadSetConfigs(ctx context.Context, kvs ...KV) error {
+	for _, kv := range kvs {
+		if err := r.run(
+			ctx,
+			runOptions{},
+			r.chainCmd.LaunchpadSetConfigCommand(kv.key, kv.value),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var gentxRe = regexp.MustCompile(`(?m)"(.+?)"`)
+
+// Gentx generates a genesis tx carrying a self delegation.
+func (r Runner) Gentx(
+	ctx context.Context,
+	validatorName,
+	selfDelegation string,
+	options ...chaincmd.GentxOption,
+) (gentxPath string, err error) {
+	b := &bytes.Buffer{}
+
+	if err := r.run(ctx, runOptions{
+		stdout: b,
+		stderr: io.MultiWriter(b, os.Stderr),
+		stdin:  os.Stdin,
+	}, r.chainCmd.GentxCommand(validatorName, selfDelegation, options...)); err != nil {
+		return "", err
+	}
+
+	return gentxRe.FindStringSubmatch(b.String())[1], nil
+}
+
+// CollectGentxs collects gentxs.
+func (r Runner) CollectGentxs(ctx context.Context) error {
+	return r.run(ctx, runOptions{}, r.chainCmd.CollectGentxsCommand())
+}
+
+// ValidateGenesis validates genesis.
+func (r Runner) ValidateGenesis(ctx context.Context) error {
+	return r.run(ctx, runOptions{}, r.chainCmd.ValidateGenesisCommand())
+}
+
+// UnsafeReset resets the blockchain database.
+func (r Runner) UnsafeReset(ctx context.Context) error {
+	return r.run(ctx, runOptions{}, r.chainCmd.UnsafeResetCommand())
+}
+
+// ShowNodeID shows node id.
+func (r Runner) ShowNodeID(ctx context.Context) (nodeID string, err error) {
+	b := &bytes.Buffer{}
+	err = r.run(ctx, runOptions{stdout: b}, r.chainCmd.ShowNodeIDCommand())
+	nodeID = strings.TrimSpace(b.String())
+	return
+}
+
+// NodeStatus keeps info about node's status.
+type NodeStatus struct {
+	ChainID string
+}
+
+// Status returns the node's status.
+func (r Runner) Status(ctx context.Context) (NodeStatus, error) {
+	b := newBuffer()
+
+	if err := r.run(ctx, runOptions{stdout: b, stderr: b}, r.chainCmd.StatusCommand()); err != nil {
+		return NodeStatus{}, err
+	}
+
+	var chainID string
+
+	data, err := b.JSONEnsuredBytes()
+	if err != nil {
+		return NodeStatus{}, err
+	}
+
+	version := r.chainCmd.SDKVersion()
+	switch {
+	case version.GTE(cosmosver.StargateFortyVersion):
+		out := struct {
+			NodeInfo struct {
+				Network string `json:"network"`
+			} `json:"NodeInfo"`
+		}{}
+
+		if err := json.Unmarshal(data, &out); err != nil {
+			return NodeStatus{}, err
+		}
+
+		chainID = out.NodeInfo.Network
+	default:
+		out := struct {
+			NodeInfo struct {
+				Network string `json:"network"`
+			} `json:"node_info"`
+		}{}
+
+		if err := json.Unmarshal(data, &out); err != nil {
+			return NodeStatus{}, err
+		}
+
+		chainID = out.NodeInfo.Network
+	}
+
+	return NodeStatus{
+		ChainID: chainID,
+	}, nil
+}
+
+// BankSend sends amount from fromAccount to toAccount.
+func (r Runner) BankSend(ctx context.Context, fromAccount, toAccount, amount string) error {
+	b := newBuffer()
+	opt := []step.Option{
+		r.chainCmd.BankSendCommand(fromAccount, toAccount, amount),
+	}
+
+	if r.chainCmd.KeyringPassword() != "" {
+		opt = append(opt, r.keyringPasswordInput())
+	}
+
+	if err := r.run(ctx, runOptions{stdout: b}, opt...); err != nil {
+		if strings.Contains(err.Error(), "key not found") || // stargate
+			strings.Contains(err.Error(), "unknown address") || // launchpad
+			strings.Contains(b.String(), "item could not be found") { // launchpad
+			return errors.New("account doesn't have any balances")
+		}
+
+		return err
+	}
+
+	code, rawLog, err := txResult(b)
+	if err != nil {
+		return err
+	}
+
+	if code > 0 {
+		return fmt.Errorf("cannot send tokens (SDK code %d): %s", code, rawLog)
+	}
+
+	return nil
+}
+
+// ErrFeeAllowanceExists is returned when the grantee already has a fee allowance from the granter.
+var ErrFeeAllowanceExists = errors.New("fee allowance already exists")
+
+// FeeGrant grants a fee allowance from granterAccount to granteeAccount limited by spendLimit
+// until expiration. spendLimit and expiration are optional.
+func (r Runner) FeeGrant(ctx context.Context, granterAccount, granteeAccount, spendLimit string, expiration time.Time) error {
+	b := newBuffer()
+	opt := []step.Option{
+		r.chainCmd.FeeGrantCommand(granterAccount, granteeAccount, spendLimit, expiration),
+	}
+
+	if r.chainCmd.KeyringPassword() != "" {
+		opt = append(opt, r.keyringPasswordInput())
+	}
+
+	if err := r.run(ctx, runOptions{stdout: b}, opt...); err != nil {
+		return err
+	}
+
+	code, rawLog, err := txResult(b)
+	if err != nil {
+		return err
+	}
+
+	if code > 0 {
+		// the feegrant module rejects a second allowance between the same accounts.
+		if strings.Contains(rawLog, ErrFeeAllowanceExists.Error()) {
+			return ErrFeeAllowanceExists
+		}
+		return fmt.Errorf("cannot grant fee allowance (SDK code %d): %s", code, rawLog)
+	}
+
+	return nil
+}
+
+// SignTx signs the transaction in txFile with fromAccount and returns the signed transaction.
+// when signed on behalf of a multisig account, the signature is returned instead.
+func (r Runner) SignTx(ctx context.Context, txFile, fromAccount string, options ...chaincmd.SignTxOption) ([]byte, error) {
+	return r.signTx(ctx, r.chainCmd.SignTxCommand(txFile, fromAccount, options...))
+}
+
+// MultiSignTx combines the signatures in signatureFiles made on behalf of the multisig account
+// into the transaction in txFile and returns the signed transaction.
+func (r Runner) MultiSignTx(ctx context.Context, txFile, multisig string, signatureFiles ...string) ([]byte, error) {
+	return r.signTx(ctx, r.chainCmd.MultiSignTxCommand(txFile, multisig, signatureFiles...))
+}
+
+func (r Runner) signTx(ctx context.Context, command step.Option) ([]byte, error) {
+	var (
+		b          = newBuffer()
+		runOptions = runOptions{stdout: b}
+		opt        = []step.Option{command}
+	)
+
+	if r.chainCmd.KeyringPassword() != "" {
+		opt = append(opt, r.keyringPasswordInput())
+	} else {
+		runOptions.stdin = os.Stdin
+	}
+
+	if err := r.run(ctx, runOptions, opt...); err != nil {
+		return nil, err
+	}
+
+	return b.JSONEnsuredBytes()
+}
+
+// BroadcastTx broadcasts the signed transaction in txFile and returns its hash.
+func (r Runner) BroadcastTx(ctx context.Context, txFile string) (txHash string, err error) {
+	b := newBuffer()
+	if err := r.run(ctx, runOptions{stdout: b}, r.chainCmd.BroadcastTxCommand(txFile)); err != nil {
+		return "", err
+	}
+
+	out := struct {
+		TxHash string `json:"txhash"`
+		Code   int    `json:"code"`
+		RawLog string `json:"raw_log"`
+	}{}
+
+	data, err := b.JSONEnsuredBytes()
+	if err != nil {
+		return "", err
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return "", err
+	}
+
+	if out.Code > 0 {
+		return "", fmt.Errorf("cannot broadcast the transaction (SDK code %d): %s", out.Code, out.RawLog)
+	}
+
+	return out.TxHash, nil
+}
+
+// keyringPasswordInput writes the keyring password for the prompts of a tx command.
+func (r Runner) keyringPasswordInput() step.Option {
+	input := &bytes.Buffer{}
+	fmt.Fprintln(input, r.chainCmd.KeyringPassword())
+	fmt.Fprintln(input, r.chainCmd.KeyringPassword())
+	fmt.Fprintln(input, r.chainCmd.KeyringPassword())
+	return step.Write(input.Bytes())
+}
+
+// txResult decodes the result code and raw log of a broadcasted tx.
+func txResult(b *buffer) (code int, rawLog string, err error) {
+	out := struct {
+		Code  int    `json:"code"`
+		Error string `json:"raw_log"`
+	}{}
+
+	data, err := b.JSONEnsuredBytes()
+	if err != nil {
+		return 0, "", err
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return 0, "", err
+	}
+
+	return out.Code, out.Error, nil
+}
+
+// Export exports the state of the chain into the specified file
+func (r Runner) Export(ctx context.Context, exportedFile string) error {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	if err := r.run(ctx, runOptions{stdout: stdout, stderr: stderr}, r.chainCmd.ExportCommand()); err != nil {
+		return err
+	}
+
+	// Exported genesis is written on stderr from Cosmos-SDK v0.44.0
+	var exportedState []byte
+	if stdout.Len() > 0 {
+		exportedState = stdout.Bytes()
+	} else {
+		exportedState = stderr.Bytes()
+	}
+
+	// Save the new state
+	return ioutil.WriteFile(exportedFile, exportedState, 0644)
+}
+
+// EventSelector is used to query events.
+type EventSelector struct {
+	typ   string
+	attr  string
+	value string
+}
+
+// NewEventSelector creates a new event selector.
+func NewEventSelector(typ, addr, value string) EventSelector {
+	return EventSelector{typ, addr, value}
+}
+
+// Event represents a TX event.
+type Event struct {
+	Type       string
+	Attributes []EventAttribute
+	Time       time.Time
+}
+
+// EventAttribute holds event's attributes.
+type EventAttribute struct {
+	Key   string
+	Value string
+}
+
+// QueryTxEvents queries tx events by event selectors.
+func (r Runner) QueryTxEvents(
+	ctx context.Context,
+	selector EventSelector,
+	moreSelectors ...EventSelector,
+) ([]Event, error) {
+	// prepare the slector.
+	var list []string
+
+	eventsSelectors := append([]EventSelector{selector}, moreSelectors...)
+
+	for _, event := range eventsSelectors {
+		list = append(list, fmt.Sprintf("%s.%s=%s", event.typ, event.attr, event.value))
+	}
+
+	query := strings.Join(list, "&")
+
+	// execute the commnd and parse the output.
+	b := newBuffer()
+
+	if err := r.run(ctx, runOptions{stdout: b}, r.chainCmd.QueryTxEventsCommand(query)); err != nil {
+		return nil, err
+	}
+
+	out := struct {
+		Txs []struct {
+			Logs []struct {
+				Events []struct {
+					Type  string `json:"type"`
+					Attrs []struct {
+						Key   string `json:"key"`
+						Value string `json:"value"`
+					} `json:"attributes"`
+				} `json:"events"`
+			} `json:"logs"`
+			TimeStamp string `json:"timestamp"`
+		} `json:"txs"`
+	}{}
+
+	data, err := b.JSONEnsuredBytes()
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+
+	var events []Event
+
+	for _, tx := range out.Txs {
+		for _, log := range tx.Logs {
+			for _, e := range log.Events {
+				var attrs []EventAttribute
+				for _, attr := range e.Attrs {
+					attrs = append(attrs, EventAttribute{
+						Key:   attr.Key,
+						Value: attr.Value,
+					})
+				}
+
+				txTime, err := time.Parse(time.RFC3339, tx.TimeStamp)
+				if err != nil {
+					return nil, err
+				}
+
+				events = append(events, Event{
+					Type:       e.Type,
+					Attributes: attrs,
+					Time:       txTime,
+				})
+			}
+		}
+	}
+
+	return events, nil
+}
@@ -0,0 +1,140 @@
+package chaincmdrunner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+// WasmTx is the result of a broadcasted wasm tx.
+type WasmTx struct {
+	TxHash string `json:"txhash"`
+	Code   int    `json:"code"`
+	RawLog string `json:"raw_log"`
+	Logs   []struct {
+		Events []struct {
+			Type       string `json:"type"`
+			Attributes []struct {
+				Key   string `json:"key"`
+				Value string `json:"value"`
+			} `json:"attributes"`
+		} `json:"events"`
+	} `json:"logs"`
+}
+
+// attribute returns the value of the first event attribute with one of keys.
+func (t WasmTx) attribute(keys ...string) (string, bool) {
+	for _, log := range t.Logs {
+		for _, e := range log.Events {
+			for _, attr := range e.Attributes {
+				for _, key := range keys {
+					if attr.Key == key {
+						return attr.Value, true
+					}
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// WasmStore uploads the contract code in wasmFile with fromAccount and returns its code id.
+func (r Runner) WasmStore(ctx context.Context, wasmFile, fromAccount string) (codeID uint64, tx WasmTx, err error) {
+	if tx, err = r.wasmTx(ctx, "store the contract code", r.chainCmd.WasmStoreCommand(wasmFile, fromAccount)); err != nil {
+		return 0, tx, err
+	}
+
+	value, ok := tx.attribute("code_id")
+	if !ok {
+		return 0, tx, fmt.Errorf("the code id of the contract cannot be found in tx %s", tx.TxHash)
+	}
+	codeID, err = strconv.ParseUint(value, 10, 64)
+	return codeID, tx, err
+}
+
+// WasmInstantiate instantiates the contract code with codeID with fromAccount and returns the
+// address of the contract.
+func (r Runner) WasmInstantiate(
+	ctx context.Context,
+	codeID uint64,
+	msg,
+	label,
+	fromAccount,
+	admin,
+	amount string,
+) (contract string, tx WasmTx, err error) {
+	command := r.chainCmd.WasmInstantiateCommand(codeID, msg, label, fromAccount, admin, amount)
+	if tx, err = r.wasmTx(ctx, "instantiate the contract", command); err != nil {
+		return "", tx, err
+	}
+
+	// the attribute has no underscore in the versions of wasmd before v0.18.
+	contract, ok := tx.attribute("_contract_address", "contract_address")
+	if !ok {
+		return "", tx, fmt.Errorf("the address of the contract cannot be found in tx %s", tx.TxHash)
+	}
+	return contract, tx, nil
+}
+
+// WasmExecute executes msg on the contract with fromAccount.
+func (r Runner) WasmExecute(ctx context.Context, contract, msg, fromAccount, amount string) (WasmTx, error) {
+	return r.wasmTx(ctx, "execute the contract", r.chainCmd.WasmExecuteCommand(contract, msg, fromAccount, amount))
+}
+
+// WasmQuery queries the contract with the smart query and returns the data of its response.
+func (r Runner) WasmQuery(ctx context.Context, contract, query string) (json.RawMessage, error) {
+	b := newBuffer()
+	if err := r.run(ctx, runOptions{stdout: b}, r.chainCmd.WasmQueryCommand(contract, query)); err != nil {
+		return nil, err
+	}
+
+	data, err := b.JSONEnsuredBytes()
+	if err != nil {
+		return nil, err
+	}
+	out := struct {
+		Data json.RawMessage `json:"data"`
+	}{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out.Data, nil
+}
+
+// wasmTx runs the command of a wasm tx and returns its result, action is what the tx does for
+// the error when it fails.
+func (r Runner) wasmTx(ctx context.Context, action string, command step.Option) (WasmTx, error) {
+	var (
+		b          = newBuffer()
+		runOptions = runOptions{stdout: b}
+		opt        = []step.Option{command}
+	)
+
+	if r.chainCmd.KeyringPassword() != "" {
+		opt = append(opt, r.keyringPasswordInput())
+	} else {
+		runOptions.stdin = os.Stdin
+	}
+
+	if err := r.run(ctx, runOptions, opt...); err != nil {
+		return WasmTx{}, err
+	}
+
+	var tx WasmTx
+	data, err := b.JSONEnsuredBytes()
+	if err != nil {
+		return WasmTx{}, err
+	}
+	if err := json.Unmarshal(data, &tx); err != nil {
+		return WasmTx{}, err
+	}
+
+	if tx.Code > 0 {
+		return tx, fmt.Errorf("cannot %s (SDK code %d): %s", action, tx.Code, tx.RawLog)
+	}
+	return tx, nil
+}
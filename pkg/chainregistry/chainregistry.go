@@ -0,0 +1,297 @@
+// Package chainregistry reads the chains of a registry in the format of the cosmos
+// chain-registry, where each chain is described in <chain-name>/chain.json and the chains of
+// testnets are in testnets/<chain-name>/chain.json.
+package chainregistry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DefaultURL is the address of the curated cosmos chain-registry.
+const DefaultURL = "https://raw.githubusercontent.com/cosmos/chain-registry/master"
+
+const (
+	chainFile   = "chain.json"
+	testnetsDir = "testnets"
+)
+
+// ErrNotFound is returned when a chain is not in the registry.
+var ErrNotFound = errors.New("chain is not in the registry")
+
+// Chain is a chain described in the registry.
+type Chain struct {
+	ChainName    string   `json:"chain_name"`
+	ChainID      string   `json:"chain_id"`
+	PrettyName   string   `json:"pretty_name,omitempty"`
+	Status       string   `json:"status,omitempty"`
+	NetworkType  string   `json:"network_type,omitempty"`
+	Bech32Prefix string   `json:"bech32_prefix"`
+	DaemonName   string   `json:"daemon_name"`
+	NodeHome     string   `json:"node_home,omitempty"`
+	Fees         Fees     `json:"fees"`
+	Staking      Staking  `json:"staking"`
+	Codebase     Codebase `json:"codebase"`
+	Peers        Peers    `json:"peers"`
+	APIs         APIs     `json:"apis"`
+}
+
+// Fees are the tokens that fees are paid with.
+type Fees struct {
+	FeeTokens []FeeToken `json:"fee_tokens"`
+}
+
+// FeeToken is a token that fees are paid with and its gas prices.
+type FeeToken struct {
+	Denom            string  `json:"denom"`
+	FixedMinGasPrice float64 `json:"fixed_min_gas_price,omitempty"`
+	LowGasPrice      float64 `json:"low_gas_price,omitempty"`
+	AverageGasPrice  float64 `json:"average_gas_price,omitempty"`
+	HighGasPrice     float64 `json:"high_gas_price,omitempty"`
+}
+
+// Staking are the tokens that are staked with validators.
+type Staking struct {
+	StakingTokens []StakingToken `json:"staking_tokens"`
+}
+
+// StakingToken is a token that's staked with validators.
+type StakingToken struct {
+	Denom string `json:"denom"`
+}
+
+// Codebase is the source of the chain's binary.
+type Codebase struct {
+	GitRepo            string   `json:"git_repo"`
+	RecommendedVersion string   `json:"recommended_version"`
+	CompatibleVersions []string `json:"compatible_versions,omitempty"`
+	Genesis            Genesis  `json:"genesis"`
+}
+
+// Genesis is the genesis of the chain.
+type Genesis struct {
+	GenesisURL string `json:"genesis_url"`
+}
+
+// Peers are the nodes that new nodes of the chain connect to.
+type Peers struct {
+	Seeds           []Peer `json:"seeds,omitempty"`
+	PersistentPeers []Peer `json:"persistent_peers,omitempty"`
+}
+
+// Peer is a node of the chain.
+type Peer struct {
+	ID       string `json:"id"`
+	Address  string `json:"address"`
+	Provider string `json:"provider,omitempty"`
+}
+
+// String returns the peer in the <id>@<host>:<port> format of Tendermint.
+func (p Peer) String() string {
+	return fmt.Sprintf("%s@%s", p.ID, p.Address)
+}
+
+// APIs are the public endpoints of the chain.
+type APIs struct {
+	RPC  []Endpoint `json:"rpc,omitempty"`
+	REST []Endpoint `json:"rest,omitempty"`
+	GRPC []Endpoint `json:"grpc,omitempty"`
+}
+
+// Endpoint is a public endpoint of the chain.
+type Endpoint struct {
+	Address  string `json:"address"`
+	Provider string `json:"provider,omitempty"`
+}
+
+// Registry is a chain registry that's served over HTTP or stored in a local dir.
+type Registry struct {
+	source string
+}
+
+// New creates a registry from source, it's an HTTP address or the path of a local dir.
+func New(source string) Registry {
+	return Registry{source: strings.TrimSuffix(source, "/")}
+}
+
+func (r Registry) isRemote() bool {
+	return strings.HasPrefix(r.source, "http://") || strings.HasPrefix(r.source, "https://")
+}
+
+// Chain returns the chain with name, testnets are looked up when there is no mainnet with name.
+func (r Registry) Chain(ctx context.Context, name string) (Chain, error) {
+	for _, path := range []string{
+		name,
+		filepath.Join(testnetsDir, name),
+	} {
+		chain, err := r.read(ctx, path)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		return chain, err
+	}
+
+	return Chain{}, fmt.Errorf("%w: %s", ErrNotFound, name)
+}
+
+// revisionSuffix is the revision number at the end of chain IDs such as cosmoshub-4.
+var revisionSuffix = regexp.MustCompile(`-\d+$`)
+
+// ChainByID returns the chain with the chain ID.
+// all chains of local registries are searched. remote registries cannot be listed, so their
+// chains are looked up by the names derived from the chain ID, such as cosmoshub for
+// cosmoshub-4, chains with other names must be looked up by their names.
+func (r Registry) ChainByID(ctx context.Context, chainID string) (Chain, error) {
+	if !r.isRemote() {
+		return r.searchChainByID(chainID)
+	}
+
+	name := revisionSuffix.ReplaceAllString(chainID, "")
+	for _, candidate := range []string{
+		name,
+		name + "testnet",
+	} {
+		chain, err := r.Chain(ctx, candidate)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return Chain{}, err
+		}
+		if chain.ChainID == chainID {
+			return chain, nil
+		}
+	}
+
+	return Chain{}, fmt.Errorf("%w: %s", ErrNotFound, chainID)
+}
+
+// Lookup returns the chain with the chain ID or the name of nameOrID.
+func (r Registry) Lookup(ctx context.Context, nameOrID string) (Chain, error) {
+	chain, err := r.ChainByID(ctx, nameOrID)
+	if errors.Is(err, ErrNotFound) {
+		return r.Chain(ctx, nameOrID)
+	}
+	return chain, err
+}
+
+func (r Registry) searchChainByID(chainID string) (Chain, error) {
+	for _, dir := range []string{r.source, filepath.Join(r.source, testnetsDir)} {
+		entries, err := os.ReadDir(dir)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return Chain{}, err
+		}
+
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+			chain, err := readChainFile(filepath.Join(dir, entry.Name(), chainFile))
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return Chain{}, err
+			}
+			if chain.ChainID == chainID {
+				return chain, nil
+			}
+		}
+	}
+
+	return Chain{}, fmt.Errorf("%w: %s", ErrNotFound, chainID)
+}
+
+// read reads the chain in the dir at path of the registry.
+func (r Registry) read(ctx context.Context, path string) (Chain, error) {
+	if !r.isRemote() {
+		return readChainFile(filepath.Join(r.source, path, chainFile))
+	}
+
+	url := fmt.Sprintf("%s/%s/%s", r.source, filepath.ToSlash(path), chainFile)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return Chain{}, err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return Chain{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return Chain{}, ErrNotFound
+	}
+	if res.StatusCode != http.StatusOK {
+		return Chain{}, fmt.Errorf("cannot read %s from the registry: %s", url, http.StatusText(res.StatusCode))
+	}
+
+	return decodeChain(res.Body)
+}
+
+func readChainFile(path string) (Chain, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return Chain{}, ErrNotFound
+	}
+	if err != nil {
+		return Chain{}, err
+	}
+	defer f.Close()
+
+	chain, err := decodeChain(f)
+	if err != nil {
+		return Chain{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return chain, nil
+}
+
+func decodeChain(r io.Reader) (Chain, error) {
+	var chain Chain
+	if err := json.NewDecoder(r).Decode(&chain); err != nil {
+		return Chain{}, fmt.Errorf("invalid chain: %w", err)
+	}
+	return chain, nil
+}
+
+// GasPrice returns the average gas price of the first fee token of the chain with its denom,
+// such as 0.025uatom, the low and the min gas prices are used when there is no average price.
+func (c Chain) GasPrice() (gasPrice string, ok bool) {
+	if len(c.Fees.FeeTokens) == 0 {
+		return "", false
+	}
+
+	token := c.Fees.FeeTokens[0]
+	for _, price := range []float64{
+		token.AverageGasPrice,
+		token.LowGasPrice,
+		token.FixedMinGasPrice,
+	} {
+		if price > 0 {
+			return strconv.FormatFloat(price, 'f', -1, 64) + token.Denom, true
+		}
+	}
+
+	return "0" + token.Denom, true
+}
+
+// RPCAddress returns the address of the first RPC endpoint of the chain.
+func (c Chain) RPCAddress() (address string, ok bool) {
+	if len(c.APIs.RPC) == 0 {
+		return "", false
+	}
+	return c.APIs.RPC[0].Address, true
+}
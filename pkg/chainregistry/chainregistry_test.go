@@ -0,0 +1,74 @@
+package chainregistry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeChain(t *testing.T, dir, chain string) {
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, chainFile), []byte(chain), 0644))
+}
+
+func TestRegistry(t *testing.T) {
+	dir := t.TempDir()
+	writeChain(t, filepath.Join(dir, "cosmoshub"), `{
+  "chain_name": "cosmoshub",
+  "chain_id": "cosmoshub-4",
+  "bech32_prefix": "cosmos",
+  "daemon_name": "gaiad",
+  "peers": {"seeds": [{"id": "abc", "address": "seed.cosmos.network:26656"}]}
+}`)
+	writeChain(t, filepath.Join(dir, testnetsDir, "cosmoshubtestnet"), `{
+  "chain_name": "cosmoshubtestnet",
+  "chain_id": "theta-testnet-001",
+  "bech32_prefix": "cosmos"
+}`)
+
+	ctx := context.Background()
+
+	for _, source := range []string{dir, httptest.NewServer(http.FileServer(http.Dir(dir))).URL} {
+		r := New(source)
+
+		chain, err := r.Chain(ctx, "cosmoshub")
+		require.NoError(t, err)
+		require.Equal(t, "gaiad", chain.DaemonName)
+		require.Equal(t, "abc@seed.cosmos.network:26656", chain.Peers.Seeds[0].String())
+
+		chain, err = r.Lookup(ctx, "cosmoshub-4")
+		require.NoError(t, err)
+		require.Equal(t, "cosmoshub", chain.ChainName)
+
+		chain, err = r.Lookup(ctx, "cosmoshubtestnet")
+		require.NoError(t, err)
+		require.Equal(t, "theta-testnet-001", chain.ChainID)
+
+		_, err = r.Lookup(ctx, "osmosis-1")
+		require.ErrorIs(t, err, ErrNotFound)
+	}
+
+	chain, err := New(dir).ChainByID(ctx, "theta-testnet-001")
+	require.NoError(t, err)
+	require.Equal(t, "cosmoshubtestnet", chain.ChainName)
+}
+
+func TestChainGasPrice(t *testing.T) {
+	_, ok := Chain{}.GasPrice()
+	require.False(t, ok)
+
+	gasPrice, ok := Chain{Fees: Fees{FeeTokens: []FeeToken{
+		{Denom: "uatom", LowGasPrice: 0.01, AverageGasPrice: 0.025},
+		{Denom: "stake", AverageGasPrice: 1},
+	}}}.GasPrice()
+	require.True(t, ok)
+	require.Equal(t, "0.025uatom", gasPrice)
+
+	gasPrice, _ = Chain{Fees: Fees{FeeTokens: []FeeToken{{Denom: "uosmo", FixedMinGasPrice: 0.0025}}}}.GasPrice()
+	require.Equal(t, "0.0025uosmo", gasPrice)
+}
@@ -0,0 +1,200 @@
+// Package cliprogress reports the progress of long-running operations on cli, such as building
+// a chain. operations are made of steps that have status lines with the time spent in them, and
+// the steps whose amount of work is known have progress bars, so users can tell slow operations
+// from stuck ones.
+package cliprogress
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/trino-network/trino/pkg/cliterm"
+)
+
+const (
+	// refreshRate is how often the status line of the current step is redrawn on terminals.
+	refreshRate = time.Millisecond * 200
+
+	// barWidth is the number of chars of progress bars.
+	barWidth = 24
+
+	// markDone and markFailed mark the status lines of finished steps.
+	markDone   = "✔"
+	markFailed = "✘"
+
+	// clearLine moves the cursor to the start of the line and clears it.
+	clearLine = "\r\033[K"
+)
+
+// Progress reports the steps of an operation. on terminals, the status line of the current step
+// is redrawn with its elapsed time and its progress bar. otherwise, such as in CI, a timestamped
+// line is written when a step starts and when it's done.
+type Progress struct {
+	w          io.Writer
+	isTerminal bool
+	steps      int
+
+	mu      sync.Mutex
+	step    int
+	text    string
+	done    int
+	total   int
+	started time.Time
+	stop    chan struct{}
+	stopped chan struct{}
+}
+
+// New creates a progress that's written to w for an operation with a number of steps, the number
+// of steps is not shown when it's 0.
+func New(w io.Writer, steps int) *Progress {
+	p := &Progress{
+		w:     w,
+		steps: steps,
+	}
+	p.isTerminal = cliterm.IsAnimated(w)
+	return p
+}
+
+// Step starts a step with text, the previous step is done. total is the amount of work of the
+// step that's reported with Add, the step has no progress bar when it's 0.
+func (p *Progress) Step(text string, total int) {
+	p.finish(markDone)
+
+	p.mu.Lock()
+	p.step++
+	p.text = text
+	p.done = 0
+	p.total = total
+	p.started = time.Now()
+	p.mu.Unlock()
+
+	if !p.isTerminal {
+		fmt.Fprintln(p.w, cliterm.Timestamp(p.line("")))
+		return
+	}
+
+	p.stop = make(chan struct{})
+	p.stopped = make(chan struct{})
+	go p.refresh(p.stop, p.stopped)
+}
+
+// Add reports n more amount of work that's done in the current step.
+func (p *Progress) Add(n int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.done += n
+	if p.total > 0 && p.done > p.total {
+		p.done = p.total
+	}
+}
+
+// Report reports the amount of work that's done in the current step out of its total, such as
+// when the total is known once the step is started.
+func (p *Progress) Report(done, total int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.done = done
+	p.total = total
+}
+
+// Stop marks the current step as done.
+func (p *Progress) Stop() {
+	p.finish(markDone)
+}
+
+// Fail marks the current step as failed.
+func (p *Progress) Fail() {
+	p.finish(markFailed)
+}
+
+// finish writes the status line of the current step with mark.
+func (p *Progress) finish(mark string) {
+	p.mu.Lock()
+	started := p.step > 0 && p.text != ""
+	p.mu.Unlock()
+	if !started {
+		return
+	}
+
+	if p.stop != nil {
+		close(p.stop)
+		<-p.stopped
+		p.stop = nil
+	}
+
+	p.mu.Lock()
+	if p.isTerminal {
+		fmt.Fprintln(p.w, clearLine+p.line(mark))
+	} else {
+		fmt.Fprintln(p.w, cliterm.Timestamp(p.line(mark)))
+	}
+	p.text = ""
+	p.mu.Unlock()
+}
+
+// refresh redraws the status line of the current step until it's stopped.
+func (p *Progress) refresh(stop, stopped chan struct{}) {
+	defer close(stopped)
+
+	ticker := time.NewTicker(refreshRate)
+	defer ticker.Stop()
+
+	for {
+		p.mu.Lock()
+		fmt.Fprint(p.w, clearLine+p.line(""))
+		p.mu.Unlock()
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// line returns the status line of the current step, with mark once it's finished.
+func (p *Progress) line(mark string) string {
+	var (
+		b    strings.Builder
+		done = mark != ""
+	)
+
+	if done {
+		b.WriteString(mark + " ")
+	} else if p.isTerminal {
+		b.WriteString("· ")
+	}
+	if p.steps > 0 {
+		fmt.Fprintf(&b, "[%d/%d] ", p.step, p.steps)
+	}
+	b.WriteString(p.text)
+
+	if p.total > 0 && !done {
+		b.WriteString(" ")
+		b.WriteString(bar(p.done, p.total))
+		fmt.Fprintf(&b, " %d/%d", p.done, p.total)
+	}
+	if p.isTerminal || done {
+		fmt.Fprintf(&b, " (%s)", elapsed(time.Since(p.started)))
+	}
+	return b.String()
+}
+
+// bar returns a progress bar of done out of total.
+func bar(done, total int) string {
+	filled := barWidth * done / total
+	return "[" + strings.Repeat("=", filled) + strings.Repeat(" ", barWidth-filled) + "]"
+}
+
+// elapsed returns d rounded for status lines, such as 4.2s or 1m5s.
+func elapsed(d time.Duration) string {
+	if d < time.Minute {
+		return d.Round(time.Millisecond * 100).String()
+	}
+	return d.Round(time.Second).String()
+}
@@ -0,0 +1,38 @@
+package cliprogress
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgress(t *testing.T) {
+	var b bytes.Buffer
+	p := New(&b, 2)
+
+	p.Step("Generating code", 4)
+	p.Add(3)
+	p.Step("Compiling", 0)
+	p.Fail()
+	p.Stop()
+
+	var (
+		durations  = regexp.MustCompile(`\([0-9.]+m?s\)`)
+		timestamps = regexp.MustCompile(`\[\d\d:\d\d:\d\d\] `)
+		output     = timestamps.ReplaceAllString(durations.ReplaceAllString(b.String(), "(0s)"), "")
+	)
+	require.Equal(t, `[1/2] Generating code [                        ] 0/4
+✔ [1/2] Generating code (0s)
+[2/2] Compiling
+✘ [2/2] Compiling (0s)
+`, output)
+}
+
+func TestBar(t *testing.T) {
+	require.Equal(t, "["+strings.Repeat(" ", barWidth)+"]", bar(0, 4))
+	require.Equal(t, "["+strings.Repeat("=", barWidth/4)+strings.Repeat(" ", barWidth*3/4)+"]", bar(1, 4))
+	require.Equal(t, "["+strings.Repeat("=", barWidth)+"]", bar(4, 4))
+}
@@ -0,0 +1,212 @@
+package cliquiz
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/goccy/go-yaml"
+)
+
+// EnvAnswerPrefix is the prefix of the env vars that answer questions. the rest of the name is
+// the key of the question in upper case with underscores, such as STARPORT_ANSWER_SOURCE_RPC.
+const EnvAnswerPrefix = "STARPORT_ANSWER_"
+
+// ErrNoAnswer is returned when a required question without a default answer has no answer while
+// questions are answered unattended.
+var ErrNoAnswer = errors.New("no answer")
+
+var (
+	// answers are the answers by the keys of questions, set with LoadAnswers or SetAnswers.
+	answers map[string]string
+
+	// unattended is true when questions are not asked because the answers are set.
+	unattended bool
+
+	// assumeYes is true when confirmations are answered with yes, set with AssumeYes.
+	assumeYes bool
+)
+
+// LoadAnswers loads the answers of an answer file at path, a YAML map of the keys of questions to
+// their answers:
+//
+//	source-rpc: http://localhost:26657
+//	source-gas-limit: 300000
+//	chains: [mars, venus]
+//
+// questions are not asked once answers are loaded, the questions without answers get their
+// default answers.
+func LoadAnswers(path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var values map[string]interface{}
+	if err := yaml.Unmarshal(b, &values); err != nil {
+		return fmt.Errorf("invalid answer file %s: %w", path, err)
+	}
+
+	loaded := make(map[string]string)
+	for key, value := range values {
+		switch v := value.(type) {
+		case nil:
+		case []interface{}:
+			var answers []string
+			for _, a := range v {
+				answers = append(answers, fmt.Sprintf("%v", a))
+			}
+			loaded[key] = strings.Join(answers, ",")
+		default:
+			loaded[key] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	SetAnswers(loaded)
+	return nil
+}
+
+// SetAnswers sets the answers by the keys of questions, questions are not asked from then on.
+// the answers of multi selections are comma separated.
+func SetAnswers(a map[string]string) {
+	answers = a
+	unattended = true
+}
+
+// AssumeYes answers questions with their default answers and confirmations with yes instead of
+// asking them. the answers that are set with SetAnswers, LoadAnswers or env vars come first.
+func AssumeYes() {
+	unattended = true
+	assumeYes = true
+}
+
+var nonAlphanumeric = regexp.MustCompile(`[^a-z0-9]+`)
+
+// key returns the key of the answer of q, the one set with Key or the question in lower case
+// with dashes, such as source-rpc for "Source RPC".
+func (q Question) key() string {
+	if q.answerKey != "" {
+		return q.answerKey
+	}
+	return strings.Trim(nonAlphanumeric.ReplaceAllString(strings.ToLower(q.question), "-"), "-")
+}
+
+// lookupAnswer returns the answer of q from the env or from the answers that are set, the env
+// comes first.
+func lookupAnswer(q Question) (string, bool) {
+	env := EnvAnswerPrefix + strings.ToUpper(strings.ReplaceAll(q.key(), "-", "_"))
+	if answer, ok := os.LookupEnv(env); ok {
+		return answer, true
+	}
+	answer, ok := answers[q.key()]
+	return answer, ok
+}
+
+// setAnswer validates answer like the answers of prompts and writes it to the answer of q.
+func (q Question) setAnswer(answer string) error {
+	answer = strings.TrimSpace(answer)
+
+	if err := q.writeAnswer(answer); err != nil {
+		return fmt.Errorf("answer %s: %w", q.key(), err)
+	}
+	return nil
+}
+
+func (q Question) writeAnswer(answer string) error {
+	if answer == "" {
+		if q.required && !q.confirm {
+			return ErrNoAnswer
+		}
+		return nil
+	}
+
+	if q.confirm {
+		confirmed, err := strconv.ParseBool(answer)
+		if err != nil {
+			if confirmed, err = parseYesNo(answer); err != nil {
+				return err
+			}
+		}
+		return core.WriteAnswer(q.answer, "", confirmed)
+	}
+
+	values := []string{answer}
+	if q.multiple {
+		values = nil
+		for _, v := range strings.Split(answer, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	for _, v := range values {
+		if len(q.choices) > 0 && !contains(q.choices, v) {
+			return fmt.Errorf("%q is not one of %s", v, strings.Join(q.choices, ", "))
+		}
+		if err := q.validate(v); err != nil {
+			return err
+		}
+	}
+
+	if q.multiple {
+		return q.writeChoices(values)
+	}
+	return core.WriteAnswer(q.answer, "", answer)
+}
+
+// setDefaultAnswer writes the default answer of q to its answer, questions without default
+// answers are left unanswered unless they are required.
+func (q Question) setDefaultAnswer() error {
+	if q.confirm && assumeYes {
+		return core.WriteAnswer(q.answer, "", true)
+	}
+
+	// selections get the same default answers as their prompts.
+	if len(q.choices) > 0 {
+		defaults := q.defaultChoices()
+		switch {
+		case len(defaults) == 0 && q.required:
+			return fmt.Errorf("answer %s: %w", q.key(), ErrNoAnswer)
+		case len(defaults) == 0:
+			return nil
+		case q.multiple:
+			return q.writeChoices(defaults)
+		}
+		return core.WriteAnswer(q.answer, "", defaults[0])
+	}
+	if q.defaultAnswer == nil {
+		if q.required && !q.confirm {
+			return fmt.Errorf("answer %s: %w", q.key(), ErrNoAnswer)
+		}
+		return nil
+	}
+
+	if reflect.TypeOf(q.defaultAnswer) == reflect.TypeOf(q.answer).Elem() {
+		reflect.ValueOf(q.answer).Elem().Set(reflect.ValueOf(q.defaultAnswer))
+		return nil
+	}
+	return q.setAnswer(fmt.Sprintf("%v", q.defaultAnswer))
+}
+
+// writeChoices writes the choices of a multi selection to its answer, WriteAnswer appends them
+// to the choices that are already in the answer otherwise.
+func (q Question) writeChoices(choices []string) error {
+	answer := reflect.ValueOf(q.answer).Elem()
+	answer.Set(reflect.Zero(answer.Type()))
+	return core.WriteAnswer(q.answer, "", choices)
+}
+
+func parseYesNo(answer string) (bool, error) {
+	switch strings.ToLower(answer) {
+	case "y", "yes":
+		return true, nil
+	case "n", "no":
+		return false, nil
+	}
+	return false, fmt.Errorf("%q is not yes or no", answer)
+}
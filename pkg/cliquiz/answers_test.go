@@ -0,0 +1,100 @@
+package cliquiz
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnswers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.yml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+source-rpc: http://localhost:26657
+source-gas-limit: 300000
+chains: [mars, venus]
+delete: yes
+`), 0644))
+	require.NoError(t, LoadAnswers(path))
+	require.NoError(t, os.Setenv(EnvAnswerPrefix+"BACKEND", "test"))
+	t.Cleanup(func() {
+		answers, unattended, assumeYes = nil, false, false
+		os.Unsetenv(EnvAnswerPrefix + "BACKEND")
+	})
+
+	var (
+		rpc      string
+		gasLimit int64
+		chains   []string
+		deleted  bool
+		backend  string
+		prefix   string
+		faucet   string
+	)
+	require.NoError(t, Ask(
+		NewQuestion("Source RPC", &rpc, Required(), Validate(URL)),
+		NewQuestion("Source Gas Limit", &gasLimit, Required()),
+		NewQuestion("Chains", &chains, MultiSelect("mars", "venus", "earth")),
+		NewQuestion("Delete account mars?", &deleted, Confirm(), Key("delete")),
+		NewQuestion("Keyring Backend", &backend, Select("os", "test"), Key("backend")),
+		NewQuestion("Source Address Prefix", &prefix, DefaultAnswer("cosmos"), Required()),
+		NewQuestion("Source Faucet", &faucet),
+	))
+	require.Equal(t, "http://localhost:26657", rpc)
+	require.Equal(t, int64(300000), gasLimit)
+	require.Equal(t, []string{"mars", "venus"}, chains)
+	require.True(t, deleted)
+	require.Equal(t, "test", backend)
+	require.Equal(t, "cosmos", prefix)
+	require.Empty(t, faucet)
+
+	var target string
+	err := Ask(NewQuestion("Target RPC", &target, Required()))
+	require.ErrorIs(t, err, ErrNoAnswer)
+
+	SetAnswers(map[string]string{"source-rpc": "htp:/localhost", "keyring-backend": "file"})
+	require.Error(t, Ask(NewQuestion("Source RPC", &rpc, Validate(URL))))
+	require.Error(t, Ask(NewQuestion("Keyring Backend", &backend, Select("os", "test"))))
+
+	AssumeYes()
+	deleted = false
+	require.NoError(t, Ask(NewQuestion("Delete account venus?", &deleted, Confirm())))
+	require.True(t, deleted)
+}
+
+func TestSelectionAnswers(t *testing.T) {
+	t.Cleanup(func() { answers, unattended, assumeYes = nil, false, false })
+
+	// the selections without answers get the default answers that are some of their choices.
+	AssumeYes()
+	var (
+		backend string
+		chains  []string
+		planets []string
+	)
+	require.NoError(t, Ask(
+		NewQuestion("Keyring Backend", &backend, Select("os", "test"), DefaultAnswer("test")),
+		NewQuestion("Chains", &chains, MultiSelect("mars", "venus", "earth"), DefaultAnswer([]string{"earth", "pluto"})),
+		NewQuestion("Planets", &planets, MultiSelect("mars", "venus"), DefaultAnswer("pluto")),
+	))
+	require.Equal(t, "test", backend)
+	require.Equal(t, []string{"earth"}, chains)
+	require.Empty(t, planets)
+
+	err := Ask(NewQuestion("Keyring Backend", &backend, Select("os", "test"), DefaultAnswer("file"), Required()))
+	require.ErrorIs(t, err, ErrNoAnswer)
+
+	// the answers that are set come before the default answers.
+	SetAnswers(map[string]string{"keyring-backend": "os", "chains": "mars, venus", "planets": "pluto"})
+	require.NoError(t, Ask(
+		NewQuestion("Keyring Backend", &backend, Select("os", "test"), DefaultAnswer("test")),
+		NewQuestion("Chains", &chains, MultiSelect("mars", "venus", "earth"), DefaultAnswer([]string{"earth"})),
+	))
+	require.Equal(t, "os", backend)
+	require.Equal(t, []string{"mars", "venus"}, chains)
+
+	err = Ask(NewQuestion("Planets", &planets, MultiSelect("mars", "venus")))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `"pluto" is not one of mars, venus`)
+}
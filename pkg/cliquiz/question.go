@@ -0,0 +1,387 @@
+// Package cliquiz is a tool to collect answers from the users on cli.
+package cliquiz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/spf13/pflag"
+)
+
+// ErrConfirmationFailed is returned when second answer is not the same with first one.
+var ErrConfirmationFailed = errors.New("failed to confirm, your answers were different")
+
+// Question holds information on what to ask to user and where
+// the answer stored at.
+type Question struct {
+	question      string
+	defaultAnswer interface{}
+	answer        interface{}
+	hidden        bool
+	confirm       bool
+	shouldConfirm bool
+	required      bool
+	choices       []string
+	multiple      bool
+	validators    []func(string) error
+	answerKey     string
+}
+
+// Option configures Question.
+type Option func(*Question)
+
+// DefaultAnswer sets a default answer to Question.
+func DefaultAnswer(answer interface{}) Option {
+	return func(q *Question) {
+		q.defaultAnswer = answer
+	}
+}
+
+// Required marks the answer as required.
+func Required() Option {
+	return func(q *Question) {
+		q.required = true
+	}
+}
+
+// Hidden hides the answer while it's typed to prevent secret information such as mnemonics and
+// passphrases being leaked.
+func Hidden() Option {
+	return func(q *Question) {
+		q.hidden = true
+	}
+}
+
+// HideAnswer hides the answer to prevent secret information being leaked.
+//
+// Deprecated: use Hidden.
+func HideAnswer() Option {
+	return Hidden()
+}
+
+// Confirm makes the question a yes or no confirmation, such as before destructive actions. the
+// answer must be a *bool, no is the default answer unless it's set with DefaultAnswer.
+func Confirm() Option {
+	return func(q *Question) {
+		q.confirm = true
+	}
+}
+
+// GetConfirmation prompts confirmation for the given answer.
+func GetConfirmation() Option {
+	return func(q *Question) {
+		q.shouldConfirm = true
+	}
+}
+
+// Select makes the question a selection of one of choices with the arrow keys, typing filters
+// the choices. the answer must be a *string.
+func Select(choices ...string) Option {
+	return func(q *Question) {
+		q.choices = choices
+		q.multiple = false
+	}
+}
+
+// MultiSelect makes the question a selection of any of choices with the arrow keys and the
+// space key, typing filters the choices. the answer must be a *[]string.
+func MultiSelect(choices ...string) Option {
+	return func(q *Question) {
+		q.choices = choices
+		q.multiple = true
+	}
+}
+
+// Validate validates the answer when it's entered, the question is asked again until the answer
+// is valid. empty answers are not validated, they are rejected with Required.
+func Validate(validate func(answer string) error) Option {
+	return func(q *Question) {
+		q.validators = append(q.validators, validate)
+	}
+}
+
+// Key sets the key of the answer of the question in answer files and env vars, the question in
+// lower case with dashes by default.
+func Key(key string) Option {
+	return func(q *Question) {
+		q.answerKey = key
+	}
+}
+
+// NewQuestion creates a new question.
+func NewQuestion(question string, answer interface{}, options ...Option) Question {
+	q := Question{
+		question: question,
+		answer:   answer,
+	}
+	for _, o := range options {
+		o(&q)
+	}
+	return q
+}
+
+// stdio is the terminal that questions are asked on, the one that the program is started with so
+// questions are still asked when the output of the program is silenced.
+var stdio = terminal.Stdio{In: os.Stdin, Out: os.Stdout, Err: os.Stderr}
+
+// selectPageSize is the number of choices that are shown at once by selections.
+const selectPageSize = 10
+
+func ask(q Question) error {
+	// the answers that are set are not asked.
+	if answer, ok := lookupAnswer(q); ok {
+		return q.setAnswer(answer)
+	}
+	if unattended {
+		return q.setDefaultAnswer()
+	}
+
+	if err := survey.AskOne(
+		q.prompt(),
+		q.answer,
+		survey.WithValidator(q.validate),
+		survey.WithStdio(stdio.In, stdio.Out, stdio.Err),
+	); err != nil {
+		return err
+	}
+
+	isValid := func() bool {
+		if answer, ok := q.answer.(string); ok {
+			if strings.TrimSpace(answer) == "" {
+				return false
+			}
+		}
+		answer := reflect.ValueOf(q.answer).Elem()
+		if answer.IsZero() || (answer.Kind() == reflect.Slice && answer.Len() == 0) {
+			return false
+		}
+		return true
+	}
+
+	// no is a valid answer to confirmations.
+	if q.required && !q.confirm && !isValid() {
+		fmt.Println("This information is required, please retry:")
+
+		if err := ask(q); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// prompt returns the prompt that asks q, the default answers of selections are only selected
+// when they are some of the choices.
+func (q Question) prompt() survey.Prompt {
+	var prompt survey.Prompt
+
+	switch {
+	case q.confirm:
+		confirm := &survey.Confirm{
+			Message: q.question,
+		}
+		if answer, ok := q.defaultAnswer.(bool); ok {
+			confirm.Default = answer
+		}
+		prompt = confirm
+	case q.hidden:
+		prompt = &survey.Password{
+			Message: q.question,
+		}
+	case len(q.choices) > 0 && q.multiple:
+		multiSelect := &survey.MultiSelect{
+			Message:  q.question,
+			Options:  q.choices,
+			PageSize: selectPageSize,
+		}
+		if !q.required {
+			multiSelect.Message += " (optional)"
+		}
+		if defaults := q.defaultChoices(); len(defaults) > 0 {
+			multiSelect.Default = defaults
+		}
+		prompt = multiSelect
+	case len(q.choices) > 0:
+		selection := &survey.Select{
+			Message:  q.question,
+			Options:  q.choices,
+			PageSize: selectPageSize,
+		}
+		if defaults := q.defaultChoices(); len(defaults) > 0 {
+			selection.Default = defaults[0]
+		}
+		prompt = selection
+	default:
+		input := &survey.Input{
+			Message: q.question,
+		}
+		if !q.required {
+			input.Message += " (optional)"
+		}
+		if q.defaultAnswer != nil {
+			input.Default = fmt.Sprintf("%v", q.defaultAnswer)
+		}
+		prompt = input
+	}
+	return prompt
+}
+
+// defaultChoices returns the default answers of a selection that are some of its choices, the
+// default answers of multi selections are a []string or comma separated.
+func (q Question) defaultChoices() []string {
+	var defaults []string
+	switch answer := q.defaultAnswer.(type) {
+	case nil:
+		return nil
+	case []string:
+		defaults = answer
+	default:
+		defaults = []string{fmt.Sprintf("%v", answer)}
+		if q.multiple {
+			defaults = strings.Split(defaults[0], ",")
+		}
+	}
+
+	var selected []string
+	for _, answer := range defaults {
+		if answer = strings.TrimSpace(answer); contains(q.choices, answer) {
+			selected = append(selected, answer)
+		}
+	}
+	if !q.multiple && len(selected) > 1 {
+		selected = selected[:1]
+	}
+	return selected
+}
+
+// validate validates the answer of a prompt with the validators of the question.
+func (q Question) validate(ans interface{}) error {
+	var answer string
+	switch a := ans.(type) {
+	case string:
+		answer = a
+	case core.OptionAnswer:
+		answer = a.Value
+	default:
+		return nil
+	}
+
+	if strings.TrimSpace(answer) == "" {
+		return nil
+	}
+	for _, validate := range q.validators {
+		if err := validate(answer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks questions and collect answers.
+func Ask(question ...Question) (err error) {
+	defer func() {
+		if err == terminal.InterruptErr {
+			err = context.Canceled
+		}
+	}()
+
+	for _, q := range question {
+		if err := ask(q); err != nil {
+			return err
+		}
+
+		// the answers that are set are not confirmed.
+		if _, ok := lookupAnswer(q); q.shouldConfirm && !ok && !unattended {
+			var secondAnswer string
+
+			options := []Option{}
+			if q.required {
+				options = append(options, Required())
+			}
+			if q.hidden {
+				options = append(options, Hidden())
+			}
+			if err := ask(NewQuestion("Confirm "+q.question, &secondAnswer, options...)); err != nil {
+				return err
+			}
+
+			t := reflect.TypeOf(secondAnswer)
+			compAnswer := reflect.ValueOf(q.answer).Elem().Convert(t).String()
+			if secondAnswer != compAnswer {
+				return ErrConfirmationFailed
+			}
+		}
+	}
+	return nil
+}
+
+// Flag represents a cmd flag.
+type Flag struct {
+	Name       string
+	IsRequired bool
+}
+
+// NewFlag creates a new flag.
+func NewFlag(name string, isRequired bool) Flag {
+	return Flag{name, isRequired}
+}
+
+// ValuesFromFlagsOrAsk returns values of flags within map[string]string where map's
+// key is the name of the flag and value is flag's value.
+// when provided, values are collected through command otherwise they're asked to user by prompting.
+// title used as a message while prompting.
+func ValuesFromFlagsOrAsk(fset *pflag.FlagSet, title string, flags ...Flag) (values map[string]string, err error) {
+	values = make(map[string]string)
+
+	answers := make(map[string]*string)
+	var questions []Question
+
+	for _, f := range flags {
+		flag := fset.Lookup(f.Name)
+		if flag == nil {
+			return nil, fmt.Errorf("flag %q is not defined", f.Name)
+		}
+		if value, _ := fset.GetString(f.Name); value != "" {
+			values[f.Name] = value
+			continue
+		}
+
+		var value string
+		answers[f.Name] = &value
+
+		options := []Option{Key(f.Name)}
+		if f.IsRequired {
+			options = append(options, Required())
+		}
+		questions = append(questions, NewQuestion(flag.Usage, &value, options...))
+	}
+
+	if len(questions) > 0 && title != "" {
+		fmt.Println(title)
+	}
+	if err := Ask(questions...); err != nil {
+		return values, err
+	}
+
+	for name, answer := range answers {
+		values[name] = *answer
+	}
+
+	return values, nil
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package cliquiz
+
+import (
+	"testing"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectDefault(t *testing.T) {
+	var backend string
+
+	prompt := NewQuestion("Keyring Backend", &backend, Select("os", "test"), DefaultAnswer("test")).prompt()
+	require.IsType(t, &survey.Select{}, prompt)
+	require.Equal(t, "test", prompt.(*survey.Select).Default)
+
+	// the default answers that are not one of the choices are not selected.
+	prompt = NewQuestion("Keyring Backend", &backend, Select("os", "test"), DefaultAnswer("file")).prompt()
+	require.Nil(t, prompt.(*survey.Select).Default)
+
+	prompt = NewQuestion("Keyring Backend", &backend, Select("os", "test")).prompt()
+	require.Nil(t, prompt.(*survey.Select).Default)
+}
+
+func TestMultiSelectDefault(t *testing.T) {
+	var chains []string
+
+	prompt := NewQuestion("Chains", &chains, MultiSelect("mars", "venus", "earth"), DefaultAnswer([]string{"venus", "pluto", "mars"})).prompt()
+	require.IsType(t, &survey.MultiSelect{}, prompt)
+	require.Equal(t, []string{"venus", "mars"}, prompt.(*survey.MultiSelect).Default)
+	require.Equal(t, "Chains (optional)", prompt.(*survey.MultiSelect).Message)
+
+	prompt = NewQuestion("Chains", &chains, MultiSelect("mars", "venus"), DefaultAnswer("venus, mars"), Required()).prompt()
+	require.Equal(t, []string{"venus", "mars"}, prompt.(*survey.MultiSelect).Default)
+	require.Equal(t, "Chains", prompt.(*survey.MultiSelect).Message)
+
+	prompt = NewQuestion("Chains", &chains, MultiSelect("mars", "venus"), DefaultAnswer([]string{"pluto"})).prompt()
+	require.Nil(t, prompt.(*survey.MultiSelect).Default)
+}
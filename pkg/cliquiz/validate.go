@@ -0,0 +1,84 @@
+package cliquiz
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// URL validates that the answer is a URL with a host, such as the address of a node. the scheme
+// is optional and defaults to http.
+func URL(answer string) error {
+	raw := answer
+	if !strings.Contains(raw, "://") {
+		raw = "http://" + raw
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || u.Hostname() == "" || strings.HasSuffix(u.Host, ":") {
+		return fmt.Errorf("%q is not a valid URL", answer)
+	}
+	switch u.Scheme {
+	case "http", "https", "tcp", "ws", "wss":
+	default:
+		return fmt.Errorf("%q has an unsupported scheme %q", answer, u.Scheme)
+	}
+	if port := u.Port(); port != "" {
+		if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			return fmt.Errorf("%q has an invalid port %q", answer, port)
+		}
+	}
+	return nil
+}
+
+// Bech32Address returns a validator for bech32 addresses with prefix, or with any prefix when
+// prefix is empty.
+func Bech32Address(prefix string) func(string) error {
+	return func(answer string) error {
+		hrp, _, err := bech32.DecodeAndConvert(answer)
+		if err != nil {
+			return fmt.Errorf("%q is not a valid bech32 address", answer)
+		}
+		if prefix != "" && hrp != prefix {
+			return fmt.Errorf("%q doesn't have the address prefix %q", answer, prefix)
+		}
+		return nil
+	}
+}
+
+// Coin validates that the answer is an amount of a denom, such as 1000stake.
+func Coin(answer string) error {
+	// coins are parsed as decimal coins so decimal amounts are not truncated silently.
+	coin, err := types.ParseDecCoin(answer)
+	if err != nil || !coin.Amount.IsInteger() {
+		return fmt.Errorf("%q is not a valid coin, such as 1000stake", answer)
+	}
+	return nil
+}
+
+// DecCoin validates that the answer is a decimal amount of a denom, such as the gas price
+// 0.025stake.
+func DecCoin(answer string) error {
+	if _, err := types.ParseDecCoin(answer); err != nil {
+		return fmt.Errorf("%q is not a valid decimal coin, such as 0.025stake", answer)
+	}
+	return nil
+}
+
+// IntRange returns a validator for integers from min to max.
+func IntRange(min, max int64) func(string) error {
+	return func(answer string) error {
+		n, err := strconv.ParseInt(answer, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", answer)
+		}
+		if n < min || n > max {
+			return fmt.Errorf("%d is not from %d to %d", n, min, max)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,59 @@
+package cliquiz
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidators(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate func(string) error
+		valid    []string
+		invalid  []string
+	}{
+		{
+			name:     "url",
+			validate: URL,
+			valid:    []string{"http://localhost:26657", "https://rpc.cosmos.network:443", "tcp://0.0.0.0:26657", "localhost:26657"},
+			invalid:  []string{"http://", "ftp://localhost", "localhost:99999", "http://local host", "htp:/localhost"},
+		},
+		{
+			name:     "bech32 address",
+			validate: Bech32Address("cosmos"),
+			valid:    []string{"cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02"},
+			invalid:  []string{"cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd03", "osmo1hsk6jryyqjfhp5dhc55tc9jtckygx0epjk7ddk", "alice"},
+		},
+		{
+			name:     "coin",
+			validate: Coin,
+			valid:    []string{"1000stake", "1ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"},
+			invalid:  []string{"stake", "1.5stake", "1000"},
+		},
+		{
+			name:     "dec coin",
+			validate: DecCoin,
+			valid:    []string{"0.025stake", "1stake"},
+			invalid:  []string{"0,025stake", "stake", ".025"},
+		},
+		{
+			name:     "int range",
+			validate: IntRange(1, math.MaxInt64),
+			valid:    []string{"1", "300000"},
+			invalid:  []string{"0", "-1", "1e6", "many"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, answer := range tt.valid {
+				require.NoError(t, tt.validate(answer), answer)
+			}
+			for _, answer := range tt.invalid {
+				require.Error(t, tt.validate(answer), answer)
+			}
+		})
+	}
+}
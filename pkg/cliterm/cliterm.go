@@ -0,0 +1,109 @@
+// Package cliterm adapts the output of Starport to where it's written. spinners and redrawn
+// status lines are meant for terminals, they are written as plain timestamped log lines
+// elsewhere, such as in CI logs.
+package cliterm
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mattn/go-isatty"
+)
+
+// TimeFormat is the format of the timestamps of log lines.
+const TimeFormat = "15:04:05"
+
+// IsTerminal checks if w is a terminal.
+func IsTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+}
+
+// IsCI checks if Starport runs in CI, from the CI env var that's set by CI services such as
+// GitHub Actions, GitLab CI and CircleCI.
+func IsCI() bool {
+	ci, err := strconv.ParseBool(os.Getenv("CI"))
+	return err == nil && ci
+}
+
+// IsAnimated checks if the output of w can be animated, when it's a terminal outside of CI.
+func IsAnimated(w io.Writer) bool {
+	return IsTerminal(w) && !IsCI()
+}
+
+// Timestamp prefixes line with the current time.
+func Timestamp(line string) string {
+	return fmt.Sprintf("[%s] %s", time.Now().Format(TimeFormat), line)
+}
+
+// controlSequences are the chars that spinners erase and redraw their frames with, and the
+// escape sequences of colors and cursor moves.
+var controlSequences = regexp.MustCompile("\b|\x7f|\r|\x1b\\[[0-9;?]*[a-zA-Z]")
+
+// Strip removes the control chars and the escape sequences of s, such as its colors.
+func Strip(s string) string {
+	return controlSequences.ReplaceAllString(s, "")
+}
+
+// spinnerLog writes the frames of spinners as log lines.
+type spinnerLog struct {
+	w io.Writer
+
+	mu   sync.Mutex
+	last []rune
+}
+
+// NewSpinnerLog returns a writer for spinners that writes a timestamped log line to w each time
+// the text of the spinner changes, instead of its animated frames.
+func NewSpinnerLog(w io.Writer) io.Writer {
+	return &spinnerLog{w: w}
+}
+
+func (l *spinnerLog) Write(p []byte) (int, error) {
+	frame := []rune(strings.TrimSpace(Strip(string(p))))
+
+	// the writes that only erase the previous frame are not logged.
+	if len(frame) == 0 {
+		return len(p), nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// consecutive frames of the same text only differ by the animated char of the spinner.
+	isSameText := isAnimationOf(l.last, frame)
+	l.last = frame
+	if isSameText {
+		return len(p), nil
+	}
+
+	if _, err := fmt.Fprintln(l.w, Timestamp(string(frame))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// isAnimationOf checks if frame is the next frame of the animation of previous, when they only
+// differ by one char.
+func isAnimationOf(previous, frame []rune) bool {
+	if len(previous) != len(frame) {
+		return false
+	}
+
+	var diff int
+	for i := range frame {
+		if frame[i] != previous[i] {
+			diff++
+		}
+	}
+	return diff <= 1
+}
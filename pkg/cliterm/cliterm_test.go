@@ -0,0 +1,29 @@
+package cliterm
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpinnerLog(t *testing.T) {
+	var b bytes.Buffer
+	w := NewSpinnerLog(&b)
+
+	frames := []string{"◐ Scaffolding... ", "◓ Scaffolding... ", "◑ Scaffolding... ", "\x1b[34m◐\x1b[0m Building... "}
+	for _, frame := range frames {
+		fmt.Fprint(w, "\b\b\x7f\x7f")
+		fmt.Fprint(w, "\r\x1b[K")
+		fmt.Fprint(w, frame)
+	}
+
+	timestamps := regexp.MustCompile(`\[\d\d:\d\d:\d\d\]`)
+	require.Equal(t, "[t] ◐ Scaffolding...\n[t] ◐ Building...\n", timestamps.ReplaceAllString(b.String(), "[t]"))
+}
+
+func TestIsAnimated(t *testing.T) {
+	require.False(t, IsAnimated(&bytes.Buffer{}))
+}
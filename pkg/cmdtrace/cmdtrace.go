@@ -0,0 +1,161 @@
+// Package cmdtrace traces the external commands that Starport runs, such as protoc, go build
+// and node programs, into a trace file with their args, durations and exit statuses. tracing is
+// off until it's enabled, so commands can be traced anywhere without checking it first.
+package cmdtrace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+// Statuses of traced commands, exited commands are traced with their exit codes.
+const (
+	StatusOK       = "ok"
+	StatusCanceled = "canceled"
+	StatusFailed   = "failed"
+)
+
+var (
+	mu sync.Mutex
+	w  io.Writer
+)
+
+// Enable starts tracing commands into the file at path, header is written first to tell which
+// run of Starport the trace is of. the file is overwritten.
+func Enable(path, header string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(f, "# %s (%s)\n", header, time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return err
+	}
+	EnableWriter(f)
+	return nil
+}
+
+// EnableWriter starts tracing commands into tw.
+func EnableWriter(tw io.Writer) {
+	mu.Lock()
+	defer mu.Unlock()
+	w = tw
+}
+
+// IsEnabled checks if commands are traced.
+func IsEnabled() bool {
+	mu.Lock()
+	defer mu.Unlock()
+	return w != nil
+}
+
+// Start starts tracing the run of command with args, the returned func ends it with the error
+// that the command exited with. nothing is traced when tracing is off.
+func Start(command string, args ...string) (end func(err error)) {
+	if !IsEnabled() {
+		return func(error) {}
+	}
+
+	start := time.Now()
+	return func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			start.Format("15:04:05.000"),
+			time.Since(start).Round(time.Millisecond),
+			Status(err),
+			commandLine(command, args),
+		)
+	}
+}
+
+// Run runs run and traces it as the run of command with args, for the commands that are run by
+// packages whose steps cannot be traced with Step.
+func Run(run func() error, command string, args ...string) error {
+	end := Start(command, args...)
+	err := run()
+	end(err)
+	return err
+}
+
+// Step traces the step that it's an option of, it must be set after the options that set the
+// hooks of the step.
+func Step() step.Option {
+	return func(s *step.Step) {
+		if !IsEnabled() {
+			return
+		}
+
+		var (
+			end       = func(error) {}
+			preExec   = s.PreExec
+			postExecs = s.PostExecs
+		)
+
+		s.PreExec = func() error {
+			if preExec != nil {
+				if err := preExec(); err != nil {
+					return err
+				}
+			}
+			end = Start(s.Exec.Command, s.Exec.Args...)
+			return nil
+		}
+
+		// the post hooks of the step are run as the runner does, the error of the command is
+		// returned only when the step has no hooks.
+		s.PostExecs = []func(error) error{func(exitErr error) error {
+			end(exitErr)
+			if len(postExecs) == 0 {
+				return exitErr
+			}
+			for _, hook := range postExecs {
+				if err := hook(exitErr); err != nil {
+					return err
+				}
+			}
+			return nil
+		}}
+	}
+}
+
+// Status returns the status of a command that exited with err.
+func Status(err error) string {
+	var exitErr *exec.ExitError
+
+	switch {
+	case err == nil:
+		return StatusOK
+	case errors.Is(err, context.Canceled):
+		return StatusCanceled
+	case errors.As(err, &exitErr):
+		return fmt.Sprintf("exit %d", exitErr.ExitCode())
+	default:
+		return StatusFailed
+	}
+}
+
+// commandLine returns command and args as they're typed in a shell.
+func commandLine(command string, args []string) string {
+	words := []string{command}
+	for _, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " \t\"'") {
+			arg = strconv.Quote(arg)
+		}
+		words = append(words, arg)
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,58 @@
+package cmdtrace
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+func TestStep(t *testing.T) {
+	run := func(options ...step.Option) error {
+		return cmdrunner.New().Run(context.Background(), step.New(append(options, Step())...))
+	}
+
+	// nothing is traced before tracing is enabled.
+	require.NoError(t, run(step.Exec("sh", "-c", "exit 0")))
+
+	var trace bytes.Buffer
+	EnableWriter(&trace)
+	defer EnableWriter(nil)
+
+	require.NoError(t, run(step.Exec("sh", "-c", "exit 0")))
+	require.Error(t, run(step.Exec("sh", "-c", "exit 3")))
+	require.Error(t, run(step.Exec("starport-that-does-not-exist")))
+
+	// the hooks of steps still decide their errors.
+	var hookErr error
+	require.NoError(t, run(
+		step.Exec("sh", "-c", "exit 2"),
+		step.PostExec(func(err error) error {
+			hookErr = err
+			return nil
+		}),
+	))
+	require.Error(t, hookErr)
+
+	lines := strings.Split(strings.TrimSpace(trace.String()), "\n")
+	require.Len(t, lines, 4)
+	for i, want := range []string{
+		"ok\tsh -c \"exit 0\"",
+		"exit 3\tsh -c \"exit 3\"",
+		"failed\tstarport-that-does-not-exist",
+		"exit 2\tsh -c \"exit 2\"",
+	} {
+		require.True(t, strings.HasSuffix(lines[i], want), lines[i])
+	}
+}
+
+func TestStatus(t *testing.T) {
+	require.Equal(t, StatusOK, Status(nil))
+	require.Equal(t, StatusCanceled, Status(context.Canceled))
+	require.Equal(t, StatusFailed, Status(errors.New("failed")))
+}
@@ -0,0 +1,96 @@
+package cosmosaccount
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// AddressForms is an address encoded in the forms that Cosmos SDK chains use for the same bytes.
+type AddressForms struct {
+	// Hex is the hex encoding of the address bytes.
+	Hex string
+
+	// Account is the account address, such as cosmos1...
+	Account string
+
+	// Validator is the validator operator address, such as cosmosvaloper1...
+	Validator string
+
+	// Consensus is the consensus node address, such as cosmosvalcons1...
+	Consensus string
+}
+
+// ParseAddress decodes a bech32 address of any prefix or a hex address into its bytes.
+// the prefix is empty for hex addresses.
+func ParseAddress(address string) (prefix string, addr []byte, err error) {
+	if prefix, addr, err = bech32.DecodeAndConvert(address); err == nil {
+		return prefix, addr, nil
+	}
+
+	if addr, err = hex.DecodeString(strings.TrimPrefix(strings.ToLower(address), "0x")); err == nil && len(addr) > 0 {
+		return "", addr, nil
+	}
+
+	return "", nil, fmt.Errorf("%q is neither a bech32 nor a hex address", address)
+}
+
+// ConvertAddress re-encodes a bech32 or hex address with the bech32 prefix.
+func ConvertAddress(address, prefix string) (string, error) {
+	_, addr, err := ParseAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return bech32.ConvertAndEncode(prefix, addr)
+}
+
+// ConvertAddressForms re-encodes a bech32 or hex address in all of its forms for the account
+// prefix of a chain. the account prefix is the one of address when prefix is empty, validator
+// and consensus prefixes are derived from it the same way the Cosmos SDK does.
+func ConvertAddressForms(address, prefix string) (AddressForms, error) {
+	addrPrefix, addr, err := ParseAddress(address)
+	if err != nil {
+		return AddressForms{}, err
+	}
+
+	if prefix == "" {
+		prefix = accountPrefix(addrPrefix)
+	}
+	if prefix == "" {
+		return AddressForms{}, fmt.Errorf("a prefix is required to convert the hex address %s", address)
+	}
+
+	forms := AddressForms{Hex: strings.ToUpper(hex.EncodeToString(addr))}
+
+	for _, f := range []struct {
+		out    *string
+		prefix string
+	}{
+		{&forms.Account, prefix},
+		{&forms.Validator, prefix + sdktypes.PrefixValidator + sdktypes.PrefixOperator},
+		{&forms.Consensus, prefix + sdktypes.PrefixValidator + sdktypes.PrefixConsensus},
+	} {
+		if *f.out, err = bech32.ConvertAndEncode(f.prefix, addr); err != nil {
+			return AddressForms{}, err
+		}
+	}
+
+	return forms, nil
+}
+
+// accountPrefix returns the account prefix of a chain from any of its address prefixes.
+func accountPrefix(prefix string) string {
+	for _, suffix := range []string{
+		sdktypes.PrefixValidator + sdktypes.PrefixOperator,
+		sdktypes.PrefixValidator + sdktypes.PrefixConsensus,
+	} {
+		if strings.HasSuffix(prefix, suffix) {
+			return strings.TrimSuffix(prefix, suffix)
+		}
+	}
+	return prefix
+}
@@ -0,0 +1,36 @@
+package cosmosaccount
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertAddressForms(t *testing.T) {
+	const (
+		hexAddr = "1A2B3C4D5E6F708192A3B4C5D6E7F8091A2B3C4D"
+		account = "cosmos1rg4ncn27dacgry4rknzadelcpydzk0zdngxhna"
+	)
+
+	forms, err := ConvertAddressForms(account, "")
+	require.NoError(t, err)
+	require.Equal(t, hexAddr, forms.Hex)
+	require.Equal(t, account, forms.Account)
+
+	fromValoper, err := ConvertAddressForms(forms.Validator, "")
+	require.NoError(t, err)
+	require.Equal(t, forms, fromValoper)
+
+	fromHex, err := ConvertAddressForms("0x"+hexAddr, "cosmos")
+	require.NoError(t, err)
+	require.Equal(t, forms, fromHex)
+
+	_, err = ConvertAddressForms(hexAddr, "")
+	require.Error(t, err)
+
+	converted, err := ConvertAddress(account, "osmo")
+	require.NoError(t, err)
+	back, err := ConvertAddress(converted, "cosmos")
+	require.NoError(t, err)
+	require.Equal(t, account, back)
+}
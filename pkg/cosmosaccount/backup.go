@@ -0,0 +1,309 @@
+package cosmosaccount
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	dkeyring "github.com/99designs/keyring"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	backupVersion = 1
+	backupKDF     = "scrypt"
+	backupCipher  = "aes-256-gcm"
+	backupKeyLen  = 32
+
+	// backupTypeAddress is the type of watch-only accounts of addresses in backups.
+	backupTypeAddress = "address"
+)
+
+// ErrBackupPassphrase is returned when a backup cannot be decrypted with the passphrase.
+var ErrBackupPassphrase = errors.New("could not decrypt the backup with the given passphrase")
+
+// backup is the encrypted archive of the accounts of a keyring.
+type backup struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	Cipher     string `json:"cipher"`
+	ScryptN    int    `json:"scrypt_n"`
+	ScryptR    int    `json:"scrypt_r"`
+	ScryptP    int    `json:"scrypt_p"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	CipherText []byte `json:"ciphertext"`
+}
+
+// backupAccount is an account in a backup, name is the name of its key including its namespace.
+type backupAccount struct {
+	Name    string          `json:"name"`
+	Type    string          `json:"type"`
+	Armor   string          `json:"armor,omitempty"`
+	PubKey  json.RawMessage `json:"pubkey,omitempty"`
+	Algo    string          `json:"algo,omitempty"`
+	Address []byte          `json:"address,omitempty"`
+}
+
+// Backup exports all the accounts of the keyring, in all namespaces, into a single archive that's
+// encrypted with passphrase. Ledger accounts are skipped since their keys are on the device.
+func (r Registry) Backup(passphrase string) (archive []byte, skipped []string, err error) {
+	if passphrase == "" {
+		return nil, nil, errors.New("a passphrase is required to encrypt the backup")
+	}
+
+	infos, err := r.Keyring.List()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cdc := pubKeyCodec()
+
+	var accounts []backupAccount
+
+	for _, info := range infos {
+		acc := backupAccount{
+			Name: info.GetName(),
+			Type: info.GetType().String(),
+			Algo: string(info.GetAlgo()),
+		}
+
+		switch info.GetType() {
+		case keyring.TypeLedger:
+			skipped = append(skipped, info.GetName())
+			continue
+
+		case keyring.TypeLocal:
+			if acc.Armor, err = r.Keyring.ExportPrivKeyArmor(info.GetName(), migrationPassphrase); err != nil {
+				return nil, nil, err
+			}
+
+		default:
+			if acc.PubKey, err = cdc.MarshalInterfaceJSON(info.GetPubKey()); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		accounts = append(accounts, acc)
+	}
+
+	addresses, err := r.allWatchOnlyAddresses()
+	if err != nil {
+		return nil, nil, err
+	}
+	accounts = append(accounts, addresses...)
+
+	data, err := json.Marshal(accounts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := backup{
+		Version: backupVersion,
+		KDF:     backupKDF,
+		Cipher:  backupCipher,
+		ScryptN: keystoreScryptN,
+		ScryptR: keystoreScryptR,
+		ScryptP: keystoreScryptP,
+		Salt:    make([]byte, 32),
+	}
+	if _, err := rand.Read(b.Salt); err != nil {
+		return nil, nil, err
+	}
+
+	aead, err := backupAEAD(passphrase, b)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(b.Nonce); err != nil {
+		return nil, nil, err
+	}
+	b.CipherText = aead.Seal(nil, b.Nonce, data, nil)
+
+	archive, err = json.MarshalIndent(b, "", "  ")
+	return archive, skipped, err
+}
+
+// Restore imports the accounts of a backup that's encrypted with passphrase. accounts are
+// restored with their namespaces, they're skipped when the keyring already has a key with
+// the same name.
+func (r Registry) Restore(archive []byte, passphrase string) (restored, skipped []string, err error) {
+	var b backup
+	if err := json.Unmarshal(archive, &b); err != nil {
+		return nil, nil, fmt.Errorf("invalid backup: %w", err)
+	}
+	if b.Version != backupVersion || b.KDF != backupKDF || b.Cipher != backupCipher {
+		return nil, nil, fmt.Errorf("unsupported backup version %d", b.Version)
+	}
+
+	aead, err := backupAEAD(passphrase, b)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := aead.Open(nil, b.Nonce, b.CipherText, nil)
+	if err != nil {
+		return nil, nil, ErrBackupPassphrase
+	}
+
+	var accounts []backupAccount
+	if err := json.Unmarshal(data, &accounts); err != nil {
+		return nil, nil, err
+	}
+
+	// the namespace of each account is part of its name.
+	global := r
+	global.namespace = ""
+
+	for _, acc := range accounts {
+		exists, err := global.keyExists(acc.Name)
+		if err != nil {
+			return restored, skipped, err
+		}
+		if exists {
+			skipped = append(skipped, acc.Name)
+			continue
+		}
+
+		if err := global.restoreAccount(acc); err != nil {
+			return restored, skipped, fmt.Errorf("cannot restore account %q: %w", acc.Name, err)
+		}
+
+		restored = append(restored, acc.Name)
+	}
+
+	return restored, skipped, nil
+}
+
+func (r Registry) restoreAccount(acc backupAccount) error {
+	if acc.Type == backupTypeAddress {
+		return r.saveWatchOnlyAddress(acc.Name, addressInfo{Name: acc.Name, Address: acc.Address})
+	}
+
+	var keyType keyring.KeyType
+	switch acc.Type {
+	case keyring.TypeLocal.String():
+		keyType = keyring.TypeLocal
+	case keyring.TypeOffline.String():
+		keyType = keyring.TypeOffline
+	case keyring.TypeMulti.String():
+		keyType = keyring.TypeMulti
+	default:
+		return fmt.Errorf("unknown account type %q", acc.Type)
+	}
+
+	if keyType == keyring.TypeLocal {
+		return saveAccount(r.Keyring, acc.Name, keyType, nil, hd.PubKeyType(acc.Algo), acc.Armor)
+	}
+
+	pubKey, err := parsePubKey(string(acc.PubKey))
+	if err != nil {
+		return err
+	}
+
+	return saveAccount(r.Keyring, acc.Name, keyType, pubKey, hd.PubKeyType(acc.Algo), "")
+}
+
+// keyExists checks if the keyring has a key or a watch-only address with keyName.
+func (r Registry) keyExists(keyName string) (bool, error) {
+	_, err := r.Keyring.Key(keyName)
+	if err == nil {
+		return true, nil
+	}
+	if !errors.Is(err, dkeyring.ErrKeyNotFound) && !errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return false, err
+	}
+
+	path, err := r.watchOnlyFile(keyName)
+	if err != nil {
+		return false, err
+	}
+	_, err = os.Stat(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// allWatchOnlyAddresses returns the watch-only accounts of addresses in all namespaces, named
+// after their key names.
+func (r Registry) allWatchOnlyAddresses() (accounts []backupAccount, err error) {
+	root := filepath.Join(r.homePath, watchOnlyDir, r.keyringServiceName)
+
+	err = filepath.Walk(root, func(path string, fi os.FileInfo, err error) error {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() || filepath.Ext(path) != ".json" {
+			return nil
+		}
+
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var info addressInfo
+		if err := json.Unmarshal(b, &info); err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		accounts = append(accounts, backupAccount{
+			Name:    strings.TrimSuffix(filepath.ToSlash(rel), ".json"),
+			Type:    backupTypeAddress,
+			Address: info.Address,
+		})
+		return nil
+	})
+
+	return accounts, err
+}
+
+// backupAEAD returns the cipher of backups with a key derived from passphrase. the scrypt
+// parameters of the backup are bounded as the ones of keystores.
+func backupAEAD(passphrase string, b backup) (cipher.AEAD, error) {
+	n, r, p := b.ScryptN, b.ScryptR, b.ScryptP
+	if n <= 1 || n > keystoreMaxScryptN || r <= 0 || r > keystoreMaxScryptR || p <= 0 || p > keystoreMaxScryptP {
+		return nil, fmt.Errorf("backup scrypt parameters n=%d r=%d p=%d are out of bounds (n<=%d r<=%d p<=%d)",
+			n, r, p, keystoreMaxScryptN, keystoreMaxScryptR, keystoreMaxScryptP)
+	}
+
+	key, err := scrypt.Key([]byte(passphrase), b.Salt, b.ScryptN, b.ScryptR, b.ScryptP, backupKeyLen)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// pubKeyCodec returns the codec that encodes public keys in the JSON format of chain binaries.
+func pubKeyCodec() codec.Codec {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
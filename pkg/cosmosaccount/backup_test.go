@@ -0,0 +1,93 @@
+package cosmosaccount
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupRestore(t *testing.T) {
+	keystoreScryptN = 1 << 10
+
+	home := t.TempDir()
+
+	r, err := New(WithKeyringBackend(KeyringTest), WithHome(home))
+	require.NoError(t, err)
+	mars, err := New(WithKeyringBackend(KeyringTest), WithHome(home), WithNamespace("mars-1"))
+	require.NoError(t, err)
+
+	alice, _, err := r.Create("alice")
+	require.NoError(t, err)
+	bob, _, err := mars.Create("bob")
+	require.NoError(t, err)
+	admin, err := mars.CreateMultisig("admin", 1, []string{"bob"}, true)
+	require.NoError(t, err)
+	watched, err := mars.AddWatchOnly("watched", alice.Address("cosmos"))
+	require.NoError(t, err)
+
+	archive, skipped, err := r.Backup("secret")
+	require.NoError(t, err)
+	require.Empty(t, skipped)
+
+	restoredRegistry, err := New(WithKeyringBackend(KeyringTest), WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	_, _, err = restoredRegistry.Restore(archive, "wrong")
+	require.ErrorIs(t, err, ErrBackupPassphrase)
+
+	restored, skipped, err := restoredRegistry.Restore(archive, "secret")
+	require.NoError(t, err)
+	require.Empty(t, skipped)
+	require.ElementsMatch(t, []string{"alice", "mars-1/bob", "mars-1/admin", "mars-1/watched"}, restored)
+
+	restoredAlice, err := restoredRegistry.GetByName("alice")
+	require.NoError(t, err)
+	require.Equal(t, alice.Address("cosmos"), restoredAlice.Address("cosmos"))
+
+	restoredRegistry.namespace = "mars-1"
+	for _, acc := range []Account{bob, admin, watched} {
+		restoredAcc, err := restoredRegistry.GetByName(acc.Name)
+		require.NoError(t, err)
+		require.Equal(t, acc.Address("cosmos"), restoredAcc.Address("cosmos"))
+		require.Equal(t, acc.Info.GetType(), restoredAcc.Info.GetType())
+	}
+
+	// private keys are restored too.
+	_, err = restoredRegistry.ExportHex("bob", "")
+	require.NoError(t, err)
+
+	_, skipped, err = restoredRegistry.Restore(archive, "secret")
+	require.NoError(t, err)
+	require.Len(t, skipped, 4)
+}
+
+func TestRestoreScryptParamsBounds(t *testing.T) {
+	r, err := New(WithKeyringBackend(KeyringTest), WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	for _, params := range [][3]int{
+		{1 << 30, 8, 1},
+		{1 << 10, 1024, 1},
+		{1 << 10, 8, 1 << 20},
+		{1 << 10, 8, 0},
+		{1, 8, 1},
+	} {
+		archive := fmt.Sprintf(`{
+  "version": %d,
+  "kdf": %q,
+  "cipher": %q,
+  "scrypt_n": %d,
+  "scrypt_r": %d,
+  "scrypt_p": %d,
+  "salt": "c2FsdA==",
+  "nonce": "bm9uY2Vub25jZTA=",
+  "ciphertext": "Y2lwaGVydGV4dA=="
+}`, backupVersion, backupKDF, backupCipher, params[0], params[1], params[2])
+
+		_, _, err := r.Restore([]byte(archive), "secret")
+		require.Error(t, err, params)
+		require.NotEqual(t, ErrBackupPassphrase, err, params)
+		require.Contains(t, err.Error(), "out of bounds", params)
+	}
+}
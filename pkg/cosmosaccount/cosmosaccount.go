@@ -0,0 +1,750 @@
+package cosmosaccount
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+
+	dkeyring "github.com/99designs/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/go-bip39"
+)
+
+const (
+	// KeyringServiceName used for the name of keyring in OS backend.
+	KeyringServiceName = "starport"
+
+	// DefaultAccount is the name of the default account.
+	DefaultAccount = "default"
+)
+
+// KeyringHome used to store account related data.
+var KeyringHome = os.ExpandEnv("$HOME/.starport/accounts")
+
+var (
+	ErrAccountExists = errors.New("account already exists")
+
+	// ErrLedgerAccountExport is returned when exporting a Ledger account, its private key never
+	// leaves the device.
+	ErrLedgerAccountExport = errors.New("cannot export a Ledger account, its private key never leaves the device")
+
+	// ErrCoinTypeEVM is returned for the coin type of EVM chains, their accounts are
+	// eth_secp256k1 keys whose addresses are not the ones of the secp256k1 keys of the keyring.
+	ErrCoinTypeEVM = fmt.Errorf("coin type %d is the coin type of EVM chains, their eth_secp256k1 keys are not supported", CoinTypeEVM)
+)
+
+const (
+	AccountPrefixCosmos = "cosmos"
+)
+
+// CoinTypeEVM is the coin type of the HD path of EVM chains.
+const CoinTypeEVM = 60
+
+// keyringLockedErrors are the messages of the errors of the keyring backends when they cannot
+// be unlocked, they're plain errors so they're matched by their messages.
+var keyringLockedErrors = []string{
+	// file backend with a wrong passphrase.
+	"integrity check failed",
+	"too many failed passphrase attempts",
+	// secret service of os backends on Linux.
+	"failed to unlock correct collection",
+	// keychain of os backends on macOS.
+	"The user name or passphrase you entered is not correct",
+	"User interaction is not allowed",
+}
+
+// IsKeyringLocked checks if err is an error of a keyring that cannot be unlocked, such as a
+// locked os keyring or a file keyring with a wrong passphrase.
+func IsKeyringLocked(err error) bool {
+	if err == nil {
+		return false
+	}
+	for _, msg := range keyringLockedErrors {
+		if strings.Contains(err.Error(), msg) {
+			return true
+		}
+	}
+	return false
+}
+
+// KeyringBackend is the backend for where keys are stored.
+type KeyringBackend string
+
+const (
+	// KeyringTest is the test keyring backend. With this backend, your keys will be
+	// stored under your app's data dir,
+	KeyringTest KeyringBackend = "test"
+
+	// KeyringOS is the OS keyring backend. with this backend, your keys will be
+	// stored in your operating system's secured keyring.
+	KeyringOS KeyringBackend = "os"
+
+	// KeyringFile is the file keyring backend. with this backend, your keys will be
+	// stored encrypted with a passphrase under your app's data dir.
+	KeyringFile KeyringBackend = "file"
+
+	// KeyringPass is the pass keyring backend. with this backend, your keys will be
+	// stored in the password store of the pass command line tool, encrypted with GPG.
+	KeyringPass KeyringBackend = "pass"
+
+	// KeyringKWallet is the KWallet keyring backend. with this backend, your keys will be
+	// stored in the wallet of KDE.
+	KeyringKWallet KeyringBackend = "kwallet"
+
+	// KeyringKeychain is the macOS Keychain keyring backend. it's the OS keyring backend
+	// that's only available on macOS.
+	KeyringKeychain KeyringBackend = "keychain"
+
+	// KeyringMemory is the in memory keyring backend. with this backend, your keys will be
+	// lost when the registry is closed.
+	KeyringMemory KeyringBackend = "memory"
+)
+
+// KeyringBackends are the keyring backends that store keys persistently.
+var KeyringBackends = []KeyringBackend{
+	KeyringTest,
+	KeyringOS,
+	KeyringFile,
+	KeyringPass,
+	KeyringKWallet,
+	KeyringKeychain,
+}
+
+// SDKBackend returns the name of the Cosmos SDK keyring backend for the backend.
+func (b KeyringBackend) SDKBackend() (string, error) {
+	switch b {
+	case KeyringKeychain:
+		if runtime.GOOS != "darwin" {
+			return "", fmt.Errorf("the %s keyring backend is only available on macOS", b)
+		}
+		return string(KeyringOS), nil
+	case KeyringMemory:
+		return string(b), nil
+	}
+
+	for _, backend := range KeyringBackends {
+		if b == backend {
+			return string(b), nil
+		}
+	}
+
+	names := make([]string, len(KeyringBackends))
+	for i, backend := range KeyringBackends {
+		names[i] = string(backend)
+	}
+	return "", fmt.Errorf("unknown keyring backend %q, supported ones are: %s", b, strings.Join(names, ", "))
+}
+
+// KeyFormat is the format of exported and imported private keys.
+type KeyFormat string
+
+const (
+	// KeyFormatArmor is the ASCII-armored private key encrypted with a passphrase, it's the
+	// format of keys exported by chain binaries.
+	KeyFormatArmor KeyFormat = "armor"
+
+	// KeyFormatHex is the unencrypted, unarmored private key in hex.
+	KeyFormatHex KeyFormat = "hex"
+
+	// KeyFormatKeystore is the keystore JSON of the Web3 Secret Storage format encrypted with a
+	// passphrase, it's supported by many wallets.
+	KeyFormatKeystore KeyFormat = "keystore"
+)
+
+// KeyFormats are the supported formats of private keys.
+var KeyFormats = []KeyFormat{KeyFormatArmor, KeyFormatHex, KeyFormatKeystore}
+
+// ParseKeyFormat parses the name of a private key format.
+func ParseKeyFormat(name string) (KeyFormat, error) {
+	for _, format := range KeyFormats {
+		if string(format) == name {
+			return format, nil
+		}
+	}
+	return "", fmt.Errorf("unknown key format %q, supported ones are: %s, %s and %s", name, KeyFormatArmor, KeyFormatHex, KeyFormatKeystore)
+}
+
+// DetectKeyFormat detects the format of a private key.
+func DetectKeyFormat(key string) KeyFormat {
+	key = strings.TrimSpace(key)
+
+	if strings.HasPrefix(key, "{") {
+		return KeyFormatKeystore
+	}
+	if b, err := hex.DecodeString(strings.TrimPrefix(key, "0x")); err == nil && len(b) == secp256k1.PrivKeySize {
+		return KeyFormatHex
+	}
+	return KeyFormatArmor
+}
+
+// Registry for accounts.
+type Registry struct {
+	homePath           string
+	keyringServiceName string
+	keyringBackend     KeyringBackend
+
+	// coinType, accountIndex and addressIndex are the components of the BIP44 HD path that
+	// keys are derived from.
+	coinType     uint32
+	accountIndex uint32
+	addressIndex uint32
+
+	// namespace scopes the accounts to a chain, keys are stored as <namespace>/<name>.
+	namespace string
+
+	// hooks are run on account events.
+	hooks []Hook
+
+	Keyring keyring.Keyring
+}
+
+// Option configures your registry.
+type Option func(*Registry)
+
+func WithHome(path string) Option {
+	return func(c *Registry) {
+		c.homePath = path
+	}
+}
+
+func WithKeyringServiceName(name string) Option {
+	return func(c *Registry) {
+		c.keyringServiceName = name
+	}
+}
+
+func WithKeyringBackend(backend KeyringBackend) Option {
+	return func(c *Registry) {
+		c.keyringBackend = backend
+	}
+}
+
+// WithNamespace scopes the accounts of the registry to the chain with chainID so the same names
+// can be used by the accounts of different chains. accounts outside of the namespace are not
+// visible to the registry. a key can only be in one namespace since the keyring indexes keys by
+// their addresses.
+func WithNamespace(chainID string) Option {
+	return func(c *Registry) {
+		c.namespace = chainID
+	}
+}
+
+// WithCoinType sets the coin type of the HD path that keys are derived from, it's the coin
+// type of the SDK config by default, which is 118 unless it's changed. CoinTypeEVM is rejected
+// with ErrCoinTypeEVM.
+func WithCoinType(coinType uint32) Option {
+	return func(c *Registry) {
+		c.coinType = coinType
+	}
+}
+
+// WithAccountIndex sets the account index of the HD path that keys are derived from, 0 by default.
+func WithAccountIndex(index uint32) Option {
+	return func(c *Registry) {
+		c.accountIndex = index
+	}
+}
+
+// WithAddressIndex sets the address index of the HD path that keys are derived from, 0 by default.
+func WithAddressIndex(index uint32) Option {
+	return func(c *Registry) {
+		c.addressIndex = index
+	}
+}
+
+// New creates a new registry to manage accounts.
+func New(options ...Option) (Registry, error) {
+	r := Registry{
+		keyringServiceName: sdktypes.KeyringServiceName(),
+		keyringBackend:     KeyringTest,
+		homePath:           KeyringHome,
+		coinType:           sdktypes.GetConfig().GetCoinType(),
+	}
+
+	for _, apply := range options {
+		apply(&r)
+	}
+
+	if r.coinType == CoinTypeEVM {
+		return Registry{}, ErrCoinTypeEVM
+	}
+
+	if strings.Contains(r.namespace, namespaceSeparator) {
+		return Registry{}, fmt.Errorf("namespace %q cannot contain %q", r.namespace, namespaceSeparator)
+	}
+
+	backend, err := r.keyringBackend.SDKBackend()
+	if err != nil {
+		return Registry{}, err
+	}
+
+	r.Keyring, err = keyring.New(r.keyringServiceName, backend, r.homePath, os.Stdin)
+	if err != nil {
+		return Registry{}, err
+	}
+
+	return r, nil
+}
+
+func NewStandalone(options ...Option) (Registry, error) {
+	return New(
+		append([]Option{
+			WithKeyringServiceName(KeyringServiceName),
+			WithHome(KeyringHome),
+		}, options...)...,
+	)
+}
+
+// Account represents an Cosmos SDK account.
+type Account struct {
+	// Name of the account.
+	Name string
+
+	// Info holds additional info about the account.
+	Info keyring.Info
+}
+
+// Address returns the address of the account from given prefix.
+func (a Account) Address(accPrefix string) string {
+	if accPrefix == "" {
+		accPrefix = AccountPrefixCosmos
+	}
+
+	return toBench32(accPrefix, a.Info.GetAddress())
+}
+
+// IsLedger checks if the keys of the account are held by a Ledger device.
+func (a Account) IsLedger() bool {
+	return a.Info.GetType() == keyring.TypeLedger
+}
+
+// IsMultisig checks if the account is a multisig account.
+func (a Account) IsMultisig() bool {
+	return a.Info.GetType() == keyring.TypeMulti
+}
+
+// PubKey returns a public key for account.
+func (a Account) PubKey() string {
+	// watch-only accounts of addresses have no public key.
+	if a.Info.GetPubKey() == nil {
+		return ""
+	}
+	// multisig public keys are described by their threshold since they're made of many keys.
+	if pk, ok := a.Info.GetPubKey().(*multisig.LegacyAminoPubKey); ok {
+		return fmt.Sprintf("multisig(%d of %d)", pk.Threshold, len(pk.PubKeys))
+	}
+	return a.Info.GetPubKey().String()
+}
+
+func toBench32(prefix string, addr []byte) string {
+	bech32Addr, err := bech32.ConvertAndEncode(prefix, addr)
+	if err != nil {
+		panic(err)
+	}
+	return bech32Addr
+}
+
+// EnsureDefaultAccount ensures that default account exists.
+func (r Registry) EnsureDefaultAccount() error {
+	_, err := r.GetByName(DefaultAccount)
+
+	var accErr *AccountDoesNotExistError
+	if errors.As(err, &accErr) {
+		_, _, err = r.Create(DefaultAccount)
+		return err
+	}
+
+	return err
+}
+
+// MnemonicLengths are the supported numbers of words of BIP39 mnemonics.
+var MnemonicLengths = []int{12, 15, 18, 21, 24}
+
+// DefaultMnemonicLength is the number of words of mnemonics of created accounts.
+const DefaultMnemonicLength = 24
+
+type createOptions struct {
+	mnemonicLength  int
+	bip39Passphrase string
+}
+
+// CreateOption configures accounts created by the registry.
+type CreateOption func(*createOptions)
+
+// WithMnemonicLength sets the number of words of the mnemonic, it's one of MnemonicLengths.
+func WithMnemonicLength(words int) CreateOption {
+	return func(o *createOptions) {
+		o.mnemonicLength = words
+	}
+}
+
+// WithBIP39Passphrase sets the BIP39 passphrase that's used with the mnemonic to derive the key,
+// also known as the 25th word. the same passphrase is needed to recover the account.
+func WithBIP39Passphrase(passphrase string) CreateOption {
+	return func(o *createOptions) {
+		o.bip39Passphrase = passphrase
+	}
+}
+
+// Create creates a new account with name. the account and its mnemonic are returned along with
+// a *HookError when a hook fails.
+func (r Registry) Create(name string, options ...CreateOption) (acc Account, mnemonic string, err error) {
+	o := createOptions{mnemonicLength: DefaultMnemonicLength}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	entropySize, err := mnemonicEntropySize(o.mnemonicLength)
+	if err != nil {
+		return Account{}, "", err
+	}
+
+	acc, err = r.GetByName(name)
+	if err == nil {
+		return Account{}, "", ErrAccountExists
+	}
+	var accErr *AccountDoesNotExistError
+	if !errors.As(err, &accErr) {
+		return Account{}, "", err
+	}
+
+	entropySeed, err := bip39.NewEntropy(entropySize)
+	if err != nil {
+		return Account{}, "", err
+	}
+	mnemonic, err = bip39.NewMnemonic(entropySeed)
+	if err != nil {
+		return Account{}, "", err
+	}
+
+	algo, err := r.algo()
+	if err != nil {
+		return Account{}, "", err
+	}
+	info, err := r.Keyring.NewAccount(r.keyName(name), mnemonic, o.bip39Passphrase, r.hdPath(), algo)
+	if err != nil {
+		return Account{}, "", err
+	}
+
+	acc = Account{
+		Name: name,
+		Info: info,
+	}
+
+	return acc, mnemonic, r.runHooks(EventCreated, acc)
+}
+
+// mnemonicEntropySize returns the size of the entropy in bits of mnemonics with words.
+func mnemonicEntropySize(words int) (int, error) {
+	for _, length := range MnemonicLengths {
+		if words == length {
+			return words * 32 / 3, nil
+		}
+	}
+	return 0, fmt.Errorf("mnemonics of %d words are not supported, supported lengths are: 12, 15, 18, 21 and 24", words)
+}
+
+// CreateLedger adds the account at the HD path of the registry from the connected Ledger device
+// with name. only the public key of the account is stored, transactions of the account are
+// signed on the device.
+func (r Registry) CreateLedger(name string) (Account, error) {
+	_, err := r.GetByName(name)
+	if err == nil {
+		return Account{}, ErrAccountExists
+	}
+	var accErr *AccountDoesNotExistError
+	if !errors.As(err, &accErr) {
+		return Account{}, err
+	}
+
+	info, err := r.Keyring.SaveLedgerKey(r.keyName(name), hd.Secp256k1, AccountPrefixCosmos, r.coinType, r.accountIndex, r.addressIndex)
+	if err != nil {
+		return Account{}, err
+	}
+
+	acc := Account{
+		Name: name,
+		Info: info,
+	}
+
+	return acc, r.runHooks(EventCreated, acc)
+}
+
+// CreateMultisig creates a multisig account with name that requires threshold signatures of the
+// accounts with keys. public keys of the accounts are sorted by their addresses like chain
+// binaries do, unless sortKeys is false and they are kept in the order of keys.
+func (r Registry) CreateMultisig(name string, threshold int, keys []string, sortKeys bool) (Account, error) {
+	_, err := r.GetByName(name)
+	if err == nil {
+		return Account{}, ErrAccountExists
+	}
+	var accErr *AccountDoesNotExistError
+	if !errors.As(err, &accErr) {
+		return Account{}, err
+	}
+
+	if threshold <= 0 || threshold > len(keys) {
+		return Account{}, fmt.Errorf("threshold must be between 1 and the number of keys (%d)", len(keys))
+	}
+
+	pubKeys := make([]cryptotypes.PubKey, len(keys))
+
+	for i, key := range keys {
+		acc, err := r.GetByName(key)
+		if err != nil {
+			return Account{}, err
+		}
+		// watch-only accounts of addresses have no public keys to sign with.
+		if pubKeys[i] = acc.Info.GetPubKey(); pubKeys[i] == nil {
+			return Account{}, fmt.Errorf("account %q has no public key, only the accounts of public keys can be keys of a multisig", key)
+		}
+	}
+
+	if sortKeys {
+		sort.Slice(pubKeys, func(i, j int) bool {
+			return bytes.Compare(pubKeys[i].Address(), pubKeys[j].Address()) < 0
+		})
+	}
+
+	info, err := r.Keyring.SaveMultisig(r.keyName(name), multisig.NewLegacyAminoPubKey(threshold, pubKeys))
+	if err != nil {
+		return Account{}, err
+	}
+
+	acc := Account{
+		Name: name,
+		Info: info,
+	}
+
+	return acc, r.runHooks(EventCreated, acc)
+}
+
+// Import imports an existing account with name and passphrase and secret where secret can be a
+// mnemonic or a private key in any of the KeyFormats. passphrase is the BIP39 passphrase of
+// mnemonics and it decrypts private keys.
+func (r Registry) Import(name, secret, passphrase string) (Account, error) {
+	_, err := r.GetByName(name)
+	if err == nil {
+		return Account{}, ErrAccountExists
+	}
+	var accErr *AccountDoesNotExistError
+	if !errors.As(err, &accErr) {
+		return Account{}, err
+	}
+
+	if bip39.IsMnemonicValid(secret) {
+		algo, err := r.algo()
+		if err != nil {
+			return Account{}, err
+		}
+		_, err = r.Keyring.NewAccount(r.keyName(name), secret, passphrase, r.hdPath(), algo)
+		if err != nil {
+			return Account{}, err
+		}
+	} else if err := r.importPrivKey(name, secret, passphrase); err != nil {
+		return Account{}, err
+	}
+
+	acc, err := r.GetByName(name)
+	if err != nil {
+		return Account{}, err
+	}
+
+	return acc, r.runHooks(EventImported, acc)
+}
+
+// importPrivKey imports a private key in any of the KeyFormats.
+func (r Registry) importPrivKey(name, key, passphrase string) error {
+	key = strings.TrimSpace(key)
+
+	var privKey []byte
+
+	switch DetectKeyFormat(key) {
+	case KeyFormatArmor:
+		return r.Keyring.ImportPrivKey(r.keyName(name), key, passphrase)
+
+	case KeyFormatHex:
+		b, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+		if err != nil {
+			return err
+		}
+		privKey = b
+
+	case KeyFormatKeystore:
+		b, err := decryptKeystore([]byte(key), passphrase)
+		if err != nil {
+			return err
+		}
+		privKey = b
+	}
+
+	// the keyring only imports armored keys.
+	armored := crypto.EncryptArmorPrivKey(&secp256k1.PrivKey{Key: privKey}, passphrase, string(hd.Secp256k1Type))
+
+	return r.Keyring.ImportPrivKey(r.keyName(name), armored, passphrase)
+}
+
+// Export exports an account as a private key.
+func (r Registry) Export(name, passphrase string) (key string, err error) {
+	acc, err := r.GetByName(name)
+	if err != nil {
+		return "", err
+	}
+	if acc.IsLedger() {
+		return "", ErrLedgerAccountExport
+	}
+	if acc.IsWatchOnly() {
+		return "", ErrWatchOnlyAccountExport
+	}
+
+	return r.Keyring.ExportPrivKeyArmor(r.keyName(name), passphrase)
+
+}
+
+// ExportHex exports an account as a private key in hex.
+func (r Registry) ExportHex(name, passphrase string) (hex string, err error) {
+	acc, err := r.GetByName(name)
+	if err != nil {
+		return "", err
+	}
+	if acc.IsLedger() {
+		return "", ErrLedgerAccountExport
+	}
+	if acc.IsWatchOnly() {
+		return "", ErrWatchOnlyAccountExport
+	}
+
+	return keyring.NewUnsafe(r.Keyring).UnsafeExportPrivKeyHex(r.keyName(name))
+}
+
+// ExportAs exports an account as a private key in format, passphrase encrypts the armored and
+// keystore formats.
+func (r Registry) ExportAs(name, passphrase string, format KeyFormat) (key string, err error) {
+	switch format {
+	case KeyFormatArmor:
+		return r.Export(name, passphrase)
+
+	case KeyFormatHex:
+		return r.ExportHex(name, passphrase)
+
+	case KeyFormatKeystore:
+		privKey, err := r.ExportHex(name, passphrase)
+		if err != nil {
+			return "", err
+		}
+
+		b, err := hex.DecodeString(privKey)
+		if err != nil {
+			return "", err
+		}
+
+		ks, err := encryptKeystore(b, passphrase)
+		if err != nil {
+			return "", err
+		}
+
+		return string(ks), nil
+
+	default:
+		return "", fmt.Errorf("unknown key format %q", format)
+	}
+}
+
+// GetByName returns an account by its name.
+func (r Registry) GetByName(name string) (Account, error) {
+	if strings.Contains(name, namespaceSeparator) {
+		return Account{}, fmt.Errorf("account name %q cannot contain %q", name, namespaceSeparator)
+	}
+
+	info, err := r.Keyring.Key(r.keyName(name))
+	if errors.Is(err, dkeyring.ErrKeyNotFound) || errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return r.watchOnlyAddress(name)
+	}
+	if err != nil {
+		return Account{}, nil
+	}
+
+	acc := Account{
+		Name: name,
+		Info: info,
+	}
+
+	return acc, nil
+}
+
+// List lists all accounts.
+func (r Registry) List() ([]Account, error) {
+	info, err := r.Keyring.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts []Account
+
+	for _, accinfo := range info {
+		name, ok := r.accountName(accinfo.GetName())
+		if !ok {
+			continue
+		}
+
+		accounts = append(accounts, Account{
+			Name: name,
+			Info: accinfo,
+		})
+	}
+
+	watchOnly, err := r.watchOnlyAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(accounts, watchOnly...), nil
+}
+
+// DeleteByName deletes an account by name.
+func (r Registry) DeleteByName(name string) error {
+	acc, err := r.GetByName(name)
+	if err != nil {
+		return err
+	}
+
+	err = r.Keyring.Delete(r.keyName(name))
+	if errors.Is(err, dkeyring.ErrKeyNotFound) || errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		err = r.deleteWatchOnlyAddress(name)
+	}
+	if err != nil {
+		return err
+	}
+
+	return r.runHooks(EventDeleted, acc)
+}
+
+func (r Registry) hdPath() string {
+	return hd.CreateHDPath(r.coinType, r.accountIndex, r.addressIndex).String()
+}
+
+func (r Registry) algo() (keyring.SignatureAlgo, error) {
+	algos, _ := r.Keyring.SupportedAlgorithms()
+	return keyring.NewSigningAlgoFromString(string(hd.Secp256k1Type), algos)
+}
+
+type AccountDoesNotExistError struct {
+	Name string
+}
+
+func (e *AccountDoesNotExistError) Error() string {
+	return fmt.Sprintf("account %q does not exist", e.Name)
+}
@@ -0,0 +1,261 @@
+package cosmosaccount
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportExportFormats(t *testing.T) {
+	// keep the key derivation fast.
+	keystoreScryptN = 1 << 10
+
+	r, err := New(WithKeyringBackend("memory"), WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	acc, _, err := r.Create("alice")
+	require.NoError(t, err)
+
+	for _, format := range KeyFormats {
+		format := format
+
+		t.Run(string(format), func(t *testing.T) {
+			key, err := r.ExportAs("alice", "passphrase", format)
+			require.NoError(t, err)
+			require.Equal(t, format, DetectKeyFormat(key))
+
+			// keys can only be imported once into a keyring.
+			other, err := New(WithKeyringBackend("memory"), WithHome(t.TempDir()))
+			require.NoError(t, err)
+
+			imported, err := other.Import("alice", key, "passphrase")
+			require.NoError(t, err)
+			require.Equal(t, acc.Address(AccountPrefixCosmos), imported.Address(AccountPrefixCosmos))
+		})
+	}
+}
+
+func TestKeystoreWrongPassphrase(t *testing.T) {
+	keystoreScryptN = 1 << 10
+
+	key := []byte("0123456789abcdef0123456789abcdef")
+
+	ks, err := encryptKeystore(key, "passphrase")
+	require.NoError(t, err)
+
+	_, err = decryptKeystore(ks, "wrong")
+	require.Equal(t, ErrKeystorePassphrase, err)
+
+	decrypted, err := decryptKeystore(ks, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, key, decrypted)
+}
+
+func TestDecryptKeystorePBKDF2(t *testing.T) {
+	// test vector of the Web3 Secret Storage definition.
+	ks := `{
+  "crypto": {
+    "cipher": "aes-128-ctr",
+    "cipherparams": {"iv": "6087dab2f9fdbbfaddc31a909735c1e6"},
+    "ciphertext": "5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46",
+    "kdf": "pbkdf2",
+    "kdfparams": {
+      "c": 262144,
+      "dklen": 32,
+      "prf": "hmac-sha256",
+      "salt": "ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"
+    },
+    "mac": "517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"
+  },
+  "id": "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+  "version": 3
+}`
+
+	key, err := decryptKeystore([]byte(ks), "testpassword")
+	require.NoError(t, err)
+	require.Equal(t, "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d", hex.EncodeToString(key))
+}
+
+func TestDecryptKeystoreKDFParamsBounds(t *testing.T) {
+	salt := "ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"
+	tests := []struct {
+		kdf    string
+		params string
+	}{
+		{"scrypt", `{"n": 1024, "r": 8, "p": 1, "dklen": 16}`},
+		{"scrypt", `{"n": 1073741824, "r": 8, "p": 1, "dklen": 32}`},
+		{"scrypt", `{"n": 1024, "r": 1024, "p": 1, "dklen": 32}`},
+		{"scrypt", `{"n": 1024, "r": 8, "p": 0, "dklen": 32}`},
+		{"pbkdf2", `{"c": 262144, "prf": "hmac-sha256", "dklen": 8}`},
+		{"pbkdf2", `{"c": 1000000000, "prf": "hmac-sha256", "dklen": 32}`},
+	}
+	for _, tt := range tests {
+		ks := fmt.Sprintf(`{
+  "crypto": {
+    "cipher": "aes-128-ctr",
+    "cipherparams": {"iv": "6087dab2f9fdbbfaddc31a909735c1e6"},
+    "ciphertext": "5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46",
+    "kdf": %q,
+    "kdfparams": %s,
+    "mac": "517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"
+  },
+  "version": 3
+}`, tt.kdf, strings.Replace(tt.params, "{", `{"salt": "`+salt+`", `, 1))
+
+		_, err := decryptKeystore([]byte(ks), "testpassword")
+		require.Error(t, err, tt.params)
+		require.NotEqual(t, ErrKeystorePassphrase, err, tt.params)
+	}
+}
+
+func TestHDPath(t *testing.T) {
+	const mnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	_, err := New(WithKeyringBackend("memory"), WithHome(t.TempDir()), WithCoinType(CoinTypeEVM))
+	require.ErrorIs(t, err, ErrCoinTypeEVM)
+
+	r, err := New(WithKeyringBackend("memory"), WithHome(t.TempDir()))
+	require.NoError(t, err)
+	acc, err := r.Import("cosmos", mnemonic, "")
+	require.NoError(t, err)
+	// the address of m/44'/118'/0'/0/0 that Cosmos wallets derive from the mnemonic.
+	require.Equal(t, "cosmos19rl4cm2hmr8afy4kldpxz3fka4jguq0auqdal4", acc.Address(AccountPrefixCosmos))
+
+	r, err = New(WithKeyringBackend("memory"), WithHome(t.TempDir()), WithCoinType(529), WithAccountIndex(2), WithAddressIndex(1))
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/529'/2'/0/1", r.hdPath())
+
+	acc1, err := r.Import("secret", mnemonic, "")
+	require.NoError(t, err)
+	require.NotEqual(t, acc.Address(AccountPrefixCosmos), acc1.Address(AccountPrefixCosmos))
+}
+
+func TestCreateMultisig(t *testing.T) {
+	r, err := New(WithKeyringBackend("memory"), WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	for _, name := range []string{"a", "b", "c"} {
+		_, _, err := r.Create(name)
+		require.NoError(t, err)
+	}
+
+	_, err = r.CreateMultisig("admin", 4, []string{"a", "b", "c"}, true)
+	require.Error(t, err)
+
+	acc, err := r.CreateMultisig("admin", 2, []string{"c", "a", "b"}, true)
+	require.NoError(t, err)
+	require.True(t, acc.IsMultisig())
+
+	pubKeys := acc.Info.GetPubKey().(*multisig.LegacyAminoPubKey).GetPubKeys()
+	require.Len(t, pubKeys, 3)
+	for i := 1; i < len(pubKeys); i++ {
+		require.Negative(t, bytes.Compare(pubKeys[i-1].Address(), pubKeys[i].Address()))
+	}
+}
+
+func TestKeyringBackendSDKBackend(t *testing.T) {
+	for _, backend := range []KeyringBackend{KeyringTest, KeyringOS, KeyringFile, KeyringPass, KeyringKWallet, KeyringMemory} {
+		name, err := backend.SDKBackend()
+		require.NoError(t, err)
+		require.Equal(t, string(backend), name)
+	}
+
+	name, err := KeyringKeychain.SDKBackend()
+	if runtime.GOOS == "darwin" {
+		require.NoError(t, err)
+		require.Equal(t, string(KeyringOS), name)
+	} else {
+		require.Error(t, err)
+	}
+
+	_, err = KeyringBackend("unknown").SDKBackend()
+	require.Error(t, err)
+}
+
+func TestCreateMnemonicOptions(t *testing.T) {
+	r, err := New(WithKeyringBackend("memory"), WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	_, _, err = r.Create("a", WithMnemonicLength(13))
+	require.Error(t, err)
+
+	acc, mnemonic, err := r.Create("a", WithMnemonicLength(12), WithBIP39Passphrase("25th"))
+	require.NoError(t, err)
+	require.Len(t, strings.Fields(mnemonic), 12)
+
+	other, err := New(WithKeyringBackend("memory"), WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	recovered, err := other.Import("a", mnemonic, "25th")
+	require.NoError(t, err)
+	require.Equal(t, acc.Address("cosmos"), recovered.Address("cosmos"))
+
+	withoutPassphrase, err := other.Import("b", mnemonic, "")
+	require.NoError(t, err)
+	require.NotEqual(t, acc.Address("cosmos"), withoutPassphrase.Address("cosmos"))
+}
+
+func TestAddWatchOnly(t *testing.T) {
+	r, err := New(WithKeyringBackend("memory"), WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	signer, _, err := r.Create("signer")
+	require.NoError(t, err)
+	pubKey := base64.StdEncoding.EncodeToString(signer.Info.GetPubKey().Bytes())
+
+	other, err := New(WithKeyringBackend("memory"), WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	byPubKey, err := other.AddWatchOnly("pubkey", pubKey)
+	require.NoError(t, err)
+	require.True(t, byPubKey.IsWatchOnly())
+	require.Equal(t, signer.Address("cosmos"), byPubKey.Address("cosmos"))
+
+	byAddress, err := other.AddWatchOnly("address", signer.Address("osmo"))
+	require.NoError(t, err)
+	require.True(t, byAddress.IsWatchOnly())
+	require.Equal(t, signer.Address("cosmos"), byAddress.Address("cosmos"))
+
+	_, err = other.AddWatchOnly("address", signer.Address("cosmos"))
+	require.ErrorIs(t, err, ErrAccountExists)
+
+	accounts, err := other.List()
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+
+	_, err = other.Export("address", "")
+	require.ErrorIs(t, err, ErrWatchOnlyAccountExport)
+
+	// only the accounts of public keys can be keys of multisigs.
+	_, err = other.CreateMultisig("admin", 1, []string{"pubkey", "address"}, true)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `account "address" has no public key`)
+	_, err = other.CreateMultisig("admin", 1, []string{"pubkey"}, true)
+	require.NoError(t, err)
+
+	require.NoError(t, other.DeleteByName("address"))
+	_, err = other.GetByName("address")
+	var accErr *AccountDoesNotExistError
+	require.ErrorAs(t, err, &accErr)
+
+	for _, name := range []string{"..", "..escape", `a\b`} {
+		_, err = other.AddWatchOnly(name, signer.Address("cosmos"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid account name")
+	}
+}
+
+func TestIsKeyringLocked(t *testing.T) {
+	require.True(t, IsKeyringLocked(fmt.Errorf("cannot list: %w", errors.New("aes.KeyUnwrap(): integrity check failed."))))
+	require.True(t, IsKeyringLocked(errors.New("too many failed passphrase attempts")))
+	require.False(t, IsKeyringLocked(ErrAccountExists))
+	require.False(t, IsKeyringLocked(nil))
+}
@@ -0,0 +1,85 @@
+package cosmosaccount
+
+import "fmt"
+
+// EventType is the type of an account event that hooks are fired on.
+type EventType string
+
+const (
+	// EventCreated is fired after an account is created, including Ledger, multisig and
+	// watch-only accounts.
+	EventCreated EventType = "created"
+
+	// EventImported is fired after an account is imported.
+	EventImported EventType = "imported"
+
+	// EventDeleted is fired after an account is deleted.
+	EventDeleted EventType = "deleted"
+)
+
+// EventTypes are the types of account events.
+var EventTypes = []EventType{EventCreated, EventImported, EventDeleted}
+
+// Event is an account event that's passed to hooks.
+type Event struct {
+	Type EventType
+
+	// Namespace is the chain ID that the account is scoped to, it's empty for global accounts.
+	Namespace string
+
+	Account Account
+}
+
+// Hook is run on account events, such as to fund new accounts from a faucet or to register
+// them with an external key management service.
+type Hook interface {
+	Run(Event) error
+}
+
+// HookFunc is an adapter to use funcs as hooks.
+type HookFunc func(Event) error
+
+// Run runs f(event).
+func (f HookFunc) Run(event Event) error {
+	return f(event)
+}
+
+// WithHooks adds hooks that are run after accounts are created, imported or deleted.
+func WithHooks(hooks ...Hook) Option {
+	return func(c *Registry) {
+		c.hooks = append(c.hooks, hooks...)
+	}
+}
+
+// HookError is returned when a hook fails, the event that the hook is fired on has already
+// taken place.
+type HookError struct {
+	Event Event
+	Err   error
+}
+
+func (e *HookError) Error() string {
+	return fmt.Sprintf("hook of account %q %s event failed: %s", e.Event.Account.Name, e.Event.Type, e.Err)
+}
+
+func (e *HookError) Unwrap() error {
+	return e.Err
+}
+
+// runHooks runs the hooks of the registry on the event of acc in order, it stops at the first
+// hook that fails.
+func (r Registry) runHooks(eventType EventType, acc Account) error {
+	event := Event{
+		Type:      eventType,
+		Namespace: r.namespace,
+		Account:   acc,
+	}
+
+	for _, hook := range r.hooks {
+		if err := hook.Run(event); err != nil {
+			return &HookError{Event: event, Err: err}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package cosmosaccount
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHooks(t *testing.T) {
+	var events []Event
+
+	r, err := New(
+		WithHome(t.TempDir()),
+		WithNamespace("mars"),
+		WithHooks(HookFunc(func(event Event) error {
+			events = append(events, event)
+			return nil
+		})),
+	)
+	require.NoError(t, err)
+
+	alice, mnemonic, err := r.Create("alice")
+	require.NoError(t, err)
+	require.NoError(t, r.DeleteByName("alice"))
+	_, err = r.Import("alice", mnemonic, "")
+	require.NoError(t, err)
+
+	require.Len(t, events, 3)
+	require.Equal(t, []EventType{EventCreated, EventDeleted, EventImported}, []EventType{
+		events[0].Type,
+		events[1].Type,
+		events[2].Type,
+	})
+	for _, event := range events {
+		require.Equal(t, "mars", event.Namespace)
+		require.Equal(t, alice.Address(AccountPrefixCosmos), event.Account.Address(AccountPrefixCosmos))
+	}
+
+	// the account is created even though the hook fails.
+	errHook := errors.New("faucet is down")
+	failing, err := New(WithHome(t.TempDir()), WithHooks(HookFunc(func(Event) error { return errHook })))
+	require.NoError(t, err)
+
+	bob, mnemonic, err := failing.Create("bob")
+	var hookErr *HookError
+	require.ErrorAs(t, err, &hookErr)
+	require.ErrorIs(t, err, errHook)
+	require.Equal(t, EventCreated, hookErr.Event.Type)
+	require.NotEmpty(t, mnemonic)
+
+	_, err = failing.GetByName(bob.Name)
+	require.NoError(t, err)
+}
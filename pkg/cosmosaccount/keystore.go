@@ -0,0 +1,229 @@
+package cosmosaccount
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	keystoreVersion = 3
+	keystoreCipher  = "aes-128-ctr"
+	keystoreKDF     = "scrypt"
+	keystoreKeyLen  = 32
+)
+
+// scrypt parameters of encrypted keystores, the standard ones of Web3 Secret Storage.
+var (
+	keystoreScryptN = 1 << 18
+	keystoreScryptP = 1
+	keystoreScryptR = 8
+)
+
+// bounds of the key derivation parameters of imported keystores, so keystores cannot make the
+// derivation run out of memory or time. the standard parameters are well within them.
+const (
+	keystoreMaxScryptN = 1 << 20
+	keystoreMaxScryptR = 8
+	keystoreMaxScryptP = 16
+	keystoreMaxPBKDF2C = 1 << 22
+)
+
+// ErrKeystorePassphrase is returned when a keystore cannot be decrypted with the passphrase.
+var ErrKeystorePassphrase = errors.New("could not decrypt the keystore with the given passphrase")
+
+// keystore is a private key encrypted in the Web3 Secret Storage format, version 3.
+type keystore struct {
+	Version int            `json:"version"`
+	ID      string         `json:"id"`
+	Address string         `json:"address,omitempty"`
+	Crypto  keystoreCrypto `json:"crypto"`
+}
+
+type keystoreCrypto struct {
+	Cipher       string                 `json:"cipher"`
+	CipherText   string                 `json:"ciphertext"`
+	CipherParams keystoreCipherParams   `json:"cipherparams"`
+	KDF          string                 `json:"kdf"`
+	KDFParams    map[string]interface{} `json:"kdfparams"`
+	MAC          string                 `json:"mac"`
+}
+
+type keystoreCipherParams struct {
+	IV string `json:"iv"`
+}
+
+// encryptKeystore encrypts the secp256k1 private key with passphrase into a keystore JSON.
+func encryptKeystore(privKey []byte, passphrase string) ([]byte, error) {
+	salt := make([]byte, 32)
+	iv := make([]byte, aes.BlockSize)
+	id := make([]byte, 16)
+	for _, b := range [][]byte{salt, iv, id} {
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+	}
+
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, keystoreScryptN, keystoreScryptR, keystoreScryptP, keystoreKeyLen)
+	if err != nil {
+		return nil, err
+	}
+
+	cipherText, err := aesCTR(derivedKey[:16], iv, privKey)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := keystoreAddress(privKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// random UUID v4.
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+
+	ks := keystore{
+		Version: keystoreVersion,
+		ID:      fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:]),
+		Address: address,
+		Crypto: keystoreCrypto{
+			Cipher:       keystoreCipher,
+			CipherText:   hex.EncodeToString(cipherText),
+			CipherParams: keystoreCipherParams{IV: hex.EncodeToString(iv)},
+			KDF:          keystoreKDF,
+			KDFParams: map[string]interface{}{
+				"n":     keystoreScryptN,
+				"r":     keystoreScryptR,
+				"p":     keystoreScryptP,
+				"dklen": keystoreKeyLen,
+				"salt":  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(keystoreMAC(derivedKey, cipherText)),
+		},
+	}
+
+	return json.MarshalIndent(ks, "", "  ")
+}
+
+// decryptKeystore decrypts the private key of a keystore JSON with passphrase, keys derived with
+// both scrypt and pbkdf2 are supported.
+func decryptKeystore(data []byte, passphrase string) ([]byte, error) {
+	var ks keystore
+	if err := json.Unmarshal(data, &ks); err != nil {
+		return nil, err
+	}
+	if ks.Version != keystoreVersion {
+		return nil, fmt.Errorf("keystore version %d is not supported", ks.Version)
+	}
+	if ks.Crypto.Cipher != keystoreCipher {
+		return nil, fmt.Errorf("keystore cipher %q is not supported", ks.Crypto.Cipher)
+	}
+
+	cipherText, err := hex.DecodeString(ks.Crypto.CipherText)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := hex.DecodeString(ks.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, err
+	}
+	mac, err := hex.DecodeString(ks.Crypto.MAC)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := keystoreDerivedKey(ks.Crypto, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hmac.Equal(keystoreMAC(derivedKey, cipherText), mac) {
+		return nil, ErrKeystorePassphrase
+	}
+
+	return aesCTR(derivedKey[:16], iv, cipherText)
+}
+
+func keystoreDerivedKey(c keystoreCrypto, passphrase string) ([]byte, error) {
+	param := func(name string) int {
+		v, _ := c.KDFParams[name].(float64)
+		return int(v)
+	}
+
+	salt, err := hex.DecodeString(fmt.Sprint(c.KDFParams["salt"]))
+	if err != nil {
+		return nil, err
+	}
+
+	// the first half of the derived key is the key of the cipher and the second half the key of the MAC.
+	if dklen := param("dklen"); dklen != keystoreKeyLen {
+		return nil, fmt.Errorf("keystore derived key length %d is not supported, it must be %d", dklen, keystoreKeyLen)
+	}
+
+	switch c.KDF {
+	case "scrypt":
+		n, r, p := param("n"), param("r"), param("p")
+		if n <= 1 || n > keystoreMaxScryptN || r <= 0 || r > keystoreMaxScryptR || p <= 0 || p > keystoreMaxScryptP {
+			return nil, fmt.Errorf("keystore scrypt parameters n=%d r=%d p=%d are out of bounds (n<=%d r<=%d p<=%d)",
+				n, r, p, keystoreMaxScryptN, keystoreMaxScryptR, keystoreMaxScryptP)
+		}
+		return scrypt.Key([]byte(passphrase), salt, n, r, p, keystoreKeyLen)
+
+	case "pbkdf2":
+		if prf := fmt.Sprint(c.KDFParams["prf"]); prf != "hmac-sha256" {
+			return nil, fmt.Errorf("keystore pseudorandom function %q is not supported", prf)
+		}
+		iterations := param("c")
+		if iterations <= 0 || iterations > keystoreMaxPBKDF2C {
+			return nil, fmt.Errorf("keystore pbkdf2 iterations %d are out of bounds (c<=%d)", iterations, keystoreMaxPBKDF2C)
+		}
+		return pbkdf2.Key([]byte(passphrase), salt, iterations, keystoreKeyLen, sha256.New), nil
+
+	default:
+		return nil, fmt.Errorf("keystore key derivation function %q is not supported", c.KDF)
+	}
+}
+
+func keystoreMAC(derivedKey, cipherText []byte) []byte {
+	return keccak256(derivedKey[16:32], cipherText)
+}
+
+// keystoreAddress returns the Ethereum style address of the key that wallets use to identify keystores.
+func keystoreAddress(privKey []byte) (string, error) {
+	_, pubKey := btcec.PrivKeyFromBytes(btcec.S256(), privKey)
+	if pubKey == nil {
+		return "", errors.New("invalid secp256k1 private key")
+	}
+
+	return hex.EncodeToString(keccak256(pubKey.SerializeUncompressed()[1:])[12:]), nil
+}
+
+func aesCTR(key, iv, in []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, len(in))
+	cipher.NewCTR(block, iv).XORKeyStream(out, in)
+	return out, nil
+}
+
+func keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(bytes.Join(data, nil))
+	return h.Sum(nil)
+}
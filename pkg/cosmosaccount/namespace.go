@@ -0,0 +1,170 @@
+package cosmosaccount
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// namespaceSeparator separates the namespace and the name of accounts in their key names.
+const namespaceSeparator = "/"
+
+// migrationPassphrase encrypts private keys while they're moved between key names, they never
+// leave the registry.
+const migrationPassphrase = "namespace-migration"
+
+// keyName returns the name of the key of the account with name in the keyring.
+func (r Registry) keyName(name string) string {
+	if r.namespace == "" {
+		return name
+	}
+	return r.namespace + namespaceSeparator + name
+}
+
+// accountName returns the name of the account of the key with keyName, it's false when the
+// key is not in the namespace of the registry.
+func (r Registry) accountName(keyName string) (name string, ok bool) {
+	if r.namespace == "" {
+		return keyName, !strings.Contains(keyName, namespaceSeparator)
+	}
+
+	name = strings.TrimPrefix(keyName, r.namespace+namespaceSeparator)
+	return name, name != keyName
+}
+
+// Namespaces lists the namespaces that have accounts in the keyring, sorted by chain ID.
+func (r Registry) Namespaces() ([]string, error) {
+	infos, err := r.Keyring.List()
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := r.allWatchOnlyAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	var keyNames []string
+	for _, info := range infos {
+		keyNames = append(keyNames, info.GetName())
+	}
+	for _, acc := range addresses {
+		keyNames = append(keyNames, acc.Name)
+	}
+
+	var (
+		namespaces []string
+		seen       = make(map[string]bool)
+	)
+	for _, keyName := range keyNames {
+		i := strings.Index(keyName, namespaceSeparator)
+		if i < 0 || seen[keyName[:i]] {
+			continue
+		}
+		seen[keyName[:i]] = true
+		namespaces = append(namespaces, keyName[:i])
+	}
+	sort.Strings(namespaces)
+	return namespaces, nil
+}
+
+// MigrateToNamespace moves the accounts that are not in any namespace to the namespace of the
+// registry. accounts are skipped when the namespace already has an account with the same name,
+// Ledger accounts are skipped too since the device is needed to add them again.
+func (r Registry) MigrateToNamespace() (migrated, skipped []string, err error) {
+	if r.namespace == "" {
+		return nil, nil, errors.New("a namespace is required to migrate accounts to")
+	}
+
+	global := r
+	global.namespace = ""
+
+	accounts, err := global.List()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, acc := range accounts {
+		_, err := r.GetByName(acc.Name)
+		if err == nil || acc.IsLedger() {
+			skipped = append(skipped, acc.Name)
+			continue
+		}
+		var accErr *AccountDoesNotExistError
+		if !errors.As(err, &accErr) {
+			return migrated, skipped, err
+		}
+
+		if err := r.moveAccount(global, acc); err != nil {
+			return migrated, skipped, err
+		}
+
+		migrated = append(migrated, acc.Name)
+	}
+
+	return migrated, skipped, nil
+}
+
+// moveAccount moves acc from the registry from to the namespace of r.
+func (r Registry) moveAccount(from Registry, acc Account) error {
+	if info, ok := acc.Info.(addressInfo); ok {
+		info.Name = r.keyName(acc.Name)
+		if err := r.saveWatchOnlyAddress(acc.Name, info); err != nil {
+			return err
+		}
+		return from.deleteWatchOnlyAddress(acc.Name)
+	}
+
+	// keys are indexed by their addresses too, so the account is deleted before it's saved with
+	// its new name, and it's restored when that fails.
+	var armor string
+	if acc.Info.GetType() == keyring.TypeLocal {
+		var err error
+		if armor, err = from.Keyring.ExportPrivKeyArmor(acc.Name, migrationPassphrase); err != nil {
+			return err
+		}
+	}
+
+	if err := from.Keyring.Delete(acc.Name); err != nil {
+		return err
+	}
+
+	if err := saveAccount(r.Keyring, r.keyName(acc.Name), acc.Info.GetType(), acc.Info.GetPubKey(), acc.Info.GetAlgo(), armor); err != nil {
+		if restoreErr := saveAccount(from.Keyring, acc.Name, acc.Info.GetType(), acc.Info.GetPubKey(), acc.Info.GetAlgo(), armor); restoreErr != nil {
+			return fmt.Errorf("%v, account %q cannot be restored: %v", err, acc.Name, restoreErr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// saveAccount saves an account of keyType to kr with keyName, armor is the private key of local
+// accounts encrypted with migrationPassphrase.
+func saveAccount(
+	kr keyring.Keyring,
+	keyName string,
+	keyType keyring.KeyType,
+	pubKey cryptotypes.PubKey,
+	algo hd.PubKeyType,
+	armor string,
+) error {
+	var err error
+
+	switch keyType {
+	case keyring.TypeLocal:
+		err = kr.ImportPrivKey(keyName, armor, migrationPassphrase)
+	case keyring.TypeOffline:
+		_, err = kr.SavePubKey(keyName, pubKey, algo)
+	case keyring.TypeMulti:
+		_, err = kr.SaveMultisig(keyName, pubKey)
+	default:
+		err = fmt.Errorf("accounts of type %s cannot be saved", keyType)
+	}
+
+	return err
+}
@@ -0,0 +1,78 @@
+package cosmosaccount
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespace(t *testing.T) {
+	home := t.TempDir()
+
+	global, err := New(WithKeyringBackend(KeyringTest), WithHome(home))
+	require.NoError(t, err)
+	mars, err := New(WithKeyringBackend(KeyringTest), WithHome(home), WithNamespace("mars-1"))
+	require.NoError(t, err)
+	venus, err := New(WithKeyringBackend(KeyringTest), WithHome(home), WithNamespace("venus-1"))
+	require.NoError(t, err)
+
+	onMars, _, err := mars.Create("alice")
+	require.NoError(t, err)
+	require.Equal(t, "alice", onMars.Name)
+
+	// the same name is available in other namespaces.
+	_, _, err = venus.Create("alice")
+	require.NoError(t, err)
+
+	_, err = global.GetByName("alice")
+	var accErr *AccountDoesNotExistError
+	require.ErrorAs(t, err, &accErr)
+
+	accounts, err := mars.List()
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, onMars.Address("cosmos"), accounts[0].Address("cosmos"))
+
+	_, err = mars.GetByName("venus-1/alice")
+	require.Error(t, err)
+
+	namespaces, err := global.Namespaces()
+	require.NoError(t, err)
+	require.Equal(t, []string{"mars-1", "venus-1"}, namespaces)
+}
+
+func TestMigrateToNamespace(t *testing.T) {
+	home := t.TempDir()
+
+	global, err := New(WithKeyringBackend(KeyringTest), WithHome(home))
+	require.NoError(t, err)
+	mars, err := New(WithKeyringBackend(KeyringTest), WithHome(home), WithNamespace("mars-1"))
+	require.NoError(t, err)
+
+	bob, _, err := global.Create("bob")
+	require.NoError(t, err)
+	_, _, err = global.Create("alice")
+	require.NoError(t, err)
+	watched, err := global.AddWatchOnly("watched", bob.Address("cosmos"))
+	require.NoError(t, err)
+	_, _, err = mars.Create("alice")
+	require.NoError(t, err)
+
+	migrated, skipped, err := mars.MigrateToNamespace()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"bob", "watched"}, migrated)
+	require.Equal(t, []string{"alice"}, skipped)
+
+	migratedBob, err := mars.GetByName("bob")
+	require.NoError(t, err)
+	require.Equal(t, bob.Address("cosmos"), migratedBob.Address("cosmos"))
+
+	migratedWatched, err := mars.GetByName("watched")
+	require.NoError(t, err)
+	require.Equal(t, watched.Address("cosmos"), migratedWatched.Address("cosmos"))
+
+	accounts, err := global.List()
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, "alice", accounts[0].Name)
+}
@@ -0,0 +1,211 @@
+package cosmosaccount
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// watchOnlyDir is the dir under the registry's home where watch-only accounts of addresses are kept,
+// the keyring can only keep accounts of public keys.
+const watchOnlyDir = "watch-only"
+
+// ErrWatchOnlyAccountExport is returned when exporting a watch-only account, it has no private key.
+var ErrWatchOnlyAccountExport = errors.New("cannot export a watch-only account, its private key is kept elsewhere")
+
+// addressInfo is the keyring.Info of a watch-only account that's only known by its address.
+// the address is kept in bytes since AccAddress is encoded with the global bech32 prefix.
+type addressInfo struct {
+	Name    string `json:"name"`
+	Address []byte `json:"address"`
+}
+
+func (i addressInfo) GetType() keyring.KeyType        { return keyring.TypeOffline }
+func (i addressInfo) GetName() string                 { return i.Name }
+func (i addressInfo) GetPubKey() cryptotypes.PubKey   { return nil }
+func (i addressInfo) GetAddress() sdktypes.AccAddress { return i.Address }
+func (i addressInfo) GetAlgo() hd.PubKeyType          { return "" }
+func (i addressInfo) GetPath() (*hd.BIP44Params, error) {
+	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
+}
+
+// IsWatchOnly checks if the account is a watch-only account, it can be used to monitor balances
+// and prepare unsigned transactions but transactions are signed elsewhere.
+func (a Account) IsWatchOnly() bool {
+	return a.Info.GetType() == keyring.TypeOffline
+}
+
+// AddWatchOnly adds a watch-only account with name from a bech32 or hex address, or from a public
+// key in the JSON format printed by chain binaries or in base64.
+func (r Registry) AddWatchOnly(name, addressOrPubKey string) (Account, error) {
+	_, err := r.GetByName(name)
+	if err == nil {
+		return Account{}, ErrAccountExists
+	}
+	var accErr *AccountDoesNotExistError
+	if !errors.As(err, &accErr) {
+		return Account{}, err
+	}
+
+	if pubKey, err := parsePubKey(addressOrPubKey); err == nil {
+		info, err := r.Keyring.SavePubKey(r.keyName(name), pubKey, hd.Secp256k1Type)
+		if err != nil {
+			return Account{}, err
+		}
+		acc := Account{Name: name, Info: info}
+		return acc, r.runHooks(EventCreated, acc)
+	}
+
+	_, addr, err := ParseAddress(addressOrPubKey)
+	if err != nil {
+		return Account{}, fmt.Errorf("%q is neither an address nor a public key", addressOrPubKey)
+	}
+
+	info := addressInfo{Name: r.keyName(name), Address: addr}
+	if err := r.saveWatchOnlyAddress(name, info); err != nil {
+		return Account{}, err
+	}
+
+	acc := Account{Name: name, Info: info}
+	return acc, r.runHooks(EventCreated, acc)
+}
+
+// saveWatchOnlyAddress saves the watch-only account of an address with name.
+func (r Registry) saveWatchOnlyAddress(name string, info addressInfo) error {
+	b, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	path, err := r.watchOnlyFile(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, b, 0644)
+}
+
+// watchOnlyAddress returns the watch-only account of an address with name.
+func (r Registry) watchOnlyAddress(name string) (Account, error) {
+	path, err := r.watchOnlyFile(name)
+	if err != nil {
+		return Account{}, err
+	}
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return Account{}, &AccountDoesNotExistError{name}
+	}
+	if err != nil {
+		return Account{}, err
+	}
+
+	var info addressInfo
+	if err := json.Unmarshal(b, &info); err != nil {
+		return Account{}, err
+	}
+
+	return Account{Name: name, Info: info}, nil
+}
+
+// watchOnlyAddresses returns the watch-only accounts of addresses sorted by their names.
+func (r Registry) watchOnlyAddresses() ([]Account, error) {
+	files, err := os.ReadDir(r.watchOnlyPath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts []Account
+
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != ".json" {
+			continue
+		}
+
+		acc, err := r.watchOnlyAddress(strings.TrimSuffix(f.Name(), ".json"))
+		if err != nil {
+			return nil, err
+		}
+
+		accounts = append(accounts, acc)
+	}
+
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].Name < accounts[j].Name })
+
+	return accounts, nil
+}
+
+// deleteWatchOnlyAddress deletes the watch-only account of an address with name.
+func (r Registry) deleteWatchOnlyAddress(name string) error {
+	path, err := r.watchOnlyFile(name)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if os.IsNotExist(err) {
+		return &AccountDoesNotExistError{name}
+	}
+	return err
+}
+
+// watchOnlyPath returns the dir of the watch-only accounts of addresses in the namespace of the
+// registry, namespaces are kept in sub dirs.
+func (r Registry) watchOnlyPath() string {
+	return filepath.Join(r.homePath, watchOnlyDir, r.keyringServiceName, r.namespace)
+}
+
+// watchOnlyFile returns the file of the watch-only account of an address with name. names are
+// checked so the file cannot be out of the dir of the namespace, only the key names of the global
+// registry are prefixed with their namespace.
+func (r Registry) watchOnlyFile(name string) (string, error) {
+	segments := []string{name}
+	if r.namespace == "" {
+		segments = strings.SplitN(name, namespaceSeparator, 2)
+	}
+	for _, segment := range segments {
+		if segment == "" || segment == "." || strings.Contains(segment, "..") || strings.ContainsAny(segment, `/\`) {
+			return "", fmt.Errorf("invalid account name %q", name)
+		}
+	}
+	return filepath.Join(r.watchOnlyPath(), name+".json"), nil
+}
+
+// parsePubKey parses a public key in the JSON format printed by chain binaries, such as
+// {"@type":"/cosmos.crypto.secp256k1.PubKey","key":"..."}, or a secp256k1 public key in base64.
+func parsePubKey(s string) (cryptotypes.PubKey, error) {
+	s = strings.TrimSpace(s)
+
+	if strings.HasPrefix(s, "{") {
+		var pubKey cryptotypes.PubKey
+		if err := pubKeyCodec().UnmarshalInterfaceJSON([]byte(s), &pubKey); err != nil {
+			return nil, err
+		}
+		return pubKey, nil
+	}
+
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != secp256k1.PubKeySize {
+		return nil, fmt.Errorf("public key must be %d bytes", secp256k1.PubKeySize)
+	}
+
+	return &secp256k1.PubKey{Key: b}, nil
+}
@@ -0,0 +1,223 @@
+// Package cosmosfaucet is a faucet to request tokens for sdk accounts.
+package cosmosfaucet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	chaincmdrunner "github.com/trino-network/trino/pkg/chaincmd/runner"
+)
+
+const (
+	// DefaultAccountName is the default account to transfer tokens from.
+	DefaultAccountName = "faucet"
+
+	// DefaultDenom is the default denomination to distribute.
+	DefaultDenom = "uatom"
+
+	// DefaultAmount specifies the default amount to transfer to an account
+	// on each request.
+	DefaultAmount = 10000000
+
+	// DefaultMaxAmount specifies the maximum amount that can be tranffered to an
+	// account in all times.
+	DefaultMaxAmount = 100000000
+
+	// DefaultLimitRefreshWindow specifies the time after which the max amount limit
+	// is refreshed for an account [1 year]
+	DefaultRefreshWindow = time.Hour * 24 * 365
+)
+
+// Faucet represents a faucet.
+type Faucet struct {
+	// runner used to intereact with blockchain's binary to transfer tokens.
+	runner chaincmdrunner.Runner
+
+	// chainID is the chain id of the chain that faucet is operating for.
+	chainID string
+
+	// accountName to transfer tokens from.
+	accountName string
+
+	// accountMnemonic is the mnemonic of the account.
+	accountMnemonic string
+
+	// coins keeps a list of coins that can be distributed by the faucet.
+	coins []coin
+
+	// coinsMax is a denom-max pair.
+	// it holds the maximum amounts of coins that can be sent to a single account.
+	coinsMax map[string]uint64
+
+	limitRefreshWindow time.Duration
+
+	// feeGrant holds the fee allowance issued to requesters, nil when fee grants are disabled.
+	feeGrant *feeGrant
+
+	// transfersDisabled indicates that the faucet only issues fee allowances.
+	transfersDisabled bool
+
+	// openAPIData holds template data customizations for serving OpenAPI page & spec.
+	openAPIData openAPIData
+
+	// onTransfer is called with the transfers of each request, nil when they're not reported.
+	onTransfer func(address string, transfers []Transfer)
+}
+
+type coin struct {
+	// amount is the amount of the coin can be distributed per request.
+	amount uint64
+
+	// denom is denomination of the coin to be distributed by the faucet.
+	denom string
+}
+
+func (c coin) String() string {
+	return fmt.Sprintf("%d%s", c.amount, c.denom)
+}
+
+type feeGrant struct {
+	// spendLimit is the maximum amount of coins that the grantee can spend on fees.
+	spendLimit []coin
+
+	// expiration is the duration after which the allowance expires.
+	expiration time.Duration
+}
+
+// spendLimitString returns spend limit in the format accepted by the CLI (e.g. 10token,5stake).
+func (g feeGrant) spendLimitString() string {
+	var coins []string
+	for _, c := range g.spendLimit {
+		coins = append(coins, c.String())
+	}
+	return strings.Join(coins, ",")
+}
+
+// Option configures the faucetOptions.
+type Option func(*Faucet)
+
+// Account provides the account information to transfer tokens from.
+// when mnemonic isn't provided, account assumed to be exists in the keyring.
+func Account(name, mnemonic string) Option {
+	return func(f *Faucet) {
+		f.accountName = name
+		f.accountMnemonic = mnemonic
+	}
+}
+
+// Coin adds a new coin to coins list to distribute by the faucet.
+// the first coin added to the list considered as the default coin during transfer requests.
+//
+// amount is the amount of the coin can be distributed per request.
+// maxAmount is the maximum amount of the coin that can be sent to a single account.
+// denom is denomination of the coin to be distributed by the faucet.
+func Coin(amount, maxAmount uint64, denom string) Option {
+	return func(f *Faucet) {
+		f.coins = append(f.coins, coin{amount, denom})
+		f.coinsMax[denom] = maxAmount
+	}
+}
+
+// FeeGrant enables issuing x/feegrant allowances to requesters so they can pay the tx fees
+// without holding the fee tokens.
+// expiration is the duration after which an allowance expires, zero means that it never expires.
+func FeeGrant(expiration time.Duration) Option {
+	return func(f *Faucet) {
+		if f.feeGrant == nil {
+			f.feeGrant = &feeGrant{}
+		}
+		f.feeGrant.expiration = expiration
+	}
+}
+
+// FeeGrantSpendLimit limits the amount of denom that can be spent on fees with issued allowances.
+// it can be used multiple times to limit several denoms, allowances are unlimited otherwise.
+func FeeGrantSpendLimit(amount uint64, denom string) Option {
+	return func(f *Faucet) {
+		if f.feeGrant == nil {
+			f.feeGrant = &feeGrant{}
+		}
+		f.feeGrant.spendLimit = append(f.feeGrant.spendLimit, coin{amount, denom})
+	}
+}
+
+// FeeGrantOnly disables coin transfers so the faucet only issues fee allowances.
+// it should be used together with FeeGrant.
+func FeeGrantOnly() Option {
+	return func(f *Faucet) {
+		f.transfersDisabled = true
+	}
+}
+
+// RefreshWindow adds the duration to refresh the transfer limit to the faucet
+func RefreshWindow(refreshWindow time.Duration) Option {
+	return func(f *Faucet) {
+		f.limitRefreshWindow = refreshWindow
+	}
+}
+
+// ChainID adds chain id to faucet. faucet will automatically fetch when it isn't provided.
+func ChainID(id string) Option {
+	return func(f *Faucet) {
+		f.chainID = id
+	}
+}
+
+// OpenAPI configures how to serve Open API page and and spec.
+func OpenAPI(apiAddress string) Option {
+	return func(f *Faucet) {
+		f.openAPIData.APIAddress = apiAddress
+	}
+}
+
+// OnTransfer calls fn with the address and the transfers of each request that's served, such
+// as to report the activity of the faucet.
+func OnTransfer(fn func(address string, transfers []Transfer)) Option {
+	return func(f *Faucet) {
+		f.onTransfer = fn
+	}
+}
+
+// New creates a new faucet with ccr (to access and use blockchain's CLI) and given options.
+func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Faucet, error) {
+	f := Faucet{
+		runner:      ccr,
+		accountName: DefaultAccountName,
+		coinsMax:    make(map[string]uint64),
+		openAPIData: openAPIData{"Blockchain", "http://localhost:1317"},
+	}
+
+	for _, apply := range options {
+		apply(&f)
+	}
+
+	if len(f.coins) == 0 {
+		Coin(DefaultAmount, DefaultMaxAmount, DefaultDenom)(&f)
+	}
+
+	if f.limitRefreshWindow == 0 {
+		RefreshWindow(DefaultRefreshWindow)(&f)
+	}
+
+	// import the account if mnemonic is provided.
+	if f.accountMnemonic != "" {
+		_, err := f.runner.AddAccount(ctx, f.accountName, f.accountMnemonic)
+		if err != nil && err != chaincmdrunner.ErrAccountAlreadyExists {
+			return Faucet{}, err
+		}
+	}
+
+	if f.chainID == "" {
+		status, err := f.runner.Status(ctx)
+		if err != nil {
+			return Faucet{}, err
+		}
+
+		f.chainID = status.ChainID
+		f.openAPIData.ChainID = status.ChainID
+	}
+
+	return f, nil
+}
@@ -0,0 +1,168 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/tendermint/starport/starport/pkg/cosmoscoin"
+	"github.com/tendermint/starport/starport/pkg/xhttp"
+)
+
+const (
+	statusOK    = "ok"
+	statusError = "error"
+)
+
+type TransferRequest struct {
+	// AccountAddress to request for coins.
+	AccountAddress string `json:"address"`
+
+	// Coins that are requested.
+	// default ones used when this one isn't provided.
+	Coins []string `json:"coins"`
+}
+
+type TransferResponse struct {
+	Error     string     `json:"error,omitempty"`
+	Transfers []Transfer `json:"transfers,omitempty"`
+	FeeGrant  *Grant     `json:"fee_grant,omitempty"`
+}
+
+type Transfer struct {
+	Coin   string `json:"coin"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Grant is the result of issuing a fee allowance.
+type Grant struct {
+	SpendLimit string `json:"spend_limit,omitempty"`
+	Expiration string `json:"expiration,omitempty"`
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+}
+
+func (f Faucet) faucetHandler(w http.ResponseWriter, r *http.Request) {
+	var req TransferRequest
+
+	// decode request into req.
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		responseError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// issue a fee allowance when enabled.
+	var grant *Grant
+
+	if f.feeGrant != nil {
+		grant = &Grant{
+			SpendLimit: f.feeGrant.spendLimitString(),
+			Status:     statusOK,
+		}
+		if f.feeGrant.expiration != 0 {
+			grant.Expiration = f.feeGrant.expiration.String()
+		}
+
+		if err := f.Grant(r.Context(), req.AccountAddress); err != nil {
+			if err == context.Canceled {
+				return
+			}
+
+			grant.Status = statusError
+			grant.Error = err.Error()
+		}
+	}
+
+	if f.transfersDisabled {
+		responseSuccess(w, nil, grant)
+		return
+	}
+
+	// determine coins to transfer.
+	coins, err := f.coinsToTransfer(req)
+	if err != nil {
+		responseError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// send coins and create a transfers response.
+	var transfers []Transfer
+
+	for _, coin := range coins {
+		t := Transfer{
+			Coin:   coin.String(),
+			Status: statusOK,
+		}
+
+		if err := f.Transfer(r.Context(), req.AccountAddress, coin.amount, coin.denom); err != nil {
+			if err == context.Canceled {
+				return
+			}
+
+			t.Status = statusError
+			t.Error = err.Error()
+		}
+
+		transfers = append(transfers, t)
+	}
+
+	if f.onTransfer != nil && len(transfers) > 0 {
+		f.onTransfer(req.AccountAddress, transfers)
+	}
+
+	// send the response.
+	responseSuccess(w, transfers, grant)
+}
+
+// FaucetInfoResponse is the faucet info payload.
+type FaucetInfoResponse struct {
+	// IsAFaucet indicates that this is a faucet endpoint.
+	// useful for auto discoveries.
+	IsAFaucet bool `json:"is_a_faucet"`
+
+	// ChainID is chain id of the chain that faucet is running for.
+	ChainID string `json:"chain_id"`
+
+	// IsFeeGrantEnabled indicates that the faucet issues fee allowances.
+	IsFeeGrantEnabled bool `json:"is_fee_grant_enabled"`
+}
+
+func (f Faucet) faucetInfoHandler(w http.ResponseWriter, r *http.Request) {
+	xhttp.ResponseJSON(w, http.StatusOK, FaucetInfoResponse{
+		IsAFaucet:         true,
+		ChainID:           f.chainID,
+		IsFeeGrantEnabled: f.feeGrant != nil,
+	})
+}
+
+// coinsToTransfer determines tokens to transfer from transfer request.
+func (f Faucet) coinsToTransfer(req TransferRequest) ([]coin, error) {
+	if len(req.Coins) == 0 {
+		return f.coins, nil
+	}
+
+	var coins []coin
+	for _, c := range req.Coins {
+		amount, denom, err := cosmoscoin.Parse(c)
+		if err != nil {
+			return nil, err
+		}
+		coins = append(coins, coin{amount, denom})
+	}
+
+	return coins, nil
+}
+
+func responseSuccess(w http.ResponseWriter, transfers []Transfer, grant *Grant) {
+	xhttp.ResponseJSON(w, http.StatusOK, TransferResponse{
+		Transfers: transfers,
+		FeeGrant:  grant,
+	})
+}
+
+func responseError(w http.ResponseWriter, code int, err error) {
+	xhttp.ResponseJSON(w, code, TransferResponse{
+		Error: err.Error(),
+	})
+}
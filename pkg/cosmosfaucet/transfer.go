@@ -0,0 +1,124 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	chaincmdrunner "github.com/trino-network/trino/pkg/chaincmd/runner"
+)
+
+var (
+	// ErrFeeGrantNotEnabled is returned when a fee allowance is requested from a faucet that does not issue them.
+	ErrFeeGrantNotEnabled = errors.New("faucet does not issue fee allowances")
+
+	// ErrTransferNotEnabled is returned when coins are requested from a faucet that only issues fee allowances.
+	ErrTransferNotEnabled = errors.New("faucet does not transfer coins, it only issues fee allowances")
+)
+
+// TotalTransferredAmount returns the total transferred amount from faucet account to toAccountAddress.
+func (f Faucet) TotalTransferredAmount(ctx context.Context, toAccountAddress, denom string) (amount uint64, err error) {
+	fromAccount, err := f.runner.ShowAccount(ctx, f.accountName)
+	if err != nil {
+		return 0, err
+	}
+
+	events, err := f.runner.QueryTxEvents(ctx,
+		chaincmdrunner.NewEventSelector("message", "sender", fromAccount.Address),
+		chaincmdrunner.NewEventSelector("transfer", "recipient", toAccountAddress))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, event := range events {
+		if event.Type == "transfer" {
+			for _, attr := range event.Attributes {
+				if attr.Key == "amount" {
+					if !strings.HasSuffix(attr.Value, denom) {
+						continue
+					}
+
+					if time.Since(event.Time) < f.limitRefreshWindow {
+						amountStr := strings.TrimRight(attr.Value, denom)
+						if a, err := strconv.ParseUint(amountStr, 10, 64); err == nil {
+							amount += a
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return amount, nil
+}
+
+// Transfer transfer amount of tokens from the faucet account to toAccountAddress.
+func (f Faucet) Transfer(ctx context.Context, toAccountAddress string, amount uint64, denom string) error {
+	if f.transfersDisabled {
+		return ErrTransferNotEnabled
+	}
+
+	amountStr := fmt.Sprintf("%d%s", amount, denom)
+
+	totalSent, err := f.TotalTransferredAmount(ctx, toAccountAddress, denom)
+	if err != nil {
+		return err
+	}
+
+	if f.coinsMax[denom] != 0 {
+		if totalSent >= f.coinsMax[denom] {
+			return fmt.Errorf("account has reached maximum credit allowed per account (%d)", f.coinsMax[denom])
+		}
+
+		if (totalSent + amount) >= f.coinsMax[denom] {
+			return fmt.Errorf("account is about to reach maximum credit allowed per account. it can only receive up to (%d) in total", f.coinsMax[denom])
+		}
+	}
+
+	fromAccount, err := f.runner.ShowAccount(ctx, f.accountName)
+	if err != nil {
+		return err
+	}
+
+	return f.runner.BankSend(ctx, fromAccount.Address, toAccountAddress, amountStr)
+}
+
+// IsFeeGrantEnabled checks if the faucet issues fee allowances.
+func (f Faucet) IsFeeGrantEnabled() bool {
+	return f.feeGrant != nil
+}
+
+// IsTransferEnabled checks if the faucet transfers coins.
+func (f Faucet) IsTransferEnabled() bool {
+	return !f.transfersDisabled
+}
+
+// Grant issues the faucet's fee allowance from the faucet account to toAccountAddress. it returns
+// once the grant is in a block, so the transfers that follow it from the faucet account don't
+// reuse its sequence.
+func (f Faucet) Grant(ctx context.Context, toAccountAddress string) error {
+	if f.feeGrant == nil {
+		return ErrFeeGrantNotEnabled
+	}
+
+	var expiration time.Time
+	if f.feeGrant.expiration != 0 {
+		expiration = time.Now().Add(f.feeGrant.expiration)
+	}
+
+	fromAccount, err := f.runner.ShowAccount(ctx, f.accountName)
+	if err != nil {
+		return err
+	}
+
+	err = f.runner.FeeGrant(ctx, fromAccount.Address, toAccountAddress, f.feeGrant.spendLimitString(), expiration)
+
+	// the account is already granted by a previous request.
+	if errors.Is(err, chaincmdrunner.ErrFeeAllowanceExists) {
+		return nil
+	}
+	return err
+}
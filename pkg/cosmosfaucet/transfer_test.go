@@ -0,0 +1,71 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trino-network/trino/pkg/chaincmd"
+	chaincmdrunner "github.com/trino-network/trino/pkg/chaincmd/runner"
+)
+
+// fakeChainBinary prints the address of the faucet account and grants a fee allowance once,
+// the next grants fail like the feegrant module does for an existing allowance. like a chain,
+// the failure is only in the result of the grants that are broadcasted in block mode, the
+// messages of txs are not run before they're in a block.
+const fakeChainBinary = `#!/bin/sh
+case "$*" in
+keys*)
+	echo cosmos1faucet
+	;;
+*feegrant*"--broadcast-mode block"*)
+	if [ -f "%[1]s" ]; then
+		echo '{"height":"7","txhash":"B","code":18,"raw_log":"failed to execute message; message index: 0: %[2]s: invalid request"}'
+	else
+		touch "%[1]s"
+		echo '{"height":"6","txhash":"A","code":0,"raw_log":"[]"}'
+	fi
+	;;
+*feegrant*)
+	echo '{"height":"0","txhash":"A","code":0,"raw_log":"[]"}'
+	;;
+esac
+`
+
+func newGrantFaucet(t *testing.T, grantError string) Faucet {
+	dir := t.TempDir()
+	binary := filepath.Join(dir, "marsd")
+	script := fmt.Sprintf(fakeChainBinary, filepath.Join(dir, "granted"), grantError)
+	require.NoError(t, os.WriteFile(binary, []byte(script), 0755))
+
+	ctx := context.Background()
+	runner, err := chaincmdrunner.New(ctx, chaincmd.New(binary))
+	require.NoError(t, err)
+
+	f, err := New(ctx, runner, ChainID("mars"), FeeGrant(time.Hour))
+	require.NoError(t, err)
+	return f
+}
+
+func TestGrant(t *testing.T) {
+	ctx := context.Background()
+
+	f := newGrantFaucet(t, chaincmdrunner.ErrFeeAllowanceExists.Error())
+	require.NoError(t, f.Grant(ctx, "cosmos1grantee"))
+	// the allowance of the first request is kept.
+	require.NoError(t, f.Grant(ctx, "cosmos1grantee"))
+
+	f = newGrantFaucet(t, "insufficient funds")
+	require.NoError(t, f.Grant(ctx, "cosmos1grantee"))
+	err := f.Grant(ctx, "cosmos1grantee")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "insufficient funds")
+
+	f, err = New(ctx, chaincmdrunner.Runner{}, ChainID("mars"))
+	require.NoError(t, err)
+	require.ErrorIs(t, f.Grant(ctx, "cosmos1grantee"), ErrFeeGrantNotEnabled)
+}
@@ -0,0 +1,172 @@
+package cosmosgen
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/otiai10/copy"
+	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+	"github.com/tendermint/starport/starport/pkg/xfilepath"
+)
+
+// cacheVersion is changed to invalidate the outputs cached by previous versions.
+const cacheVersion = "1"
+
+// cachePath is the path where the outputs of code generation are cached.
+var cachePath = xfilepath.JoinFromHome(
+	xfilepath.Path(".starport"),
+	xfilepath.Path("cache"),
+	xfilepath.Path("cosmosgen"),
+)
+
+// generateCache caches the outputs of code generation keyed by the hash of its inputs.
+type generateCache struct {
+	path string
+}
+
+func newGenerateCache(target string) (generateCache, error) {
+	path, err := cachePath()
+	if err != nil {
+		return generateCache{}, err
+	}
+
+	return generateCache{path: filepath.Join(path, target)}, nil
+}
+
+// restore copies the output cached with key into out, it returns false if there is none.
+func (c generateCache) restore(key, out string) (bool, error) {
+	path := filepath.Join(c.path, key)
+
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, copy.Copy(path, out)
+}
+
+// save caches the output at out with key.
+func (c generateCache) save(key, out string) error {
+	if err := os.MkdirAll(c.path, 0755); err != nil {
+		return err
+	}
+
+	// copy into a temporary dir first so concurrent runs never see a partial output.
+	tmp, err := ioutil.TempDir(c.path, key+"-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	if err := copy.Copy(out, tmp); err != nil {
+		return err
+	}
+
+	path := filepath.Join(c.path, key)
+	if err := os.Rename(tmp, path); err != nil {
+		// cached by a concurrent run already.
+		if _, statErr := os.Stat(path); statErr == nil {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// protoPackageHash hashes the .proto files under pkgPath, their imports that reside inside the app
+// and the given params, such as include paths and plugin versions, that affect code generation.
+func (g *generator) protoPackageHash(pkgPath string, includePaths []string, params ...string) (string, error) {
+	h := sha256.New()
+
+	fmt.Fprintln(h, cacheVersion)
+	for _, param := range append(params, includePaths...) {
+		fmt.Fprintln(h, param)
+	}
+
+	var files []string
+	err := filepath.Walk(pkgPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && filepath.Ext(path) == ".proto" {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(files)
+
+	visited := make(map[string]bool)
+	for _, file := range files {
+		visited[file] = true
+	}
+
+	for i := 0; i < len(files); i++ {
+		file := files[i]
+
+		if err := g.hashFile(h, file); err != nil {
+			return "", err
+		}
+
+		parsed, err := protoanalysis.ParseFile(file)
+		if err != nil {
+			return "", err
+		}
+
+		for _, dep := range parsed.Dependencies {
+			path, ok := resolveProtoImport(dep, includePaths)
+
+			// imports outside of the app are pinned by their include paths.
+			if !ok || visited[path] || !strings.HasPrefix(path, g.appPath) {
+				continue
+			}
+
+			visited[path] = true
+			files = append(files, path)
+		}
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashFile writes the path of file relative to the app and its content to h.
+func (g *generator) hashFile(h hash.Hash, file string) error {
+	rel, err := filepath.Rel(g.appPath, file)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(h, filepath.ToSlash(rel))
+
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(h, f)
+	return err
+}
+
+// resolveProtoImport finds the path of an imported .proto file in includePaths the same way protoc does.
+func resolveProtoImport(dep string, includePaths []string) (string, bool) {
+	for _, include := range includePaths {
+		path := filepath.Join(include, dep)
+		if _, err := os.Stat(path); err == nil {
+			return path, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,63 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtoPackageHash(t *testing.T) {
+	appPath := t.TempDir()
+	protoPath := filepath.Join(appPath, "proto")
+
+	write := func(path, content string) {
+		path = filepath.Join(protoPath, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	write("blog/post.proto", `syntax = "proto3";
+package mars.blog;
+import "blog/comment.proto";
+message Post { string title = 1; }`)
+	write("blog/comment.proto", `syntax = "proto3";
+package mars.blog;
+message Comment { string body = 1; }`)
+	write("common/coin.proto", `syntax = "proto3";
+package mars.common;
+message Coin { string denom = 1; }`)
+	write("loan/loan.proto", `syntax = "proto3";
+package mars.loan;
+import "common/coin.proto";
+message Loan { string id = 1; }`)
+
+	var (
+		g            = &generator{appPath: appPath}
+		includePaths = []string{protoPath}
+		loanPath     = filepath.Join(protoPath, "loan")
+	)
+
+	hash := func(params ...string) string {
+		h, err := g.protoPackageHash(loanPath, includePaths, params...)
+		require.NoError(t, err)
+		return h
+	}
+
+	initial := hash("v1")
+	require.Equal(t, initial, hash("v1"))
+	require.NotEqual(t, initial, hash("v2"), "plugin versions are part of the hash")
+
+	// changes to packages that aren't imported don't invalidate the hash.
+	write("blog/post.proto", `syntax = "proto3";
+package mars.blog;
+message Post { string title = 1; string body = 2; }`)
+	require.Equal(t, initial, hash("v1"))
+
+	// changes to imported files do.
+	write("common/coin.proto", `syntax = "proto3";
+package mars.common;
+message Coin { string denom = 1; string amount = 2; }`)
+	require.NotEqual(t, initial, hash("v1"))
+}
@@ -0,0 +1,314 @@
+package cosmosgen
+
+import (
+	"context"
+
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
+	gomodmodule "golang.org/x/mod/module"
+	"golang.org/x/sync/errgroup"
+)
+
+// generateOptions used to configure code generation.
+type generateOptions struct {
+	includeDirs    []string
+	dependencyDirs []string
+	gomodPath      string
+	pluginVersions map[string]string
+	modules        []string
+	progress       func(done, total int)
+
+	jsOut               func(module.Module) string
+	jsIncludeThirdParty bool
+	vuexStoreRootPath   string
+
+	tsClientOut               func(module.Module) string
+	tsClientIncludeThirdParty bool
+	tsClientRootPath          string
+	tsClientGRPCWeb           bool
+
+	composablesRootPath string
+	hooksRootPath       string
+	piniaRootPath       string
+
+	specOut string
+
+	docsOut string
+
+	goClientOut string
+
+	dartOut               func(module.Module) string
+	dartIncludeThirdParty bool
+	dartRootPath          string
+
+	kotlinIncludeThirdParty bool
+	kotlinRootPath          string
+
+	swiftIncludeThirdParty bool
+	swiftRootPath          string
+}
+
+// TODO add WithInstall.
+
+// Option configures code generation.
+type Option func(*generateOptions)
+
+// WithJSGeneration adds JS code generation. out hook is called for each module to
+// retrieve the path that should be used to place generated js code inside for a given module.
+// if includeThirdPartyModules set to true, code generation will be made for the 3rd party modules
+// used by the app -including the SDK- as well.
+func WithJSGeneration(includeThirdPartyModules bool, out func(module.Module) (path string)) Option {
+	return func(o *generateOptions) {
+		o.jsOut = out
+		o.jsIncludeThirdParty = includeThirdPartyModules
+	}
+}
+
+// WithVuexGeneration adds Vuex code generation. storeRootPath is used to determine the root path of generated
+// Vuex stores. includeThirdPartyModules and out configures the underlying JS lib generation which is
+// documented in WithJSGeneration.
+func WithVuexGeneration(includeThirdPartyModules bool, out func(module.Module) (path string), storeRootPath string) Option {
+	return func(o *generateOptions) {
+		o.jsOut = out
+		o.jsIncludeThirdParty = includeThirdPartyModules
+		o.vuexStoreRootPath = storeRootPath
+	}
+}
+
+// WithTSClientGeneration adds standalone TypeScript client generation. rootPath is used to
+// determine the root path of the generated client package and out hook is called for each module
+// to retrieve the path that should be used to place generated code inside for a given module.
+// includeThirdPartyModules is documented in WithJSGeneration.
+func WithTSClientGeneration(includeThirdPartyModules bool, out func(module.Module) (path string), rootPath string) Option {
+	return func(o *generateOptions) {
+		o.tsClientOut = out
+		o.tsClientIncludeThirdParty = includeThirdPartyModules
+		o.tsClientRootPath = rootPath
+	}
+}
+
+// WithTSClientGRPCWeb adds gRPC-web query clients to the TypeScript client, which needs
+// to be enabled by WithTSClientGeneration.
+func WithTSClientGRPCWeb() Option {
+	return func(o *generateOptions) {
+		o.tsClientGRPCWeb = true
+	}
+}
+
+// WithComposablesGeneration adds Vue 3 composables generation for module queries on top of the
+// TypeScript client, which needs to be enabled by WithTSClientGeneration. rootPath is used to determine
+// the root path of the generated composables.
+func WithComposablesGeneration(rootPath string) Option {
+	return func(o *generateOptions) {
+		o.composablesRootPath = rootPath
+	}
+}
+
+// WithHooksGeneration adds React Query hooks generation for module queries on top of the
+// TypeScript client, which needs to be enabled by WithTSClientGeneration. rootPath is used to determine
+// the root path of the generated hooks.
+func WithHooksGeneration(rootPath string) Option {
+	return func(o *generateOptions) {
+		o.hooksRootPath = rootPath
+	}
+}
+
+// WithPiniaGeneration adds Pinia stores generation for modules on top of the TypeScript client,
+// which needs to be enabled by WithTSClientGeneration. rootPath is used to determine the root path
+// of the generated stores.
+func WithPiniaGeneration(rootPath string) Option {
+	return func(o *generateOptions) {
+		o.piniaRootPath = rootPath
+	}
+}
+
+func WithDartGeneration(includeThirdPartyModules bool, out func(module.Module) (path string), rootPath string) Option {
+	return func(o *generateOptions) {
+		o.dartOut = out
+		o.dartIncludeThirdParty = includeThirdPartyModules
+		o.dartRootPath = rootPath
+	}
+}
+
+// WithKotlinGeneration adds Kotlin client generation for Android apps. rootPath is the path of the
+// generated library that has the protobuf models of the modules and their query and tx clients.
+// includeThirdPartyModules is documented in WithJSGeneration.
+func WithKotlinGeneration(includeThirdPartyModules bool, rootPath string) Option {
+	return func(o *generateOptions) {
+		o.kotlinIncludeThirdParty = includeThirdPartyModules
+		o.kotlinRootPath = rootPath
+	}
+}
+
+// WithSwiftGeneration adds Swift client generation for iOS apps. rootPath is the path of the
+// generated Swift package that has the protobuf models of the modules and their query and tx clients.
+// it requires protoc-gen-swift to be installed. includeThirdPartyModules is documented in WithJSGeneration.
+func WithSwiftGeneration(includeThirdPartyModules bool, rootPath string) Option {
+	return func(o *generateOptions) {
+		o.swiftIncludeThirdParty = includeThirdPartyModules
+		o.swiftRootPath = rootPath
+	}
+}
+
+// WithGoGeneration adds Go code generation.
+func WithGoGeneration(gomodPath string) Option {
+	return func(o *generateOptions) {
+		o.gomodPath = gomodPath
+	}
+}
+
+// WithGoClientGeneration adds standalone Go client generation for the app's modules. out is the
+// path of the generated Go module and must be inside the app. it requires Go generation to be
+// enabled by WithGoGeneration so the copied types are up to date.
+func WithGoClientGeneration(out string) Option {
+	return func(o *generateOptions) {
+		o.goClientOut = out
+	}
+}
+
+// WithOpenAPIGeneration adds OpenAPI spec generation.
+func WithOpenAPIGeneration(out string) Option {
+	return func(o *generateOptions) {
+		o.specOut = out
+	}
+}
+
+// WithDocsGeneration adds generation of a static documentation site for the app's proto files
+// with their comments, messages and RPC methods. out is the path of the site.
+func WithDocsGeneration(out string) Option {
+	return func(o *generateOptions) {
+		o.docsOut = out
+	}
+}
+
+// IncludeDirs configures the third party proto dirs that used by app's proto.
+// relative to the projectPath.
+func IncludeDirs(dirs []string) Option {
+	return func(o *generateOptions) {
+		o.includeDirs = dirs
+	}
+}
+
+// IncludeDependencyDirs configures the absolute paths of proto dirs that are resolved outside
+// of the app's Go dependencies, such as the ones of buf dependencies. they have the lowest
+// precedence while resolving imports.
+func IncludeDependencyDirs(dirs []string) Option {
+	return func(o *generateOptions) {
+		o.dependencyDirs = dirs
+	}
+}
+
+// WithPluginVersions overrides the pinned versions of the default protoc plugins, versions is
+// keyed by the names of plugins such as protoc-gen-gocosmos.
+func WithPluginVersions(versions map[string]string) Option {
+	return func(o *generateOptions) {
+		o.pluginVersions = versions
+	}
+}
+
+// WithModules limits the generation of Go code and the TypeScript client to the modules with
+// names, so the code of other modules is left as is. modules of the TypeScript client that are
+// not generated yet are still generated.
+func WithModules(names ...string) Option {
+	return func(o *generateOptions) {
+		o.modules = names
+	}
+}
+
+// WithProgress reports the progress of the generation of Go code, report is called with the
+// number of proto packages that are generated out of the total each time a package is generated.
+func WithProgress(report func(done, total int)) Option {
+	return func(o *generateOptions) {
+		o.progress = report
+	}
+}
+
+// generator generates code for sdk and sdk apps.
+type generator struct {
+	ctx          context.Context
+	appPath      string
+	protoDir     string
+	o            *generateOptions
+	deps         []gomodmodule.Version
+	protoModules []resolvedProtoModule
+	plugins      []Plugin
+	pluginPaths  map[string]string // plugin name-binary path pair.
+	appModules   []module.Module
+	thirdModules map[string][]module.Module // app dependency-modules pair.
+}
+
+// Generate generates code from protoDir of an SDK app residing at appPath with given options.
+// protoDir must be relative to the projectPath.
+func Generate(ctx context.Context, appPath, protoDir string, options ...Option) error {
+	g := &generator{
+		ctx:          ctx,
+		appPath:      appPath,
+		protoDir:     protoDir,
+		o:            &generateOptions{},
+		thirdModules: make(map[string][]module.Module),
+	}
+
+	for _, apply := range options {
+		apply(g.o)
+	}
+
+	if err := g.setup(); err != nil {
+		return err
+	}
+
+	if err := g.checkModules(); err != nil {
+		return err
+	}
+
+	if err := g.setupPlugins(); err != nil {
+		return err
+	}
+
+	if g.o.gomodPath != "" {
+		if err := g.generateGo(); err != nil {
+			return err
+		}
+	}
+
+	// go client copies the generated Go types.
+	if g.o.goClientOut != "" {
+		if err := g.generateGoClient(); err != nil {
+			return err
+		}
+	}
+
+	// the clients are generated from the Go types, since the sdk.Msg implementations are defined
+	// on the generated Go types, so they're generated once the Go code is. they're independent of
+	// each other, so they're generated concurrently.
+	gg, ctx := errgroup.WithContext(g.ctx)
+	g.ctx = ctx
+
+	if g.o.jsOut != nil {
+		gg.Go(g.generateJS)
+	}
+
+	if g.o.tsClientOut != nil {
+		gg.Go(g.generateTSClient)
+	}
+
+	if g.o.dartOut != nil {
+		gg.Go(g.generateDart)
+	}
+
+	if g.o.kotlinRootPath != "" {
+		gg.Go(g.generateKotlin)
+	}
+
+	if g.o.swiftRootPath != "" {
+		gg.Go(g.generateSwift)
+	}
+
+	if g.o.specOut != "" {
+		gg.Go(func() error { return generateOpenAPISpec(g) })
+	}
+
+	if g.o.docsOut != "" {
+		gg.Go(g.generateDocs)
+	}
+
+	return gg.Wait()
+}
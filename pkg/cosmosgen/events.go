@@ -0,0 +1,56 @@
+package cosmosgen
+
+import (
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
+	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+)
+
+// eventsFile is the conventional name of the proto file that defines the typed events of a module.
+const eventsFile = "events.proto"
+
+// moduleEvent is a typed event emitted by a module.
+type moduleEvent struct {
+	// Name is the name of the event's proto message.
+	Name string
+
+	// ID is the name of the event without the Event prefix.
+	ID string
+
+	// Type is the type of the event, which is the full name of its proto message.
+	Type string
+
+	// Path is the path of the proto file that defines the event.
+	Path string
+}
+
+// moduleEvents returns the typed events of m. proto messages are considered as events when they
+// are defined in an events.proto file or when their names are prefixed with Event, following the
+// convention of the Cosmos SDK.
+func moduleEvents(m module.Module) []moduleEvent {
+	var events []moduleEvent
+	for _, msg := range m.Pkg.Messages {
+		if !isEventMessage(msg) {
+			continue
+		}
+		events = append(events, moduleEvent{
+			Name: msg.Name,
+			ID:   strings.TrimPrefix(msg.Name, "Event"),
+			Type: m.Pkg.Name + "." + msg.Name,
+			Path: msg.Path,
+		})
+	}
+	return events
+}
+
+func isEventMessage(msg protoanalysis.Message) bool {
+	if filepath.Base(msg.Path) == eventsFile {
+		return true
+	}
+
+	name := strings.TrimPrefix(msg.Name, "Event")
+	return name != msg.Name && name != "" && unicode.IsUpper([]rune(name)[0])
+}
@@ -0,0 +1,28 @@
+package cosmosgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
+	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+)
+
+func TestModuleEvents(t *testing.T) {
+	m := module.Module{
+		Pkg: protoanalysis.Package{
+			Name: "mars.blog",
+			Messages: []protoanalysis.Message{
+				{Name: "EventPostCreated", Path: "proto/blog/post.proto"},
+				{Name: "PostDeleted", Path: "proto/blog/events.proto"},
+				{Name: "Eventual", Path: "proto/blog/post.proto"},
+				{Name: "Post", Path: "proto/blog/post.proto"},
+			},
+		},
+	}
+
+	require.Equal(t, []moduleEvent{
+		{Name: "EventPostCreated", ID: "PostCreated", Type: "mars.blog.EventPostCreated", Path: "proto/blog/post.proto"},
+		{Name: "PostDeleted", ID: "PostDeleted", Type: "mars.blog.PostDeleted", Path: "proto/blog/events.proto"},
+	}, moduleEvents(m))
+}
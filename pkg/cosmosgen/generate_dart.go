@@ -0,0 +1,171 @@
+package cosmosgen
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mattn/go-zglob"
+	"github.com/pkg/errors"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
+	protocgendart "github.com/tendermint/starport/starport/pkg/protoc-gen-dart"
+	"golang.org/x/sync/errgroup"
+)
+
+var (
+	dartOut = []string{
+		"--dart_out=grpc:.",
+	}
+)
+
+const (
+	dartExportFileName = "export.dart"
+	dartClientDirName  = "client"
+)
+
+// dartModule is a module that is included in the Dart client.
+type dartModule struct {
+	// Name is the import prefix of the module.
+	Name string
+
+	// Path is the path of the module relative to the client root.
+	Path string
+}
+
+type dartGenerator struct {
+	g *generator
+
+	mu      sync.Mutex
+	modules []dartModule
+}
+
+func newDartGenerator(g *generator) *dartGenerator {
+	return &dartGenerator{
+		g: g,
+	}
+}
+
+func (g *generator) generateDart() error {
+	dg := newDartGenerator(g)
+
+	if err := dg.generateModules(); err != nil {
+		return err
+	}
+
+	return dg.generateRoot()
+}
+
+func (g *dartGenerator) generateModules() error {
+	flag, cleanup, err := protocgendart.Flag()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	gg := &errgroup.Group{}
+
+	add := func(sourcePath string, modules []module.Module) {
+		for _, m := range modules {
+			m := m
+			gg.Go(func() error { return g.generateModule(g.g.ctx, flag, sourcePath, m) })
+		}
+	}
+
+	add(g.g.appPath, g.g.appModules)
+
+	if g.g.o.dartIncludeThirdParty {
+		for sourcePath, modules := range g.g.thirdModules {
+			add(sourcePath, modules)
+		}
+	}
+
+	return gg.Wait()
+}
+
+func (g *dartGenerator) generateModule(ctx context.Context, plugin, appPath string, m module.Module) error {
+	var (
+		out       = g.g.o.dartOut(m)
+		clientOut = filepath.Join(out, dartClientDirName)
+		exportOut = filepath.Join(out, dartExportFileName)
+	)
+
+	includePaths, err := g.g.resolveInclude(appPath)
+	if err != nil {
+		return err
+	}
+
+	// reset destination dir.
+	if err := os.RemoveAll(out); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(clientOut, 0766); err != nil {
+		return err
+	}
+
+	// generate grpc client and protobuf types.
+	if err := generateProto(
+		ctx,
+		clientOut,
+		m.Pkg.Path,
+		includePaths,
+		dartOut,
+		protocPlugin(plugin),
+		protocDependencies(),
+	); err != nil {
+		return err
+	}
+
+	// generate an export file to export all generated code through a single entrypoint.
+	generatedFiles, err := zglob.Glob(filepath.Join(clientOut, "**/*.dart"))
+	if err != nil {
+		return err
+	}
+
+	var exportContent bytes.Buffer
+	for _, file := range generatedFiles {
+		path, err := filepath.Rel(out, file)
+		if err != nil {
+			return err
+		}
+		exportContent.WriteString(fmt.Sprintf("export '%s';\n", path))
+	}
+
+	err = os.WriteFile(exportOut, exportContent.Bytes(), 0644)
+	if err != nil {
+		return errors.Wrap(err, "could not create the Dart export file for module")
+	}
+
+	// generate the module's entrypoint that registers its msgs and creates its gRPC clients.
+	if err := templateDartModule.Write(out, "", struct{ Module module.Module }{m}); err != nil {
+		return err
+	}
+
+	path, err := filepath.Rel(g.g.o.dartRootPath, out)
+	if err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.modules = append(g.modules, dartModule{
+		Name: strings.ReplaceAll(m.Pkg.Name, ".", "_"),
+		Path: filepath.ToSlash(path),
+	})
+
+	return nil
+}
+
+// generateRoot generates the client that registers msgs of all modules and signs transactions
+// with the wallets of the Flutter app.
+func (g *dartGenerator) generateRoot() error {
+	// keep the output stable between generations.
+	sort.Slice(g.modules, func(i, j int) bool { return g.modules[i].Path < g.modules[j].Path })
+
+	return templateDartRoot.Write(g.g.o.dartRootPath, "", struct{ Modules []dartModule }{g.modules})
+}
@@ -0,0 +1,606 @@
+package cosmosgen
+
+import (
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/exec"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+	"github.com/trino-network/trino/pkg/cmdtrace"
+	"github.com/trino-network/trino/pkg/toolchain"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	docsTemplatesDir  = "templates/docs"
+	docsDescriptorSet = "descriptor_set.pb"
+
+	// docsScaffoldingComment marks the comments placed by scaffolding, they are left out of the docs.
+	docsScaffoldingComment = "this line is used by starport scaffolding"
+
+	// httpRuleField is the field number of the google.api.http option of RPC methods.
+	httpRuleField = 72295728
+)
+
+// field numbers of the descriptors that are used in the paths of source code locations.
+const (
+	fileMessageField   = 4
+	fileEnumField      = 5
+	fileServiceField   = 6
+	messageFieldField  = 2
+	messageNestedField = 3
+	messageEnumField   = 4
+	enumValueField     = 2
+	serviceMethodField = 2
+)
+
+// docsPackage is a proto package that is documented.
+type docsPackage struct {
+	Name     string
+	Files    []string
+	Services []docsService
+	Messages []docsMessage
+	Enums    []docsEnum
+}
+
+// Page is the name of the package's page.
+func (p docsPackage) Page() string {
+	return p.Name + ".html"
+}
+
+type docsService struct {
+	Name    string
+	Comment string
+	Methods []docsMethod
+}
+
+type docsMethod struct {
+	Name           string
+	Comment        string
+	Request        docsType
+	Response       docsType
+	RequestStream  bool
+	ResponseStream bool
+	HTTPRules      []docsHTTPRule
+}
+
+type docsHTTPRule struct {
+	Method string
+	Path   string
+}
+
+type docsMessage struct {
+	Name     string
+	FullName string
+	Comment  string
+	Fields   []docsField
+}
+
+type docsField struct {
+	Name    string
+	Number  int32
+	Label   string
+	Type    docsType
+	Comment string
+}
+
+type docsEnum struct {
+	Name     string
+	FullName string
+	Comment  string
+	Values   []docsEnumValue
+}
+
+type docsEnumValue struct {
+	Name    string
+	Number  int32
+	Comment string
+}
+
+// docsType is the type of a field or an RPC method, Link is set when the type is documented.
+type docsType struct {
+	Name string
+	Link string
+}
+
+// docsGenerator generates a static documentation site for the proto files of the app.
+type docsGenerator struct {
+	g *generator
+
+	// comments are the comments of descriptors keyed by their file and path.
+	comments map[string]string
+
+	// pages are the pages of documented types keyed by their full names.
+	pages map[string]string
+
+	// mapEntries are the key and value types of map fields keyed by the full names of their entries.
+	mapEntries map[string][2]*descriptorpb.FieldDescriptorProto
+}
+
+func (g *generator) generateDocs() error {
+	files, err := g.docsDescriptors()
+	if err != nil {
+		return err
+	}
+
+	dg := &docsGenerator{
+		g:          g,
+		comments:   make(map[string]string),
+		pages:      make(map[string]string),
+		mapEntries: make(map[string][2]*descriptorpb.FieldDescriptorProto),
+	}
+
+	return dg.generate(files)
+}
+
+// docsDescriptors returns the descriptors of the app's proto files with their comments.
+func (g *generator) docsDescriptors() ([]*descriptorpb.FileDescriptorProto, error) {
+	includePaths, err := g.resolveInclude(g.appPath)
+	if err != nil {
+		return nil, err
+	}
+
+	pkgs, err := protoanalysis.Parse(g.ctx, nil, filepath.Join(g.appPath, g.protoDir))
+	if err != nil {
+		return nil, err
+	}
+
+	files := pkgs.Files().Paths()
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	tmp, err := ioutil.TempDir("", "gen-docs")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	cmd, cleanup, err := toolchain.ProtocCommand()
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	command := cmd.Command
+	for _, path := range includePaths {
+		if _, err := os.Stat(path); err == nil {
+			command = append(command, "-I", path)
+		}
+	}
+
+	setPath := filepath.Join(tmp, docsDescriptorSet)
+	command = append(command, "--include_source_info", "--descriptor_set_out="+setPath)
+	command = append(command, files...)
+
+	if err := exec.Exec(g.ctx, command,
+		exec.StepOption(step.Workdir(tmp)),
+		exec.StepOption(cmdtrace.Step()),
+		exec.IncludeStdLogsToError(),
+	); err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(setPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// options of other proto files, such as google.api.http, are kept as unknown fields
+	// regardless of the types linked into the binary.
+	var set descriptorpb.FileDescriptorSet
+	if err := (proto.UnmarshalOptions{Resolver: &protoregistry.Types{}}).Unmarshal(data, &set); err != nil {
+		return nil, err
+	}
+
+	return set.File, nil
+}
+
+func (g *docsGenerator) generate(files []*descriptorpb.FileDescriptorProto) error {
+	// index comments and types first so types can be linked across packages.
+	for _, file := range files {
+		g.indexComments(file)
+		g.indexTypes(file)
+	}
+
+	pkgs := make(map[string]*docsPackage)
+
+	for _, file := range files {
+		pkg, ok := pkgs[file.GetPackage()]
+		if !ok {
+			pkg = &docsPackage{Name: file.GetPackage()}
+			pkgs[pkg.Name] = pkg
+		}
+
+		g.addFile(pkg, file)
+	}
+
+	var packages []docsPackage
+	for _, pkg := range pkgs {
+		sort.Strings(pkg.Files)
+		sort.Slice(pkg.Services, func(i, j int) bool { return pkg.Services[i].Name < pkg.Services[j].Name })
+		sort.Slice(pkg.Messages, func(i, j int) bool { return pkg.Messages[i].Name < pkg.Messages[j].Name })
+		sort.Slice(pkg.Enums, func(i, j int) bool { return pkg.Enums[i].Name < pkg.Enums[j].Name })
+		packages = append(packages, *pkg)
+	}
+	sort.Slice(packages, func(i, j int) bool { return packages[i].Name < packages[j].Name })
+
+	return g.render(packages)
+}
+
+// render writes the index page, a page for each package and the assets of the site.
+func (g *docsGenerator) render(packages []docsPackage) error {
+	out := g.g.o.docsOut
+
+	if err := resetDocsDir(g.g.appPath, out); err != nil {
+		return err
+	}
+
+	tpl, err := template.New("docs").ParseFS(templates, docsTemplatesDir+"/*.html.tpl")
+	if err != nil {
+		return err
+	}
+
+	write := func(name, page string, data interface{}) error {
+		f, err := os.Create(filepath.Join(out, page))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		return tpl.ExecuteTemplate(f, name, data)
+	}
+
+	site := struct {
+		Title    string
+		Packages []docsPackage
+	}{
+		Title:    filepath.Base(g.g.appPath),
+		Packages: packages,
+	}
+
+	if err := write("index.html.tpl", "index.html", site); err != nil {
+		return err
+	}
+
+	for _, pkg := range packages {
+		data := struct {
+			Title    string
+			Packages []docsPackage
+			Package  docsPackage
+		}{site.Title, packages, pkg}
+
+		if err := write("package.html.tpl", pkg.Page(), data); err != nil {
+			return err
+		}
+	}
+
+	style, err := templates.ReadFile(docsTemplatesDir + "/style.css")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(out, "style.css"), style, 0644)
+}
+
+// resetDocsDir removes the pages and the assets of the previous site from out, the other files of
+// out are kept. out cannot be the app's root or one of its parents, its pages would be mixed
+// with the app's files.
+func resetDocsDir(appPath, out string) error {
+	absApp, err := filepath.Abs(appPath)
+	if err != nil {
+		return err
+	}
+	absOut, err := filepath.Abs(out)
+	if err != nil {
+		return err
+	}
+	if rel, err := filepath.Rel(absOut, absApp); err == nil && !strings.HasPrefix(rel, "..") {
+		return fmt.Errorf("the docs cannot be generated to %s, it's not a dir of the app", out)
+	}
+
+	entries, err := os.ReadDir(out)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for _, e := range entries {
+		if e.IsDir() || (filepath.Ext(e.Name()) != ".html" && e.Name() != "style.css") {
+			continue
+		}
+		if err := os.Remove(filepath.Join(out, e.Name())); err != nil {
+			return err
+		}
+	}
+
+	return os.MkdirAll(out, 0766)
+}
+
+func (g *docsGenerator) indexComments(file *descriptorpb.FileDescriptorProto) {
+	for _, loc := range file.GetSourceCodeInfo().GetLocation() {
+		comment := loc.GetLeadingComments()
+		if comment == "" {
+			comment = loc.GetTrailingComments()
+		}
+
+		if comment = cleanDocsComment(comment); comment != "" {
+			g.comments[docsCommentKey(file, loc.Path...)] = comment
+		}
+	}
+}
+
+func (g *docsGenerator) indexTypes(file *descriptorpb.FileDescriptorProto) {
+	page := file.GetPackage() + ".html"
+
+	var addMessages func(prefix string, messages []*descriptorpb.DescriptorProto)
+	addMessages = func(prefix string, messages []*descriptorpb.DescriptorProto) {
+		for _, m := range messages {
+			name := prefix + "." + m.GetName()
+
+			if m.GetOptions().GetMapEntry() {
+				g.mapEntries[name] = [2]*descriptorpb.FieldDescriptorProto{m.Field[0], m.Field[1]}
+				continue
+			}
+
+			g.pages[name] = page
+			for _, e := range m.EnumType {
+				g.pages[name+"."+e.GetName()] = page
+			}
+
+			addMessages(name, m.NestedType)
+		}
+	}
+
+	addMessages(file.GetPackage(), file.MessageType)
+
+	for _, e := range file.EnumType {
+		g.pages[file.GetPackage()+"."+e.GetName()] = page
+	}
+}
+
+// addFile adds the services, messages and enums of file to pkg.
+func (g *docsGenerator) addFile(pkg *docsPackage, file *descriptorpb.FileDescriptorProto) {
+	pkg.Files = append(pkg.Files, file.GetName())
+
+	for i, s := range file.Service {
+		service := docsService{
+			Name:    s.GetName(),
+			Comment: g.comments[docsCommentKey(file, fileServiceField, int32(i))],
+		}
+
+		for j, m := range s.Method {
+			service.Methods = append(service.Methods, docsMethod{
+				Name:           m.GetName(),
+				Comment:        g.comments[docsCommentKey(file, fileServiceField, int32(i), serviceMethodField, int32(j))],
+				Request:        g.typeRef(m.GetInputType()),
+				Response:       g.typeRef(m.GetOutputType()),
+				RequestStream:  m.GetClientStreaming(),
+				ResponseStream: m.GetServerStreaming(),
+				HTTPRules:      httpRules(m.GetOptions()),
+			})
+		}
+
+		pkg.Services = append(pkg.Services, service)
+	}
+
+	var addMessages func(prefix string, path []int32, messages []*descriptorpb.DescriptorProto)
+	addMessages = func(prefix string, path []int32, messages []*descriptorpb.DescriptorProto) {
+		for i, m := range messages {
+			if m.GetOptions().GetMapEntry() {
+				continue
+			}
+
+			var (
+				name        = strings.TrimPrefix(prefix+"."+m.GetName(), file.GetPackage()+".")
+				messagePath = append(append([]int32{}, path...), int32(i))
+			)
+
+			message := docsMessage{
+				Name:     name,
+				FullName: prefix + "." + m.GetName(),
+				Comment:  g.comments[docsCommentKey(file, messagePath...)],
+			}
+
+			for j, f := range m.Field {
+				message.Fields = append(message.Fields, g.field(file, f, append(messagePath, messageFieldField, int32(j))))
+			}
+
+			pkg.Messages = append(pkg.Messages, message)
+
+			for j, e := range m.EnumType {
+				pkg.Enums = append(pkg.Enums, g.enum(file, message.FullName, e, append(messagePath, messageEnumField, int32(j))))
+			}
+
+			addMessages(message.FullName, append(messagePath, messageNestedField), m.NestedType)
+		}
+	}
+
+	addMessages(file.GetPackage(), []int32{fileMessageField}, file.MessageType)
+
+	for i, e := range file.EnumType {
+		pkg.Enums = append(pkg.Enums, g.enum(file, file.GetPackage(), e, []int32{fileEnumField, int32(i)}))
+	}
+}
+
+func (g *docsGenerator) field(file *descriptorpb.FileDescriptorProto, f *descriptorpb.FieldDescriptorProto, path []int32) docsField {
+	field := docsField{
+		Name:    f.GetName(),
+		Number:  f.GetNumber(),
+		Type:    g.fieldType(f),
+		Comment: g.comments[docsCommentKey(file, path...)],
+	}
+
+	switch {
+	case g.isMapField(f):
+		field.Label = "map"
+	case f.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED:
+		field.Label = "repeated"
+	case f.GetProto3Optional():
+		field.Label = "optional"
+	}
+
+	return field
+}
+
+func (g *docsGenerator) enum(file *descriptorpb.FileDescriptorProto, prefix string, e *descriptorpb.EnumDescriptorProto, path []int32) docsEnum {
+	enum := docsEnum{
+		Name:     strings.TrimPrefix(prefix+"."+e.GetName(), file.GetPackage()+"."),
+		FullName: prefix + "." + e.GetName(),
+		Comment:  g.comments[docsCommentKey(file, path...)],
+	}
+
+	for i, v := range e.Value {
+		enum.Values = append(enum.Values, docsEnumValue{
+			Name:    v.GetName(),
+			Number:  v.GetNumber(),
+			Comment: g.comments[docsCommentKey(file, append(path, enumValueField, int32(i))...)],
+		})
+	}
+
+	return enum
+}
+
+func (g *docsGenerator) isMapField(f *descriptorpb.FieldDescriptorProto) bool {
+	_, ok := g.mapEntries[strings.TrimPrefix(f.GetTypeName(), ".")]
+	return ok && f.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+}
+
+// fieldType returns the type of f, map fields are shown as map<key, value>.
+func (g *docsGenerator) fieldType(f *descriptorpb.FieldDescriptorProto) docsType {
+	if g.isMapField(f) {
+		entry := g.mapEntries[strings.TrimPrefix(f.GetTypeName(), ".")]
+		key, value := g.fieldType(entry[0]), g.fieldType(entry[1])
+
+		return docsType{
+			Name: fmt.Sprintf("map<%s, %s>", key.Name, value.Name),
+			Link: value.Link,
+		}
+	}
+
+	if f.GetTypeName() != "" {
+		return g.typeRef(f.GetTypeName())
+	}
+
+	return docsType{Name: strings.ToLower(strings.TrimPrefix(f.GetType().String(), "TYPE_"))}
+}
+
+// typeRef returns the type with the fully qualified name, it's linked to its page when it's documented.
+func (g *docsGenerator) typeRef(name string) docsType {
+	name = strings.TrimPrefix(name, ".")
+
+	t := docsType{Name: name}
+	if page, ok := g.pages[name]; ok {
+		t.Link = page + "#" + name
+	}
+
+	return t
+}
+
+func docsCommentKey(file *descriptorpb.FileDescriptorProto, path ...int32) string {
+	key := file.GetName()
+	for _, p := range path {
+		key += "." + strconv.Itoa(int(p))
+	}
+	return key
+}
+
+// cleanDocsComment trims comment and removes the lines placed by scaffolding.
+func cleanDocsComment(comment string) string {
+	var lines []string
+	for _, line := range strings.Split(comment, "\n") {
+		if strings.Contains(line, docsScaffoldingComment) {
+			continue
+		}
+		lines = append(lines, strings.TrimPrefix(line, " "))
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// httpRules returns the HTTP rules of the google.api.http option of an RPC method.
+func httpRules(options *descriptorpb.MethodOptions) []docsHTTPRule {
+	var rules []docsHTTPRule
+
+	b := options.ProtoReflect().GetUnknown()
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return rules
+		}
+		b = b[n:]
+
+		if num != httpRuleField || typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return rules
+			}
+			b = b[n:]
+			continue
+		}
+
+		rule, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return rules
+		}
+		b = b[n:]
+
+		rules = append(rules, parseHTTPRule(rule)...)
+	}
+
+	return rules
+}
+
+// parseHTTPRule parses a google.api.HttpRule with its additional bindings.
+func parseHTTPRule(b []byte) []docsHTTPRule {
+	var (
+		rule       docsHTTPRule
+		additional []docsHTTPRule
+	)
+
+	methods := map[protowire.Number]string{2: "GET", 3: "PUT", 4: "POST", 5: "DELETE", 6: "PATCH"}
+
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			break
+		}
+		b = b[n:]
+
+		if typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				break
+			}
+			b = b[n:]
+			continue
+		}
+
+		value, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			break
+		}
+		b = b[n:]
+
+		if method, ok := methods[num]; ok {
+			rule = docsHTTPRule{Method: method, Path: string(value)}
+		}
+
+		// additional_bindings.
+		if num == 11 {
+			additional = append(additional, parseHTTPRule(value)...)
+		}
+	}
+
+	if rule.Method == "" {
+		return additional
+	}
+	return append([]docsHTTPRule{rule}, additional...)
+}
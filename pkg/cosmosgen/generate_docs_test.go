@@ -0,0 +1,66 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestCleanDocsComment(t *testing.T) {
+	comment := " Queries a list of posts.\n this line is used by starport scaffolding # 2\n"
+	require.Equal(t, "Queries a list of posts.", cleanDocsComment(comment))
+}
+
+func TestHTTPRules(t *testing.T) {
+	var additional []byte
+	additional = protowire.AppendTag(additional, 4, protowire.BytesType)
+	additional = protowire.AppendString(additional, "/mars/posts")
+
+	var rule []byte
+	rule = protowire.AppendTag(rule, 2, protowire.BytesType)
+	rule = protowire.AppendString(rule, "/mars/posts/{index}")
+	rule = protowire.AppendTag(rule, 11, protowire.BytesType)
+	rule = protowire.AppendBytes(rule, additional)
+
+	var unknown []byte
+	unknown = protowire.AppendTag(unknown, httpRuleField, protowire.BytesType)
+	unknown = protowire.AppendBytes(unknown, rule)
+
+	options := &descriptorpb.MethodOptions{}
+	options.ProtoReflect().SetUnknown(unknown)
+
+	require.Equal(t, []docsHTTPRule{
+		{Method: "GET", Path: "/mars/posts/{index}"},
+		{Method: "POST", Path: "/mars/posts"},
+	}, httpRules(options))
+}
+
+func TestResetDocsDir(t *testing.T) {
+	appPath := t.TempDir()
+	out := filepath.Join(appPath, "docs", "static")
+	require.NoError(t, os.MkdirAll(filepath.Join(out, "images"), 0755))
+	for _, name := range []string{"index.html", "mars.mars.html", "style.css", "README.md"} {
+		require.NoError(t, os.WriteFile(filepath.Join(out, name), nil, 0644))
+	}
+
+	require.NoError(t, resetDocsDir(appPath, out))
+	entries, err := os.ReadDir(out)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "README.md", entries[0].Name())
+	require.Equal(t, "images", entries[1].Name())
+
+	require.NoError(t, resetDocsDir(appPath, filepath.Join(appPath, "site")))
+	_, err = os.Stat(filepath.Join(appPath, "site"))
+	require.NoError(t, err)
+
+	for _, out := range []string{appPath, filepath.Dir(appPath), filepath.Join(appPath, "x", "..")} {
+		err := resetDocsDir(appPath, out)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "not a dir of the app")
+	}
+}
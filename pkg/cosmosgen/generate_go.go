@@ -0,0 +1,177 @@
+package cosmosgen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"sync"
+
+	"github.com/otiai10/copy"
+	"github.com/pkg/errors"
+	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+	"golang.org/x/sync/errgroup"
+)
+
+var (
+	goOuts = []pluginOut{
+		{pluginGoCosmos, "--gocosmos_out=plugins=interfacetype+grpc,Mgoogle/protobuf/any.proto=github.com/cosmos/cosmos-sdk/codec/types:."},
+		{pluginGRPCGateway, "--grpc-gateway_out=logtostderr=true:."},
+	}
+)
+
+func (g *generator) generateGo() error {
+	includePaths, err := g.resolveInclude(g.appPath)
+	if err != nil {
+		return err
+	}
+
+	// created a temporary dir to locate generated code under which later only some of them will be moved to the
+	// app's source code. this also prevents having leftover files in the app's source code or its parent dir -when
+	// command executed directly there- in case of an interrupt.
+	tmp, err := ioutil.TempDir("", "")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	// discover proto packages in the app.
+	pp := filepath.Join(g.appPath, g.protoDir)
+	pkgs, err := protoanalysis.Parse(g.ctx, nil, pp)
+	if err != nil {
+		return err
+	}
+
+	cache, err := newGenerateCache("go")
+	if err != nil {
+		return err
+	}
+
+	params := []string{starportVersion()}
+	for _, out := range goOuts {
+		params = append(params, out.out)
+	}
+	params = append(params, g.pluginVersions()...)
+	params = append(params, g.protoModuleVersions()...)
+
+	// code generate for each module in parallel, generated code is kept separately for each one
+	// so it can be cached.
+	var (
+		gg      = &errgroup.Group{}
+		workers = make(chan struct{}, runtime.NumCPU())
+		outs    = make([]string, len(pkgs))
+		report  = g.goProgress(pkgs)
+	)
+
+	for i, pkg := range pkgs {
+		i, pkg := i, pkg
+		outs[i] = filepath.Join(tmp, strconv.Itoa(i))
+
+		if !g.isPackageSelected(pkg.Path) {
+			continue
+		}
+
+		gg.Go(func() error {
+			workers <- struct{}{}
+			defer func() { <-workers }()
+
+			if err := g.generateGoPackage(cache, pkg, outs[i], includePaths, params); err != nil {
+				return err
+			}
+			report()
+			return nil
+		})
+	}
+
+	if err := gg.Wait(); err != nil {
+		return err
+	}
+
+	// move generated code for the app under the relative locations in its source code.
+	for _, out := range outs {
+		generatedPath := filepath.Join(out, g.o.gomodPath)
+
+		_, err = os.Stat(generatedPath)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := copy.Copy(generatedPath, g.appPath); err != nil {
+			return errors.Wrap(err, "cannot copy path")
+		}
+	}
+
+	return nil
+}
+
+// goProgress returns a func that reports that one more of the selected packages of pkgs is
+// generated.
+func (g *generator) goProgress(pkgs []protoanalysis.Package) (report func()) {
+	if g.o.progress == nil {
+		return func() {}
+	}
+
+	var total int
+	for _, pkg := range pkgs {
+		if g.isPackageSelected(pkg.Path) {
+			total++
+		}
+	}
+	g.o.progress(0, total)
+
+	var (
+		mu   sync.Mutex
+		done int
+	)
+	return func() {
+		mu.Lock()
+		defer mu.Unlock()
+
+		done++
+		g.o.progress(done, total)
+	}
+}
+
+// generateGoPackage generates Go code for the proto package into out, or restores it from the cache
+// when the package and its inputs are not changed since the last generation.
+func (g *generator) generateGoPackage(
+	cache generateCache,
+	pkg protoanalysis.Package,
+	out string,
+	includePaths,
+	params []string,
+) error {
+	key, err := g.protoPackageHash(pkg.Path, includePaths, params...)
+	if err != nil {
+		return err
+	}
+
+	restored, err := cache.restore(key, out)
+	if err != nil || restored {
+		return err
+	}
+
+	if err := os.MkdirAll(out, 0755); err != nil {
+		return err
+	}
+
+	for _, o := range goOuts {
+		if err := g.generateWithPlugin(out, pkg.Path, includePaths, o); err != nil {
+			return err
+		}
+	}
+
+	return cache.save(key, out)
+}
+
+// protoModuleVersions returns the versions of the modules that third-party proto files are resolved from.
+func (g *generator) protoModuleVersions() (versions []string) {
+	for _, m := range g.protoModules {
+		versions = append(versions, m.version.String())
+	}
+	return versions
+}
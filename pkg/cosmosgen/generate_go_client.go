@@ -0,0 +1,268 @@
+package cosmosgen
+
+import (
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
+	"github.com/tendermint/starport/starport/pkg/gomodule"
+	"github.com/tendermint/starport/starport/pkg/gomodulepath"
+	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+	"github.com/trino-network/trino/pkg/cmdtrace"
+)
+
+const (
+	starportModulePath = "github.com/tendermint/starport"
+
+	// defaultStarportVersion is the version of Starport required by the Go client when it cannot
+	// be read from the build info.
+	defaultStarportVersion = "v0.18.6"
+)
+
+// goClientModule is a module that is included in the Go client.
+type goClientModule struct {
+	// Name is the package name of the module's client.
+	Name string
+
+	// Title is the name of the module's client field.
+	Title string
+
+	// Module is the module itself.
+	Module module.Module
+
+	// Queries is the list of query RPC funcs of the module.
+	Queries []protoanalysis.RPCFunc
+
+	// Txs is the list of msg RPC funcs of the module.
+	Txs []protoanalysis.RPCFunc
+
+	// Events is the list of typed events of the module.
+	Events []moduleEvent
+
+	// ModulePath is the Go import path of the client module.
+	ModulePath string
+}
+
+type goClientGenerator struct {
+	g *generator
+
+	// chainPath is the Go import path of the chain.
+	chainPath string
+
+	// modulePath is the Go import path of the client module.
+	modulePath string
+}
+
+func (g *generator) generateGoClient() error {
+	chainPath, _, err := gomodulepath.Find(g.appPath)
+	if err != nil {
+		return err
+	}
+
+	rel, err := filepath.Rel(g.appPath, g.o.goClientOut)
+	if err != nil {
+		return err
+	}
+	if strings.HasPrefix(rel, "..") {
+		return fmt.Errorf("go client must be placed inside the chain's source code: %s", g.o.goClientOut)
+	}
+
+	gcg := &goClientGenerator{
+		g:          g,
+		chainPath:  chainPath.RawPath,
+		modulePath: chainPath.RawPath + "/" + filepath.ToSlash(rel),
+	}
+
+	// reset destination dir.
+	if err := os.RemoveAll(g.o.goClientOut); err != nil {
+		return err
+	}
+
+	var modules []goClientModule
+	for _, m := range g.appModules {
+		gm, err := gcg.generateModule(m)
+		if err != nil {
+			return err
+		}
+		modules = append(modules, gm)
+	}
+
+	sort.Slice(modules, func(i, j int) bool { return modules[i].Name < modules[j].Name })
+
+	return gcg.generateRoot(modules)
+}
+
+// generateModule copies the types of a module and generates its typed query and tx client.
+func (g *goClientGenerator) generateModule(m module.Module) (goClientModule, error) {
+	gm := goClientModule{
+		Name:       strings.ToLower(m.Name),
+		Title:      strings.Title(m.Name),
+		Module:     m,
+		Events:     moduleEvents(m),
+		ModulePath: g.modulePath,
+	}
+
+	for _, s := range m.Pkg.Services {
+		switch s.Name {
+		case "Query":
+			gm.Queries = s.RPCFuncs
+		case "Msg":
+			gm.Txs = s.RPCFuncs
+		}
+	}
+
+	out := filepath.Join(g.g.o.goClientOut, gm.Name)
+	if err := g.copyTypes(m, filepath.Join(out, "types")); err != nil {
+		return gm, err
+	}
+
+	return gm, g.write(templateGoClientModule, out, gm)
+}
+
+// copyTypes copies the Go types of m into out so the client doesn't depend on the chain's source code.
+func (g *goClientGenerator) copyTypes(m module.Module, out string) error {
+	typesPath := filepath.Join(g.g.appPath, strings.TrimPrefix(m.Pkg.GoImportPath(), g.chainPath))
+
+	files, err := os.ReadDir(typesPath)
+	if err != nil {
+		return errors.Wrapf(err, "cannot find the Go types of the %s module", m.Name)
+	}
+
+	if err := os.MkdirAll(out, 0766); err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(typesPath, name))
+		if err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(filepath.Join(out, name), []byte(g.rewriteImports(string(content))), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rewriteImports replaces imports of the chain's module types with the copied ones.
+func (g *goClientGenerator) rewriteImports(content string) string {
+	for _, m := range g.g.appModules {
+		var (
+			oldPath = fmt.Sprintf("%q", m.Pkg.GoImportPath())
+			newPath = fmt.Sprintf("%q", g.modulePath+"/"+strings.ToLower(m.Name)+"/types")
+		)
+		content = strings.ReplaceAll(content, oldPath, newPath)
+	}
+	return content
+}
+
+// generateRoot generates the client that brings all modules together and the Go module definition.
+func (g *goClientGenerator) generateRoot(modules []goClientModule) error {
+	modfile, err := gomodule.ParseAt(g.g.appPath)
+	if err != nil {
+		return err
+	}
+
+	var sdkVersion string
+	for _, r := range modfile.Require {
+		if r.Mod.Path == defaultSdkImport {
+			sdkVersion = r.Mod.Version
+		}
+	}
+
+	// keep the replaces of the chain that are not pointing to the local filesystem,
+	// such as the gogo/protobuf one required by the SDK.
+	var replaces []string
+	for _, r := range modfile.Replace {
+		if r.New.Version == "" {
+			continue
+		}
+		replaces = append(replaces, fmt.Sprintf("%s => %s %s", r.Old.Path, r.New.Path, r.New.Version))
+	}
+
+	data := struct {
+		Package         string
+		ModulePath      string
+		SDKVersion      string
+		StarportVersion string
+		Replaces        []string
+		Modules         []goClientModule
+	}{
+		Package:         strings.ReplaceAll(filepath.Base(g.modulePath), "-", ""),
+		ModulePath:      g.modulePath,
+		SDKVersion:      sdkVersion,
+		StarportVersion: starportVersion(),
+		Replaces:        replaces,
+		Modules:         modules,
+	}
+
+	if err := g.write(templateGoClientRoot, g.g.o.goClientOut, data); err != nil {
+		return err
+	}
+
+	// resolve the dependencies of the client.
+	return cmdrunner.
+		New(cmdrunner.DefaultWorkdir(g.g.o.goClientOut)).
+		Run(g.g.ctx, step.New(step.Exec("go", "mod", "tidy"), cmdtrace.Step()))
+}
+
+// write writes the template to out and formats the generated Go files.
+func (g *goClientGenerator) write(t templateWriter, out string, data interface{}) error {
+	if err := os.MkdirAll(out, 0766); err != nil {
+		return err
+	}
+
+	if err := t.Write(out, "", data); err != nil {
+		return err
+	}
+
+	files, err := filepath.Glob(filepath.Join(out, "*.go"))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		formatted, err := format.Source(content)
+		if err != nil {
+			return errors.Wrapf(err, "cannot format %s", file)
+		}
+
+		if err := os.WriteFile(file, formatted, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// starportVersion returns the version of Starport that provides cosmosclient to the Go client.
+func starportVersion() string {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range info.Deps {
+			if dep.Path == starportModulePath {
+				return dep.Version
+			}
+		}
+	}
+	return defaultStarportVersion
+}
@@ -0,0 +1,174 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/tendermint/starport/starport/pkg/giturl"
+	"github.com/tendermint/starport/starport/pkg/gomodulepath"
+	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+)
+
+var (
+	kotlinOut = []string{
+		"--java_out=lite:.",
+		"--kotlin_out=lite:.",
+	}
+)
+
+const (
+	kotlinModelsDir = "src/main/java"
+	kotlinClientDir = "src/main/kotlin"
+)
+
+func (g *generator) generateKotlin() error {
+	kg := &mobileGenerator{
+		g:                 g,
+		includeThirdParty: g.o.kotlinIncludeThirdParty,
+		typeName:          kotlinTypeName,
+	}
+
+	chainPath, _, err := gomodulepath.Find(g.appPath)
+	if err != nil {
+		return err
+	}
+
+	chainURL, err := giturl.Parse(chainPath.RawPath)
+	if err != nil {
+		return err
+	}
+
+	var (
+		rootPath    = g.o.kotlinRootPath
+		packageName = kotlinPackageName(chainURL.Repo)
+		clientOut   = filepath.Join(rootPath, kotlinClientDir, filepath.FromSlash(strings.ReplaceAll(packageName, ".", "/")))
+		modules     = kg.modules()
+	)
+
+	// reset destination dir.
+	if err := os.RemoveAll(rootPath); err != nil {
+		return err
+	}
+
+	if err := kg.generateModels(filepath.Join(rootPath, kotlinModelsDir), modules, kotlinOut); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(clientOut, 0766); err != nil {
+		return err
+	}
+
+	var mobileModules []mobileModule
+
+	for _, m := range modules {
+		mm := kg.mobileModule(m)
+
+		data := struct {
+			mobileModule
+			Package string
+		}{mm, packageName}
+
+		if err := writeModuleClient(templateKotlinModule, clientOut, "client.kt", mm.Title+"Client.kt", data); err != nil {
+			return err
+		}
+
+		mobileModules = append(mobileModules, mm)
+	}
+
+	data := struct {
+		Modules []mobileModule
+		Package string
+		Repo    string
+	}{
+		Modules: mobileModules,
+		Package: packageName,
+		Repo:    chainURL.Repo,
+	}
+
+	if err := templateKotlinRoot.Write(clientOut, "", data); err != nil {
+		return err
+	}
+
+	// gradle files and readme belong to the root of the client.
+	for _, file := range []string{"build.gradle.kts", "readme.md"} {
+		if err := os.Rename(filepath.Join(clientOut, file), filepath.Join(rootPath, file)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// kotlinTypeName returns the name of the Java class that protoc generates for a message of pkg.
+// messages are nested inside the outer class of their file since proto files of Cosmos SDK apps
+// don't set java_multiple_files.
+func kotlinTypeName(pkg protoanalysis.Package, message string) (string, bool) {
+	msg, err := pkg.MessageByName(message)
+	if err != nil {
+		return "", false
+	}
+
+	return pkg.Name + "." + javaOuterClassName(pkg, msg.Path) + "." + msg.Name, true
+}
+
+// javaOuterClassName returns the name of the outer class that protoc generates for the proto
+// file at path of pkg. it's the camel cased name of the file, suffixed by OuterClass when a
+// message or service in the file has the same name.
+func javaOuterClassName(pkg protoanalysis.Package, path string) string {
+	var (
+		name    strings.Builder
+		capNext = true
+	)
+
+	for _, r := range strings.TrimSuffix(filepath.Base(path), ".proto") {
+		switch {
+		case unicode.IsLower(r) && r < unicode.MaxASCII:
+			if capNext {
+				r = unicode.ToUpper(r)
+			}
+			name.WriteRune(r)
+			capNext = false
+		case unicode.IsUpper(r) && r < unicode.MaxASCII:
+			name.WriteRune(r)
+			capNext = false
+		case unicode.IsDigit(r) && r < unicode.MaxASCII:
+			name.WriteRune(r)
+			capNext = true
+		default:
+			capNext = true
+		}
+	}
+
+	outer := name.String()
+
+	for _, msg := range pkg.Messages {
+		if msg.Path == path && msg.Name == outer {
+			return outer + "OuterClass"
+		}
+	}
+	for _, s := range pkg.Services {
+		if s.Name == outer && serviceFile(pkg, s) == path {
+			return outer + "OuterClass"
+		}
+	}
+
+	return outer
+}
+
+// kotlinPackageName returns the package of the Kotlin client for the repo of a chain.
+func kotlinPackageName(repo string) string {
+	name := strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, repo)
+
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "chain" + name
+	}
+
+	return name + ".client"
+}
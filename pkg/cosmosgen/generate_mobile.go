@@ -0,0 +1,302 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/exec"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
+	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+	"github.com/trino-network/trino/pkg/cmdtrace"
+	"github.com/trino-network/trino/pkg/toolchain"
+)
+
+// mobileSDKProtos are the proto files of the Cosmos SDK that mobile clients need to query
+// accounts, and to sign and broadcast transactions.
+var mobileSDKProtos = []string{
+	"cosmos/auth/v1beta1/query.proto",
+	"cosmos/crypto/secp256k1/keys.proto",
+	"cosmos/tx/v1beta1/tx.proto",
+}
+
+// mobileExcludedProtos are the prefixes of imports that only define proto options or are the
+// well-known types shipped with the protobuf runtimes, no models are generated for them.
+var mobileExcludedProtos = []string{
+	"gogoproto/",
+	"cosmos_proto/",
+	"google/api/",
+	"google/protobuf/",
+}
+
+// mobileModule is a module that is included in a mobile client.
+type mobileModule struct {
+	// Name is the name of the module's field in the client.
+	Name string
+
+	// Title is the prefix of the module's client types.
+	Title string
+
+	// Module is the module itself.
+	Module module.Module
+
+	// Queries is the list of queries of the module.
+	Queries []mobileRPC
+
+	// Msgs is the list of sdk.Msgs of the module.
+	Msgs []mobileMsg
+}
+
+// mobileRPC is a query of a module that is sent as an ABCI query.
+type mobileRPC struct {
+	// Name is the name of the query's func.
+	Name string
+
+	// Path is the ABCI query path of the query.
+	Path string
+
+	// Request and Response are the types of the query in the client's language.
+	Request, Response string
+}
+
+// mobileMsg is an sdk.Msg of a module.
+type mobileMsg struct {
+	// Name is the name of the msg's composer func.
+	Name string
+
+	// TypeURL is the type URL of the msg when it's packed into an Any.
+	TypeURL string
+
+	// Type is the type of the msg in the client's language.
+	Type string
+}
+
+// mobileGenerator generates the protobuf models and the query and tx clients of a mobile client.
+type mobileGenerator struct {
+	g *generator
+
+	// includeThirdParty includes the modules of the app's dependencies in the client.
+	includeThirdParty bool
+
+	// typeName returns the name of a message of pkg in the client's language.
+	typeName func(pkg protoanalysis.Package, message string) (string, bool)
+}
+
+// modules returns the modules included in the client.
+func (g *mobileGenerator) modules() []module.Module {
+	modules := append([]module.Module{}, g.g.appModules...)
+
+	if g.includeThirdParty {
+		for _, thirdModules := range g.g.thirdModules {
+			modules = append(modules, thirdModules...)
+		}
+	}
+
+	sort.Slice(modules, func(i, j int) bool { return modules[i].Pkg.Name < modules[j].Pkg.Name })
+	return modules
+}
+
+// generateModels generates the protobuf models of the modules, the SDK's tx types and
+// all the proto files imported by them into out with protocOuts. flags are passed to protoc
+// as is, such as the paths of plugins that are not bundled.
+func (g *mobileGenerator) generateModels(out string, modules []module.Module, protocOuts []string, flags ...string) error {
+	includePaths, err := g.includePaths()
+	if err != nil {
+		return err
+	}
+
+	var roots []string
+	for _, m := range modules {
+		roots = append(roots, m.Pkg.Files.Paths()...)
+	}
+	for _, file := range mobileSDKProtos {
+		path, ok := resolveProtoImport(file, includePaths)
+		if !ok {
+			continue
+		}
+		roots = append(roots, path)
+	}
+
+	files, err := mobileProtoFiles(roots, includePaths)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(out, 0766); err != nil {
+		return err
+	}
+
+	cmd, cleanup, err := toolchain.ProtocCommand()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	command := append(cmd.Command, flags...)
+	for _, path := range includePaths {
+		if _, err := os.Stat(path); err == nil {
+			command = append(command, "-I", path)
+		}
+	}
+
+	// generate all models at once so files that are imported by many modules are only generated once.
+	for _, protocOut := range protocOuts {
+		command := append(append(command, protocOut), files...)
+
+		if err := exec.Exec(g.g.ctx, command,
+			exec.StepOption(step.Workdir(out)),
+			exec.StepOption(cmdtrace.Step()),
+			exec.IncludeStdLogsToError(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// includePaths returns the include paths of the app and the proto dirs of the app's dependencies
+// when their modules are included in the client.
+func (g *mobileGenerator) includePaths() ([]string, error) {
+	includePaths, err := g.g.resolveInclude(g.g.appPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if g.includeThirdParty {
+		var sourcePaths []string
+		for sourcePath := range g.g.thirdModules {
+			sourcePaths = append(sourcePaths, sourcePath)
+		}
+		sort.Strings(sourcePaths)
+
+		for _, sourcePath := range sourcePaths {
+			includePaths = append(includePaths, filepath.Join(sourcePath, g.g.protoDir))
+		}
+	}
+
+	return includePaths, nil
+}
+
+// mobileModule returns the queries and msgs of m with their types in the client's language.
+func (g *mobileGenerator) mobileModule(m module.Module) mobileModule {
+	mm := mobileModule{
+		Name:   strcase.ToLowerCamel(strings.ReplaceAll(m.Pkg.Name, ".", "_")),
+		Title:  strcase.ToCamel(strings.ReplaceAll(m.Pkg.Name, ".", "_")),
+		Module: m,
+	}
+
+	for _, s := range m.Pkg.Services {
+		if s.Name != "Query" {
+			continue
+		}
+
+		for _, rpc := range s.RPCFuncs {
+			request, ok := g.typeName(m.Pkg, rpc.RequestType)
+			if !ok {
+				continue
+			}
+			response, ok := g.typeName(m.Pkg, rpc.ReturnsType)
+			if !ok {
+				continue
+			}
+
+			mm.Queries = append(mm.Queries, mobileRPC{
+				Name:     strcase.ToLowerCamel(rpc.Name),
+				Path:     "/" + m.Pkg.Name + "." + s.Name + "/" + rpc.Name,
+				Request:  request,
+				Response: response,
+			})
+		}
+	}
+
+	for _, msg := range m.Msgs {
+		typ, ok := g.typeName(m.Pkg, msg.Name)
+		if !ok {
+			continue
+		}
+
+		mm.Msgs = append(mm.Msgs, mobileMsg{
+			Name:    strcase.ToLowerCamel(msg.Name),
+			TypeURL: "/" + msg.URI,
+			Type:    typ,
+		})
+	}
+
+	return mm
+}
+
+// writeModuleClient writes the client of a module with t into dir and renames the written file
+// to name, so file names are unique among modules.
+func writeModuleClient(t templateWriter, dir, file, name string, data interface{}) error {
+	if err := t.Write(dir, "", data); err != nil {
+		return err
+	}
+
+	return os.Rename(filepath.Join(dir, file), filepath.Join(dir, name))
+}
+
+// mobileProtoFiles returns the proto files of roots and the files imported by them, except the
+// excluded ones.
+func mobileProtoFiles(roots, includePaths []string) ([]string, error) {
+	var (
+		files   []string
+		visited = make(map[string]bool)
+	)
+
+	add := func(path string) {
+		if !visited[path] {
+			visited[path] = true
+			files = append(files, path)
+		}
+	}
+
+	for _, root := range roots {
+		add(root)
+	}
+
+	for i := 0; i < len(files); i++ {
+		parsed, err := protoanalysis.ParseFile(files[i])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, dep := range parsed.Dependencies {
+			if isExcludedMobileProto(dep) {
+				continue
+			}
+			if path, ok := resolveProtoImport(dep, includePaths); ok {
+				add(path)
+			}
+		}
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+func isExcludedMobileProto(path string) bool {
+	for _, prefix := range mobileExcludedProtos {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// serviceFile returns the path of the proto file that defines s, it is found by the file of
+// the service's request types since services are not tracked by files.
+func serviceFile(pkg protoanalysis.Package, s protoanalysis.Service) string {
+	if len(s.RPCFuncs) == 0 {
+		return ""
+	}
+
+	message, err := pkg.MessageByName(s.RPCFuncs[0].RequestType)
+	if err != nil {
+		return ""
+	}
+	return message.Path
+}
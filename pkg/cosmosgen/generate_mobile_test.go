@@ -0,0 +1,54 @@
+package cosmosgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+)
+
+func TestJavaOuterClassName(t *testing.T) {
+	pkg := protoanalysis.Package{
+		Name: "mars.blog",
+		Messages: []protoanalysis.Message{
+			{Name: "MsgCreatePost", Path: "proto/blog/tx.proto"},
+			{Name: "QueryPostRequest", Path: "proto/blog/query.proto"},
+			{Name: "Post", Path: "proto/blog/post.proto"},
+			{Name: "GenesisState", Path: "proto/blog/genesis_state.proto"},
+			{Name: "Params", Path: "proto/blog/params_v2.proto"},
+		},
+		Services: []protoanalysis.Service{
+			{Name: "Query", RPCFuncs: []protoanalysis.RPCFunc{{Name: "Post", RequestType: "QueryPostRequest"}}},
+		},
+	}
+
+	cases := map[string]string{
+		"proto/blog/tx.proto":            "Tx",
+		"proto/blog/query.proto":         "QueryOuterClass",
+		"proto/blog/post.proto":          "PostOuterClass",
+		"proto/blog/genesis_state.proto": "GenesisStateOuterClass",
+		"proto/blog/params_v2.proto":     "ParamsV2",
+	}
+
+	for path, name := range cases {
+		require.Equal(t, name, javaOuterClassName(pkg, path), path)
+	}
+
+	typ, ok := kotlinTypeName(pkg, "MsgCreatePost")
+	require.True(t, ok)
+	require.Equal(t, "mars.blog.Tx.MsgCreatePost", typ)
+
+	_, ok = kotlinTypeName(pkg, "cosmos.base.query.v1beta1.PageRequest")
+	require.False(t, ok)
+}
+
+func TestKotlinPackageName(t *testing.T) {
+	require.Equal(t, "mars.client", kotlinPackageName("mars"))
+	require.Equal(t, "marschain.client", kotlinPackageName("Mars-Chain"))
+	require.Equal(t, "chain2mars.client", kotlinPackageName("2mars"))
+}
+
+func TestSwiftPackagePrefix(t *testing.T) {
+	require.Equal(t, "Cosmos_Bank_V1beta1_", swiftPackagePrefix("cosmos.bank.v1beta1"))
+	require.Equal(t, "Mars_BlogPosts_", swiftPackagePrefix("mars.blog_posts"))
+}
@@ -0,0 +1,231 @@
+package cosmosgen
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/iancoleman/strcase"
+	"github.com/pkg/errors"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
+	swaggercombine "github.com/tendermint/starport/starport/pkg/nodetime/programs/swagger-combine"
+	"github.com/trino-network/trino/pkg/cmdtrace"
+)
+
+var openAPIOut = pluginOut{
+	pluginOpenAPIV2,
+	"--openapiv2_out=logtostderr=true,allow_merge=true,fqn_for_openapi_name=true,simple_operation_ids=true,Mgoogle/protobuf/any.proto=github.com/cosmos/cosmos-sdk/codec/types:.",
+}
+
+const (
+	// openAPIAnnotationsFile is the name of the file placed next to a module's proto files
+	// that holds annotations to merge into the module's spec.
+	openAPIAnnotationsFile = "openapi.yml"
+
+	openAPIDescription = `Queries are public and don't require authentication.
+
+Transactions can't be sent through the query endpoints. Sign them with an account of the chain
+and broadcast them with the POST /cosmos/tx/v1beta1/txs endpoint, the signature authenticates the sender.`
+)
+
+func generateOpenAPISpec(g *generator) error {
+	out := filepath.Join(g.appPath, g.o.specOut)
+
+	var (
+		specDirs []string
+		conf     = swaggercombine.Config{
+			Swagger: "2.0",
+			Info: swaggercombine.Info{
+				Title:       "HTTP API Console",
+				Description: openAPIDescription,
+			},
+		}
+	)
+
+	defer func() {
+		for _, dir := range specDirs {
+			os.RemoveAll(dir)
+		}
+	}()
+
+	// gen generates a spec for a module where it's source code resides at src.
+	// and adds needed swaggercombine configure for it.
+	gen := func(src string, m module.Module) (err error) {
+		include, err := g.resolveInclude(src)
+		if err != nil {
+			return err
+		}
+
+		dir, err := ioutil.TempDir("", "gen-openapi-module-spec")
+		if err != nil {
+			return err
+		}
+
+		if err := g.generateWithPlugin(dir, m.Pkg.Path, include, openAPIOut); err != nil {
+			return err
+		}
+
+		specDirs = append(specDirs, dir)
+
+		specPath := filepath.Join(dir, "apidocs.swagger.json")
+		if err := refineOpenAPISpec(specPath, filepath.Join(m.Pkg.Path, openAPIAnnotationsFile)); err != nil {
+			return err
+		}
+
+		return conf.AddSpec(strcase.ToCamel(m.Pkg.Name), specPath)
+	}
+
+	// generate specs for each module and persist them in the file system
+	// after add their path and config to swaggercombine.Config so we can combine them
+	// into a single spec.
+
+	add := func(src string, modules []module.Module) error {
+		for _, m := range modules {
+			m := m
+			if err := gen(src, m); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// protoc openapi generator acts weird on conccurrent run, so do not use goroutines here.
+	if err := add(g.appPath, g.appModules); err != nil {
+		return err
+	}
+
+	for src, modules := range g.thirdModules {
+		if err := add(src, modules); err != nil {
+			return err
+		}
+	}
+
+	sort.Slice(conf.APIs, func(a, b int) bool { return conf.APIs[a].ID < conf.APIs[b].ID })
+
+	// ensure out dir exists.
+	outDir := filepath.Dir(out)
+	if err := os.MkdirAll(outDir, 0766); err != nil {
+		return err
+	}
+
+	// combine specs into one and save to out.
+	return cmdtrace.Run(func() error {
+		return swaggercombine.Combine(g.ctx, conf, out)
+	}, "swagger-combine", out)
+}
+
+// refineOpenAPISpec merges the annotations at annotationsPath into the spec at specPath if there
+// are any and adds examples to the properties of the spec's definitions.
+func refineOpenAPISpec(specPath, annotationsPath string) error {
+	content, err := os.ReadFile(specPath)
+	if err != nil {
+		return err
+	}
+
+	var spec map[string]interface{}
+	if err := json.Unmarshal(content, &spec); err != nil {
+		return err
+	}
+
+	annotations, err := os.ReadFile(annotationsPath)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return err
+	default:
+		var overlay map[string]interface{}
+		if err := yaml.Unmarshal(annotations, &overlay); err != nil {
+			return errors.Wrapf(err, "cannot parse OpenAPI annotations %s", annotationsPath)
+		}
+		mergeOpenAPISpec(spec, overlay)
+	}
+
+	if definitions, ok := spec["definitions"].(map[string]interface{}); ok {
+		for _, definition := range definitions {
+			addOpenAPIExamples(definition)
+		}
+	}
+
+	content, err = json.Marshal(spec)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(specPath, content, 0644)
+}
+
+// mergeOpenAPISpec deeply merges overlay into spec, values of overlay take precedence.
+func mergeOpenAPISpec(spec, overlay map[string]interface{}) {
+	for key, value := range overlay {
+		overlayValue, isOverlayMap := value.(map[string]interface{})
+		specValue, isSpecMap := spec[key].(map[string]interface{})
+		if isOverlayMap && isSpecMap {
+			mergeOpenAPISpec(specValue, overlayValue)
+			continue
+		}
+		spec[key] = value
+	}
+}
+
+// addOpenAPIExamples adds an example to the properties of schema that don't have any, based on their types.
+func addOpenAPIExamples(schema interface{}) {
+	s, ok := schema.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	if items, ok := s["items"]; ok {
+		addOpenAPIExamples(items)
+	}
+
+	properties, ok := s["properties"].(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	for name, property := range properties {
+		p, ok := property.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		addOpenAPIExamples(p)
+
+		if _, ok := p["example"]; ok {
+			continue
+		}
+		if example, ok := openAPIExample(name, p); ok {
+			p["example"] = example
+		}
+	}
+}
+
+// openAPIExample returns an example value for the property with name.
+func openAPIExample(name string, property map[string]interface{}) (example interface{}, ok bool) {
+	format, _ := property["format"].(string)
+
+	switch property["type"] {
+	case "boolean":
+		return true, true
+	case "integer", "number":
+		return 1, true
+	case "string":
+		switch {
+		case format == "uint64", format == "int64", format == "uint32", format == "int32":
+			return "1", true
+		case format == "byte":
+			return "aGVsbG8=", true
+		case format == "date-time":
+			return "2021-01-01T00:00:00Z", true
+		case strings.Contains(name, "denom"):
+			return "stake", true
+		case strings.Contains(name, "amount"):
+			return "1000", true
+		}
+	}
+
+	return nil, false
+}
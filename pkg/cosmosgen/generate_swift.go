@@ -0,0 +1,133 @@
+package cosmosgen
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pkg/errors"
+	"github.com/tendermint/starport/starport/pkg/giturl"
+	"github.com/tendermint/starport/starport/pkg/gomodulepath"
+	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+)
+
+var (
+	swiftOut = []string{
+		"--swift_out=Visibility=Public,FileNaming=PathToUnderscores:.",
+	}
+)
+
+const (
+	swiftPlugin    = "protoc-gen-swift"
+	swiftModelsDir = "Models"
+)
+
+// ErrSwiftPluginNotInstalled is returned when the Swift plugin of protoc cannot be found in PATH.
+var ErrSwiftPluginNotInstalled = errors.New("protoc-gen-swift is not installed, install swift-protobuf to generate the Swift client: https://github.com/apple/swift-protobuf")
+
+func (g *generator) generateSwift() error {
+	plugin, err := exec.LookPath(swiftPlugin)
+	if err != nil {
+		return ErrSwiftPluginNotInstalled
+	}
+
+	sg := &mobileGenerator{
+		g:                 g,
+		includeThirdParty: g.o.swiftIncludeThirdParty,
+		typeName:          swiftTypeName,
+	}
+
+	chainPath, _, err := gomodulepath.Find(g.appPath)
+	if err != nil {
+		return err
+	}
+
+	chainURL, err := giturl.Parse(chainPath.RawPath)
+	if err != nil {
+		return err
+	}
+
+	var (
+		rootPath   = g.o.swiftRootPath
+		targetName = strcase.ToCamel(chainURL.Repo) + "Client"
+		sourcesOut = filepath.Join(rootPath, "Sources", targetName)
+		modules    = sg.modules()
+	)
+
+	// reset destination dir.
+	if err := os.RemoveAll(rootPath); err != nil {
+		return err
+	}
+
+	if err := sg.generateModels(
+		filepath.Join(sourcesOut, swiftModelsDir),
+		modules,
+		swiftOut,
+		"--plugin="+swiftPlugin+"="+plugin,
+	); err != nil {
+		return err
+	}
+
+	var swiftModules []mobileModule
+
+	for _, m := range modules {
+		mm := sg.mobileModule(m)
+
+		if err := writeModuleClient(templateSwiftModule, sourcesOut, "client.swift", mm.Title+"Client.swift", mm); err != nil {
+			return err
+		}
+
+		swiftModules = append(swiftModules, mm)
+	}
+
+	data := struct {
+		Modules []mobileModule
+		Target  string
+	}{
+		Modules: swiftModules,
+		Target:  targetName,
+	}
+
+	if err := templateSwiftRoot.Write(sourcesOut, "", data); err != nil {
+		return err
+	}
+
+	// package manifest and readme belong to the root of the client.
+	for _, file := range []string{"Package.swift", "readme.md"} {
+		if err := os.Rename(filepath.Join(sourcesOut, file), filepath.Join(rootPath, file)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// swiftTypeName returns the name of the Swift struct that swift-protobuf generates for a message
+// of pkg, which is prefixed by the upper camel cased components of the package.
+func swiftTypeName(pkg protoanalysis.Package, message string) (string, bool) {
+	if _, err := pkg.MessageByName(message); err != nil {
+		return "", false
+	}
+
+	return swiftPackagePrefix(pkg.Name) + message, true
+}
+
+// swiftPackagePrefix returns the prefix of types that swift-protobuf generates for a proto package,
+// for example Cosmos_Bank_V1beta1_ for cosmos.bank.v1beta1.
+func swiftPackagePrefix(pkg string) string {
+	var prefix strings.Builder
+
+	for _, component := range strings.Split(pkg, ".") {
+		for _, word := range strings.Split(component, "_") {
+			if word == "" {
+				continue
+			}
+			prefix.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+		prefix.WriteString("_")
+	}
+
+	return prefix.String()
+}
@@ -0,0 +1,34 @@
+package cosmosgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
+	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+)
+
+func TestModuleSelection(t *testing.T) {
+	g := &generator{
+		o: &generateOptions{modules: []string{"mars"}},
+		appModules: []module.Module{
+			{Name: "mars", Pkg: protoanalysis.Package{Path: "proto/mars"}},
+			{Name: "venus", Pkg: protoanalysis.Package{Path: "proto/venus"}},
+		},
+		thirdModules: map[string][]module.Module{},
+	}
+
+	require.NoError(t, g.checkModules())
+	require.True(t, g.isModuleSelected(g.appModules[0]))
+	require.False(t, g.isModuleSelected(g.appModules[1]))
+	require.True(t, g.isPackageSelected("proto/mars"))
+	require.False(t, g.isPackageSelected("proto/venus"))
+	require.False(t, g.isPackageSelected("proto/shared"))
+
+	g.o.modules = []string{"jupiter"}
+	require.EqualError(t, g.checkModules(), `unknown module "jupiter", the app's modules are: mars, venus`)
+
+	g.o.modules = nil
+	require.True(t, g.isModuleSelected(g.appModules[1]))
+	require.True(t, g.isPackageSelected("proto/shared"))
+}
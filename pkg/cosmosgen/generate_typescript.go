@@ -0,0 +1,324 @@
+package cosmosgen
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/iancoleman/strcase"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
+	"github.com/tendermint/starport/starport/pkg/giturl"
+	"github.com/tendermint/starport/starport/pkg/gomodulepath"
+	tsproto "github.com/tendermint/starport/starport/pkg/nodetime/programs/ts-proto"
+	"golang.org/x/sync/errgroup"
+)
+
+// tsClientModule is a module that is included in the ts client package.
+type tsClientModule struct {
+	// Name is the namespace of the module inside the client.
+	Name string
+
+	// Path is the path of the module relative to the package root.
+	Path string
+
+	// GRPCWeb is true when the module has a gRPC-web query client.
+	GRPCWeb bool
+
+	module module.Module
+}
+
+type tsClientGenerator struct {
+	g *generator
+
+	mu      sync.Mutex
+	modules []tsClientModule
+}
+
+func newTSClientGenerator(g *generator) *tsClientGenerator {
+	return &tsClientGenerator{
+		g: g,
+	}
+}
+
+func (g *generator) generateTSClient() error {
+	tsg := newTSClientGenerator(g)
+
+	if err := tsg.generateModules(); err != nil {
+		return err
+	}
+
+	if err := tsg.generateRoot(); err != nil {
+		return err
+	}
+
+	if g.o.composablesRootPath != "" {
+		err := tsg.generateQueryWrappers(g.o.composablesRootPath, templateComposablesRoot, templateComposablesModule)
+		if err != nil {
+			return err
+		}
+	}
+
+	if g.o.hooksRootPath != "" {
+		err := tsg.generateQueryWrappers(g.o.hooksRootPath, templateHooksRoot, templateHooksModule)
+		if err != nil {
+			return err
+		}
+	}
+
+	if g.o.piniaRootPath != "" {
+		return tsg.generateQueryWrappers(g.o.piniaRootPath, templatePiniaRoot, templatePiniaModule)
+	}
+
+	return nil
+}
+
+func (g *tsClientGenerator) generateModules() error {
+	tsprotoPluginPath, cleanup, err := tsproto.BinaryPath()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	gg := &errgroup.Group{}
+
+	add := func(sourcePath string, modules []module.Module) {
+		for _, m := range modules {
+			m := m
+
+			// modules that are not selected are kept as is but still included in the client.
+			if !g.g.isModuleSelected(m) {
+				if _, err := os.Stat(g.g.o.tsClientOut(m)); err == nil {
+					gg.Go(func() error { return g.addModule(m) })
+					continue
+				}
+			}
+
+			gg.Go(func() error { return g.generateModule(g.g.ctx, tsprotoPluginPath, sourcePath, m) })
+		}
+	}
+
+	add(g.g.appPath, g.g.appModules)
+
+	if g.g.o.tsClientIncludeThirdParty {
+		for sourcePath, modules := range g.g.thirdModules {
+			add(sourcePath, modules)
+		}
+	}
+
+	return gg.Wait()
+}
+
+// generateModule generates the typed message composers, query client and types of a module.
+func (g *tsClientGenerator) generateModule(ctx context.Context, tsprotoPluginPath, appPath string, m module.Module) error {
+	var (
+		out      = g.g.o.tsClientOut(m)
+		typesOut = filepath.Join(out, "types")
+	)
+
+	includePaths, err := g.g.resolveInclude(appPath)
+	if err != nil {
+		return err
+	}
+
+	// reset destination dir.
+	if err := os.RemoveAll(out); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(typesOut, 0766); err != nil {
+		return err
+	}
+
+	// generate ts-proto types.
+	protocOuts := tsOut
+	if g.g.o.tsClientGRPCWeb {
+		protocOuts = tsGRPCWebOut
+	}
+
+	err = generateProto(
+		ctx,
+		typesOut,
+		m.Pkg.Path,
+		includePaths,
+		protocOuts,
+		protocPlugin(tsprotoPluginPath),
+	)
+	if err != nil {
+		return err
+	}
+
+	// generate OpenAPI spec.
+	oaitemp, err := ioutil.TempDir("", "gen-ts-client-openapi-module-spec")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(oaitemp)
+
+	if err := g.g.generateWithPlugin(oaitemp, m.Pkg.Path, includePaths, jsOpenAPIOut); err != nil {
+		return err
+	}
+
+	// generate the typed REST client from the OpenAPI spec.
+	var (
+		srcspec = filepath.Join(oaitemp, "apidocs.swagger.json")
+		outREST = filepath.Join(out, "rest.ts")
+	)
+
+	if err := generateREST(ctx, outREST, srcspec); err != nil {
+		return err
+	}
+
+	// generate the module's entrypoint.
+	pp := filepath.Join(appPath, g.g.protoDir)
+
+	var queryFile string
+	if g.g.o.tsClientGRPCWeb {
+		queryFile = queryServiceFile(m)
+	}
+
+	rootPath, err := filepath.Rel(out, g.g.o.tsClientRootPath)
+	if err != nil {
+		return err
+	}
+
+	data := struct {
+		Module module.Module
+
+		// QueryFile is the proto file that defines the Query service, used to import its gRPC-web client.
+		QueryFile string
+
+		// Events is the list of typed events of the module.
+		Events []moduleEvent
+
+		// RootPath is the path of the package root relative to the module.
+		RootPath string
+	}{
+		Module:    m,
+		QueryFile: queryFile,
+		Events:    moduleEvents(m),
+		RootPath:  filepath.ToSlash(rootPath),
+	}
+
+	if err := templateTSClientModule.Write(out, pp, data); err != nil {
+		return err
+	}
+
+	return g.addModule(m)
+}
+
+// addModule includes the generated module m in the client package.
+func (g *tsClientGenerator) addModule(m module.Module) error {
+	path, err := filepath.Rel(g.g.o.tsClientRootPath, g.g.o.tsClientOut(m))
+	if err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.modules = append(g.modules, tsClientModule{
+		Name:    strcase.ToLowerCamel(strings.ReplaceAll(m.Pkg.Name, ".", "_")),
+		Path:    filepath.ToSlash(path),
+		GRPCWeb: g.g.o.tsClientGRPCWeb && queryServiceFile(m) != "",
+		module:  m,
+	})
+
+	return nil
+}
+
+// generateRoot generates the client package that brings all modules together.
+func (g *tsClientGenerator) generateRoot() error {
+	chainPath, _, err := gomodulepath.Find(g.g.appPath)
+	if err != nil {
+		return err
+	}
+
+	chainURL, err := giturl.Parse(chainPath.RawPath)
+	if err != nil {
+		return err
+	}
+
+	// keep the output stable between generations.
+	sort.Slice(g.modules, func(i, j int) bool { return g.modules[i].Path < g.modules[j].Path })
+
+	data := struct {
+		Modules []tsClientModule
+		User    string
+		Repo    string
+		GRPCWeb bool
+	}{
+		Modules: g.modules,
+		User:    chainURL.User,
+		Repo:    chainURL.Repo,
+		GRPCWeb: g.g.o.tsClientGRPCWeb,
+	}
+
+	return templateTSClientRoot.Write(g.g.o.tsClientRootPath, "", data)
+}
+
+// generateQueryWrappers generates framework specific wrappers for the queries of the client's
+// modules inside rootPath, such as Vue composables, React hooks or Pinia stores.
+func (g *tsClientGenerator) generateQueryWrappers(rootPath string, rootTemplate, moduleTemplate templateWriter) error {
+	clientPath, err := filepath.Rel(rootPath, g.g.o.tsClientRootPath)
+	if err != nil {
+		return err
+	}
+	clientPath = filepath.ToSlash(clientPath)
+	if !strings.HasPrefix(clientPath, ".") {
+		clientPath = "./" + clientPath
+	}
+
+	// reset destination dir.
+	if err := os.RemoveAll(rootPath); err != nil {
+		return err
+	}
+
+	var modules []tsClientModule
+
+	for _, m := range g.modules {
+		out := filepath.Join(rootPath, m.module.Pkg.Name)
+		if err := os.MkdirAll(out, 0766); err != nil {
+			return err
+		}
+
+		data := struct {
+			Module module.Module
+			Name   string
+		}{
+			Module: m.module,
+			Name:   m.Name,
+		}
+
+		if err := moduleTemplate.Write(out, "", data); err != nil {
+			return err
+		}
+
+		modules = append(modules, tsClientModule{
+			Name: m.Name,
+			Path: m.module.Pkg.Name,
+		})
+	}
+
+	data := struct {
+		Modules    []tsClientModule
+		ClientPath string
+	}{
+		Modules:    modules,
+		ClientPath: clientPath,
+	}
+
+	return rootTemplate.Write(rootPath, "", data)
+}
+
+// queryServiceFile returns the path of the proto file that defines the Query service of m.
+func queryServiceFile(m module.Module) string {
+	for _, s := range m.Pkg.Services {
+		if s.Name == "Query" {
+			return serviceFile(m.Pkg, s)
+		}
+	}
+	return ""
+}
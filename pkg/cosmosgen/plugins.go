@@ -0,0 +1,221 @@
+package cosmosgen
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+	"github.com/tendermint/starport/starport/pkg/xfilepath"
+	"github.com/trino-network/trino/pkg/cmdtrace"
+)
+
+const (
+	pluginGoCosmos    = "protoc-gen-gocosmos"
+	pluginGRPCGateway = "protoc-gen-grpc-gateway"
+	pluginOpenAPIV2   = "protoc-gen-openapiv2"
+)
+
+// toolsPath is the path of the managed tools dir where protoc plugins are installed.
+var toolsPath = xfilepath.JoinFromHome(
+	xfilepath.Path(".starport"),
+	xfilepath.Path("tools"),
+)
+
+// Plugin is a protoc plugin built from a Go package at a pinned version.
+type Plugin struct {
+	// Name is the name of the plugin's binary.
+	Name string
+
+	// Package is the Go package of the plugin.
+	Package string
+
+	// Version is the version of the plugin's Go module.
+	Version string
+}
+
+// DefaultPlugins are the protoc plugins used to generate code, pinned to the versions that are
+// compatible with the Cosmos SDK. the ts-proto plugin is bundled with Starport.
+var DefaultPlugins = []Plugin{
+	{
+		Name:    pluginGoCosmos,
+		Package: "github.com/regen-network/cosmos-proto/protoc-gen-gocosmos",
+		Version: "v0.3.1",
+	},
+	{
+		Name:    pluginGRPCGateway,
+		Package: "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-grpc-gateway",
+		Version: "v1.16.0",
+	},
+	{
+		Name:    pluginOpenAPIV2,
+		Package: "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2",
+		Version: "v2.0.1",
+	},
+}
+
+// pluginReplaces are the replace directives of the Go module that plugins are built in, they
+// are the same with the ones of the Cosmos SDK so the plugins generate code for its forks.
+var pluginReplaces = []string{
+	"github.com/gogo/protobuf => github.com/regen-network/protobuf v1.3.3-alpha.regen.1",
+}
+
+// ResolvePlugins returns the default plugins with their versions overridden by versions, which
+// is keyed by the plugin names.
+func ResolvePlugins(versions map[string]string) ([]Plugin, error) {
+	for name := range versions {
+		if _, ok := findPlugin(DefaultPlugins, name); !ok {
+			return nil, fmt.Errorf("unknown protoc plugin %q", name)
+		}
+	}
+
+	var plugins []Plugin
+	for _, p := range DefaultPlugins {
+		if version, ok := versions[p.Name]; ok && version != "" {
+			p.Version = version
+		}
+		plugins = append(plugins, p)
+	}
+
+	return plugins, nil
+}
+
+func findPlugin(plugins []Plugin, name string) (Plugin, bool) {
+	for _, p := range plugins {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return Plugin{}, false
+}
+
+// InstallPlugins installs the default plugins with their versions overridden by versions, so code
+// can be generated later without downloading them.
+func InstallPlugins(ctx context.Context, versions map[string]string) error {
+	plugins, err := ResolvePlugins(versions)
+	if err != nil {
+		return err
+	}
+	_, err = installPlugins(ctx, plugins)
+	return err
+}
+
+// installPlugins installs plugins into the managed tools dir unless they're already installed
+// and returns the paths of their binaries keyed by their names.
+//
+// each plugin is built in its own Go module that only requires the plugin at its pinned
+// version, so the plugins and their dependencies don't depend on the app's go.mod or the
+// binaries installed in PATH, and code is generated the same way on every machine.
+func installPlugins(ctx context.Context, plugins []Plugin) (map[string]string, error) {
+	root, err := toolsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make(map[string]string)
+
+	for _, p := range plugins {
+		var (
+			dir  = filepath.Join(root, p.Name+"@"+p.Version)
+			path = filepath.Join(dir, p.Name)
+		)
+
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			if err := installPlugin(ctx, p, dir); err != nil {
+				return nil, errors.Wrapf(err, "cannot install %s@%s", p.Name, p.Version)
+			}
+		} else if err != nil {
+			return nil, err
+		}
+
+		paths[p.Name] = path
+	}
+
+	return paths, nil
+}
+
+// installPlugin builds plugin into dir, the build happens in a tmp dir that is renamed to dir
+// afterwards so an interrupted build is never used.
+func installPlugin(ctx context.Context, p Plugin, dir string) error {
+	if err := os.MkdirAll(filepath.Dir(dir), 0755); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempDir(filepath.Dir(dir), p.Name)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	gomod := "module starport-tools/" + p.Name + "\n\ngo 1.16\n"
+	for _, r := range pluginReplaces {
+		gomod += "\nreplace " + r + "\n"
+	}
+	if err := os.WriteFile(filepath.Join(tmp, "go.mod"), []byte(gomod), 0644); err != nil {
+		return err
+	}
+
+	errb := &bytes.Buffer{}
+	err = cmdrunner.
+		New(
+			cmdrunner.DefaultStderr(errb),
+			cmdrunner.DefaultWorkdir(tmp),
+		).
+		Run(ctx,
+			step.New(step.Exec("go", "get", p.Package+"@"+p.Version), cmdtrace.Step()),
+			step.New(step.Exec("go", "build", "-mod=mod", "-o", p.Name, p.Package), cmdtrace.Step()),
+		)
+	if err != nil {
+		return errors.Wrap(err, errb.String())
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, dir)
+}
+
+// setupPlugins resolves the versions of the plugins and installs them.
+func (g *generator) setupPlugins() (err error) {
+	if g.plugins, err = ResolvePlugins(g.o.pluginVersions); err != nil {
+		return err
+	}
+
+	g.pluginPaths, err = installPlugins(g.ctx, g.plugins)
+	return err
+}
+
+// pluginVersions returns the names and versions of the plugins used by the generator, sorted.
+func (g *generator) pluginVersions() (versions []string) {
+	for _, p := range g.plugins {
+		versions = append(versions, p.Name+"@"+p.Version)
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// pluginOut is a protoc out of a plugin.
+type pluginOut struct {
+	plugin string
+	out    string
+}
+
+// generateWithPlugin generates code for the proto files at protoPath into outDir with the
+// installed plugin of o.
+func (g *generator) generateWithPlugin(outDir, protoPath string, includePaths []string, o pluginOut) error {
+	return generateProto(
+		g.ctx,
+		outDir,
+		protoPath,
+		includePaths,
+		[]string{o.out},
+		protocPlugin(o.plugin+"="+g.pluginPaths[o.plugin]),
+	)
+}
@@ -0,0 +1,24 @@
+package cosmosgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolvePlugins(t *testing.T) {
+	plugins, err := ResolvePlugins(map[string]string{pluginGRPCGateway: "v1.14.7"})
+	require.NoError(t, err)
+	require.Len(t, plugins, len(DefaultPlugins))
+
+	p, ok := findPlugin(plugins, pluginGRPCGateway)
+	require.True(t, ok)
+	require.Equal(t, "v1.14.7", p.Version)
+
+	p, ok = findPlugin(plugins, pluginGoCosmos)
+	require.True(t, ok)
+	require.Equal(t, "v0.3.1", p.Version)
+
+	_, err = ResolvePlugins(map[string]string{"protoc-gen-unknown": "v1.0.0"})
+	require.Error(t, err)
+}
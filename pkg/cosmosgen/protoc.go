@@ -0,0 +1,150 @@
+package cosmosgen
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/exec"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+	"github.com/trino-network/trino/pkg/cmdtrace"
+	"github.com/trino-network/trino/pkg/toolchain"
+)
+
+// protocOption configures generateProto.
+type protocOption func(*protocOptions)
+
+type protocOptions struct {
+	pluginPath           string
+	generateDependencies bool
+}
+
+// protocPlugin sets the plugin that generates code, as name=path or as the path of a binary
+// named protoc-gen-name.
+func protocPlugin(path string) protocOption {
+	return func(o *protocOptions) {
+		o.pluginPath = path
+	}
+}
+
+// protocDependencies generates code for the proto files that the proto files depend on as well,
+// for plugins that don't generate it themselves.
+func protocDependencies() protocOption {
+	return func(o *protocOptions) {
+		o.generateDependencies = true
+	}
+}
+
+// generateProto generates code for the proto files at protoPath into outDir with protoc, once
+// for each of protocOuts. protoc is the one installed by the toolchain when there is one.
+func generateProto(
+	ctx context.Context,
+	outDir, protoPath string,
+	includePaths, protocOuts []string,
+	options ...protocOption,
+) error {
+	var o protocOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	cmd, cleanup, err := toolchain.ProtocCommand()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	command := cmd.Command
+	if o.pluginPath != "" {
+		command = append(command, "--plugin", o.pluginPath)
+	}
+
+	// include paths of third party proto files that don't exist are skipped.
+	var existentIncludePaths []string
+	for _, path := range includePaths {
+		if _, err := os.Stat(path); err == nil {
+			existentIncludePaths = append(existentIncludePaths, path)
+			command = append(command, "-I", path)
+		}
+	}
+
+	files, err := discoverProtoFiles(ctx, protoPath, append(cmd.Included, existentIncludePaths...), o.generateDependencies)
+	if err != nil {
+		return err
+	}
+
+	for _, out := range protocOuts {
+		command := append(append(command, out), files...)
+
+		if err := exec.Exec(ctx, command,
+			exec.StepOption(step.Workdir(outDir)),
+			exec.StepOption(cmdtrace.Step()),
+			exec.IncludeStdLogsToError(),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// discoverProtoFiles returns the proto files at protoPath and, with withDependencies, the proto
+// files in includePaths that they import, directly or not.
+func discoverProtoFiles(ctx context.Context, protoPath string, includePaths []string, withDependencies bool) ([]string, error) {
+	pkgs, err := protoanalysis.Parse(ctx, protoanalysis.NewCache(), protoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	files := pkgs.Files().Paths()
+	if !withDependencies {
+		return files, nil
+	}
+
+	seen := make(map[string]bool)
+	for _, file := range files {
+		seen[file] = true
+	}
+
+	var visit func(file protoanalysis.File) error
+	visit = func(file protoanalysis.File) error {
+		for _, dep := range file.Dependencies {
+			// imports are looked up relative to the file first, then in includePaths.
+			if path := filepath.Join(filepath.Dir(file.Path), dep); fileExists(path) {
+				if !seen[path] {
+					seen[path] = true
+					files = append(files, path)
+				}
+				continue
+			}
+
+			path, ok := resolveProtoImport(dep, includePaths)
+			if !ok || seen[path] || strings.HasPrefix(path, protoPath) {
+				continue
+			}
+			seen[path] = true
+			files = append(files, path)
+
+			depFile, err := protoanalysis.ParseFile(path)
+			if err != nil {
+				return err
+			}
+			if err := visit(depFile); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, file := range pkgs.Files() {
+		if err := visit(file); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,177 @@
+package cosmosgen
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+	"github.com/trino-network/trino/pkg/cmdtrace"
+	"golang.org/x/mod/module"
+)
+
+// protoModule is a Go module that hosts proto files which are imported by the proto files of apps.
+type protoModule struct {
+	// path is the path of the module without its major version suffix.
+	path string
+
+	// protoDirs are the paths of the module's proto dirs relative to its root.
+	protoDirs []string
+
+	// required makes generation fail when the module is not a dependency of the app.
+	required bool
+}
+
+// protoModules are the modules that third-party proto files are resolved from, in the order of
+// their precedence. they are resolved at the versions selected by the app's go.mod so imported
+// proto files always match the Go types that the generated code depends on.
+var protoModules = []protoModule{
+	{path: defaultSdkImport, protoDirs: []string{"proto", "third_party/proto"}, required: true},
+	{path: "github.com/cosmos/ibc-go", protoDirs: []string{"proto", "third_party/proto"}},
+	{path: "github.com/CosmWasm/wasmd", protoDirs: []string{"proto"}},
+}
+
+// resolvedProtoModule is a protoModule found in the build list of an app.
+type resolvedProtoModule struct {
+	protoModule
+
+	// version is the module and version that is used by the app, it's the replacement of the
+	// module if the app replaces it.
+	version module.Version
+
+	// dir is the path of the module's source code.
+	dir string
+}
+
+// includePaths returns the existing proto dirs of the module.
+func (m resolvedProtoModule) includePaths() (paths []string) {
+	for _, protoDir := range m.protoDirs {
+		path := filepath.Join(m.dir, protoDir)
+		if _, err := os.Stat(path); err == nil {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// listedModule is a module listed by 'go list -m -json'.
+type listedModule struct {
+	Path    string
+	Version string
+	Dir     string
+	Replace *listedModule
+}
+
+// resolveProtoModules finds protoModules in the build list of the app at appPath, including the
+// indirect dependencies and the replacements of the app.
+func resolveProtoModules(ctx context.Context, appPath string) ([]resolvedProtoModule, error) {
+	listed, err := listModules(ctx, appPath, "all")
+	if err != nil {
+		return nil, err
+	}
+
+	var resolved []resolvedProtoModule
+
+	for _, pm := range protoModules {
+		m, ok := findListedModule(listed, pm.path)
+		if !ok {
+			if pm.required {
+				return nil, fmt.Errorf("%s is not a dependency of the app, it's required to resolve proto files", pm.path)
+			}
+			continue
+		}
+
+		if m.Replace != nil {
+			m = *m.Replace
+		}
+
+		// the source of a module is not downloaded yet when it's not needed to build the app.
+		if m.Dir == "" {
+			downloaded, err := downloadModule(ctx, appPath, module.Version{Path: m.Path, Version: m.Version})
+			if err != nil {
+				return nil, err
+			}
+			m.Dir = downloaded.Dir
+		}
+
+		resolved = append(resolved, resolvedProtoModule{
+			protoModule: pm,
+			version:     module.Version{Path: m.Path, Version: m.Version},
+			dir:         m.Dir,
+		})
+	}
+
+	return resolved, nil
+}
+
+// findListedModule finds the module with path ignoring its major version suffix.
+func findListedModule(listed []listedModule, path string) (listedModule, bool) {
+	for _, m := range listed {
+		prefix, _, ok := module.SplitPathVersion(m.Path)
+		if ok && prefix == path {
+			return m, true
+		}
+	}
+	return listedModule{}, false
+}
+
+func listModules(ctx context.Context, appPath string, patterns ...string) ([]listedModule, error) {
+	out := &bytes.Buffer{}
+	errb := &bytes.Buffer{}
+
+	if err := cmdrunner.
+		New(cmdrunner.DefaultWorkdir(appPath)).
+		Run(ctx, step.New(
+			step.Exec("go", append([]string{"list", "-m", "-json"}, patterns...)...),
+			step.Stdout(out),
+			step.Stderr(errb),
+			cmdtrace.Step(),
+		)); err != nil {
+		return nil, errors.Wrap(err, errb.String())
+	}
+
+	var listed []listedModule
+
+	d := json.NewDecoder(out)
+	for {
+		var m listedModule
+		if err := d.Decode(&m); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		listed = append(listed, m)
+	}
+
+	return listed, nil
+}
+
+func downloadModule(ctx context.Context, appPath string, v module.Version) (listedModule, error) {
+	out := &bytes.Buffer{}
+	errb := &bytes.Buffer{}
+
+	if err := cmdrunner.
+		New(cmdrunner.DefaultWorkdir(appPath)).
+		Run(ctx, step.New(
+			step.Exec("go", "mod", "download", "-json", v.String()),
+			step.Stdout(out),
+			step.Stderr(errb),
+			cmdtrace.Step(),
+		)); err != nil {
+		return listedModule{}, errors.Wrap(err, errb.String())
+	}
+
+	var m listedModule
+	if err := json.NewDecoder(out).Decode(&m); err != nil {
+		return listedModule{}, err
+	}
+
+	return m, nil
+}
@@ -0,0 +1,26 @@
+package cosmosgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindListedModule(t *testing.T) {
+	listed := []listedModule{
+		{Path: "github.com/tendermint/tendermint", Version: "v0.34.14"},
+		{Path: "github.com/cosmos/ibc-go/v2", Version: "v2.0.0"},
+		{Path: "github.com/cosmos/cosmos-sdk", Version: "v0.44.3", Replace: &listedModule{Path: "../cosmos-sdk", Dir: "/src/cosmos-sdk"}},
+	}
+
+	m, ok := findListedModule(listed, "github.com/cosmos/ibc-go")
+	require.True(t, ok)
+	require.Equal(t, "v2.0.0", m.Version)
+
+	m, ok = findListedModule(listed, "github.com/cosmos/cosmos-sdk")
+	require.True(t, ok)
+	require.Equal(t, "/src/cosmos-sdk", m.Replace.Dir)
+
+	_, ok = findListedModule(listed, "github.com/CosmWasm/wasmd")
+	require.False(t, ok)
+}
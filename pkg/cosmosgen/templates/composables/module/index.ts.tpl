@@ -0,0 +1,31 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import { Ref, unref } from "vue";
+import { useQuery } from "@tanstack/vue-query";
+import { useClient } from "../client";
+
+type MaybeRef<T> = T | Ref<T>;
+{{ range .Module.HTTPQueries }}{{ $FullName := .FullName }}{{ range $i, $rule := .Rules }}{{ $n := "" }}{{ if (gt $i 0) }}{{ $n = inc $i }}{{ end }}
+// use{{ $FullName }}{{ $n }} queries {{ $FullName }} of the {{ $.Module.Pkg.Name }} module, reactive params refetch the query.
+export function use{{ $FullName }}{{ $n }}(
+  {{ range $rule.Params }}{{ . }}: MaybeRef<string>,
+  {{ end }}{{ if $rule.HasQuery }}query?: MaybeRef<Record<string, any>>,
+  {{ end }}{{ if $rule.HasBody }}body: MaybeRef<any> = {},
+  {{ end }}options: Record<string, any> = {},
+) {
+  const client = useClient();
+
+  return useQuery({
+    queryKey: ["{{ $.Module.Pkg.Name }}", "{{ $FullName }}{{ $n }}"{{ range $rule.Params }}, {{ . }}{{ end }}{{ if $rule.HasQuery }}, query{{ end }}{{ if $rule.HasBody }}, body{{ end }}],
+    queryFn: async () => {
+      const res = await client.query.{{ $.Name }}.{{ camelCase $FullName }}{{ $n }}(
+        {{- range $j, $a := $rule.Params }}{{ if (gt $j 0) }}, {{ end }}unref({{ $a }}){{ end -}}
+        {{- if $rule.HasQuery }}{{ if $rule.Params }}, {{ end }}unref(query){{ end -}}
+        {{- if $rule.HasBody }}{{ if or $rule.HasQuery $rule.Params }}, {{ end }}unref(body){{ end -}}
+      );
+      return res.data;
+    },
+    ...options,
+  });
+}
+{{ end }}{{ end }}
@@ -0,0 +1,29 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import { App, inject, InjectionKey } from "vue";
+import { Client } from "{{ .ClientPath }}";
+
+export const clientKey: InjectionKey<Client> = Symbol("client");
+
+let defaultClient: Client | undefined;
+
+// createClientPlugin returns a Vue plugin that provides client to the composables.
+export function createClientPlugin(client: Client) {
+  return {
+    install(app: App) {
+      app.provide(clientKey, client);
+    },
+  };
+}
+
+// useClient returns the provided client or a client with the default env if there isn't one.
+export function useClient(): Client {
+  const client = inject(clientKey, undefined);
+  if (client) {
+    return client;
+  }
+  if (!defaultClient) {
+    defaultClient = new Client();
+  }
+  return defaultClient;
+}
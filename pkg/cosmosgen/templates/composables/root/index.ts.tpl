@@ -0,0 +1,5 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+export * from "./client";
+{{ range .Modules }}export * as {{ .Name }} from "./{{ .Path }}";
+{{ end }}
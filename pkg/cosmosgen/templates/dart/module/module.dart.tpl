@@ -0,0 +1,17 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import 'package:alan/alan.dart' as alan;
+
+import 'export.dart';
+
+export 'export.dart';
+
+/// Registers the sdk.Msg types of the {{ .Module.Pkg.Name }} module so they can be signed with alan.
+void registerTypes() {
+  {{ range .Module.Msgs }}alan.Codec.registerMsgType('/{{ .URI }}', {{ .Name }}());
+  {{ end }}
+}
+{{ range .Module.Pkg.Services }}{{ if ne .Name "Msg" }}
+/// Returns a gRPC client for the {{ .Name }} service of the {{ $.Module.Pkg.Name }} module.
+{{ .Name }}Client {{ camelCase .Name }}Client(alan.NetworkInfo networkInfo) => {{ .Name }}Client(networkInfo.gRPCChannel);
+{{ end }}{{ end }}
@@ -0,0 +1,54 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import 'package:alan/alan.dart' as alan;
+import 'package:cosmos_utils/cosmos_utils.dart';
+import 'package:protobuf/protobuf.dart';
+import 'package:transaction_signing_gateway/alan/alan_transaction.dart';
+import 'package:transaction_signing_gateway/gateway/transaction_signing_gateway.dart';
+import 'package:transaction_signing_gateway/model/wallet_lookup_key.dart';
+
+{{ range .Modules }}import '{{ .Path }}/module.dart' as {{ .Name }};
+{{ end }}
+/// Registers the sdk.Msg types of all modules so they can be signed with alan.
+void registerTypes() {
+  {{ range .Modules }}{{ .Name }}.registerTypes();
+  {{ end }}
+}
+
+/// Signs and broadcasts transactions with the wallets stored by the transaction signing gateway of the Flutter app.
+class TxSigner {
+  final TransactionSigningGateway transactionSigningGateway;
+
+  TxSigner(this.transactionSigningGateway) {
+    registerTypes();
+  }
+
+  /// Signs messages with the wallet of walletLookupKey, broadcasts them in a single transaction
+  /// and returns the transaction hash.
+  Future<String> signAndBroadcast(
+    List<GeneratedMessage> messages,
+    WalletLookupKey walletLookupKey, {
+    String memo = '',
+    alan.Fee? fee,
+  }) async {
+    final unsignedTransaction = UnsignedAlanTransaction(messages: messages, memo: memo, fee: fee);
+
+    final result = await transactionSigningGateway
+        .signTransaction(
+          transaction: unsignedTransaction,
+          walletLookupKey: walletLookupKey,
+        )
+        .mapError<dynamic>((error) => throw error)
+        .flatMap(
+          (signed) => transactionSigningGateway.broadcastTransaction(
+            walletLookupKey: walletLookupKey,
+            transaction: signed,
+          ),
+        );
+
+    return result.fold(
+      (fail) => throw fail as Object,
+      (hash) => hash.txHash,
+    );
+  }
+}
@@ -0,0 +1,21 @@
+{{ template "head" .Title }}
+{{- template "nav" . }}
+<main>
+  <h1>{{ .Title }} API reference</h1>
+  <p>Reference documentation generated from the proto files of the chain.</p>
+  {{- range .Packages }}
+  <section>
+    <h2><a href="{{ .Page }}">{{ .Name }}</a></h2>
+    {{- if .Services }}
+    <ul>
+      {{- $pkg := . }}
+      {{- range .Services }}
+      <li><a href="{{ $pkg.Page }}#{{ $pkg.Name }}.{{ .Name }}">{{ .Name }}</a> service, {{ len .Methods }} methods</li>
+      {{- end }}
+    </ul>
+    {{- end }}
+  </section>
+  {{- end }}
+</main>
+</body>
+</html>
@@ -0,0 +1,24 @@
+{{ define "head" }}<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <title>{{ . }}</title>
+  <link rel="stylesheet" href="style.css">
+</head>
+<body>
+{{ end }}
+
+{{ define "nav" }}<nav>
+  <a class="title" href="index.html">{{ .Title }}</a>
+  <ul>
+    {{- range .Packages }}
+    <li><a href="{{ .Page }}">{{ .Name }}</a></li>
+    {{- end }}
+  </ul>
+</nav>
+{{ end }}
+
+{{ define "type" }}{{ if .Link }}<a href="{{ .Link }}"><code>{{ .Name }}</code></a>{{ else }}<code>{{ .Name }}</code>{{ end }}{{ end }}
+
+{{ define "comment" }}{{ if . }}<p class="comment">{{ . }}</p>{{ end }}{{ end }}
@@ -0,0 +1,76 @@
+{{ template "head" .Package.Name }}
+{{- template "nav" . }}
+<main>
+  {{- $pkg := .Package }}
+  <h1>{{ $pkg.Name }}</h1>
+  <p class="files">{{ range $i, $file := $pkg.Files }}{{ if $i }}, {{ end }}<code>{{ $file }}</code>{{ end }}</p>
+
+  {{- range $pkg.Services }}
+  <section id="{{ $pkg.Name }}.{{ .Name }}">
+    <h2>{{ .Name }} service</h2>
+    {{ template "comment" .Comment }}
+    {{- range .Methods }}
+    <div class="method">
+      <h3>{{ .Name }}</h3>
+      <p class="signature">
+        <code>rpc {{ .Name }}(</code>{{ if .RequestStream }}<code>stream </code>{{ end }}{{ template "type" .Request }}<code>)
+        returns (</code>{{ if .ResponseStream }}<code>stream </code>{{ end }}{{ template "type" .Response }}<code>)</code>
+      </p>
+      {{- range .HTTPRules }}
+      <p class="http"><span class="verb">{{ .Method }}</span> <code>{{ .Path }}</code></p>
+      {{- end }}
+      {{ template "comment" .Comment }}
+    </div>
+    {{- end }}
+  </section>
+  {{- end }}
+
+  {{- if $pkg.Messages }}
+  <h2>Messages</h2>
+  {{- range $pkg.Messages }}
+  <section id="{{ .FullName }}">
+    <h3>{{ .Name }}</h3>
+    {{ template "comment" .Comment }}
+    {{- if .Fields }}
+    <table>
+      <thead><tr><th>Field</th><th>Type</th><th>Number</th><th>Description</th></tr></thead>
+      <tbody>
+        {{- range .Fields }}
+        <tr>
+          <td><code>{{ .Name }}</code></td>
+          <td>{{ if .Label }}<code>{{ .Label }} </code>{{ end }}{{ template "type" .Type }}</td>
+          <td>{{ .Number }}</td>
+          <td>{{ template "comment" .Comment }}</td>
+        </tr>
+        {{- end }}
+      </tbody>
+    </table>
+    {{- end }}
+  </section>
+  {{- end }}
+  {{- end }}
+
+  {{- if $pkg.Enums }}
+  <h2>Enums</h2>
+  {{- range $pkg.Enums }}
+  <section id="{{ .FullName }}">
+    <h3>{{ .Name }}</h3>
+    {{ template "comment" .Comment }}
+    <table>
+      <thead><tr><th>Name</th><th>Number</th><th>Description</th></tr></thead>
+      <tbody>
+        {{- range .Values }}
+        <tr>
+          <td><code>{{ .Name }}</code></td>
+          <td>{{ .Number }}</td>
+          <td>{{ template "comment" .Comment }}</td>
+        </tr>
+        {{- end }}
+      </tbody>
+    </table>
+  </section>
+  {{- end }}
+  {{- end }}
+</main>
+</body>
+</html>
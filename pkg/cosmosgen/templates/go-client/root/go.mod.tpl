@@ -0,0 +1,13 @@
+module {{ .ModulePath }}
+
+go 1.16
+
+require (
+	github.com/cosmos/cosmos-sdk {{ .SDKVersion }}
+	github.com/tendermint/starport {{ .StarportVersion }}
+)
+{{ if .Replaces }}
+replace (
+{{ range .Replaces }}	{{ . }}
+{{ end }})
+{{ end }}
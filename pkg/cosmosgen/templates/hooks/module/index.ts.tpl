@@ -0,0 +1,28 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import { useQuery } from "@tanstack/react-query";
+import { useClient } from "../client";
+{{ range .Module.HTTPQueries }}{{ $FullName := .FullName }}{{ range $i, $rule := .Rules }}{{ $n := "" }}{{ if (gt $i 0) }}{{ $n = inc $i }}{{ end }}
+// use{{ $FullName }}{{ $n }} queries {{ $FullName }} of the {{ $.Module.Pkg.Name }} module, changed params refetch the query.
+export function use{{ $FullName }}{{ $n }}(
+  {{ range $rule.Params }}{{ . }}: string,
+  {{ end }}{{ if $rule.HasQuery }}query?: Record<string, any>,
+  {{ end }}{{ if $rule.HasBody }}body: any = {},
+  {{ end }}options: Record<string, any> = {},
+) {
+  const client = useClient();
+
+  return useQuery({
+    queryKey: ["{{ $.Module.Pkg.Name }}", "{{ $FullName }}{{ $n }}"{{ range $rule.Params }}, {{ . }}{{ end }}{{ if $rule.HasQuery }}, query{{ end }}{{ if $rule.HasBody }}, body{{ end }}],
+    queryFn: async () => {
+      const res = await client.query.{{ $.Name }}.{{ camelCase $FullName }}{{ $n }}(
+        {{- range $j, $a := $rule.Params }}{{ if (gt $j 0) }}, {{ end }}{{ $a }}{{ end -}}
+        {{- if $rule.HasQuery }}{{ if $rule.Params }}, {{ end }}query{{ end -}}
+        {{- if $rule.HasBody }}{{ if or $rule.HasQuery $rule.Params }}, {{ end }}body{{ end -}}
+      );
+      return res.data;
+    },
+    ...options,
+  });
+}
+{{ end }}{{ end }}
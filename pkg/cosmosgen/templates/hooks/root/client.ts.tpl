@@ -0,0 +1,17 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import { createContext, createElement, ReactNode, useContext } from "react";
+import { Client } from "{{ .ClientPath }}";
+
+// ClientContext holds the client used by the hooks, it has a client with the default env if not provided.
+export const ClientContext = createContext<Client>(new Client());
+
+// ClientProvider provides client to the hooks.
+export function ClientProvider({ client, children }: { client: Client; children?: ReactNode }) {
+  return createElement(ClientContext.Provider, { value: client }, children);
+}
+
+// useClient returns the provided client.
+export function useClient(): Client {
+  return useContext(ClientContext);
+}
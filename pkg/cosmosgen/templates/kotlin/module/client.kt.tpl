@@ -0,0 +1,20 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+package {{ .Package }}
+
+import com.google.protobuf.Any as ProtoAny
+
+// {{ .Title }}QueryClient sends the queries of {{ .Module.Pkg.Name }}.
+class {{ .Title }}QueryClient(private val rpc: TendermintRpc) {
+{{- range .Queries }}
+    fun {{ .Name }}(request: {{ .Request }}): {{ .Response }} =
+        {{ .Response }}.parseFrom(rpc.abciQuery("{{ .Path }}", request.toByteArray()))
+{{ end -}}
+}
+
+// {{ .Title }}Msgs packs the msgs of {{ .Module.Pkg.Name }} to be signed by TxClient.
+object {{ .Title }}Msgs {
+{{- range .Msgs }}
+    fun {{ .Name }}(msg: {{ .Type }}): ProtoAny = pack("{{ .TypeURL }}", msg.toByteString())
+{{ end -}}
+}
@@ -0,0 +1,14 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+package {{ .Package }}
+
+// Client brings the query clients of all modules together.
+class Client(val rpc: TendermintRpc) {
+    constructor(rpcUrl: String) : this(TendermintRpc(rpcUrl))
+{{ range .Modules }}
+    val {{ .Name }} = {{ .Title }}QueryClient(rpc)
+{{- end }}
+
+    // txClient returns a client that signs txs for the chain with chainId by signer.
+    fun txClient(chainId: String, signer: Signer) = TxClient(rpc, chainId, signer)
+}
@@ -0,0 +1,72 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+package {{ .Package }}
+
+import java.io.IOException
+import java.net.HttpURLConnection
+import java.net.URL
+import java.util.Base64
+import org.json.JSONObject
+
+// AbciException is thrown when a node responds to a query or a tx with a non-zero code.
+class AbciException(val code: Int, val codespace: String, message: String) : IOException(message)
+
+// BroadcastResult is the result of a tx that is checked by a node.
+data class BroadcastResult(val hash: String, val code: Int, val codespace: String, val log: String)
+
+// TendermintRpc sends ABCI queries and txs to the Tendermint RPC of a node.
+class TendermintRpc(private val url: String) {
+    // abciQuery sends an ABCI query with the protobuf encoded data to path and returns the
+    // protobuf encoded response.
+    fun abciQuery(path: String, data: ByteArray): ByteArray {
+        val params = JSONObject()
+            .put("path", path)
+            .put("data", data.joinToString("") { "%02x".format(it) })
+        val response = call("abci_query", params).getJSONObject("response")
+
+        val code = response.optInt("code")
+        if (code != 0) {
+            throw AbciException(code, response.optString("codespace"), response.optString("log"))
+        }
+
+        return Base64.getDecoder().decode(response.optString("value"))
+    }
+
+    // broadcastTxSync broadcasts a tx and waits for it to be checked by the node.
+    fun broadcastTxSync(tx: ByteArray): BroadcastResult {
+        val params = JSONObject().put("tx", Base64.getEncoder().encodeToString(tx))
+        val result = call("broadcast_tx_sync", params)
+
+        return BroadcastResult(
+            hash = result.optString("hash"),
+            code = result.optInt("code"),
+            codespace = result.optString("codespace"),
+            log = result.optString("log"),
+        )
+    }
+
+    private fun call(method: String, params: JSONObject): JSONObject {
+        val request = JSONObject()
+            .put("jsonrpc", "2.0")
+            .put("id", 0)
+            .put("method", method)
+            .put("params", params)
+
+        val connection = URL(url).openConnection() as HttpURLConnection
+        try {
+            connection.requestMethod = "POST"
+            connection.doOutput = true
+            connection.setRequestProperty("Content-Type", "application/json")
+            connection.outputStream.use { it.write(request.toString().toByteArray()) }
+
+            val response = JSONObject(connection.inputStream.bufferedReader().use { it.readText() })
+            response.optJSONObject("error")?.let {
+                throw IOException("${it.optString("message")}: ${it.optString("data")}")
+            }
+
+            return response.getJSONObject("result")
+        } finally {
+            connection.disconnect()
+        }
+    }
+}
@@ -0,0 +1,105 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+package {{ .Package }}
+
+import com.google.protobuf.ByteString
+import com.google.protobuf.Any as ProtoAny
+import cosmos.auth.v1beta1.Auth
+import cosmos.auth.v1beta1.QueryOuterClass.QueryAccountRequest
+import cosmos.auth.v1beta1.QueryOuterClass.QueryAccountResponse
+import cosmos.base.v1beta1.CoinOuterClass.Coin
+import cosmos.crypto.secp256k1.Keys
+import cosmos.tx.signing.v1beta1.Signing.SignMode
+import cosmos.tx.v1beta1.TxOuterClass.AuthInfo
+import cosmos.tx.v1beta1.TxOuterClass.Fee
+import cosmos.tx.v1beta1.TxOuterClass.ModeInfo
+import cosmos.tx.v1beta1.TxOuterClass.SignDoc
+import cosmos.tx.v1beta1.TxOuterClass.SignerInfo
+import cosmos.tx.v1beta1.TxOuterClass.TxBody
+import cosmos.tx.v1beta1.TxOuterClass.TxRaw
+
+// Signer signs txs with a secp256k1 key, implement it with the key store of your app.
+interface Signer {
+    // address is the bech32 address of the signer's account.
+    val address: String
+
+    // publicKey is the compressed secp256k1 public key of the signer.
+    val publicKey: ByteArray
+
+    // sign returns the 64 bytes r || s signature of the SHA-256 hash of bytes.
+    fun sign(bytes: ByteArray): ByteArray
+}
+
+// coin returns a coin with amount of denom.
+fun coin(denom: String, amount: String): Coin =
+    Coin.newBuilder().setDenom(denom).setAmount(amount).build()
+
+// fee returns the fee of a tx that can use up to gasLimit gas.
+fun fee(gasLimit: Long, vararg amount: Coin): Fee =
+    Fee.newBuilder().setGasLimit(gasLimit).addAllAmount(amount.toList()).build()
+
+// TxClient signs txs with signer in direct mode and broadcasts them to the chain.
+class TxClient(
+    private val rpc: TendermintRpc,
+    private val chainId: String,
+    private val signer: Signer,
+) {
+    // signAndBroadcast signs a tx with msgs and broadcasts it, msgs are packed by the Msgs
+    // objects of modules.
+    fun signAndBroadcast(msgs: List<ProtoAny>, fee: Fee, memo: String = ""): BroadcastResult {
+        val account = account()
+
+        val body = TxBody.newBuilder()
+            .addAllMessages(msgs)
+            .setMemo(memo)
+            .build()
+
+        val publicKey = Keys.PubKey.newBuilder()
+            .setKey(ByteString.copyFrom(signer.publicKey))
+            .build()
+
+        val signerInfo = SignerInfo.newBuilder()
+            .setPublicKey(pack("/cosmos.crypto.secp256k1.PubKey", publicKey.toByteString()))
+            .setModeInfo(
+                ModeInfo.newBuilder().setSingle(
+                    ModeInfo.Single.newBuilder().setMode(SignMode.SIGN_MODE_DIRECT),
+                ),
+            )
+            .setSequence(account.sequence)
+            .build()
+
+        val authInfo = AuthInfo.newBuilder()
+            .addSignerInfos(signerInfo)
+            .setFee(fee)
+            .build()
+
+        val signDoc = SignDoc.newBuilder()
+            .setBodyBytes(body.toByteString())
+            .setAuthInfoBytes(authInfo.toByteString())
+            .setChainId(chainId)
+            .setAccountNumber(account.accountNumber)
+            .build()
+
+        val tx = TxRaw.newBuilder()
+            .setBodyBytes(signDoc.bodyBytes)
+            .setAuthInfoBytes(signDoc.authInfoBytes)
+            .addSignatures(ByteString.copyFrom(signer.sign(signDoc.toByteArray())))
+            .build()
+
+        return rpc.broadcastTxSync(tx.toByteArray())
+    }
+
+    // account returns the account of the signer to get its number and sequence.
+    private fun account(): Auth.BaseAccount {
+        val request = QueryAccountRequest.newBuilder().setAddress(signer.address).build()
+        val response = QueryAccountResponse.parseFrom(
+            rpc.abciQuery("/cosmos.auth.v1beta1.Query/Account", request.toByteArray()),
+        )
+
+        return Auth.BaseAccount.parseFrom(response.account.value)
+    }
+}
+
+// pack packs a protobuf encoded value with typeUrl into an Any.
+internal fun pack(typeUrl: String, value: ByteString): ProtoAny =
+    ProtoAny.newBuilder().setTypeUrl(typeUrl).setValue(value).build()
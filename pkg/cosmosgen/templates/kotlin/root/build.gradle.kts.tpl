@@ -0,0 +1,20 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+plugins {
+    id("com.android.library")
+    kotlin("android")
+}
+
+android {
+    namespace = "{{ .Package }}"
+    compileSdk = 33
+
+    defaultConfig {
+        minSdk = 26
+    }
+}
+
+dependencies {
+    api("com.google.protobuf:protobuf-javalite:3.21.12")
+    api("com.google.protobuf:protobuf-kotlin-lite:3.21.12")
+}
@@ -0,0 +1,50 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import { defineStore } from "pinia";
+import { StdFee } from "@cosmjs/stargate";
+import { Client, useClient } from "../client";
+
+// key identifies a cached response by the arguments of its query.
+const key = (...args: any[]) => JSON.stringify(args);
+
+// useStore is the store of the {{ .Module.Pkg.Name }} module.
+export const useStore = defineStore("{{ .Module.Pkg.Name }}", {
+  state: () => ({
+    {{ range .Module.HTTPQueries }}{{ $FullName := .FullName }}{{ range $i, $rule := .Rules }}{{ $n := "" }}{{ if (gt $i 0) }}{{ $n = inc $i }}{{ end }}{{ $FullName }}{{ $n }}: {} as Record<string, any>,
+    {{ end }}{{ end }}
+  }),
+
+  getters: {
+    {{ range .Module.HTTPQueries }}{{ $FullName := .FullName }}{{ range $i, $rule := .Rules }}{{ $n := "" }}{{ if (gt $i 0) }}{{ $n = inc $i }}{{ end }}// get{{ $FullName }}{{ $n }} returns the cached response of {{ $FullName }}.
+    get{{ $FullName }}{{ $n }}: (state) => (
+      {{ range $rule.Params }}{{ . }}: string,
+      {{ end }}{{ if $rule.HasQuery }}query?: Record<string, any>,
+      {{ end }}{{ if $rule.HasBody }}body: any = {},
+      {{ end }}) => state.{{ $FullName }}{{ $n }}[key({{ range $j, $a := $rule.Params }}{{ if (gt $j 0) }}, {{ end }}{{ $a }}{{ end }}{{ if $rule.HasQuery }}{{ if $rule.Params }}, {{ end }}query{{ end }}{{ if $rule.HasBody }}{{ if or $rule.HasQuery $rule.Params }}, {{ end }}body{{ end }})],
+    {{ end }}{{ end }}
+  },
+
+  actions: {
+    {{ range .Module.HTTPQueries }}{{ $FullName := .FullName }}{{ range $i, $rule := .Rules }}{{ $n := "" }}{{ if (gt $i 0) }}{{ $n = inc $i }}{{ end }}// fetch{{ $FullName }}{{ $n }} queries {{ $FullName }} of the module and caches the response.
+    async fetch{{ $FullName }}{{ $n }}(
+      {{ range $rule.Params }}{{ . }}: string,
+      {{ end }}{{ if $rule.HasQuery }}query?: Record<string, any>,
+      {{ end }}{{ if $rule.HasBody }}body: any = {},
+      {{ end }}) {
+      const res = await useClient().query.{{ $.Name }}.{{ camelCase $FullName }}{{ $n }}(
+        {{- range $j, $a := $rule.Params }}{{ if (gt $j 0) }}, {{ end }}{{ $a }}{{ end -}}
+        {{- if $rule.HasQuery }}{{ if $rule.Params }}, {{ end }}query{{ end -}}
+        {{- if $rule.HasBody }}{{ if or $rule.HasQuery $rule.Params }}, {{ end }}body{{ end -}}
+      );
+      this.{{ $FullName }}{{ $n }}[key({{ range $j, $a := $rule.Params }}{{ if (gt $j 0) }}, {{ end }}{{ $a }}{{ end }}{{ if $rule.HasQuery }}{{ if $rule.Params }}, {{ end }}query{{ end }}{{ if $rule.HasBody }}{{ if or $rule.HasQuery $rule.Params }}, {{ end }}body{{ end }})] = res.data;
+      return res.data;
+    },
+    {{ end }}{{ end }}{{ range .Module.Msgs }}
+    // send{{ .Name }} signs and broadcasts {{ .Name }} with the signer of the client.
+    async send{{ .Name }}(value: Parameters<Client["msgs"]["{{ $.Name }}"]["{{ camelCase .Name }}"]>[0], fee?: StdFee, memo?: string) {
+      const client = useClient();
+      return client.signAndBroadcast([client.msgs.{{ $.Name }}.{{ camelCase .Name }}(value)], fee, memo);
+    },
+    {{ end }}
+  },
+});
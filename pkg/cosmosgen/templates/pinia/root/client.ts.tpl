@@ -0,0 +1,20 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import { Client } from "{{ .ClientPath }}";
+
+export { Client };
+
+let client: Client | undefined;
+
+// setClient sets the client used by the stores.
+export function setClient(c: Client) {
+  client = c;
+}
+
+// useClient returns the client set by setClient() or a client with the default env if there isn't one.
+export function useClient(): Client {
+  if (!client) {
+    client = new Client();
+  }
+  return client;
+}
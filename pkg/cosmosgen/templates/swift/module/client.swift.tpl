@@ -0,0 +1,29 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import Foundation
+import SwiftProtobuf
+
+/// Sends the queries of {{ .Module.Pkg.Name }}.
+public final class {{ .Title }}QueryClient {
+    private let rpc: TendermintRPC
+
+    public init(rpc: TendermintRPC) {
+        self.rpc = rpc
+    }
+{{ range .Queries }}
+    public func {{ .Name }}(_ request: {{ .Request }}) async throws -> {{ .Response }} {
+        try {{ .Response }}(
+            serializedData: try await rpc.abciQuery(path: "{{ .Path }}", data: try request.serializedData())
+        )
+    }
+{{ end -}}
+}
+
+/// Packs the msgs of {{ .Module.Pkg.Name }} to be signed by TxClient.
+public enum {{ .Title }}Msgs {
+{{- range .Msgs }}
+    public static func {{ .Name }}(_ msg: {{ .Type }}) throws -> Google_Protobuf_Any {
+        pack(typeURL: "{{ .TypeURL }}", value: try msg.serializedData())
+    }
+{{ end -}}
+}
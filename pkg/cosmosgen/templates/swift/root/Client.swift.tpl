@@ -0,0 +1,27 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import Foundation
+
+/// Brings the query clients of all modules together.
+public final class Client {
+    public let rpc: TendermintRPC
+{{ range .Modules }}
+    public let {{ .Name }}: {{ .Title }}QueryClient
+{{- end }}
+
+    public init(rpc: TendermintRPC) {
+        self.rpc = rpc
+{{- range .Modules }}
+        self.{{ .Name }} = {{ .Title }}QueryClient(rpc: rpc)
+{{- end }}
+    }
+
+    public convenience init(rpcURL: URL) {
+        self.init(rpc: TendermintRPC(url: rpcURL))
+    }
+
+    /// Returns a client that signs txs for the chain with chainID by signer.
+    public func txClient(chainID: String, signer: Signer) -> TxClient {
+        TxClient(rpc: rpc, chainID: chainID, signer: signer)
+    }
+}
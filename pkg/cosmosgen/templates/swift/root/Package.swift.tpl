@@ -0,0 +1,21 @@
+// swift-tools-version:5.5
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import PackageDescription
+
+let package = Package(
+    name: "{{ .Target }}",
+    platforms: [.iOS(.v15), .macOS(.v12)],
+    products: [
+        .library(name: "{{ .Target }}", targets: ["{{ .Target }}"]),
+    ],
+    dependencies: [
+        .package(url: "https://github.com/apple/swift-protobuf.git", from: "1.20.0"),
+    ],
+    targets: [
+        .target(
+            name: "{{ .Target }}",
+            dependencies: [.product(name: "SwiftProtobuf", package: "swift-protobuf")]
+        ),
+    ]
+)
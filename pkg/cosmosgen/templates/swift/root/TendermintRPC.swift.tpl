@@ -0,0 +1,79 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import Foundation
+
+/// An error returned by a node to a query or a tx with a non-zero code, or by the RPC itself.
+public enum RPCError: Error {
+    case abci(code: Int, codespace: String, log: String)
+    case rpc(message: String)
+}
+
+/// The result of a tx that is checked by a node.
+public struct BroadcastResult {
+    public let hash: String
+    public let code: Int
+    public let codespace: String
+    public let log: String
+}
+
+/// Sends ABCI queries and txs to the Tendermint RPC of a node.
+public final class TendermintRPC {
+    private let url: URL
+    private let session: URLSession
+
+    public init(url: URL, session: URLSession = .shared) {
+        self.url = url
+        self.session = session
+    }
+
+    /// Sends an ABCI query with the protobuf encoded data to path and returns the protobuf encoded response.
+    public func abciQuery(path: String, data: Data) async throws -> Data {
+        let hex = data.map { String(format: "%02x", $0) }.joined()
+        let result = try await call(method: "abci_query", params: ["path": path, "data": hex])
+        let response = result["response"] as? [String: Any] ?? [:]
+
+        let code = response["code"] as? Int ?? 0
+        if code != 0 {
+            throw RPCError.abci(
+                code: code,
+                codespace: response["codespace"] as? String ?? "",
+                log: response["log"] as? String ?? ""
+            )
+        }
+
+        return Data(base64Encoded: response["value"] as? String ?? "") ?? Data()
+    }
+
+    /// Broadcasts a tx and waits for it to be checked by the node.
+    public func broadcastTxSync(_ tx: Data) async throws -> BroadcastResult {
+        let result = try await call(method: "broadcast_tx_sync", params: ["tx": tx.base64EncodedString()])
+
+        return BroadcastResult(
+            hash: result["hash"] as? String ?? "",
+            code: result["code"] as? Int ?? 0,
+            codespace: result["codespace"] as? String ?? "",
+            log: result["log"] as? String ?? ""
+        )
+    }
+
+    private func call(method: String, params: [String: Any]) async throws -> [String: Any] {
+        var request = URLRequest(url: url)
+        request.httpMethod = "POST"
+        request.setValue("application/json", forHTTPHeaderField: "Content-Type")
+        request.httpBody = try JSONSerialization.data(withJSONObject: [
+            "jsonrpc": "2.0",
+            "id": 0,
+            "method": method,
+            "params": params,
+        ])
+
+        let (data, _) = try await session.data(for: request)
+        let response = try JSONSerialization.jsonObject(with: data) as? [String: Any] ?? [:]
+
+        if let error = response["error"] as? [String: Any] {
+            throw RPCError.rpc(message: "\(error["message"] ?? ""): \(error["data"] ?? "")")
+        }
+
+        return response["result"] as? [String: Any] ?? [:]
+    }
+}
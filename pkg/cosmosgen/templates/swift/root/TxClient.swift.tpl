@@ -0,0 +1,106 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import Foundation
+import SwiftProtobuf
+
+/// Signs txs with a secp256k1 key, implement it with the key store of your app.
+public protocol Signer {
+    /// The bech32 address of the signer's account.
+    var address: String { get }
+
+    /// The compressed secp256k1 public key of the signer.
+    var publicKey: Data { get }
+
+    /// Returns the 64 bytes r || s signature of the SHA-256 hash of data.
+    func sign(_ data: Data) async throws -> Data
+}
+
+/// Returns a coin with amount of denom.
+public func coin(denom: String, amount: String) -> Cosmos_Base_V1beta1_Coin {
+    var coin = Cosmos_Base_V1beta1_Coin()
+    coin.denom = denom
+    coin.amount = amount
+    return coin
+}
+
+/// Returns the fee of a tx that can use up to gasLimit gas.
+public func fee(gasLimit: UInt64, _ amount: Cosmos_Base_V1beta1_Coin...) -> Cosmos_Tx_V1beta1_Fee {
+    var fee = Cosmos_Tx_V1beta1_Fee()
+    fee.gasLimit = gasLimit
+    fee.amount = amount
+    return fee
+}
+
+/// Signs txs with signer in direct mode and broadcasts them to the chain.
+public final class TxClient {
+    private let rpc: TendermintRPC
+    private let chainID: String
+    private let signer: Signer
+
+    public init(rpc: TendermintRPC, chainID: String, signer: Signer) {
+        self.rpc = rpc
+        self.chainID = chainID
+        self.signer = signer
+    }
+
+    /// Signs a tx with msgs and broadcasts it, msgs are packed by the Msgs enums of modules.
+    public func signAndBroadcast(
+        _ msgs: [Google_Protobuf_Any],
+        fee: Cosmos_Tx_V1beta1_Fee,
+        memo: String = ""
+    ) async throws -> BroadcastResult {
+        let account = try await self.account()
+
+        var body = Cosmos_Tx_V1beta1_TxBody()
+        body.messages = msgs
+        body.memo = memo
+
+        var publicKey = Cosmos_Crypto_Secp256k1_PubKey()
+        publicKey.key = signer.publicKey
+
+        var signerInfo = Cosmos_Tx_V1beta1_SignerInfo()
+        signerInfo.publicKey = pack(typeURL: "/cosmos.crypto.secp256k1.PubKey", value: try publicKey.serializedData())
+        signerInfo.modeInfo.single.mode = .direct
+        signerInfo.sequence = account.sequence
+
+        var authInfo = Cosmos_Tx_V1beta1_AuthInfo()
+        authInfo.signerInfos = [signerInfo]
+        authInfo.fee = fee
+
+        var signDoc = Cosmos_Tx_V1beta1_SignDoc()
+        signDoc.bodyBytes = try body.serializedData()
+        signDoc.authInfoBytes = try authInfo.serializedData()
+        signDoc.chainID = chainID
+        signDoc.accountNumber = account.accountNumber
+
+        var tx = Cosmos_Tx_V1beta1_TxRaw()
+        tx.bodyBytes = signDoc.bodyBytes
+        tx.authInfoBytes = signDoc.authInfoBytes
+        tx.signatures = [try await signer.sign(try signDoc.serializedData())]
+
+        return try await rpc.broadcastTxSync(try tx.serializedData())
+    }
+
+    /// Returns the account of the signer to get its number and sequence.
+    private func account() async throws -> Cosmos_Auth_V1beta1_BaseAccount {
+        var request = Cosmos_Auth_V1beta1_QueryAccountRequest()
+        request.address = signer.address
+
+        let response = try Cosmos_Auth_V1beta1_QueryAccountResponse(
+            serializedData: try await rpc.abciQuery(
+                path: "/cosmos.auth.v1beta1.Query/Account",
+                data: try request.serializedData()
+            )
+        )
+
+        return try Cosmos_Auth_V1beta1_BaseAccount(serializedData: response.account.value)
+    }
+}
+
+/// Packs a protobuf encoded value with typeURL into an Any.
+func pack(typeURL: String, value: Data) -> Google_Protobuf_Any {
+    var any = Google_Protobuf_Any()
+    any.typeURL = typeURL
+    any.value = value
+    return any
+}
@@ -0,0 +1,41 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import { EncodeObject, GeneratedType } from "@cosmjs/proto-signing";
+import { Api } from "./rest";
+import { decodeAttributes, EventDecoder } from "{{ .RootPath }}/events";
+{{ if .QueryFile }}import { GrpcWebImpl, QueryClientImpl } from "./types/{{ resolveFile .QueryFile }}";
+{{ end }}{{ range .Module.Msgs }}import { {{ .Name }} } from "./types/{{ resolveFile .FilePath }}";
+{{ end }}{{ range .Events }}import { {{ .Name }} } from "./types/{{ resolveFile .Path }}";
+{{ end }}
+{{ range .Module.Msgs }}export { {{ .Name }} } from "./types/{{ resolveFile .FilePath }}";
+{{ end }}{{ range .Module.Types }}export { {{ .Name }} } from "./types/{{ resolveFile .FilePath }}";
+{{ end }}
+
+// registry holds the sdk.Msg types of the {{ .Module.Pkg.Name }} module.
+export const registry: Array<[string, GeneratedType]> = [
+  {{ range .Module.Msgs }}["/{{ .URI }}", {{ .Name }}],
+  {{ end }}
+];
+
+// msgs composes the sdk.Msgs of the {{ .Module.Pkg.Name }} module to sign and broadcast.
+export const msgs = {
+  {{ range .Module.Msgs }}{{ camelCase .Name }}: (value: Partial<{{ .Name }}>): EncodeObject => ({
+    typeUrl: "/{{ .URI }}",
+    value: {{ .Name }}.fromPartial(value),
+  }),
+  {{ end }}
+};
+
+// events decodes the typed events of the {{ .Module.Pkg.Name }} module by their types.
+export const events: Record<string, EventDecoder> = {
+  {{ range .Events }}"{{ .Type }}": (attributes) => {{ .Name }}.fromJSON(decodeAttributes(attributes)),
+  {{ end }}
+};
+
+// queryClient returns a typed client for the query endpoints of the {{ .Module.Pkg.Name }} module.
+export const queryClient = (baseUrl: string) => new Api({ baseUrl });
+{{ if .QueryFile }}
+// grpcWebQueryClient returns a typed gRPC-web client for the query service of the {{ .Module.Pkg.Name }} module.
+export const grpcWebQueryClient = (grpcWebURL: string, options: ConstructorParameters<typeof GrpcWebImpl>[1] = {}) =>
+  new QueryClientImpl(new GrpcWebImpl(grpcWebURL, options));
+{{ end }}
\ No newline at end of file
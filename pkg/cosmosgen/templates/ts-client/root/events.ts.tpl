@@ -0,0 +1,60 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+// Attribute is an attribute of a Tendermint event. keys and values are base64 encoded
+// in the events sent by the websocket of Tendermint v0.34.
+export interface Attribute {
+  key: string;
+  value: string;
+}
+
+// Event is a Tendermint event.
+export interface Event {
+  type: string;
+  attributes: Attribute[];
+}
+
+// EventDecoder decodes the attributes of a typed event into its proto message.
+export type EventDecoder<T = any> = (attributes: Attribute[]) => T;
+
+// TypedEvent is a decoded typed event, type is the full name of its proto message.
+export interface TypedEvent<T = any> {
+  type: string;
+  value: T;
+}
+
+// attribute keys of typed events are proto field names, anything else is base64 encoded.
+const fieldName = /^[a-z_][a-z0-9_]*$/;
+
+function decodeBase64(s: string): string {
+  const { atob, Buffer } = globalThis as any;
+  return atob ? atob(s) : Buffer.from(s, "base64").toString();
+}
+
+function camelCase(s: string): string {
+  return s.replace(/_([a-z0-9])/g, (_, c: string) => c.toUpperCase());
+}
+
+function camelCaseKeys(value: any): any {
+  if (Array.isArray(value)) {
+    return value.map(camelCaseKeys);
+  }
+  if (value !== null && typeof value === "object") {
+    return Object.fromEntries(Object.entries(value).map(([k, v]) => [camelCase(k), camelCaseKeys(v)]));
+  }
+  return value;
+}
+
+// decodeAttributes converts the attributes of a typed event into a JSON object that can be
+// decoded with the fromJSON() of its proto message. values of the attributes are JSON encoded
+// fields of the message.
+export function decodeAttributes(attributes: Attribute[]): any {
+  const object: any = {};
+  for (let { key, value } of attributes) {
+    if (!fieldName.test(key)) {
+      key = decodeBase64(key);
+      value = decodeBase64(value);
+    }
+    object[camelCase(key)] = camelCaseKeys(JSON.parse(value));
+  }
+  return object;
+}
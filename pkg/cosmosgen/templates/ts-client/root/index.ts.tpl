@@ -0,0 +1,215 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import { DirectSecp256k1HdWallet, EncodeObject, GeneratedType, OfflineSigner, Registry } from "@cosmjs/proto-signing";
+import { defaultRegistryTypes, SigningStargateClient, StdFee } from "@cosmjs/stargate";
+import { Event, EventDecoder, TypedEvent } from "./events";
+{{ range .Modules }}import * as {{ .Name }} from "./{{ .Path }}";
+{{ end }}
+export { {{ range $i, $m := .Modules }}{{ if (gt $i 0) }}, {{ end }}{{ $m.Name }}{{ end }} };
+
+// Env configures the endpoints of the chain.
+export interface Env {
+  // rpcURL is the address of the Tendermint RPC.
+  rpcURL: string;
+
+  // apiURL is the address of the Cosmos SDK API.
+  apiURL: string;
+
+  // wsURL is the address of the Tendermint websocket, derived from rpcURL if not set.
+  wsURL?: string;
+{{ if .GRPCWeb }}
+  // grpcWebURL is the address of the gRPC-web endpoint.
+  grpcWebURL: string;
+{{ end }}
+  // chainId is the id of the chain, required to use Keplr.
+  chainId?: string;
+
+  // prefix is the bech32 prefix of the account addresses.
+  prefix: string;
+
+  // WebSocket is the websocket implementation to subscribe to events, defaults to the global one.
+  WebSocket?: any;
+}
+
+export const defaultEnv: Env = {
+  rpcURL: "http://localhost:26657",
+  apiURL: "http://localhost:1317",{{ if .GRPCWeb }}
+  grpcWebURL: "http://localhost:9091",{{ end }}
+  prefix: "cosmos",
+};
+
+export const defaultFee: StdFee = {
+  amount: [],
+  gas: "200000",
+};
+
+export const MissingSignerError = new Error("signer is required, use withSigner(), useKeplr() or fromMnemonic()");
+
+const types: Array<[string, GeneratedType]> = [
+  ...defaultRegistryTypes,
+  {{ range .Modules }}...{{ .Name }}.registry,
+  {{ end }}
+];
+
+// events decodes the typed events of all modules by their types.
+export const events: Record<string, EventDecoder> = {
+  {{ range .Modules }}...{{ .Name }}.events,
+  {{ end }}
+};
+
+// decodeEvents decodes the typed events among rawEvents, the other events are skipped.
+export function decodeEvents(rawEvents: Event[]): TypedEvent[] {
+  const decoded: TypedEvent[] = [];
+  for (const event of rawEvents) {
+    const decode = events[event.type];
+    if (decode) {
+      decoded.push({ type: event.type, value: decode(event.attributes) });
+    }
+  }
+  return decoded;
+}
+
+// registry holds the sdk.Msg types of all modules.
+export const registry = new Registry(types);
+
+// msgs composes the sdk.Msgs of all modules.
+export const msgs = {
+  {{ range .Modules }}{{ .Name }}: {{ .Name }}.msgs,
+  {{ end }}
+};
+
+export class Client {
+  readonly env: Env;
+  readonly msgs = msgs;
+  readonly query: {
+    {{ range .Modules }}{{ .Name }}: ReturnType<typeof {{ .Name }}.queryClient>;
+    {{ end }}
+  };{{ if .GRPCWeb }}
+  readonly grpcWeb: {
+    {{ range .Modules }}{{ if .GRPCWeb }}{{ .Name }}: ReturnType<typeof {{ .Name }}.grpcWebQueryClient>;
+    {{ end }}{{ end }}
+  };{{ end }}
+
+  private signer?: OfflineSigner;
+  private signingClient?: SigningStargateClient;
+
+  constructor(env: Partial<Env> = {}, signer?: OfflineSigner) {
+    this.env = { ...defaultEnv, ...env };
+    this.signer = signer;
+    this.query = {
+      {{ range .Modules }}{{ .Name }}: {{ .Name }}.queryClient(this.env.apiURL),
+      {{ end }}
+    };{{ if .GRPCWeb }}
+    this.grpcWeb = {
+      {{ range .Modules }}{{ if .GRPCWeb }}{{ .Name }}: {{ .Name }}.grpcWebQueryClient(this.env.grpcWebURL),
+      {{ end }}{{ end }}
+    };{{ end }}
+  }
+
+  // fromMnemonic creates a client that signs with the account of the mnemonic.
+  static async fromMnemonic(mnemonic: string, env: Partial<Env> = {}): Promise<Client> {
+    const prefix = env.prefix ?? defaultEnv.prefix;
+    const wallet = await DirectSecp256k1HdWallet.fromMnemonic(mnemonic, { prefix });
+    return new Client(env, wallet);
+  }
+
+  // withSigner sets the signer used to sign transactions.
+  withSigner(signer: OfflineSigner): Client {
+    this.signer = signer;
+    this.signingClient = undefined;
+    return this;
+  }
+
+  // useKeplr signs transactions with the Keplr wallet, chainInfo is suggested to Keplr if given.
+  async useKeplr(chainInfo?: any): Promise<Client> {
+    const keplr = (globalThis as any).keplr;
+    if (!keplr) {
+      throw new Error("Keplr extension is not installed");
+    }
+
+    const chainId = this.env.chainId ?? chainInfo?.chainId;
+    if (!chainId) {
+      throw new Error("chainId is required to use Keplr");
+    }
+
+    if (chainInfo) {
+      await keplr.experimentalSuggestChain(chainInfo);
+    }
+    await keplr.enable(chainId);
+
+    return this.withSigner(keplr.getOfflineSigner(chainId));
+  }
+
+  // address returns the address of the signer's account.
+  async address(): Promise<string> {
+    if (!this.signer) {
+      throw MissingSignerError;
+    }
+    const [account] = await this.signer.getAccounts();
+    return account.address;
+  }
+
+  // sign signs msgs without broadcasting them.
+  async sign(msgs: EncodeObject[], fee: StdFee = defaultFee, memo = "") {
+    const client = await this.connect();
+    return client.sign(await this.address(), msgs, fee, memo);
+  }
+
+  // signAndBroadcast signs and broadcasts msgs in a single transaction.
+  async signAndBroadcast(msgs: EncodeObject[], fee: StdFee = defaultFee, memo = "") {
+    const client = await this.connect();
+    return client.signAndBroadcast(await this.address(), msgs, fee, memo);
+  }
+
+  // subscribe subscribes to the Tendermint events that match query and returns a func to unsubscribe.
+  subscribe(query: string, handler: (result: any) => void): () => void {
+    const WebSocket = this.env.WebSocket ?? (globalThis as any).WebSocket;
+    if (!WebSocket) {
+      throw new Error("WebSocket is not available, set it in the env");
+    }
+
+    const ws = new WebSocket(this.env.wsURL ?? this.env.rpcURL.replace(/^http/, "ws") + "/websocket");
+    ws.onopen = () => {
+      ws.send(JSON.stringify({ jsonrpc: "2.0", method: "subscribe", id: "0", params: { query } }));
+    };
+    ws.onmessage = (message: { data: string }) => {
+      const { result } = JSON.parse(message.data);
+      // the first result only confirms the subscription.
+      if (result?.data) {
+        handler(result);
+      }
+    };
+
+    return () => ws.close();
+  }
+
+  // onNewBlock calls handler with every new block.
+  onNewBlock(handler: (result: any) => void): () => void {
+    return this.subscribe("tm.event='NewBlock'", handler);
+  }
+
+  // onTx calls handler with every transaction that matches query.
+  onTx(handler: (result: any) => void, query = ""): () => void {
+    return this.subscribe("tm.event='Tx'" + (query ? " AND " + query : ""), handler);
+  }
+
+  // onEvents calls handler with the typed events of every transaction that matches query.
+  onEvents(handler: (events: TypedEvent[], result: any) => void, query = ""): () => void {
+    return this.onTx((result) => {
+      const typedEvents = decodeEvents(result.data?.value?.TxResult?.result?.events ?? []);
+      if (typedEvents.length > 0) {
+        handler(typedEvents, result);
+      }
+    }, query);
+  }
+
+  private async connect(): Promise<SigningStargateClient> {
+    if (!this.signer) {
+      throw MissingSignerError;
+    }
+    if (!this.signingClient) {
+      this.signingClient = await SigningStargateClient.connectWithSigner(this.env.rpcURL, this.signer, { registry });
+    }
+    return this.signingClient;
+  }
+}
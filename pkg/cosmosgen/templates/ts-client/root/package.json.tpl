@@ -0,0 +1,34 @@
+{
+  "name": "{{ .User }}-{{ .Repo }}-client-ts",
+  "version": "0.1.0",
+  "description": "Autogenerated TypeScript client for the {{ .Repo }} blockchain",
+  "author": "Starport Codegen <hello@tendermint.com>",
+  "license": "Apache-2.0",
+  "licenses": [
+    {
+      "type": "Apache-2.0",
+      "url": "http://www.apache.org/licenses/LICENSE-2.0"
+    }
+  ],
+  "main": "lib/index.js",
+  "types": "lib/index.d.ts",
+  "scripts": {
+    "build": "tsc",
+    "prepare": "tsc"
+  },
+  "dependencies": {
+    "@cosmjs/proto-signing": "0.27.0",
+    "@cosmjs/stargate": "0.27.0",{{ if .GRPCWeb }}
+    "@improbable-eng/grpc-web": "^0.14.1",
+    "browser-headers": "^0.4.1",
+    "rxjs": "^7.5.2",{{ end }}
+    "long": "^4.0.0",
+    "protobufjs": "^6.11.2"
+  },
+  "devDependencies": {
+    "typescript": "^4.5.4"
+  },
+  "publishConfig": {
+    "access": "public"
+  }
+}
@@ -0,0 +1,17 @@
+{
+  "name": "{{ .User }}-{{ .Repo }}-js",
+  "version": "0.1.0",
+  "description": "Autogenerated cosmos modules vuex store",
+  "author": "Starport Codegen <hello@tendermint.com>",  
+  "license": "Apache-2.0",
+  "licenses": [
+    {
+      "type": "Apache-2.0",
+      "url": "http://www.apache.org/licenses/LICENSE-2.0"
+    }
+  ],
+  "main": "index.js",
+  "publishConfig": {
+    "access": "public"
+  }
+}
\ No newline at end of file
@@ -0,0 +1,119 @@
+// Package cosmwasm compiles CosmWasm contracts for chains that have the wasm module.
+package cosmwasm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+	"github.com/tendermint/starport/starport/pkg/gomodule"
+	"github.com/trino-network/trino/pkg/cmdtrace"
+)
+
+const (
+	// ModulePath is the path of the Go module of the wasm module.
+	ModulePath = "github.com/CosmWasm/wasmd"
+
+	// OptimizerImage is the image of rust-optimizer that compiles contracts into small and
+	// reproducible wasm files.
+	OptimizerImage = "cosmwasm/rust-optimizer:0.12.4"
+
+	// ArtifactsDir is the dir in a contract that rust-optimizer writes the wasm files into.
+	ArtifactsDir = "artifacts"
+
+	// ManifestFile is the name of the Cargo manifest of a contract.
+	ManifestFile = "Cargo.toml"
+
+	dockerBinaryName = "docker"
+)
+
+var (
+	// ErrNotEnabled is returned when the chain doesn't have the wasm module.
+	ErrNotEnabled = fmt.Errorf("the chain doesn't have the wasm module, add %s to the chain to use contracts", ModulePath)
+
+	// ErrDockerNotInstalled is returned when the docker binary that runs rust-optimizer cannot be found in PATH.
+	ErrDockerNotInstalled = errors.New("docker is not installed, it's needed to compile contracts with rust-optimizer, see https://docs.docker.com/get-docker")
+)
+
+// IsEnabled checks if the chain at appPath has the wasm module.
+func IsEnabled(appPath string) (bool, error) {
+	gomod, err := gomodule.ParseAt(appPath)
+	if err != nil {
+		return false, err
+	}
+	for _, req := range gomod.Require {
+		if req.Mod.Path == ModulePath {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// IsContract checks if path is the dir of a contract, rather than a compiled wasm file.
+func IsContract(path string) bool {
+	_, err := os.Stat(filepath.Join(path, ManifestFile))
+	return err == nil
+}
+
+// Optimize compiles the contract at contractPath with rust-optimizer and returns the path of its
+// wasm file. the build caches of cargo are kept in docker volumes so the next builds are faster.
+func Optimize(ctx context.Context, contractPath string) (wasmFile string, err error) {
+	contractPath, err = filepath.Abs(contractPath)
+	if err != nil {
+		return "", err
+	}
+	name, err := crateName(contractPath)
+	if err != nil {
+		return "", err
+	}
+	if _, err := exec.LookPath(dockerBinaryName); err != nil {
+		return "", ErrDockerNotInstalled
+	}
+
+	args := []string{
+		"run", "--rm",
+		"-v", contractPath + ":/code",
+		"--mount", fmt.Sprintf("type=volume,source=%s_cache,target=/code/target", filepath.Base(contractPath)),
+		"--mount", "type=volume,source=registry_cache,target=/usr/local/cargo/registry",
+		OptimizerImage,
+	}
+
+	output := &bytes.Buffer{}
+	err = cmdrunner.
+		New(cmdrunner.DefaultStdout(output), cmdrunner.DefaultStderr(output)).
+		Run(ctx, step.New(step.Exec(dockerBinaryName, args...), cmdtrace.Step()))
+	if err != nil {
+		if output.Len() > 0 {
+			return "", errors.New(string(bytes.TrimSpace(output.Bytes())))
+		}
+		return "", err
+	}
+
+	wasmFile = filepath.Join(contractPath, ArtifactsDir, name+".wasm")
+	if _, err := os.Stat(wasmFile); err != nil {
+		return "", errors.Wrapf(err, "rust-optimizer didn't compile %s", name)
+	}
+	return wasmFile, nil
+}
+
+// crateName returns the name of the crate of the contract at contractPath as it's named in the
+// wasm file that rust-optimizer writes.
+func crateName(contractPath string) (string, error) {
+	manifest, err := toml.LoadFile(filepath.Join(contractPath, ManifestFile))
+	if err != nil {
+		return "", err
+	}
+	name, ok := manifest.Get("package.name").(string)
+	if !ok || name == "" {
+		return "", fmt.Errorf("%s has no package name, only the contracts of a single crate can be compiled", ManifestFile)
+	}
+	return strings.ReplaceAll(name, "-", "_"), nil
+}
@@ -0,0 +1,40 @@
+package cosmwasm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsEnabled(t *testing.T) {
+	appPath := t.TempDir()
+	gomod := filepath.Join(appPath, "go.mod")
+
+	require.NoError(t, os.WriteFile(gomod, []byte("module github.com/alice/mars\n\ngo 1.16\n"), 0644))
+	enabled, err := IsEnabled(appPath)
+	require.NoError(t, err)
+	require.False(t, enabled)
+
+	require.NoError(t, os.WriteFile(gomod, []byte("module github.com/alice/mars\n\ngo 1.16\n\nrequire github.com/CosmWasm/wasmd v0.21.0\n"), 0644))
+	enabled, err = IsEnabled(appPath)
+	require.NoError(t, err)
+	require.True(t, enabled)
+}
+
+func TestCrateName(t *testing.T) {
+	contractPath := t.TempDir()
+	require.False(t, IsContract(contractPath))
+
+	manifest := filepath.Join(contractPath, ManifestFile)
+	require.NoError(t, os.WriteFile(manifest, []byte("[package]\nname = \"cw20-base\"\nversion = \"0.10.3\"\n"), 0644))
+	require.True(t, IsContract(contractPath))
+	name, err := crateName(contractPath)
+	require.NoError(t, err)
+	require.Equal(t, "cw20_base", name)
+
+	require.NoError(t, os.WriteFile(manifest, []byte("[workspace]\nmembers = [\"contracts/*\"]\n"), 0644))
+	_, err = crateName(contractPath)
+	require.EqualError(t, err, "Cargo.toml has no package name, only the contracts of a single crate can be compiled")
+}
@@ -0,0 +1,156 @@
+package explorer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newChain serves a fake Tendermint RPC and API of a chain with a block at height 2 that has a
+// transfer tx.
+func newChain(t *testing.T, rawTx []byte) (rpc, api string) {
+	hash := fmt.Sprintf("%X", sha256.Sum256(rawTx))
+	b64 := base64.StdEncoding.EncodeToString
+
+	rpcServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/status":
+			io.WriteString(w, `{"result":{"sync_info":{"latest_block_height":"2"}}}`)
+		case r.URL.Query().Get("height") == "1":
+			io.WriteString(w, `{"result":{"block_id":{"hash":"B1"},"block":{"header":{"time":"2021-11-02T10:15:30Z","proposer_address":"P"},"data":{"txs":[]}}}}`)
+		default:
+			fmt.Fprintf(w, `{"result":{"block_id":{"hash":"B2"},"block":{"header":{"time":"2021-11-02T10:15:35Z","proposer_address":"P"},"data":{"txs":["%s"]}}}}`, b64(rawTx))
+		}
+	}))
+	t.Cleanup(rpcServer.Close)
+
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cosmos/tx/v1beta1/txs/"+hash {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, `{
+  "tx": {"body": {"messages": [{"@type": "/cosmos.bank.v1beta1.MsgSend", "amount": [{"denom": "stake", "amount": "10"}]}]}},
+  "tx_response": {"code": 0, "gas_wanted": "200000", "gas_used": "51234", "events": [
+    {"type": "transfer", "attributes": [{"key": "%s", "value": "%s"}]}
+  ]}
+}`, b64([]byte("amount")), b64([]byte("10stake")))
+	}))
+	t.Cleanup(apiServer.Close)
+
+	return rpcServer.URL, apiServer.URL
+}
+
+func TestIndexer(t *testing.T) {
+	ctx := context.Background()
+	rawTx := []byte("tx")
+
+	store, err := Open(filepath.Join(t.TempDir(), "explorer.db"))
+	require.NoError(t, err)
+	defer store.Close()
+
+	rpc, api := newChain(t, rawTx)
+	require.NoError(t, NewIndexer(store, rpc, api).Index(ctx))
+
+	height, err := store.LastHeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), height)
+
+	block, err := store.Block(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, "B2", block.Hash)
+	require.Len(t, block.Txs, 1)
+
+	tx, err := store.Tx(ctx, fmt.Sprintf("%X", sha256.Sum256(rawTx)))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), tx.Height)
+	require.Equal(t, int64(51234), tx.GasUsed)
+	require.Equal(t, []string{"/cosmos.bank.v1beta1.MsgSend"}, tx.Messages)
+	require.Equal(t, []Event{{Type: "transfer", Attributes: []Attribute{{Key: "amount", Value: "10stake"}}}}, tx.Events)
+	require.Contains(t, tx.Body, `"denom": "stake"`)
+
+	_, err = store.Block(ctx, 3)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestOpen(t *testing.T) {
+	store, err := Open(filepath.Join(t.TempDir(), "explorer.db"))
+	require.NoError(t, err)
+	defer store.Close()
+
+	var journalMode string
+	require.NoError(t, store.db.QueryRow("PRAGMA journal_mode").Scan(&journalMode))
+	require.Equal(t, "wal", journalMode)
+
+	var busyTimeout int
+	require.NoError(t, store.db.QueryRow("PRAGMA busy_timeout").Scan(&busyTimeout))
+	require.Equal(t, 5000, busyTimeout)
+}
+
+func TestIndexerReset(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := Open(filepath.Join(t.TempDir(), "explorer.db"))
+	require.NoError(t, err)
+	defer store.Close()
+
+	// the blocks of the chain before its state is reset.
+	for height := int64(1); height <= 5; height++ {
+		require.NoError(t, store.Save(ctx, Block{Height: height, Hash: "OLD"}))
+	}
+
+	rpc, api := newChain(t, []byte("tx"))
+	require.NoError(t, NewIndexer(store, rpc, api).Index(ctx))
+
+	height, err := store.LastHeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), height)
+
+	block, err := store.Block(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, "B2", block.Hash)
+
+	_, err = store.Block(ctx, 5)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestHandler(t *testing.T) {
+	ctx := context.Background()
+	rawTx := []byte("tx")
+	hash := fmt.Sprintf("%X", sha256.Sum256(rawTx))
+
+	store, err := Open(filepath.Join(t.TempDir(), "explorer.db"))
+	require.NoError(t, err)
+	defer store.Close()
+
+	rpc, api := newChain(t, rawTx)
+	require.NoError(t, NewIndexer(store, rpc, api).Index(ctx))
+
+	handler := Handler(store, "mars")
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	w := get("/")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), hash)
+
+	w = get("/txs/" + hash)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), "10stake")
+
+	w = get("/search?q=2")
+	require.Equal(t, "/blocks/2", w.Header().Get("Location"))
+
+	require.Equal(t, http.StatusNotFound, get("/blocks/3").Code)
+}
@@ -0,0 +1,107 @@
+package explorer
+
+import (
+	"embed"
+	"errors"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// pageSize is the number of the latest blocks and txs listed on the home page.
+const pageSize = 20
+
+//go:embed templates/*.html
+var templatesFS embed.FS
+
+var templates = template.Must(template.ParseFS(templatesFS, "templates/*.html"))
+
+// page is the data of a page, Chain is the name of the chain in the header of pages.
+type page struct {
+	Chain    string
+	Blocks   []Block
+	Txs      []Tx
+	Block    Block
+	Tx       Tx
+	Previous int64
+	Next     int64
+	Query    string
+}
+
+// Handler returns the handler of the web explorer of the blocks and txs in store, chain is the
+// name of the chain.
+func Handler(store *Store, chain string) http.Handler {
+	mux := http.NewServeMux()
+
+	render := func(w http.ResponseWriter, status int, name string, p page) {
+		p.Chain = chain
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(status)
+		templates.ExecuteTemplate(w, name, p)
+	}
+	fail := func(w http.ResponseWriter, err error, query string) {
+		if errors.Is(err, ErrNotFound) {
+			render(w, http.StatusNotFound, "notfound.html", page{Query: query})
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			render(w, http.StatusNotFound, "notfound.html", page{Query: r.URL.Path})
+			return
+		}
+		blocks, err := store.Blocks(r.Context(), pageSize)
+		if err != nil {
+			fail(w, err, "")
+			return
+		}
+		txs, err := store.Txs(r.Context(), pageSize)
+		if err != nil {
+			fail(w, err, "")
+			return
+		}
+		render(w, http.StatusOK, "index.html", page{Blocks: blocks, Txs: txs})
+	})
+
+	mux.HandleFunc("/blocks/", func(w http.ResponseWriter, r *http.Request) {
+		query := strings.TrimPrefix(r.URL.Path, "/blocks/")
+		height, err := strconv.ParseInt(query, 10, 64)
+		if err != nil {
+			render(w, http.StatusNotFound, "notfound.html", page{Query: query})
+			return
+		}
+		block, err := store.Block(r.Context(), height)
+		if err != nil {
+			fail(w, err, query)
+			return
+		}
+		render(w, http.StatusOK, "block.html", page{Block: block, Previous: height - 1, Next: height + 1})
+	})
+
+	mux.HandleFunc("/txs/", func(w http.ResponseWriter, r *http.Request) {
+		hash := strings.ToUpper(strings.TrimPrefix(r.URL.Path, "/txs/"))
+		tx, err := store.Tx(r.Context(), hash)
+		if err != nil {
+			fail(w, err, hash)
+			return
+		}
+		render(w, http.StatusOK, "tx.html", page{Tx: tx})
+	})
+
+	// heights are searched as blocks, other queries as the hashes of txs.
+	mux.HandleFunc("/search", func(w http.ResponseWriter, r *http.Request) {
+		query := strings.TrimSpace(r.URL.Query().Get("q"))
+		if _, err := strconv.ParseInt(query, 10, 64); err == nil {
+			http.Redirect(w, r, "/blocks/"+query, http.StatusFound)
+			return
+		}
+		hash := strings.TrimPrefix(strings.ToUpper(query), "0X")
+		http.Redirect(w, r, "/txs/"+url.PathEscape(hash), http.StatusFound)
+	})
+
+	return mux
+}
@@ -0,0 +1,278 @@
+// Package explorer indexes the blocks, txs and events of a chain that's served in development
+// into a SQLite database and serves a small web explorer for them. txs are decoded with the proto
+// types of the chain by its own API, so they can be read without external decoders.
+package explorer
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultInterval is how often the indexer checks for new blocks.
+const DefaultInterval = time.Second
+
+// Indexer indexes the blocks of a chain into a store, from its Tendermint RPC and its API.
+type Indexer struct {
+	store    *Store
+	rpc, api string
+	interval time.Duration
+	client   *http.Client
+}
+
+// NewIndexer creates an indexer that indexes the chain with the Tendermint RPC at rpc and the API
+// at api, both http urls, into store.
+func NewIndexer(store *Store, rpc, api string) Indexer {
+	return Indexer{
+		store:    store,
+		rpc:      rpc,
+		api:      api,
+		interval: DefaultInterval,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Run indexes new blocks until ctx is canceled. the chain may not be started yet, or be
+// restarting, so failures are retried on the next check.
+func (i Indexer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(i.interval)
+	defer ticker.Stop()
+
+	for {
+		_ = i.Index(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Index indexes the blocks that are not indexed yet.
+func (i Indexer) Index(ctx context.Context) error {
+	last, err := i.store.LastHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	var status struct {
+		SyncInfo struct {
+			LatestBlockHeight   string `json:"latest_block_height"`
+			EarliestBlockHeight string `json:"earliest_block_height"`
+		} `json:"sync_info"`
+	}
+	if err := i.rpcCall(ctx, "/status", &status); err != nil {
+		return err
+	}
+	latest, err := strconv.ParseInt(status.SyncInfo.LatestBlockHeight, 10, 64)
+	if err != nil {
+		return err
+	}
+	earliest, _ := strconv.ParseInt(status.SyncInfo.EarliestBlockHeight, 10, 64)
+
+	// the chain is behind the index once its state is reset, its blocks are indexed again.
+	if latest < last {
+		if err := i.store.Truncate(ctx); err != nil {
+			return err
+		}
+		last = 0
+	}
+
+	// the blocks before the earliest one are not kept by the node, such as the blocks before
+	// the initial height of the chain when it's started from an exported genesis.
+	from := last + 1
+	if earliest > from {
+		from = earliest
+	}
+
+	for height := from; height <= latest; height++ {
+		block, err := i.fetchBlock(ctx, height)
+		if err != nil {
+			return err
+		}
+		if err := i.store.Save(ctx, block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fetchBlock fetches the block at height with its txs.
+func (i Indexer) fetchBlock(ctx context.Context, height int64) (Block, error) {
+	var result struct {
+		BlockID struct {
+			Hash string `json:"hash"`
+		} `json:"block_id"`
+		Block struct {
+			Header struct {
+				Time            time.Time `json:"time"`
+				ProposerAddress string    `json:"proposer_address"`
+			} `json:"header"`
+			Data struct {
+				Txs []string `json:"txs"`
+			} `json:"data"`
+		} `json:"block"`
+	}
+	if err := i.rpcCall(ctx, fmt.Sprintf("/block?height=%d", height), &result); err != nil {
+		return Block{}, err
+	}
+
+	block := Block{
+		Height:   height,
+		Hash:     result.BlockID.Hash,
+		Time:     result.Block.Header.Time,
+		Proposer: result.Block.Header.ProposerAddress,
+	}
+	for index, raw := range result.Block.Data.Txs {
+		tx, err := i.fetchTx(ctx, raw)
+		if err != nil {
+			return Block{}, err
+		}
+		tx.Height = height
+		tx.Index = index
+		block.Txs = append(block.Txs, tx)
+	}
+	return block, nil
+}
+
+// fetchTx fetches the tx raw, encoded as base64, decoded with its result from the API. the tx is
+// kept undecoded when the API cannot decode it.
+func (i Indexer) fetchTx(ctx context.Context, raw string) (Tx, error) {
+	b, err := base64.StdEncoding.DecodeString(raw)
+	if err != nil {
+		return Tx{}, err
+	}
+	tx := Tx{
+		Hash: fmt.Sprintf("%X", sha256.Sum256(b)),
+		Raw:  raw,
+	}
+
+	var result struct {
+		Tx         json.RawMessage `json:"tx"`
+		TxResponse struct {
+			Code      uint32 `json:"code"`
+			RawLog    string `json:"raw_log"`
+			GasWanted string `json:"gas_wanted"`
+			GasUsed   string `json:"gas_used"`
+			Events    []struct {
+				Type       string `json:"type"`
+				Attributes []struct {
+					Key   string `json:"key"`
+					Value string `json:"value"`
+				} `json:"attributes"`
+			} `json:"events"`
+		} `json:"tx_response"`
+	}
+	// the block is indexed again on the next check when the tx is not indexed by the node yet.
+	body, err := i.get(ctx, i.api+"/cosmos/tx/v1beta1/txs/"+tx.Hash)
+	if err != nil {
+		return Tx{}, err
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return tx, nil
+	}
+
+	var decoded struct {
+		Body struct {
+			Messages []struct {
+				Type string `json:"@type"`
+			} `json:"messages"`
+		} `json:"body"`
+	}
+	if err := json.Unmarshal(result.Tx, &decoded); err != nil {
+		return tx, nil
+	}
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, result.Tx, "", "  "); err != nil {
+		return tx, nil
+	}
+	tx.Body = indented.String()
+
+	r := result.TxResponse
+	tx.Code = r.Code
+	tx.Log = r.RawLog
+	tx.GasWanted, _ = strconv.ParseInt(r.GasWanted, 10, 64)
+	tx.GasUsed, _ = strconv.ParseInt(r.GasUsed, 10, 64)
+	for _, msg := range decoded.Body.Messages {
+		tx.Messages = append(tx.Messages, msg.Type)
+	}
+	for _, e := range r.Events {
+		event := Event{Type: e.Type}
+		for _, a := range e.Attributes {
+			event.Attributes = append(event.Attributes, Attribute{
+				Key:   decodeAttribute(a.Key),
+				Value: decodeAttribute(a.Value),
+			})
+		}
+		tx.Events = append(tx.Events, event)
+	}
+	return tx, nil
+}
+
+// decodeAttribute decodes the key or the value of an event attribute, they're base64 encoded
+// by the Cosmos SDK versions that return them as bytes.
+func decodeAttribute(s string) string {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil || !utf8.Valid(b) {
+		return s
+	}
+	for _, r := range string(b) {
+		if !unicode.IsPrint(r) {
+			return s
+		}
+	}
+	return string(b)
+}
+
+// rpcCall calls the Tendermint RPC at path and decodes the result into result.
+func (i Indexer) rpcCall(ctx context.Context, path string, result interface{}) error {
+	body, err := i.get(ctx, i.rpc+path)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+			Data    string `json:"data"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return err
+	}
+	if response.Error != nil {
+		return fmt.Errorf("%s: %s", response.Error.Message, response.Error.Data)
+	}
+	return json.Unmarshal(response.Result, result)
+}
+
+func (i Indexer) get(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := i.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var body bytes.Buffer
+	if _, err := body.ReadFrom(resp.Body); err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", url, resp.Status)
+	}
+	return body.Bytes(), nil
+}
@@ -0,0 +1,269 @@
+package explorer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	// the SQLite driver of the store.
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// ErrNotFound is returned when a block or a tx is not indexed.
+var ErrNotFound = errors.New("not found")
+
+const schema = `
+CREATE TABLE IF NOT EXISTS blocks (
+	height   INTEGER PRIMARY KEY,
+	hash     TEXT NOT NULL,
+	time     TIMESTAMP NOT NULL,
+	proposer TEXT NOT NULL,
+	num_txs  INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS txs (
+	hash       TEXT PRIMARY KEY,
+	height     INTEGER NOT NULL,
+	idx        INTEGER NOT NULL,
+	code       INTEGER NOT NULL,
+	log        TEXT NOT NULL,
+	gas_wanted INTEGER NOT NULL,
+	gas_used   INTEGER NOT NULL,
+	messages   TEXT NOT NULL,
+	body       TEXT NOT NULL,
+	raw        TEXT NOT NULL,
+	events     TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS txs_height ON txs (height, idx);
+`
+
+// Block is an indexed block.
+type Block struct {
+	Height   int64
+	Hash     string
+	Time     time.Time
+	Proposer string
+	NumTxs   int
+
+	// Txs are the txs of the block, they're only loaded with the block by Block.
+	Txs []Tx
+}
+
+// Tx is an indexed tx.
+type Tx struct {
+	Hash   string
+	Height int64
+	Index  int
+
+	// Code is the result code of the tx, 0 when it succeeded.
+	Code      uint32
+	Log       string
+	GasWanted int64
+	GasUsed   int64
+
+	// Messages are the types of the messages of the tx.
+	Messages []string
+
+	// Body is the tx decoded with the proto types of the chain, as indented JSON. it's empty when
+	// the tx cannot be decoded.
+	Body string
+
+	// Raw is the tx as base64.
+	Raw string
+
+	Events []Event
+}
+
+// Event is an event emitted by a tx.
+type Event struct {
+	Type       string      `json:"type"`
+	Attributes []Attribute `json:"attributes"`
+}
+
+// Attribute is an attribute of an event.
+type Attribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Store keeps the indexed blocks and txs in a SQLite database.
+type Store struct {
+	db *sql.DB
+}
+
+// Open opens the store at path, the database is created when it doesn't exist.
+func Open(path string) (*Store, error) {
+	// the indexer writes while the explorer reads, the readers don't wait for the writer with the
+	// WAL journal and the writes wait for each other instead of failing with SQLITE_BUSY.
+	db, err := sql.Open("sqlite3", path+"?_busy_timeout=5000&_journal_mode=WAL")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &Store{db: db}, nil
+}
+
+// Close closes the store.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// LastHeight returns the height of the last indexed block, 0 when none is indexed.
+func (s *Store) LastHeight(ctx context.Context) (int64, error) {
+	var height sql.NullInt64
+	err := s.db.QueryRowContext(ctx, "SELECT MAX(height) FROM blocks").Scan(&height)
+	return height.Int64, err
+}
+
+// Truncate deletes the indexed blocks and txs.
+func (s *Store) Truncate(ctx context.Context) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, table := range []string{"blocks", "txs"} {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM "+table); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Save saves block with its txs.
+func (s *Store) Save(ctx context.Context, block Block) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT OR REPLACE INTO blocks (height, hash, time, proposer, num_txs) VALUES (?, ?, ?, ?, ?)",
+		block.Height, block.Hash, block.Time.UTC(), block.Proposer, len(block.Txs),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range block.Txs {
+		messages, err := json.Marshal(t.Messages)
+		if err != nil {
+			return err
+		}
+		events, err := json.Marshal(t.Events)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx,
+			`INSERT OR REPLACE INTO txs (hash, height, idx, code, log, gas_wanted, gas_used, messages, body, raw, events)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			t.Hash, block.Height, t.Index, t.Code, t.Log, t.GasWanted, t.GasUsed, string(messages), t.Body, t.Raw, string(events),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Blocks returns the last limit blocks, the latest first.
+func (s *Store) Blocks(ctx context.Context, limit int) ([]Block, error) {
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT height, hash, time, proposer, num_txs FROM blocks ORDER BY height DESC LIMIT ?", limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var blocks []Block
+	for rows.Next() {
+		var b Block
+		if err := rows.Scan(&b.Height, &b.Hash, &b.Time, &b.Proposer, &b.NumTxs); err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, b)
+	}
+	return blocks, rows.Err()
+}
+
+// Block returns the block at height with its txs.
+func (s *Store) Block(ctx context.Context, height int64) (Block, error) {
+	var b Block
+	err := s.db.QueryRowContext(ctx,
+		"SELECT height, hash, time, proposer, num_txs FROM blocks WHERE height = ?", height,
+	).Scan(&b.Height, &b.Hash, &b.Time, &b.Proposer, &b.NumTxs)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Block{}, ErrNotFound
+	}
+	if err != nil {
+		return Block{}, err
+	}
+
+	b.Txs, err = s.queryTxs(ctx, "WHERE height = ? ORDER BY idx", height)
+	return b, err
+}
+
+// Txs returns the last limit txs, the latest first.
+func (s *Store) Txs(ctx context.Context, limit int) ([]Tx, error) {
+	return s.queryTxs(ctx, "ORDER BY height DESC, idx DESC LIMIT ?", limit)
+}
+
+// Tx returns the tx with hash.
+func (s *Store) Tx(ctx context.Context, hash string) (Tx, error) {
+	txs, err := s.queryTxs(ctx, "WHERE hash = ?", hash)
+	if err != nil {
+		return Tx{}, err
+	}
+	if len(txs) == 0 {
+		return Tx{}, ErrNotFound
+	}
+	return txs[0], nil
+}
+
+// Range returns the txs of the blocks from height from to height to, in the order they were
+// executed.
+func (s *Store) Range(ctx context.Context, from, to int64) ([]Tx, error) {
+	return s.queryTxs(ctx, "WHERE height BETWEEN ? AND ? ORDER BY height, idx", from, to)
+}
+
+func (s *Store) queryTxs(ctx context.Context, clause string, args ...interface{}) ([]Tx, error) {
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT hash, height, idx, code, log, gas_wanted, gas_used, messages, body, raw, events FROM txs "+clause,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var txs []Tx
+	for rows.Next() {
+		var (
+			t                Tx
+			messages, events string
+		)
+		err := rows.Scan(&t.Hash, &t.Height, &t.Index, &t.Code, &t.Log, &t.GasWanted, &t.GasUsed, &messages, &t.Body, &t.Raw, &events)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(messages), &t.Messages); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(events), &t.Events); err != nil {
+			return nil, err
+		}
+		txs = append(txs, t)
+	}
+	return txs, rows.Err()
+}
@@ -0,0 +1,189 @@
+// Package ibctests generates the ibctesting tests of the IBC modules of a chain: the handshake of
+// their channels, and the round trip and the timeout of their packets between two in-process
+// chains of the chain.
+package ibctests
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/trino-network/trino/pkg/txreplay"
+)
+
+const (
+	// helpersFile is the file of the ibctesting helpers in the tests dir, it's written again each
+	// time the tests are generated.
+	helpersFile = "ibc_test.go"
+
+	// moduleIBCFile is the file of the IBC callbacks of the modules that are scaffolded with --ibc.
+	moduleIBCFile = "module_ibc.go"
+)
+
+// ErrNoIBCModules is returned when the chain has no IBC modules to test.
+var ErrNoIBCModules = errors.New(`the chain has no IBC modules, scaffold one with "starport scaffold module [name] --ibc"`)
+
+//go:embed templates/*.tpl
+var templatesFS embed.FS
+
+var templates = template.Must(
+	template.New("").
+		Delims("<%", "%>").
+		ParseFS(templatesFS, "templates/*.tpl"),
+)
+
+// Module is an IBC module of a chain.
+type Module struct {
+	Name       multiformatname.Name
+	ModulePath string
+
+	// Order is the order of the channels of the module, UNORDERED when the module accepts both.
+	Order string
+
+	// Packets are the names of the packets of the module in upper camel case.
+	Packets []string
+}
+
+// Test is a test file of an IBC module.
+type Test struct {
+	Module Module
+	Path   string
+
+	// Skipped is true when the test exists and it's not overwritten.
+	Skipped bool
+}
+
+// Modules returns the IBC modules of the chain at appPath with the Go module modulePath.
+func Modules(appPath, modulePath string) ([]Module, error) {
+	files, err := filepath.Glob(filepath.Join(appPath, "x", "*", moduleIBCFile))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	var modules []Module
+	for _, file := range files {
+		name, err := multiformatname.NewName(filepath.Base(filepath.Dir(file)))
+		if err != nil {
+			return nil, err
+		}
+		m := Module{
+			Name:       name,
+			ModulePath: modulePath,
+			Order:      "UNORDERED",
+		}
+		if err := m.parse(file); err != nil {
+			return nil, err
+		}
+		modules = append(modules, m)
+	}
+	return modules, nil
+}
+
+// parse reads the order of the channels and the packets of the module from its IBC callbacks.
+func (m *Module) parse(file string) error {
+	f, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+	if err != nil {
+		return err
+	}
+
+	// the packets are dispatched by the type of the oneof of the packet data of the module, such
+	// as *types.MarsPacketData_BuyOrderPacket.
+	prefix := strings.Title(m.Name.Original) + "PacketData_"
+	seen := make(map[string]bool)
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			// the order is checked in OnChanOpenInit when the module requires one.
+			if n.Name.Name == "OnChanOpenInit" && n.Body != nil {
+				ast.Inspect(n.Body, func(n ast.Node) bool {
+					if sel, ok := n.(*ast.SelectorExpr); ok && (sel.Sel.Name == "ORDERED" || sel.Sel.Name == "UNORDERED") {
+						m.Order = sel.Sel.Name
+						return false
+					}
+					return true
+				})
+			}
+		case *ast.CaseClause:
+			for _, expr := range n.List {
+				star, ok := expr.(*ast.StarExpr)
+				if !ok {
+					continue
+				}
+				sel, ok := star.X.(*ast.SelectorExpr)
+				if !ok || !strings.HasPrefix(sel.Sel.Name, prefix) || !strings.HasSuffix(sel.Sel.Name, "Packet") {
+					continue
+				}
+				packet := strings.TrimSuffix(strings.TrimPrefix(sel.Sel.Name, prefix), "Packet")
+				if packet != "" && !seen[packet] {
+					seen[packet] = true
+					m.Packets = append(m.Packets, packet)
+				}
+			}
+		}
+		return true
+	})
+	return nil
+}
+
+// TestPath returns the path of the test of module in the chain at appPath.
+func TestPath(appPath string, module Module) string {
+	return filepath.Join(appPath, txreplay.Dir, "ibc_"+module.Name.Snake+"_test.go")
+}
+
+// Write writes the tests of the IBC modules of the chain at appPath with the Go module modulePath
+// into its tests dir. the tests that exist are only overwritten with force, since their packets
+// are meant to be filled.
+func Write(appPath, modulePath string, force bool) ([]Test, error) {
+	modules, err := Modules(appPath, modulePath)
+	if err != nil {
+		return nil, err
+	}
+	if len(modules) == 0 {
+		return nil, ErrNoIBCModules
+	}
+
+	dir := filepath.Join(appPath, txreplay.Dir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	data := struct{ ModulePath string }{modulePath}
+	if err := execute(filepath.Join(dir, helpersFile), "ibc_test.go.tpl", data); err != nil {
+		return nil, err
+	}
+
+	var tests []Test
+	for _, m := range modules {
+		t := Test{Module: m, Path: TestPath(appPath, m)}
+		if _, err := os.Stat(t.Path); err == nil && !force {
+			t.Skipped = true
+		} else if err := execute(t.Path, "module_test.go.tpl", m); err != nil {
+			return nil, err
+		}
+		tests = append(tests, t)
+	}
+	return tests, nil
+}
+
+func execute(path, name string, data interface{}) error {
+	var buf bytes.Buffer
+	if err := templates.ExecuteTemplate(&buf, name, data); err != nil {
+		return err
+	}
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, code, 0644)
+}
@@ -0,0 +1,96 @@
+package ibctests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const moduleIBCGo = `package mars
+
+func (am AppModule) OnChanOpenInit(ctx sdk.Context, order channeltypes.Order) error {
+	if order != channeltypes.ORDERED {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s ", channeltypes.ORDERED, order)
+	}
+	return nil
+}
+
+func (am AppModule) OnRecvPacket(ctx sdk.Context, modulePacket channeltypes.Packet) ibcexported.Acknowledgement {
+	switch packet := modulePacketData.Packet.(type) {
+	case *types.MarsPacketData_BuyOrderPacket:
+	case *types.MarsPacketData_SellOrderPacket:
+	default:
+	}
+}
+
+func (am AppModule) OnTimeoutPacket(ctx sdk.Context, modulePacket channeltypes.Packet) (*sdk.Result, error) {
+	switch packet := modulePacketData.Packet.(type) {
+	case *types.MarsPacketData_BuyOrderPacket:
+	default:
+	}
+}
+`
+
+func writeModule(t *testing.T, appPath, name, moduleIBC string) {
+	path := filepath.Join(appPath, "x", name, moduleIBCFile)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(moduleIBC), 0644))
+}
+
+func TestModules(t *testing.T) {
+	appPath := t.TempDir()
+	writeModule(t, appPath, "mars", moduleIBCGo)
+	writeModule(t, appPath, "venus", "package venus\n")
+	require.NoError(t, os.MkdirAll(filepath.Join(appPath, "x", "earth"), 0755))
+
+	modules, err := Modules(appPath, "github.com/alice/mars")
+	require.NoError(t, err)
+	require.Len(t, modules, 2)
+
+	require.Equal(t, "mars", modules[0].Name.Original)
+	require.Equal(t, "ORDERED", modules[0].Order)
+	require.Equal(t, []string{"BuyOrder", "SellOrder"}, modules[0].Packets)
+
+	require.Equal(t, "venus", modules[1].Name.Original)
+	require.Equal(t, "UNORDERED", modules[1].Order)
+	require.Empty(t, modules[1].Packets)
+}
+
+func TestWrite(t *testing.T) {
+	appPath := t.TempDir()
+	_, err := Write(appPath, "github.com/alice/mars", false)
+	require.ErrorIs(t, err, ErrNoIBCModules)
+
+	writeModule(t, appPath, "mars", moduleIBCGo)
+	tests, err := Write(appPath, "github.com/alice/mars", false)
+	require.NoError(t, err)
+	require.Len(t, tests, 1)
+	require.False(t, tests[0].Skipped)
+
+	b, err := os.ReadFile(filepath.Join(appPath, "tests", helpersFile))
+	require.NoError(t, err)
+	require.Contains(t, string(b), `"github.com/alice/mars/app"`)
+
+	b, err = os.ReadFile(tests[0].Path)
+	require.NoError(t, err)
+	require.Contains(t, string(b), `"github.com/alice/mars/x/mars/types"`)
+	require.Contains(t, string(b), "func TestIBCMarsChannelHandshake(t *testing.T)")
+	require.Contains(t, string(b), "func TestIBCMarsBuyOrderPacketRoundTrip(t *testing.T)")
+	require.Contains(t, string(b), "func TestIBCMarsSellOrderPacketTimeout(t *testing.T)")
+	require.Contains(t, string(b), "channeltypes.ORDERED")
+
+	// the tests whose packets are filled are kept.
+	require.NoError(t, os.WriteFile(tests[0].Path, []byte("package tests\n"), 0644))
+	tests, err = Write(appPath, "github.com/alice/mars", false)
+	require.NoError(t, err)
+	require.True(t, tests[0].Skipped)
+	b, err = os.ReadFile(tests[0].Path)
+	require.NoError(t, err)
+	require.Equal(t, "package tests\n", string(b))
+
+	tests, err = Write(appPath, "github.com/alice/mars", true)
+	require.NoError(t, err)
+	require.False(t, tests[0].Skipped)
+}
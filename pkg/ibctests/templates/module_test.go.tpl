@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"<% .ModulePath %>/x/<% .Name.Original %>/types"
+)
+
+// TestIBC<% .Name.UpperCamel %>ChannelHandshake opens a channel between the <% .Name.Original %> modules of two chains.
+func TestIBC<% .Name.UpperCamel %>ChannelHandshake(t *testing.T) {
+	_, path := newIBCPath(t, types.PortID, types.Version, channeltypes.<% .Order %>)
+
+	require.Equal(t, channeltypes.OPEN, path.EndpointA.GetChannel().State)
+	require.Equal(t, channeltypes.OPEN, path.EndpointB.GetChannel().State)
+	require.Equal(t, types.Version, path.EndpointA.GetChannel().Version)
+}
+<%- range .Packets %>
+
+// TestIBC<% $.Name.UpperCamel %><% . %>PacketRoundTrip sends a <% . %> packet, receives it on the counterparty
+// chain and relays its acknowledgement back.
+func TestIBC<% $.Name.UpperCamel %><% . %>PacketRoundTrip(t *testing.T) {
+	_, path := newIBCPath(t, types.PortID, types.Version, channeltypes.<% $.Order %>)
+
+	// set the fields of the packet to test how they're received and acknowledged.
+	data := types.<% . %>PacketData{}
+	bytes, err := data.GetBytes()
+	require.NoError(t, err)
+
+	packet := channeltypes.NewPacket(
+		bytes,
+		1,
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		clienttypes.NewHeight(0, 100),
+		0,
+	)
+	require.NoError(t, path.EndpointA.SendPacket(packet))
+
+	ack := recvIBCPacket(t, path.EndpointB, packet)
+	require.NoError(t, path.EndpointA.AcknowledgePacket(packet, ack))
+	requireIBCPacketDone(t, path.EndpointA, packet)
+}
+
+// TestIBC<% $.Name.UpperCamel %><% . %>PacketTimeout sends a <% . %> packet that times out before it's
+// received on the counterparty chain.
+func TestIBC<% $.Name.UpperCamel %><% . %>PacketTimeout(t *testing.T) {
+	coordinator, path := newIBCPath(t, types.PortID, types.Version, channeltypes.<% $.Order %>)
+
+	data := types.<% . %>PacketData{}
+	bytes, err := data.GetBytes()
+	require.NoError(t, err)
+
+	timeoutHeight := clienttypes.NewHeight(0, uint64(path.EndpointB.Chain.GetContext().BlockHeight())+1)
+	packet := channeltypes.NewPacket(
+		bytes,
+		1,
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		timeoutHeight,
+		0,
+	)
+	require.NoError(t, path.EndpointA.SendPacket(packet))
+
+	coordinator.CommitNBlocks(path.EndpointB.Chain, 2)
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+	requireIBCPacketDone(t, path.EndpointA, packet)
+}
+<%- end %>
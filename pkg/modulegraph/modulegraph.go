@@ -0,0 +1,563 @@
+// Package modulegraph analyzes the app.go of a chain to find the dependencies between its keepers
+// and modules: the keepers that are passed to the constructors of keepers, to app modules and as
+// staking hooks. the graph is rendered in the DOT and Mermaid formats.
+package modulegraph
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/gomodule"
+)
+
+// AppDir is the dir of the app package of a chain.
+const AppDir = "app"
+
+// ErrNoKeepers is returned when no keepers are found in the app package.
+var ErrNoKeepers = errors.New("no keepers are found in the app package of the chain")
+
+// EdgeKind is how a keeper depends on another one.
+type EdgeKind string
+
+const (
+	// EdgeKeeper is a keeper that's passed to the constructor of a keeper.
+	EdgeKeeper EdgeKind = "keeper"
+
+	// EdgeModule is a keeper that's passed to the app module of a keeper.
+	EdgeModule EdgeKind = "module"
+
+	// EdgeHooks is a keeper whose hooks are set on a keeper, such as the staking hooks of distr.
+	EdgeHooks EdgeKind = "hooks"
+)
+
+// Node is a keeper of the app, named after its field in the app without the Keeper suffix.
+type Node struct {
+	Name string `json:"name"`
+
+	// Package is the package of the constructor of the keeper, if it's known.
+	Package string `json:"package,omitempty"`
+
+	// Local is true for the keepers of the modules of the chain.
+	Local bool `json:"local"`
+}
+
+// Edge is a dependency of the keeper From on the keeper To.
+type Edge struct {
+	From string   `json:"from"`
+	To   string   `json:"to"`
+	Kind EdgeKind `json:"kind"`
+
+	// Unused is true when the module of a local keeper expects To with an interface that has no
+	// methods, such as the dependencies that are scaffolded with --dep and never used.
+	Unused bool `json:"unused,omitempty"`
+}
+
+// Graph is the dependency graph of the keepers of an app.
+type Graph struct {
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+
+	// Cycles are the groups of keepers that depend on each other through their keepers and
+	// modules. hooks are not part of cycles since keepers depend on each other through them by design.
+	Cycles [][]string `json:"cycles"`
+}
+
+// Analyze analyzes the app package of the chain at appPath.
+func Analyze(appPath string) (Graph, error) {
+	gomod, err := gomodule.ParseAt(appPath)
+	if err != nil {
+		return Graph{}, err
+	}
+
+	fset := token.NewFileSet()
+	notTest := func(fi fs.FileInfo) bool { return !strings.HasSuffix(fi.Name(), "_test.go") }
+	pkgs, err := parser.ParseDir(fset, filepath.Join(appPath, AppDir), notTest, 0)
+	if err != nil {
+		return Graph{}, err
+	}
+
+	a := &analyzer{
+		nodes: map[string]*Node{},
+		edges: map[Edge]bool{},
+	}
+	for _, pkg := range pkgs {
+		var names []string
+		for name := range pkg.Files {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			f := pkg.Files[name]
+			imports := fileImports(f)
+			for _, decl := range f.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+					a.analyzeFunc(fn, imports)
+				}
+			}
+		}
+	}
+	if len(a.nodes) == 0 {
+		return Graph{}, ErrNoKeepers
+	}
+
+	g := a.graph(gomod.Module.Mod.Path)
+	if err := markUnused(&g, appPath, gomod.Module.Mod.Path); err != nil {
+		return Graph{}, err
+	}
+	g.Cycles = findCycles(g)
+	return g, nil
+}
+
+type analyzer struct {
+	nodes map[string]*Node
+	edges map[Edge]bool
+}
+
+func (a *analyzer) node(name string) *Node {
+	n, ok := a.nodes[name]
+	if !ok {
+		n = &Node{Name: name}
+		a.nodes[name] = n
+	}
+	return n
+}
+
+func (a *analyzer) edge(from, to string, kind EdgeKind) {
+	if from == to {
+		return
+	}
+	a.node(to)
+	a.edges[Edge{From: from, To: to, Kind: kind}] = true
+}
+
+// graph returns the graph of the analyzed keepers sorted by their names.
+func (a *analyzer) graph(modulePath string) Graph {
+	var g Graph
+	for _, n := range a.nodes {
+		n.Local = n.Package == modulePath || strings.HasPrefix(n.Package, modulePath+"/")
+		g.Nodes = append(g.Nodes, *n)
+	}
+	for e := range a.edges {
+		g.Edges = append(g.Edges, e)
+	}
+
+	sort.Slice(g.Nodes, func(i, j int) bool { return g.Nodes[i].Name < g.Nodes[j].Name })
+	sort.Slice(g.Edges, func(i, j int) bool {
+		ei, ej := g.Edges[i], g.Edges[j]
+		if ei.From != ej.From {
+			return ei.From < ej.From
+		}
+		if ei.To != ej.To {
+			return ei.To < ej.To
+		}
+		return ei.Kind < ej.Kind
+	})
+	return g
+}
+
+// analyzeFunc finds the keepers that are assigned to the fields of the app in fn, and the keepers
+// that they depend on.
+func (a *analyzer) analyzeFunc(fn *ast.FuncDecl, imports map[string]string) {
+	s := scope{
+		imports: imports,
+		locals:  map[string]ast.Expr{},
+		owners:  map[string]string{},
+	}
+
+	type assignment struct{ lhs, rhs ast.Expr }
+	var assignments []assignment
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		if as, ok := n.(*ast.AssignStmt); ok && len(as.Lhs) == len(as.Rhs) {
+			for i := range as.Lhs {
+				assignments = append(assignments, assignment{as.Lhs[i], as.Rhs[i]})
+			}
+		}
+		return true
+	})
+
+	// keepers are often created in local vars before they're assigned to the app, such as
+	// the staking keeper that its hooks are set on.
+	for _, as := range assignments {
+		if id, ok := as.lhs.(*ast.Ident); ok && isKeeperName(id.Name) {
+			if _, ok := s.locals[id.Name]; !ok {
+				s.locals[id.Name] = as.rhs
+			}
+		}
+	}
+	for _, as := range assignments {
+		if name, ok := s.appKeeper(as.lhs); ok {
+			if local, ok := s.rootLocal(as.rhs); ok {
+				s.owners[local] = name
+			}
+		}
+	}
+
+	for _, as := range assignments {
+		name, ok := s.appKeeper(as.lhs)
+		if !ok {
+			continue
+		}
+		n := a.node(name)
+
+		exprs := []ast.Expr{as.rhs}
+		if local, ok := s.rootLocal(as.rhs); ok && s.owners[local] == name {
+			exprs = append([]ast.Expr{s.locals[local]}, exprs...)
+		}
+		for _, expr := range exprs {
+			if pkg, ok := s.constructorPackage(expr); ok && n.Package == "" {
+				n.Package = pkg
+			}
+			s.dependencies(expr, false, func(dep string, hooks bool) {
+				kind := EdgeKeeper
+				if hooks {
+					kind = EdgeHooks
+				}
+				a.edge(name, dep, kind)
+			})
+		}
+	}
+
+	// the keepers that are passed to app modules, the module belongs to the keeper that's in its
+	// package, such as x/bank/keeper for x/bank. modules without keepers of their own, like
+	// genutil and vesting, are skipped.
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "NewAppModule" {
+			return true
+		}
+		pkg, ok := sel.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		modulePkg := imports[pkg.Name]
+
+		var keepers []string
+		for _, arg := range call.Args {
+			if name, ok := s.resolve(arg, 0); ok {
+				keepers = append(keepers, name)
+			}
+		}
+		if len(keepers) == 0 {
+			return true
+		}
+
+		var owner string
+		for _, name := range keepers {
+			if dir := path.Dir(a.node(name).Package); dir != "." && (modulePkg == dir || modulePkg == dir+"/module") {
+				owner = name
+				break
+			}
+		}
+		if owner == "" {
+			return true
+		}
+		for _, name := range keepers {
+			if !a.edges[Edge{From: owner, To: name, Kind: EdgeKeeper}] {
+				a.edge(owner, name, EdgeModule)
+			}
+		}
+		return true
+	})
+}
+
+// scope is the scope of a func of the app package.
+type scope struct {
+	// imports are the paths of the imports of the file of the func by their names.
+	imports map[string]string
+
+	// locals are the exprs that the local keeper vars are defined with, and owners are the keepers
+	// of the app that they're assigned to.
+	locals map[string]ast.Expr
+	owners map[string]string
+}
+
+// appKeeper returns the name of the keeper when expr is a keeper field of the app, such as
+// app.BankKeeper. the scoped capability keepers are not keepers of modules.
+func (s scope) appKeeper(expr ast.Expr) (name string, ok bool) {
+	sel, ok := unwrap(expr).(*ast.SelectorExpr)
+	if !ok {
+		return "", false
+	}
+	x, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return "", false
+	}
+	if _, isImport := s.imports[x.Name]; isImport {
+		return "", false
+	}
+	field := sel.Sel.Name
+	if !isKeeperName(field) || strings.HasPrefix(field, "Scoped") {
+		return "", false
+	}
+	return strings.TrimSuffix(field, "Keeper"), true
+}
+
+// resolve returns the name of the keeper that expr refers to, either as a field of the app,
+// derived from one, or as a local var that's assigned to the app.
+func (s scope) resolve(expr ast.Expr, depth int) (name string, ok bool) {
+	// locals defined with each other are not followed forever.
+	if depth > 8 {
+		return "", false
+	}
+	for {
+		if name, ok := s.appKeeper(expr); ok {
+			return name, true
+		}
+		switch e := expr.(type) {
+		case *ast.Ident:
+			if owner, ok := s.owners[e.Name]; ok {
+				return owner, true
+			}
+			if def, ok := s.locals[e.Name]; ok {
+				return s.resolve(def, depth+1)
+			}
+			return "", false
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.UnaryExpr:
+			expr = e.X
+		case *ast.ParenExpr:
+			expr = e.X
+		case *ast.CallExpr:
+			expr = e.Fun
+		case *ast.SelectorExpr:
+			expr = e.X
+		default:
+			return "", false
+		}
+	}
+}
+
+// rootLocal returns the local keeper var that expr is derived from, such as stakingKeeper for
+// *stakingKeeper.SetHooks(hooks).
+func (s scope) rootLocal(expr ast.Expr) (name string, ok bool) {
+	for {
+		switch e := expr.(type) {
+		case *ast.Ident:
+			_, ok := s.locals[e.Name]
+			return e.Name, ok
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.UnaryExpr:
+			expr = e.X
+		case *ast.ParenExpr:
+			expr = e.X
+		case *ast.CallExpr:
+			expr = e.Fun
+		case *ast.SelectorExpr:
+			if _, ok := s.appKeeper(e); ok {
+				return "", false
+			}
+			expr = e.X
+		default:
+			return "", false
+		}
+	}
+}
+
+// constructorPackage returns the package of the constructor that expr calls, such as the package
+// of bankkeeper for bankkeeper.NewBaseKeeper(...).
+func (s scope) constructorPackage(expr ast.Expr) (pkg string, ok bool) {
+	call, ok := unwrap(expr).(*ast.CallExpr)
+	if !ok {
+		return "", false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", false
+	}
+	x, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return "", false
+	}
+	pkg, ok = s.imports[x.Name]
+	return pkg, ok
+}
+
+// dependencies calls fn with the keepers that are referred to in expr, hooks is true for the
+// keepers whose hooks are set with SetHooks.
+func (s scope) dependencies(expr ast.Expr, hooks bool, fn func(name string, hooks bool)) {
+	ast.Inspect(expr, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			if sel, ok := n.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "SetHooks" && !hooks {
+				s.dependencies(sel.X, hooks, fn)
+				for _, arg := range n.Args {
+					s.dependencies(arg, true, fn)
+				}
+				return false
+			}
+		case *ast.SelectorExpr:
+			if name, ok := s.appKeeper(n); ok {
+				fn(name, hooks)
+				return false
+			}
+		case *ast.Ident:
+			if _, ok := s.locals[n.Name]; ok {
+				if name, ok := s.resolve(n, 0); ok {
+					fn(name, hooks)
+				}
+			}
+		}
+		return true
+	})
+}
+
+// unwrap returns expr without its dereferences, address operators and parens.
+func unwrap(expr ast.Expr) ast.Expr {
+	for {
+		switch e := expr.(type) {
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.UnaryExpr:
+			expr = e.X
+		case *ast.ParenExpr:
+			expr = e.X
+		default:
+			return expr
+		}
+	}
+}
+
+func isKeeperName(name string) bool {
+	return strings.HasSuffix(name, "Keeper") && name != "Keeper" && !strings.HasPrefix(name, "New")
+}
+
+// fileImports returns the paths of the imports of f by their names in f.
+func fileImports(f *ast.File) map[string]string {
+	imports := map[string]string{}
+	for _, imp := range f.Imports {
+		p := strings.Trim(imp.Path.Value, `"`)
+		name := path.Base(p)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports[name] = p
+	}
+	return imports
+}
+
+// markUnused marks the keepers that local keepers depend on but that their modules expect with
+// interfaces that have no methods, in their expected_keepers.go.
+func markUnused(g *Graph, appPath, modulePath string) error {
+	for _, n := range g.Nodes {
+		if !n.Local {
+			continue
+		}
+
+		// the types package is next to the keeper package in scaffolded modules.
+		dir := filepath.Join(appPath, filepath.FromSlash(strings.TrimPrefix(path.Dir(n.Package), modulePath)), "types")
+		empty, err := emptyInterfaces(dir)
+		if err != nil {
+			return err
+		}
+		for i, e := range g.Edges {
+			if e.From == n.Name && e.Kind == EdgeKeeper && empty[e.To+"Keeper"] {
+				g.Edges[i].Unused = true
+			}
+		}
+	}
+	return nil
+}
+
+// emptyInterfaces returns the names of the interfaces that have no methods in the package at dir.
+func emptyInterfaces(dir string) (map[string]bool, error) {
+	empty := map[string]bool{}
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, nil, 0)
+	if errors.Is(err, fs.ErrNotExist) {
+		return empty, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			ast.Inspect(f, func(n ast.Node) bool {
+				spec, ok := n.(*ast.TypeSpec)
+				if !ok {
+					return true
+				}
+				if iface, ok := spec.Type.(*ast.InterfaceType); ok && len(iface.Methods.List) == 0 {
+					empty[spec.Name.Name] = true
+				}
+				return false
+			})
+		}
+	}
+	return empty, nil
+}
+
+// findCycles returns the strongly connected components of the keepers through their keepers
+// and modules that have more than one keeper.
+func findCycles(g Graph) [][]string {
+	deps := map[string][]string{}
+	for _, e := range g.Edges {
+		if e.Kind != EdgeHooks {
+			deps[e.From] = append(deps[e.From], e.To)
+		}
+	}
+
+	var (
+		index   = map[string]int{}
+		lowlink = map[string]int{}
+		onStack = map[string]bool{}
+		stack   []string
+		cycles  [][]string
+		visit   func(name string)
+	)
+	visit = func(name string) {
+		index[name] = len(index)
+		lowlink[name] = index[name]
+		stack = append(stack, name)
+		onStack[name] = true
+
+		for _, dep := range deps[name] {
+			if _, ok := index[dep]; !ok {
+				visit(dep)
+				if lowlink[dep] < lowlink[name] {
+					lowlink[name] = lowlink[dep]
+				}
+			} else if onStack[dep] && index[dep] < lowlink[name] {
+				lowlink[name] = index[dep]
+			}
+		}
+
+		if lowlink[name] != index[name] {
+			return
+		}
+		var component []string
+		for {
+			last := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[last] = false
+			component = append(component, last)
+			if last == name {
+				break
+			}
+		}
+		if len(component) > 1 {
+			sort.Strings(component)
+			cycles = append(cycles, component)
+		}
+	}
+
+	for _, n := range g.Nodes {
+		if _, ok := index[n.Name]; !ok {
+			visit(n.Name)
+		}
+	}
+	sort.Slice(cycles, func(i, j int) bool { return cycles[i][0] < cycles[j][0] })
+	return cycles
+}
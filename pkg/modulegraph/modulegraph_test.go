@@ -0,0 +1,134 @@
+package modulegraph
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const appGo = `package app
+
+import (
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	marsmodule "github.com/alice/mars/x/mars"
+	marsmodulekeeper "github.com/alice/mars/x/mars/keeper"
+	venusmodulekeeper "github.com/alice/mars/x/venus/keeper"
+)
+
+func New() *App {
+	app := &App{}
+
+	scopedMarsKeeper := app.CapabilityKeeper.ScopeToModule("mars")
+	app.ScopedMarsKeeper = scopedMarsKeeper
+
+	app.BankKeeper = bankkeeper.NewBaseKeeper(appCodec, app.AccountKeeper)
+	stakingKeeper := stakingkeeper.NewKeeper(appCodec, app.AccountKeeper, app.BankKeeper)
+	app.DistrKeeper = distrkeeper.NewKeeper(appCodec, app.BankKeeper, &stakingKeeper)
+	app.StakingKeeper = *stakingKeeper.SetHooks(
+		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks()),
+	)
+
+	app.MarsKeeper = *marsmodulekeeper.NewKeeper(appCodec, scopedMarsKeeper, app.BankKeeper, app.StakingKeeper, app.VenusKeeper)
+	app.VenusKeeper = *venusmodulekeeper.NewKeeper(appCodec, app.MarsKeeper)
+
+	app.mm = module.NewManager(
+		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
+		marsmodule.NewAppModule(appCodec, app.MarsKeeper, app.AccountKeeper),
+	)
+	return app
+}
+`
+
+const expectedKeepersGo = `package types
+
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error
+}
+
+type StakingKeeper interface {
+	// Methods imported from staking should be defined here
+}
+`
+
+func writeApp(t *testing.T) string {
+	appPath := t.TempDir()
+	files := map[string]string{
+		"go.mod":                           "module github.com/alice/mars\n\ngo 1.16\n",
+		"app/app.go":                       appGo,
+		"x/mars/types/expected_keepers.go": expectedKeepersGo,
+	}
+	for name, content := range files {
+		path := filepath.Join(appPath, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	return appPath
+}
+
+func TestAnalyze(t *testing.T) {
+	g, err := Analyze(writeApp(t))
+	require.NoError(t, err)
+
+	require.Equal(t, []Node{
+		{Name: "Account"},
+		{Name: "Bank", Package: "github.com/cosmos/cosmos-sdk/x/bank/keeper"},
+		{Name: "Capability"},
+		{Name: "Distr", Package: "github.com/cosmos/cosmos-sdk/x/distribution/keeper"},
+		{Name: "Mars", Package: "github.com/alice/mars/x/mars/keeper", Local: true},
+		{Name: "Staking", Package: "github.com/cosmos/cosmos-sdk/x/staking/keeper"},
+		{Name: "Venus", Package: "github.com/alice/mars/x/venus/keeper", Local: true},
+	}, g.Nodes)
+
+	require.Equal(t, []Edge{
+		{From: "Bank", To: "Account", Kind: EdgeKeeper},
+		{From: "Distr", To: "Bank", Kind: EdgeKeeper},
+		{From: "Distr", To: "Staking", Kind: EdgeKeeper},
+		{From: "Mars", To: "Account", Kind: EdgeModule},
+		{From: "Mars", To: "Bank", Kind: EdgeKeeper},
+		{From: "Mars", To: "Capability", Kind: EdgeKeeper},
+		{From: "Mars", To: "Staking", Kind: EdgeKeeper, Unused: true},
+		{From: "Mars", To: "Venus", Kind: EdgeKeeper},
+		{From: "Staking", To: "Account", Kind: EdgeKeeper},
+		{From: "Staking", To: "Bank", Kind: EdgeKeeper},
+		{From: "Staking", To: "Distr", Kind: EdgeHooks},
+		{From: "Venus", To: "Mars", Kind: EdgeKeeper},
+	}, g.Edges)
+
+	require.Equal(t, [][]string{{"Mars", "Venus"}}, g.Cycles)
+}
+
+func TestAnalyzeNoKeepers(t *testing.T) {
+	appPath := writeApp(t)
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "app/app.go"), []byte("package app\n"), 0644))
+
+	_, err := Analyze(appPath)
+	require.ErrorIs(t, err, ErrNoKeepers)
+}
+
+func TestRender(t *testing.T) {
+	g, err := Analyze(writeApp(t))
+	require.NoError(t, err)
+
+	var dot strings.Builder
+	require.NoError(t, Render(&dot, g, FormatDOT))
+	require.Contains(t, dot.String(), `"Mars" [style=bold];`)
+	require.Contains(t, dot.String(), `"Mars" -> "Staking" [label="unused", style=dashed, color=red];`)
+	require.Contains(t, dot.String(), `"Staking" -> "Distr" [label="hooks", style=dotted];`)
+	require.Contains(t, dot.String(), `"Venus" -> "Mars" [color=red];`)
+
+	var mermaid strings.Builder
+	require.NoError(t, Render(&mermaid, g, FormatMermaid))
+	require.Contains(t, mermaid.String(), "Mars[Mars]:::local\n")
+	require.Contains(t, mermaid.String(), "Mars -->|module| Account\n")
+	require.Contains(t, mermaid.String(), "Mars -.->|unused| Staking\n")
+	require.Contains(t, mermaid.String(), "linkStyle 6,7,11 stroke:red\n")
+
+	require.Error(t, Render(&dot, g, "svg"))
+}
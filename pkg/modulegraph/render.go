@@ -0,0 +1,138 @@
+package modulegraph
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Format is a format that graphs are rendered in.
+type Format string
+
+const (
+	// FormatDOT is the DOT format of Graphviz.
+	FormatDOT Format = "dot"
+
+	// FormatMermaid is the format of Mermaid flowcharts.
+	FormatMermaid Format = "mermaid"
+)
+
+// Formats are the formats that graphs are rendered in.
+var Formats = []Format{FormatDOT, FormatMermaid}
+
+// Render writes g to w in format. local keepers are in bold, unused dependencies are dashed and
+// red, and the dependencies in cycles are red.
+func Render(w io.Writer, g Graph, format Format) error {
+	switch format {
+	case FormatDOT:
+		return renderDOT(w, g)
+	case FormatMermaid:
+		return renderMermaid(w, g)
+	default:
+		return fmt.Errorf("unknown graph format %q, the formats are %s", format, formatList())
+	}
+}
+
+func renderDOT(w io.Writer, g Graph) error {
+	var b strings.Builder
+	b.WriteString("digraph modules {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+	for _, n := range g.Nodes {
+		if n.Local {
+			fmt.Fprintf(&b, "  %q [style=bold];\n", n.Name)
+		} else {
+			fmt.Fprintf(&b, "  %q;\n", n.Name)
+		}
+	}
+
+	inCycle := cycleEdges(g)
+	for _, e := range g.Edges {
+		var attrs []string
+		if e.Kind != EdgeKeeper {
+			attrs = append(attrs, fmt.Sprintf("label=%q", e.Kind))
+		}
+		switch {
+		case e.Unused:
+			attrs = append(attrs, `label="unused"`, "style=dashed", "color=red")
+		case e.Kind == EdgeHooks:
+			attrs = append(attrs, "style=dotted")
+		case inCycle[e]:
+			attrs = append(attrs, "color=red")
+		}
+
+		fmt.Fprintf(&b, "  %q -> %q", e.From, e.To)
+		if len(attrs) > 0 {
+			fmt.Fprintf(&b, " [%s]", strings.Join(attrs, ", "))
+		}
+		b.WriteString(";\n")
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func renderMermaid(w io.Writer, g Graph) error {
+	var b strings.Builder
+	b.WriteString("graph LR\n")
+	for _, n := range g.Nodes {
+		fmt.Fprintf(&b, "  %s[%s]", n.Name, n.Name)
+		if n.Local {
+			b.WriteString(":::local")
+		}
+		b.WriteString("\n")
+	}
+
+	// links are styled by their index in the order they're defined.
+	var red []string
+	inCycle := cycleEdges(g)
+	for i, e := range g.Edges {
+		switch {
+		case e.Unused:
+			fmt.Fprintf(&b, "  %s -.->|unused| %s\n", e.From, e.To)
+			red = append(red, fmt.Sprint(i))
+		case e.Kind == EdgeHooks:
+			fmt.Fprintf(&b, "  %s -.->|hooks| %s\n", e.From, e.To)
+		case e.Kind == EdgeModule:
+			fmt.Fprintf(&b, "  %s -->|module| %s\n", e.From, e.To)
+		default:
+			fmt.Fprintf(&b, "  %s --> %s\n", e.From, e.To)
+		}
+		if inCycle[e] && !e.Unused {
+			red = append(red, fmt.Sprint(i))
+		}
+	}
+	b.WriteString("  classDef local font-weight:bold\n")
+	if len(red) > 0 {
+		fmt.Fprintf(&b, "  linkStyle %s stroke:red\n", strings.Join(red, ","))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// cycleEdges returns the edges between the keepers of the same cycle.
+func cycleEdges(g Graph) map[Edge]bool {
+	cycleOf := map[string]int{}
+	for i, cycle := range g.Cycles {
+		for _, name := range cycle {
+			cycleOf[name] = i + 1
+		}
+	}
+	edges := map[Edge]bool{}
+	for _, e := range g.Edges {
+		if e.Kind != EdgeHooks && cycleOf[e.From] != 0 && cycleOf[e.From] == cycleOf[e.To] {
+			edges[e] = true
+		}
+	}
+	return edges
+}
+
+func formatList() string {
+	var formats []string
+	for _, f := range Formats {
+		formats = append(formats, string(f))
+	}
+	return strings.Join(formats, ", ")
+}
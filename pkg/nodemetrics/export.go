@@ -0,0 +1,54 @@
+package nodemetrics
+
+import (
+	"embed"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+const (
+	// DashboardFile is the name of the Grafana dashboard that charts the metrics of a node.
+	DashboardFile = "grafana-dashboard.json"
+
+	// PrometheusConfigFile is the name of the Prometheus config that scrapes the metrics of a node.
+	PrometheusConfigFile = "prometheus.yml"
+)
+
+//go:embed templates/*.tpl
+var templatesFS embed.FS
+
+// the Grafana dashboard has placeholders in braces, so other delims are used.
+var templates = template.Must(
+	template.New("").
+		Delims("<%", "%>").
+		ParseFS(templatesFS, "templates/*.tpl"),
+)
+
+// Export writes the Grafana dashboard and the Prometheus config that chart the metrics in
+// namespace of the node served at target, the host and port of its Prometheus instrumentation,
+// into dir.
+func Export(dir, target, namespace string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	data := struct {
+		Target, Namespace string
+	}{target, namespace}
+
+	for _, name := range []string{DashboardFile, PrometheusConfigFile} {
+		f, err := os.Create(filepath.Join(dir, name))
+		if err != nil {
+			return err
+		}
+		err = templates.ExecuteTemplate(f, name+".tpl", data)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
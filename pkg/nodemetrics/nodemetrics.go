@@ -0,0 +1,137 @@
+// Package nodemetrics reads the Prometheus metrics of a Tendermint node to summarize the activity
+// of its chain, and exports a Grafana dashboard and a Prometheus config to chart them.
+package nodemetrics
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultNamespace is the namespace of the metrics of Tendermint nodes, set by
+// instrumentation.namespace in their config.toml.
+const DefaultNamespace = "tendermint"
+
+// Sample is the value of the metrics of a node that chain activity is summarized from, at Time.
+// the values of the metrics of a node are reset when it's restarted.
+type Sample struct {
+	Time time.Time
+
+	// BlockIntervalSum and BlockIntervalCount are the sum and the count of the intervals between
+	// the blocks that the node committed.
+	BlockIntervalSum   float64
+	BlockIntervalCount float64
+
+	// MempoolSize is the number of txs in the mempool.
+	MempoolSize float64
+
+	// TotalTxs is the number of txs that the node committed.
+	TotalTxs float64
+}
+
+// Scrape reads the metrics of the node served at url, the /metrics endpoint of its Prometheus
+// instrumentation.
+func Scrape(ctx context.Context, url, namespace string) (Sample, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return Sample{}, err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return Sample{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return Sample{}, fmt.Errorf("cannot read the metrics of the node: %s", res.Status)
+	}
+
+	s, err := Parse(res.Body, namespace)
+	s.Time = time.Now()
+	return s, err
+}
+
+// Parse reads the metrics in namespace from r, in the Prometheus text format. the values of a
+// metric with several sets of labels are summed.
+func Parse(r io.Reader, namespace string) (Sample, error) {
+	metrics := map[string]*float64{}
+	var s Sample
+	metrics[namespace+"_consensus_block_interval_seconds_sum"] = &s.BlockIntervalSum
+	metrics[namespace+"_consensus_block_interval_seconds_count"] = &s.BlockIntervalCount
+	metrics[namespace+"_mempool_size"] = &s.MempoolSize
+	metrics[namespace+"_consensus_total_txs"] = &s.TotalTxs
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		name := line
+		if i := strings.IndexAny(line, "{ "); i >= 0 {
+			name = line[:i]
+		}
+		value, ok := metrics[name]
+		if !ok {
+			continue
+		}
+
+		// the value follows the labels, it may be followed by a timestamp.
+		fields := strings.Fields(line[strings.LastIndex(line, "}")+1:])
+		if len(fields) == 0 {
+			return Sample{}, fmt.Errorf("metric %s has no value", name)
+		}
+		v, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return Sample{}, fmt.Errorf("metric %s has an invalid value: %w", name, err)
+		}
+		*value += v
+	}
+	return s, scanner.Err()
+}
+
+// Summary is the activity of a chain between two samples of the metrics of its node.
+type Summary struct {
+	// Blocks is the number of committed blocks and BlockTime is their average interval.
+	Blocks    int
+	BlockTime time.Duration
+
+	// MempoolSize is the number of txs in the mempool at the end.
+	MempoolSize int
+
+	// Throughput is the number of committed txs per second.
+	Throughput float64
+}
+
+// Summarize summarizes the activity of a chain between the samples prev and cur, false is
+// returned when the node is restarted in between.
+func Summarize(prev, cur Sample) (Summary, bool) {
+	elapsed := cur.Time.Sub(prev.Time).Seconds()
+	if elapsed <= 0 || cur.BlockIntervalCount < prev.BlockIntervalCount || cur.TotalTxs < prev.TotalTxs {
+		return Summary{}, false
+	}
+
+	s := Summary{
+		Blocks:      int(cur.BlockIntervalCount - prev.BlockIntervalCount),
+		MempoolSize: int(cur.MempoolSize),
+		Throughput:  (cur.TotalTxs - prev.TotalTxs) / elapsed,
+	}
+	if s.Blocks > 0 {
+		interval := (cur.BlockIntervalSum - prev.BlockIntervalSum) / float64(s.Blocks)
+		s.BlockTime = time.Duration(interval * float64(time.Second)).Round(time.Millisecond)
+	}
+	return s, true
+}
+
+func (s Summary) String() string {
+	blockTime := "no blocks"
+	if s.Blocks > 0 {
+		blockTime = fmt.Sprintf("block time %s", s.BlockTime)
+	}
+	return fmt.Sprintf("%s, mempool %d txs, %.2f tx/s", blockTime, s.MempoolSize, s.Throughput)
+}
@@ -0,0 +1,73 @@
+package nodemetrics
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const metrics = `# HELP tendermint_consensus_block_interval_seconds Time between this and the last block.
+# TYPE tendermint_consensus_block_interval_seconds histogram
+tendermint_consensus_block_interval_seconds_bucket{chain_id="mars",le="+Inf"} 20
+tendermint_consensus_block_interval_seconds_sum{chain_id="mars"} 21
+tendermint_consensus_block_interval_seconds_count{chain_id="mars"} 20
+# TYPE tendermint_consensus_total_txs gauge
+tendermint_consensus_total_txs{chain_id="mars"} 12
+tendermint_mempool_size{chain_id="mars"} 3 1637000000000
+tendermint_mempool_size_bytes{chain_id="mars"} 300
+mars_mempool_size{chain_id="mars"} 7
+`
+
+func TestParse(t *testing.T) {
+	s, err := Parse(strings.NewReader(metrics), DefaultNamespace)
+	require.NoError(t, err)
+	require.Equal(t, Sample{
+		BlockIntervalSum:   21,
+		BlockIntervalCount: 20,
+		MempoolSize:        3,
+		TotalTxs:           12,
+	}, s)
+
+	_, err = Parse(strings.NewReader(`tendermint_mempool_size{chain_id="mars"} x`), DefaultNamespace)
+	require.Error(t, err)
+}
+
+func TestSummarize(t *testing.T) {
+	now := time.Now()
+	prev := Sample{Time: now, BlockIntervalSum: 21, BlockIntervalCount: 20, TotalTxs: 12}
+	cur := Sample{Time: now.Add(10 * time.Second), BlockIntervalSum: 31.5, BlockIntervalCount: 30, MempoolSize: 2, TotalTxs: 17}
+
+	s, ok := Summarize(prev, cur)
+	require.True(t, ok)
+	require.Equal(t, Summary{Blocks: 10, BlockTime: 1050 * time.Millisecond, MempoolSize: 2, Throughput: 0.5}, s)
+	require.Equal(t, "block time 1.05s, mempool 2 txs, 0.50 tx/s", s.String())
+
+	s, ok = Summarize(prev, Sample{Time: cur.Time, BlockIntervalSum: 21, BlockIntervalCount: 20, TotalTxs: 12})
+	require.True(t, ok)
+	require.Equal(t, "no blocks, mempool 0 txs, 0.00 tx/s", s.String())
+
+	// the node is restarted.
+	_, ok = Summarize(cur, Sample{Time: cur.Time.Add(time.Second), BlockIntervalCount: 1})
+	require.False(t, ok)
+}
+
+func TestExport(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "metrics")
+	require.NoError(t, Export(dir, "localhost:26660", "mars"))
+
+	b, err := os.ReadFile(filepath.Join(dir, DashboardFile))
+	require.NoError(t, err)
+	var dashboard map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &dashboard))
+	require.Contains(t, string(b), `"expr": "mars_mempool_size"`)
+	require.Contains(t, string(b), `"legendFormat": "{{chain_id}}"`)
+
+	b, err = os.ReadFile(filepath.Join(dir, PrometheusConfigFile))
+	require.NoError(t, err)
+	require.Contains(t, string(b), `- targets: ["localhost:26660"]`)
+}
@@ -0,0 +1,9 @@
+# scrapes the metrics of the node of the chain served by starport chain serve --metrics,
+# run it with: prometheus --config.file=prometheus.yml
+global:
+  scrape_interval: 5s
+
+scrape_configs:
+  - job_name: tendermint
+    static_configs:
+      - targets: ["<% .Target %>"]
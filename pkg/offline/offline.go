@@ -0,0 +1,109 @@
+// Package offline runs Starport and the Go commands that it runs without network access, from
+// the Go modules that are cached beforehand, such as with `starport cache warm`.
+package offline
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/exec"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+	"golang.org/x/mod/semver"
+)
+
+// EnvOffline is the env var that turns offline mode on.
+const EnvOffline = "STARPORT_OFFLINE"
+
+// pseudoVersion matches the pseudo versions of modules, such as v0.0.0-20210101000000-abcdefabcdef.
+var pseudoVersion = regexp.MustCompile(`[-.]\d{14}-[0-9a-f]{12}(\+incompatible)?$`)
+
+// IsEnabled checks if offline mode is on.
+func IsEnabled() bool {
+	offline, _ := strconv.ParseBool(os.Getenv(EnvOffline))
+	return offline
+}
+
+// Enable turns offline mode on for Starport and the commands that it runs, Go modules are
+// resolved from the download cache of modCache instead of the module proxy.
+func Enable(modCache string) error {
+	for key, value := range map[string]string{
+		EnvOffline: "1",
+		"GOPROXY":  proxyURL(modCache),
+
+		// the checksums of the cached modules were verified when they were downloaded.
+		"GOSUMDB": "off",
+	} {
+		if err := os.Setenv(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ModCache returns the module cache of Go.
+func ModCache(ctx context.Context) (string, error) {
+	var out bytes.Buffer
+	if err := exec.Exec(ctx, []string{"go", "env", "GOMODCACHE"}, exec.StepOption(step.Stdout(&out))); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out.String()), nil
+}
+
+// IndexModCache writes the version lists of the modules of the download cache of modCache, so
+// the cache can be used as the module proxy. the versions are the released versions whose
+// source is cached, so the latest ones are the ones that were downloaded.
+func IndexModCache(modCache string) error {
+	root := downloadDir(modCache)
+
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() || info.Name() != "@v" {
+			return nil
+		}
+
+		zips, err := filepath.Glob(filepath.Join(path, "*.zip"))
+		if err != nil {
+			return err
+		}
+
+		var versions []string
+		for _, zip := range zips {
+			version := strings.TrimSuffix(filepath.Base(zip), ".zip")
+			if semver.IsValid(version) && !pseudoVersion.MatchString(version) {
+				versions = append(versions, version)
+			}
+		}
+		if len(versions) == 0 {
+			return filepath.SkipDir
+		}
+		sort.Slice(versions, func(i, j int) bool { return semver.Compare(versions[i], versions[j]) < 0 })
+
+		list := strings.Join(versions, "\n") + "\n"
+		if err := os.WriteFile(filepath.Join(path, "list"), []byte(list), 0644); err != nil {
+			return err
+		}
+		return filepath.SkipDir
+	})
+}
+
+func downloadDir(modCache string) string {
+	return filepath.Join(modCache, "cache", "download")
+}
+
+// proxyURL returns the url of the download cache of modCache as a module proxy.
+func proxyURL(modCache string) string {
+	path := filepath.ToSlash(downloadDir(modCache))
+	if !strings.HasPrefix(path, "/") {
+		// paths with volume names such as C:/ on Windows.
+		path = "/" + path
+	}
+	return "file://" + path
+}
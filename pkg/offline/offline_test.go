@@ -0,0 +1,43 @@
+package offline
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexModCache(t *testing.T) {
+	modCache := t.TempDir()
+	dir := filepath.Join(downloadDir(modCache), "github.com", "spf13", "cobra", "@v")
+	require.NoError(t, os.MkdirAll(dir, 0755))
+
+	for _, file := range []string{
+		"v1.2.1.zip", "v1.2.1.mod",
+		"v1.10.0.zip", "v1.10.0.mod",
+		// versions without source and pseudo versions are not listed.
+		"v1.11.0.mod",
+		"v0.0.0-20210101000000-abcdefabcdef.zip",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, file), nil, 0644))
+	}
+
+	require.NoError(t, IndexModCache(modCache))
+
+	list, err := os.ReadFile(filepath.Join(dir, "list"))
+	require.NoError(t, err)
+	require.Equal(t, "v1.2.1\nv1.10.0\n", string(list))
+}
+
+func TestEnable(t *testing.T) {
+	t.Setenv(EnvOffline, "")
+	t.Setenv("GOPROXY", "")
+	t.Setenv("GOSUMDB", "")
+	require.False(t, IsEnabled())
+
+	require.NoError(t, Enable("/home/alice/go/pkg/mod"))
+	require.True(t, IsEnabled())
+	require.Equal(t, "file:///home/alice/go/pkg/mod/cache/download", os.Getenv("GOPROXY"))
+	require.Equal(t, "off", os.Getenv("GOSUMDB"))
+}
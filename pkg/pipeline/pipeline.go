@@ -0,0 +1,140 @@
+// Package pipeline runs the stages of an operation, such as building a chain, by their
+// dependency graph. stages run as soon as the stages that they depend on are done, so the ones
+// that are independent run concurrently.
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Stage is a stage of a pipeline.
+type Stage struct {
+	// Name is the unique name of the stage.
+	Name string
+
+	// After are the names of the stages that must be done before the stage runs.
+	After []string
+
+	// Run runs the stage.
+	Run func(ctx context.Context) error
+}
+
+// Timing is the time spent in a stage.
+type Timing struct {
+	// Stage is the name of the stage.
+	Stage string
+
+	// Start is when the stage started, since the start of the pipeline.
+	Start time.Duration
+
+	// Duration is how long the stage ran.
+	Duration time.Duration
+}
+
+// Run runs stages by their dependency graph and returns the timings of the stages that ran,
+// ordered by their start. once a stage fails, the stages that are running are canceled through
+// their ctx and the stages that didn't start are not run.
+func Run(ctx context.Context, stages ...Stage) ([]Timing, error) {
+	if err := validate(stages); err != nil {
+		return nil, err
+	}
+
+	var (
+		start   = time.Now()
+		done    = make(map[string]chan struct{}, len(stages))
+		timings []Timing
+		mu      sync.Mutex
+	)
+	for _, s := range stages {
+		done[s.Name] = make(chan struct{})
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	for _, s := range stages {
+		s := s
+		g.Go(func() error {
+			for _, name := range s.After {
+				select {
+				case <-done[name]:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+
+			stageStart := time.Now()
+			if err := s.Run(ctx); err != nil {
+				return err
+			}
+
+			mu.Lock()
+			timings = append(timings, Timing{
+				Stage:    s.Name,
+				Start:    stageStart.Sub(start),
+				Duration: time.Since(stageStart),
+			})
+			mu.Unlock()
+
+			close(done[s.Name])
+			return nil
+		})
+	}
+	err := g.Wait()
+
+	sort.Slice(timings, func(i, j int) bool { return timings[i].Start < timings[j].Start })
+	return timings, err
+}
+
+// validate checks that the names of stages are unique, that the stages that they depend on
+// exist and that they don't depend on each other in a cycle.
+func validate(stages []Stage) error {
+	after := make(map[string][]string, len(stages))
+	for _, s := range stages {
+		if _, ok := after[s.Name]; ok {
+			return fmt.Errorf("stage %q is defined more than once", s.Name)
+		}
+		after[s.Name] = s.After
+	}
+	for _, s := range stages {
+		for _, name := range s.After {
+			if _, ok := after[name]; !ok {
+				return fmt.Errorf("stage %q runs after %q that doesn't exist", s.Name, name)
+			}
+		}
+	}
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int, len(stages))
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("stage %q depends on itself", name)
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		for _, dep := range after[name] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		return nil
+	}
+	for _, s := range stages {
+		if err := visit(s.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		order []string
+	)
+	stage := func(name string, d time.Duration, after ...string) Stage {
+		return Stage{
+			Name:  name,
+			After: after,
+			Run: func(context.Context) error {
+				time.Sleep(d)
+				mu.Lock()
+				order = append(order, name)
+				mu.Unlock()
+				return nil
+			},
+		}
+	}
+
+	timings, err := Run(context.Background(),
+		stage("compile", 0, "dependencies"),
+		stage("clients", time.Millisecond*100, "proto"),
+		stage("dependencies", time.Millisecond*20, "proto"),
+		stage("proto", time.Millisecond*20),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{"proto", "dependencies", "compile", "clients"}, order)
+
+	require.Len(t, timings, 4)
+	require.Equal(t, "proto", timings[0].Stage)
+	for _, timing := range timings[1:] {
+		require.GreaterOrEqual(t, timing.Start, timings[0].Duration)
+	}
+}
+
+func TestRunError(t *testing.T) {
+	errBuild := errors.New("build")
+	var compiled, canceled bool
+
+	timings, err := Run(context.Background(),
+		Stage{Name: "proto", Run: func(context.Context) error { return nil }},
+		Stage{Name: "clients", After: []string{"proto"}, Run: func(ctx context.Context) error {
+			<-ctx.Done()
+			canceled = true
+			return ctx.Err()
+		}},
+		Stage{Name: "dependencies", After: []string{"proto"}, Run: func(context.Context) error { return errBuild }},
+		Stage{Name: "compile", After: []string{"dependencies"}, Run: func(context.Context) error {
+			compiled = true
+			return nil
+		}},
+	)
+	require.ErrorIs(t, err, errBuild)
+	require.True(t, canceled)
+	require.False(t, compiled)
+	require.Len(t, timings, 1)
+}
+
+func TestRunInvalid(t *testing.T) {
+	run := func(context.Context) error { return nil }
+
+	for _, stages := range [][]Stage{
+		{{Name: "a", Run: run}, {Name: "a", Run: run}},
+		{{Name: "a", After: []string{"b"}, Run: run}},
+		{{Name: "a", After: []string{"b"}, Run: run}, {Name: "b", After: []string{"a"}, Run: run}},
+	} {
+		_, err := Run(context.Background(), stages...)
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,226 @@
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/rpc"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	conf "github.com/trino-network/trino/chainconf"
+	"github.com/trino-network/trino/pkg/scaffoldhook"
+	"github.com/trino-network/trino/pkg/servehook"
+)
+
+const (
+	// handshakeTimeout is the time that plugins have to start serving their commands.
+	handshakeTimeout = 10 * time.Second
+
+	// closeTimeout is the time that plugins have to exit once Starport is done with them.
+	closeTimeout = 5 * time.Second
+)
+
+// Client is a plugin that's started by Starport.
+type Client struct {
+	cmd     *exec.Cmd
+	stdin   io.WriteCloser
+	address string
+	token   string
+	host    net.Listener
+	output  chan struct{}
+}
+
+// Start starts the plugin that's run by cmd and serves host to it. what the plugin prints is
+// written to the stdout and the stderr of cmd, os.Stdout and os.Stderr by default.
+// the plugin must be closed with Close.
+func Start(cmd *exec.Cmd, host Host) (*Client, error) {
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	go serveRPC(l, token, func(context.Context) (*rpc.Server, error) {
+		s := rpc.NewServer()
+		return s, s.RegisterName("Host", &hostServer{host})
+	})
+
+	c, err := start(cmd, l, token)
+	if err != nil {
+		l.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+func start(cmd *exec.Cmd, host net.Listener, token string) (*Client, error) {
+	stdout := cmd.Stdout
+	if stdout == nil {
+		stdout = os.Stdout
+	}
+	cmd.Stdout = nil
+	if cmd.Stderr == nil {
+		cmd.Stderr = os.Stderr
+	}
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+	cmd.Env = append(cmd.Env,
+		MagicCookieKey+"="+MagicCookieValue,
+		EnvHostAddress+"="+host.Addr().String(),
+		EnvToken+"="+token,
+	)
+
+	pluginStdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	c := &Client{
+		cmd:    cmd,
+		stdin:  stdin,
+		token:  token,
+		host:   host,
+		output: make(chan struct{}),
+	}
+
+	r := bufio.NewReader(pluginStdout)
+	c.address, err = handshake(r)
+	if err != nil {
+		cmd.Process.Kill()
+		cmd.Wait()
+		return nil, fmt.Errorf("%s: %w", cmd.Path, err)
+	}
+
+	go func() {
+		io.Copy(stdout, r)
+		close(c.output)
+	}()
+
+	return c, nil
+}
+
+// handshake reads the handshake of a plugin from its stdout, it returns the address that the
+// plugin serves its commands at.
+func handshake(r *bufio.Reader) (address string, err error) {
+	type result struct {
+		line string
+		err  error
+	}
+	read := make(chan result, 1)
+	go func() {
+		line, err := r.ReadString('\n')
+		read <- result{line, err}
+	}()
+
+	var line string
+	select {
+	case res := <-read:
+		if res.err != nil {
+			return "", ErrNotPlugin
+		}
+		line = strings.TrimSpace(res.line)
+	case <-time.After(handshakeTimeout):
+		return "", fmt.Errorf("%w: no handshake in %s", ErrNotPlugin, handshakeTimeout)
+	}
+
+	parts := strings.Split(line, "|")
+	if len(parts) != 3 || parts[1] != "tcp" {
+		return "", ErrNotPlugin
+	}
+	version, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return "", ErrNotPlugin
+	}
+	if version != ProtocolVersion {
+		return "", fmt.Errorf("plugin speaks version %d of the protocol of plugins, Starport speaks %d", version, ProtocolVersion)
+	}
+	return parts[2], nil
+}
+
+// Commands returns the commands of the plugin.
+func (c *Client) Commands() ([]Command, error) {
+	var commands []Command
+	err := call(context.Background(), c.address, c.token, "Plugin.Commands", struct{}{}, &commands)
+	return commands, err
+}
+
+// Execute runs a command of the plugin, the context of the command is canceled with ctx.
+func (c *Client) Execute(ctx context.Context, cmd ExecutedCommand) error {
+	return call(ctx, c.address, c.token, "Plugin.Execute", cmd, &struct{}{})
+}
+
+// ScaffoldHook runs the scaffold hook of the plugin on event, the context of the hook is
+// canceled with ctx.
+func (c *Client) ScaffoldHook(ctx context.Context, event scaffoldhook.Event) error {
+	return call(ctx, c.address, c.token, "Plugin.ScaffoldHook", event, &struct{}{})
+}
+
+// ServeHook runs the serve hook of the plugin on event, the context of the hook is canceled
+// with ctx.
+func (c *Client) ServeHook(ctx context.Context, event servehook.Event) error {
+	return call(ctx, c.address, c.token, "Plugin.ServeHook", event, &struct{}{})
+}
+
+// Close stops the plugin, it's killed when it doesn't exit in time.
+func (c *Client) Close() error {
+	c.stdin.Close()
+	defer c.host.Close()
+
+	select {
+	case <-c.output:
+	case <-time.After(closeTimeout):
+		return c.kill()
+	}
+	return c.cmd.Wait()
+}
+
+func (c *Client) kill() error {
+	c.stdin.Close()
+	if err := c.cmd.Process.Kill(); err != nil {
+		return err
+	}
+	<-c.output
+	c.cmd.Wait()
+	return nil
+}
+
+// hostServer serves the Host API to plugins over RPC.
+type hostServer struct {
+	host Host
+}
+
+// ChainConfig serves the config.yml of a chain.
+func (s *hostServer) ChainConfig(appPath string, config *conf.Config) (err error) {
+	*config, err = s.host.ChainConfig(appPath)
+	return err
+}
+
+// ScaffoldModule scaffolds a module.
+func (s *hostServer) ScaffoldModule(req ScaffoldModuleRequest, sm *SourceModification) (err error) {
+	*sm, err = s.host.ScaffoldModule(req)
+	return err
+}
+
+// ScaffoldType scaffolds a type.
+func (s *hostServer) ScaffoldType(req ScaffoldTypeRequest, sm *SourceModification) (err error) {
+	*sm, err = s.host.ScaffoldType(req)
+	return err
+}
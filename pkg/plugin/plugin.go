@@ -0,0 +1,171 @@
+// Package plugin adds commands of third parties to Starport with external plugins.
+//
+// A plugin is an executable that's started by Starport when one of its commands is run, such
+// as "starport mycompany deploy" for the commands of the mycompany plugin. Starport and the
+// plugin talk over JSON-RPC on the loopback interface: the plugin serves its commands, and
+// Starport serves the Host API that gives the plugin access to the config of chains and the
+// scaffolder. the calls of both are authenticated with a random token of the session.
+//
+// Plugins are written in Go by implementing Plugin and calling Serve in their main:
+//
+//	func main() {
+//		plugin.Serve(deployer{})
+//	}
+package plugin
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	conf "github.com/trino-network/trino/chainconf"
+	"github.com/trino-network/trino/pkg/scaffoldhook"
+	"github.com/trino-network/trino/pkg/servehook"
+)
+
+// ProtocolVersion is the version of the protocol between Starport and plugins, plugins that
+// speak another version are refused.
+const ProtocolVersion = 2
+
+const (
+	// MagicCookieKey and MagicCookieValue are set in the env of plugins so a plugin can tell
+	// that it's started by Starport, they aren't a security measure.
+	MagicCookieKey   = "STARPORT_PLUGIN_MAGIC_COOKIE"
+	MagicCookieValue = "b7c1e7a3f9d04e9cb4c6f0f6c1b5e2f8"
+
+	// EnvHostAddress is the env var of the address that Starport serves the Host API at.
+	EnvHostAddress = "STARPORT_PLUGIN_HOST"
+
+	// EnvToken is the env var of the random token of the session between Starport and the
+	// plugin, every call between them is authenticated with it.
+	EnvToken = "STARPORT_PLUGIN_TOKEN"
+)
+
+// Home is the dir of the global config of plugins and of the plugins that are built by Starport.
+var Home = os.ExpandEnv("$HOME/.starport/plugins")
+
+// ErrNotPlugin is returned when an executable doesn't speak the protocol of plugins.
+var ErrNotPlugin = errors.New("not a plugin of Starport")
+
+// Plugin is implemented by plugins to serve their commands.
+type Plugin interface {
+	// Commands returns the commands of the plugin, they are added under the name of the plugin.
+	Commands() ([]Command, error)
+
+	// Execute runs the command of the plugin, it can call Starport with host.
+	Execute(ctx context.Context, cmd ExecutedCommand, host Host) error
+}
+
+// ScaffoldHooker is implemented by plugins that are notified of the scaffold events in the hooks
+// of their config.
+type ScaffoldHooker interface {
+	// ScaffoldHook is run on the scaffold event, a failing pre hook cancels scaffolding.
+	ScaffoldHook(ctx context.Context, event scaffoldhook.Event, host Host) error
+}
+
+// ServeHooker is implemented by plugins that are notified of the serve events in the serve hooks
+// of their config.
+type ServeHooker interface {
+	// ServeHook is run on the serve event, a failing hook doesn't stop serving.
+	ServeHook(ctx context.Context, event servehook.Event, host Host) error
+}
+
+// Host is the API that Starport serves to plugins.
+type Host interface {
+	// ChainConfig returns the config.yml of the chain at appPath.
+	ChainConfig(appPath string) (conf.Config, error)
+
+	// ScaffoldModule scaffolds a module in the chain.
+	ScaffoldModule(req ScaffoldModuleRequest) (SourceModification, error)
+
+	// ScaffoldType scaffolds a type in a module of the chain.
+	ScaffoldType(req ScaffoldTypeRequest) (SourceModification, error)
+}
+
+// FlagType is the type of the value of a flag.
+type FlagType string
+
+const (
+	FlagTypeString FlagType = "string"
+	FlagTypeBool   FlagType = "bool"
+	FlagTypeInt    FlagType = "int"
+)
+
+// Command is a command of a plugin, commands with sub commands only group them.
+type Command struct {
+	Use      string    `json:"use"`
+	Aliases  []string  `json:"aliases,omitempty"`
+	Short    string    `json:"short"`
+	Long     string    `json:"long,omitempty"`
+	Flags    []Flag    `json:"flags,omitempty"`
+	Commands []Command `json:"commands,omitempty"`
+}
+
+// Flag is a flag of a command of a plugin.
+type Flag struct {
+	Name      string   `json:"name"`
+	Shorthand string   `json:"shorthand,omitempty"`
+	Usage     string   `json:"usage"`
+	Default   string   `json:"default,omitempty"`
+	Type      FlagType `json:"type,omitempty"`
+}
+
+// ExecutedCommand is a command of a plugin that's run by the user.
+type ExecutedCommand struct {
+	// Path is the path of the command under the plugin, such as ["deploy"] for
+	// "starport mycompany deploy".
+	Path []string `json:"path"`
+
+	Args []string `json:"args"`
+
+	// Flags are the values of the flags of the command, keyed by their names.
+	Flags map[string]string `json:"flags"`
+
+	// With are the params of the plugin that are set in its config.
+	With map[string]string `json:"with"`
+}
+
+// ScaffoldModuleRequest is the request to scaffold a module.
+type ScaffoldModuleRequest struct {
+	AppPath string `json:"app_path"`
+	Name    string `json:"name"`
+	IBC     bool   `json:"ibc"`
+
+	// IBCOrdering is the ordering of the channels of IBC modules: none, ordered or unordered.
+	IBCOrdering string `json:"ibc_ordering"`
+
+	// Dependencies are the modules that the module depends on in the <module>[:<keeper>] format.
+	Dependencies []string `json:"dependencies"`
+}
+
+// Type kinds that are scaffolded by ScaffoldType.
+const (
+	TypeKindList   = "list"
+	TypeKindMap    = "map"
+	TypeKindSingle = "single"
+	TypeKindType   = "type"
+)
+
+// ScaffoldTypeRequest is the request to scaffold a type.
+type ScaffoldTypeRequest struct {
+	AppPath string `json:"app_path"`
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+
+	// Fields are the fields of the type in the <name>[:<type>] format.
+	Fields []string `json:"fields"`
+
+	// Indexes are the indexes of map types.
+	Indexes []string `json:"indexes"`
+
+	// Module is the module to scaffold the type in, the app's main module by default.
+	Module    string `json:"module"`
+	NoMessage bool   `json:"no_message"`
+	Signer    string `json:"signer"`
+}
+
+// SourceModification lists the files that are created and modified by the scaffolder.
+type SourceModification struct {
+	Created  []string `json:"created"`
+	Modified []string `json:"modified"`
+}
@@ -0,0 +1,156 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/rpc/jsonrpc"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	conf "github.com/trino-network/trino/chainconf"
+	"github.com/trino-network/trino/pkg/scaffoldhook"
+	"github.com/trino-network/trino/pkg/servehook"
+)
+
+// TestMain serves testPlugin when the test binary is started as a plugin.
+func TestMain(m *testing.M) {
+	if os.Getenv(MagicCookieKey) == MagicCookieValue {
+		Serve(testPlugin{})
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+type testPlugin struct{}
+
+func (testPlugin) Commands() ([]Command, error) {
+	return []Command{
+		{
+			Use:   "deploy [app-path]",
+			Short: "Deploy the chain",
+			Flags: []Flag{{Name: "env", Usage: "Environment", Default: "staging"}},
+		},
+	}, nil
+}
+
+func (testPlugin) Execute(_ context.Context, cmd ExecutedCommand, host Host) error {
+	if cmd.Path[0] != "deploy" {
+		return errors.New("unknown command")
+	}
+
+	config, err := host.ChainConfig(cmd.Args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Printf("deploying %s to %s of %s\n", config.Validator.Name, cmd.Flags["env"], cmd.With["team"])
+	return nil
+}
+
+func (testPlugin) ScaffoldHook(_ context.Context, event scaffoldhook.Event, _ Host) error {
+	if event.Stage == scaffoldhook.StagePre && event.Name == "forbidden" {
+		return errors.New("forbidden name")
+	}
+	fmt.Printf("%s %s\n", event.Type(), event.Name)
+	return nil
+}
+
+func (testPlugin) ServeHook(_ context.Context, event servehook.Event, _ Host) error {
+	fmt.Printf("%s %s\n", event.Type, event.ChainID)
+	return nil
+}
+
+type testHost struct{}
+
+func (testHost) ChainConfig(appPath string) (conf.Config, error) {
+	if appPath != "mars" {
+		return conf.Config{}, errors.New("no config.yml")
+	}
+	return conf.Config{Validator: conf.Validator{Name: "alice"}}, nil
+}
+
+func (testHost) ScaffoldModule(ScaffoldModuleRequest) (SourceModification, error) {
+	return SourceModification{}, nil
+}
+
+func (testHost) ScaffoldType(ScaffoldTypeRequest) (SourceModification, error) {
+	return SourceModification{}, nil
+}
+
+func TestPlugin(t *testing.T) {
+	var stdout bytes.Buffer
+	cmd := exec.Command(os.Args[0])
+	cmd.Stdout = &stdout
+
+	c, err := Start(cmd, testHost{})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	commands, err := c.Commands()
+	require.NoError(t, err)
+	require.Len(t, commands, 1)
+	require.Equal(t, "deploy [app-path]", commands[0].Use)
+	require.Equal(t, "staging", commands[0].Flags[0].Default)
+
+	require.NoError(t, c.Execute(ctx, ExecutedCommand{
+		Path:  []string{"deploy"},
+		Args:  []string{"mars"},
+		Flags: map[string]string{"env": "production"},
+		With:  map[string]string{"team": "ops"},
+	}))
+
+	err = c.Execute(ctx, ExecutedCommand{Path: []string{"deploy"}, Args: []string{"venus"}})
+	require.EqualError(t, err, "no config.yml")
+
+	require.NoError(t, c.ScaffoldHook(ctx, scaffoldhook.Event{Stage: scaffoldhook.StagePost, Target: scaffoldhook.TargetModule, Name: "blog"}))
+	err = c.ScaffoldHook(ctx, scaffoldhook.Event{Stage: scaffoldhook.StagePre, Target: scaffoldhook.TargetType, Name: "forbidden"})
+	require.EqualError(t, err, "forbidden name")
+
+	require.NoError(t, c.ServeHook(ctx, servehook.Event{Type: servehook.EventChainStarted, ChainID: "mars"}))
+
+	require.NoError(t, c.Close())
+	require.Equal(t, "deploying alice to production of ops\npost-module blog\nchain-started mars\n", stdout.String())
+}
+
+func TestPluginUnauthenticated(t *testing.T) {
+	c, err := Start(exec.Command(os.Args[0]), testHost{})
+	require.NoError(t, err)
+	defer c.Close()
+
+	// the plugin and the Host API of Starport refuse the calls of other processes.
+	for address, method := range map[string]string{
+		c.address:              "Plugin.Commands",
+		c.host.Addr().String(): "Host.ChainConfig",
+	} {
+		rc, err := jsonrpc.Dial("tcp", address)
+		require.NoError(t, err)
+		err = rc.Call(method, "mars", &json.RawMessage{})
+		rc.Close()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), ErrUnauthenticated.Error())
+	}
+
+	err = call(context.Background(), c.address, "wrong", "Plugin.Commands", struct{}{}, &[]Command{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), ErrUnauthenticated.Error())
+}
+
+func TestPluginCanceled(t *testing.T) {
+	c, err := Start(exec.Command(os.Args[0]), testHost{})
+	require.NoError(t, err)
+	defer c.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = c.Execute(ctx, ExecutedCommand{Path: []string{"deploy"}, Args: []string{"mars"}})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestStartNotPlugin(t *testing.T) {
+	_, err := Start(exec.Command("true"), testHost{})
+	require.ErrorIs(t, err, ErrNotPlugin)
+}
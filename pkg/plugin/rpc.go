@@ -0,0 +1,116 @@
+package plugin
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+)
+
+// ErrUnauthenticated is returned by the calls that don't have the token of the session.
+var ErrUnauthenticated = errors.New("the call is not authenticated with the token of the session")
+
+// authenticatedRequest is the params of a call with the token of the session that Starport
+// passes to the plugin in its env, so the other processes of the machine can't call them.
+type authenticatedRequest struct {
+	Token  string          `json:"token"`
+	Params json.RawMessage `json:"params"`
+}
+
+// newToken returns a random token for a session between Starport and a plugin.
+func newToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// tokenClientCodec sends the token with every call.
+type tokenClientCodec struct {
+	rpc.ClientCodec
+	token string
+}
+
+func (c tokenClientCodec) WriteRequest(r *rpc.Request, params interface{}) error {
+	b, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return c.ClientCodec.WriteRequest(r, authenticatedRequest{Token: c.token, Params: b})
+}
+
+// tokenServerCodec refuses the calls without the token. closed is called once the connection is
+// closed by the client.
+type tokenServerCodec struct {
+	rpc.ServerCodec
+	token  string
+	closed func()
+}
+
+func (c tokenServerCodec) ReadRequestHeader(r *rpc.Request) error {
+	err := c.ServerCodec.ReadRequestHeader(r)
+	if err != nil && c.closed != nil {
+		c.closed()
+	}
+	return err
+}
+
+func (c tokenServerCodec) ReadRequestBody(body interface{}) error {
+	// the params of the calls without the token don't decode as an authenticated request.
+	var req authenticatedRequest
+	if err := c.ServerCodec.ReadRequestBody(&req); err != nil {
+		return ErrUnauthenticated
+	}
+	if subtle.ConstantTimeCompare([]byte(req.Token), []byte(c.token)) != 1 {
+		return ErrUnauthenticated
+	}
+	if body == nil {
+		return nil
+	}
+	return json.Unmarshal(req.Params, body)
+}
+
+// serveRPC serves the connections of l with the server that's returned by newServer for each of
+// them, its context is canceled once the connection is closed.
+func serveRPC(l net.Listener, token string, newServer func(ctx context.Context) (*rpc.Server, error)) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		s, err := newServer(ctx)
+		if err != nil {
+			cancel()
+			conn.Close()
+			continue
+		}
+		go s.ServeCodec(tokenServerCodec{jsonrpc.NewServerCodec(conn), token, cancel})
+	}
+}
+
+// call calls method at address with the token. the connection of the call is closed when ctx is
+// canceled, which cancels the context of the call on the other side.
+func call(ctx context.Context, address, token, method string, args, reply interface{}) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	c := rpc.NewClientWithCodec(tokenClientCodec{jsonrpc.NewClientCodec(conn), token})
+	defer c.Close()
+
+	select {
+	case done := <-c.Go(method, args, reply, make(chan *rpc.Call, 1)).Done:
+		return done.Error
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,136 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/rpc"
+	"os"
+
+	conf "github.com/trino-network/trino/chainconf"
+	"github.com/trino-network/trino/pkg/scaffoldhook"
+	"github.com/trino-network/trino/pkg/servehook"
+)
+
+// Serve serves the commands of p to Starport, it's called in the main of plugins and it returns
+// when Starport is done with the plugin. stdin of the plugin is held by Starport so the commands
+// of plugins can't read from it.
+func Serve(p Plugin) {
+	if err := serve(p, os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func serve(p Plugin, stdin io.Reader, stdout io.Writer) error {
+	if os.Getenv(MagicCookieKey) != MagicCookieValue {
+		return fmt.Errorf("this executable is a plugin of Starport, add it to the plugins of a config.yml to use it")
+	}
+	token := os.Getenv(EnvToken)
+	if token == "" {
+		return fmt.Errorf("%s is not set, this version of Starport is too old for the plugin", EnvToken)
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+
+	host := &hostConn{address: os.Getenv(EnvHostAddress), token: token}
+	go serveRPC(l, token, func(ctx context.Context) (*rpc.Server, error) {
+		s := rpc.NewServer()
+		return s, s.RegisterName("Plugin", &pluginServer{ctx: ctx, plugin: p, host: host})
+	})
+
+	if _, err := fmt.Fprintf(stdout, "%d|tcp|%s\n", ProtocolVersion, l.Addr()); err != nil {
+		return err
+	}
+
+	// Starport closes the stdin of the plugin when it's done with it.
+	_, err = io.Copy(io.Discard, stdin)
+	return err
+}
+
+// pluginServer serves a plugin over RPC, on a connection of a call of Starport. ctx is canceled
+// when Starport cancels the call.
+type pluginServer struct {
+	ctx    context.Context
+	plugin Plugin
+	host   *hostConn
+}
+
+// Commands serves the commands of the plugin.
+func (s *pluginServer) Commands(_ struct{}, commands *[]Command) (err error) {
+	*commands, err = s.plugin.Commands()
+	return err
+}
+
+// Execute runs a command of the plugin.
+func (s *pluginServer) Execute(cmd ExecutedCommand, _ *struct{}) error {
+	return s.plugin.Execute(s.ctx, cmd, s.host.client(s.ctx))
+}
+
+// ScaffoldHook runs the scaffold hook of the plugin.
+func (s *pluginServer) ScaffoldHook(event scaffoldhook.Event, _ *struct{}) error {
+	hooker, ok := s.plugin.(ScaffoldHooker)
+	if !ok {
+		return errors.New("plugin doesn't handle scaffold hooks")
+	}
+	return hooker.ScaffoldHook(s.ctx, event, s.host.client(s.ctx))
+}
+
+// ServeHook runs the serve hook of the plugin.
+func (s *pluginServer) ServeHook(event servehook.Event, _ *struct{}) error {
+	hooker, ok := s.plugin.(ServeHooker)
+	if !ok {
+		return errors.New("plugin doesn't handle serve hooks")
+	}
+	return hooker.ServeHook(s.ctx, event, s.host.client(s.ctx))
+}
+
+// hostConn is where the Host API of Starport is served.
+type hostConn struct {
+	address string
+	token   string
+}
+
+// client returns the Host API whose calls are canceled with ctx.
+func (h *hostConn) client(ctx context.Context) *hostClient {
+	return &hostClient{ctx, h}
+}
+
+// hostClient is the Host API of Starport that's called by plugins over RPC.
+type hostClient struct {
+	ctx  context.Context
+	conn *hostConn
+}
+
+func (h *hostClient) call(method string, args, reply interface{}) error {
+	err := call(h.ctx, h.conn.address, h.conn.token, method, args, reply)
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return fmt.Errorf("cannot connect to Starport: %w", err)
+	}
+	return err
+}
+
+func (h *hostClient) ChainConfig(appPath string) (conf.Config, error) {
+	var config conf.Config
+	err := h.call("Host.ChainConfig", appPath, &config)
+	return config, err
+}
+
+func (h *hostClient) ScaffoldModule(req ScaffoldModuleRequest) (SourceModification, error) {
+	var sm SourceModification
+	err := h.call("Host.ScaffoldModule", req, &sm)
+	return sm, err
+}
+
+func (h *hostClient) ScaffoldType(req ScaffoldTypeRequest) (SourceModification, error) {
+	var sm SourceModification
+	err := h.call("Host.ScaffoldType", req, &sm)
+	return sm, err
+}
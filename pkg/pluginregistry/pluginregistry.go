@@ -0,0 +1,372 @@
+// Package pluginregistry is a client for git-based registries of plugins and template packs.
+//
+// A registry is a git repository with an index.yml at its root that lists plugins and template
+// packs of chains:
+//
+//	plugins:
+//	  - name: mycompany
+//	    description: Deploy chains to the infrastructure of mycompany
+//	    repository: https://github.com/mycompany/starport-plugin
+//	    path: cmd/plugin
+//	templates:
+//	  - name: mycompany-chain
+//	    description: Chain with the modules and the CI of mycompany
+//	    repository: https://github.com/mycompany/chain-template
+//
+// The versions of a plugin are the semver tags of its repository, the commits of the versions
+// are pinned along with them.
+package pluginregistry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/goccy/go-yaml"
+)
+
+// DefaultURL is the git repository of the default registry.
+const DefaultURL = "https://github.com/trino-network/plugins"
+
+// IndexFile is the index of a registry at the root of its repository.
+const IndexFile = "index.yml"
+
+var (
+	// ErrNotFound is returned when a plugin or a version is not in the registry.
+	ErrNotFound = errors.New("not found")
+
+	// ErrCommitMismatch is returned when the version of a plugin is not at its pinned commit.
+	ErrCommitMismatch = errors.New("commit mismatch")
+)
+
+// Plugin is a plugin in the index of a registry.
+type Plugin struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+
+	// Repository is the git repository of the source of the plugin.
+	Repository string `yaml:"repository"`
+
+	// Path is the dir of the Go main package of the plugin in its repository, its root by default.
+	Path string `yaml:"path"`
+}
+
+// Template is a template pack of chains in the index of a registry.
+type Template struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+
+	// Repository is the git repository of the template pack.
+	Repository string `yaml:"repository"`
+}
+
+type index struct {
+	Plugins   []Plugin   `yaml:"plugins"`
+	Templates []Template `yaml:"templates"`
+}
+
+// Registry is a git-based registry of plugins, its index and the sources of its plugins are
+// cloned to a dir in home.
+type Registry struct {
+	url  string
+	path string
+}
+
+var nonAlphanumeric = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// New creates a registry for the git repository at url, that's cloned to a dir in home.
+func New(url, home string) Registry {
+	name := strings.Trim(nonAlphanumeric.ReplaceAllString(url, "-"), "-")
+	return Registry{
+		url:  url,
+		path: filepath.Join(home, "registries", name),
+	}
+}
+
+// URL returns the url of the registry.
+func (r Registry) URL() string {
+	return r.url
+}
+
+func (r Registry) indexPath() string {
+	return filepath.Join(r.path, "index")
+}
+
+// Update clones the index of the registry or pulls its latest changes.
+func (r Registry) Update(ctx context.Context) error {
+	repo, err := git.PlainOpen(r.indexPath())
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		_, err = git.PlainCloneContext(ctx, r.indexPath(), false, &git.CloneOptions{URL: r.url, Depth: 1})
+		if err != nil {
+			os.RemoveAll(r.indexPath())
+			return fmt.Errorf("cannot clone the registry %s: %w", r.url, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+	err = wt.PullContext(ctx, &git.PullOptions{Force: true})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return fmt.Errorf("cannot update the registry %s: %w", r.url, err)
+	}
+	return nil
+}
+
+// index returns the index of the registry, it's cloned when it's not yet.
+func (r Registry) index(ctx context.Context) (index, error) {
+	b, err := os.ReadFile(filepath.Join(r.indexPath(), IndexFile))
+	if os.IsNotExist(err) {
+		if err := r.Update(ctx); err != nil {
+			return index{}, err
+		}
+		b, err = os.ReadFile(filepath.Join(r.indexPath(), IndexFile))
+	}
+	if err != nil {
+		return index{}, err
+	}
+
+	var idx index
+	if err := yaml.Unmarshal(b, &idx); err != nil {
+		return index{}, fmt.Errorf("invalid index of the registry %s: %w", r.url, err)
+	}
+	return idx, nil
+}
+
+// Plugins returns the plugins in the index of the registry.
+func (r Registry) Plugins(ctx context.Context) ([]Plugin, error) {
+	idx, err := r.index(ctx)
+	return idx.Plugins, err
+}
+
+// Plugin returns the plugin with name.
+func (r Registry) Plugin(ctx context.Context, name string) (Plugin, error) {
+	plugins, err := r.Plugins(ctx)
+	if err != nil {
+		return Plugin{}, err
+	}
+
+	for _, p := range plugins {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return Plugin{}, fmt.Errorf("plugin %s is %w in the registry %s", name, ErrNotFound, r.url)
+}
+
+// Templates returns the template packs in the index of the registry.
+func (r Registry) Templates(ctx context.Context) ([]Template, error) {
+	idx, err := r.index(ctx)
+	return idx.Templates, err
+}
+
+// Template returns the template pack with name.
+func (r Registry) Template(ctx context.Context, name string) (Template, error) {
+	templates, err := r.Templates(ctx)
+	if err != nil {
+		return Template{}, err
+	}
+
+	for _, t := range templates {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+	return Template{}, fmt.Errorf("template pack %s is %w in the registry %s", name, ErrNotFound, r.url)
+}
+
+// Search returns the plugins whose names or descriptions contain query, all of them when query
+// is empty.
+func Search(plugins []Plugin, query string) []Plugin {
+	query = strings.ToLower(query)
+
+	var found []Plugin
+	for _, p := range plugins {
+		if strings.Contains(strings.ToLower(p.Name), query) || strings.Contains(strings.ToLower(p.Description), query) {
+			found = append(found, p)
+		}
+	}
+	return found
+}
+
+// tags returns the tags of the repository of p.
+func tags(p Plugin) ([]string, error) {
+	remote := git.NewRemote(memory.NewStorage(), &config.RemoteConfig{
+		Name: git.DefaultRemoteName,
+		URLs: []string{p.Repository},
+	})
+	refs, err := remote.List(&git.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot list the versions of plugin %s: %w", p.Name, err)
+	}
+
+	var tags []string
+	for _, ref := range refs {
+		if ref.Name().IsTag() {
+			tags = append(tags, ref.Name().Short())
+		}
+	}
+	return tags, nil
+}
+
+// Versions returns the versions of p from the oldest to the latest.
+func Versions(ctx context.Context, p Plugin) ([]string, error) {
+	repoTags, err := tags(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions semver.Versions
+	tags := make(map[string]string)
+	for _, tag := range repoTags {
+		v, err := semver.ParseTolerant(tag)
+		if err != nil {
+			continue
+		}
+		if _, ok := tags[v.String()]; !ok {
+			versions = append(versions, v)
+		}
+		tags[v.String()] = tag
+	}
+	sort.Sort(versions)
+
+	var sorted []string
+	for _, v := range versions {
+		sorted = append(sorted, tags[v.String()])
+	}
+	return sorted, nil
+}
+
+// LatestVersion returns the latest version of p.
+func LatestVersion(ctx context.Context, p Plugin) (string, error) {
+	versions, err := Versions(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	if len(versions) == 0 {
+		return "", fmt.Errorf("versions of plugin %s are %w", p.Name, ErrNotFound)
+	}
+	return versions[len(versions)-1], nil
+}
+
+// Fetch clones the source of p at version unless it's already cloned, it returns the dir of the
+// main package of the plugin and the commit of the version. the source is verified to be at
+// commit when it's not empty, since the tags of a repository can be moved.
+func (r Registry) Fetch(ctx context.Context, p Plugin, version, commit string) (mainPath, head string, err error) {
+	// the name and the version are a dir of the registry, they cannot lead out of it.
+	if !IsValidRefName(p.Name) {
+		return "", "", fmt.Errorf("name %q of plugin is invalid", p.Name)
+	}
+	if !IsValidRefName(version) {
+		return "", "", fmt.Errorf("version %q of plugin %s is invalid", version, p.Name)
+	}
+	if filepath.IsAbs(p.Path) || isOutside(filepath.Clean(p.Path)) {
+		return "", "", fmt.Errorf("path %s of plugin %s must be in its repository", p.Path, p.Name)
+	}
+
+	path := filepath.Join(r.path, "src", fmt.Sprintf("%s@%s", p.Name, version))
+	mainPath = filepath.Join(path, p.Path)
+
+	if _, err := os.Stat(path); err != nil {
+		// the source is cloned next to its dir so a failed clone doesn't leave a partial source.
+		tmp := path + ".tmp"
+		os.RemoveAll(tmp)
+		_, err := git.PlainCloneContext(ctx, tmp, false, &git.CloneOptions{
+			URL:           p.Repository,
+			ReferenceName: plumbing.NewTagReferenceName(version),
+			SingleBranch:  true,
+			Depth:         1,
+		})
+		if err != nil {
+			os.RemoveAll(tmp)
+			if repoTags, terr := tags(p); terr == nil && !contains(repoTags, version) {
+				return "", "", fmt.Errorf("version %s of plugin %s is %w", version, p.Name, ErrNotFound)
+			}
+			return "", "", fmt.Errorf("cannot clone plugin %s: %w", p.Name, err)
+		}
+		if err := os.Rename(tmp, path); err != nil {
+			return "", "", err
+		}
+	}
+
+	if head, err = headCommit(path); err != nil {
+		return "", "", err
+	}
+	if commit != "" && head != commit {
+		// the source is fetched again once the pinned commit is fixed.
+		os.RemoveAll(path)
+		return "", "", fmt.Errorf("version %s of plugin %s is at commit %s instead of %s: %w", version, p.Name, head, commit, ErrCommitMismatch)
+	}
+
+	// the main package may be a link of the repository.
+	if resolved, err := filepath.EvalSymlinks(mainPath); err == nil {
+		root, err := filepath.EvalSymlinks(path)
+		if err != nil {
+			return "", "", err
+		}
+		if rel, err := filepath.Rel(root, resolved); err != nil || isOutside(rel) {
+			return "", "", fmt.Errorf("path %s of plugin %s must be in its repository", p.Path, p.Name)
+		}
+	}
+
+	return mainPath, head, nil
+}
+
+// headCommit returns the SHA of the commit of the git repository at path.
+func headCommit(path string) (string, error) {
+	repo, err := git.PlainOpen(path)
+	if err != nil {
+		return "", err
+	}
+	ref, err := repo.Head()
+	if err != nil {
+		return "", err
+	}
+	return ref.Hash().String(), nil
+}
+
+// IsValidRefName checks if name is a valid single component of a git ref name, such as a tag,
+// so it cannot contain path separators or lead to a parent dir either.
+func IsValidRefName(name string) bool {
+	if name == "" || name == "@" ||
+		strings.HasPrefix(name, ".") || strings.HasPrefix(name, "-") ||
+		strings.HasSuffix(name, ".") || strings.HasSuffix(name, ".lock") ||
+		strings.Contains(name, "..") || strings.Contains(name, "@{") {
+		return false
+	}
+	for _, c := range name {
+		if c < 0x20 || c == 0x7f || strings.ContainsRune(" ~^:?*[/\\", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// isOutside checks if the clean relative path rel is outside of its base dir.
+func isOutside(rel string) bool {
+	return rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
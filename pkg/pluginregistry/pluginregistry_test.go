@@ -0,0 +1,137 @@
+package pluginregistry
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+)
+
+// newRepo creates a git repository with a commit of files for each tag.
+func newRepo(t *testing.T, commits ...map[string]string) string {
+	path := t.TempDir()
+	repo, err := git.PlainInit(path, false)
+	require.NoError(t, err)
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+
+	for _, files := range commits {
+		for name, content := range files {
+			if name == "tag" {
+				continue
+			}
+			require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(path, name)), 0755))
+			require.NoError(t, os.WriteFile(filepath.Join(path, name), []byte(content), 0644))
+			_, err := wt.Add(name)
+			require.NoError(t, err)
+		}
+
+		hash, err := wt.Commit("commit", &git.CommitOptions{
+			Author: &object.Signature{Name: "test", Email: "test@test", When: time.Now()},
+		})
+		require.NoError(t, err)
+
+		if tag, ok := files["tag"]; ok {
+			_, err := repo.CreateTag(tag, hash, nil)
+			require.NoError(t, err)
+		}
+	}
+
+	return path
+}
+
+func TestRegistry(t *testing.T) {
+	ctx := context.Background()
+
+	pluginRepo := newRepo(t,
+		map[string]string{"cmd/plugin/main.go": "// v0.1.0", "tag": "v0.1.0"},
+		map[string]string{"cmd/plugin/main.go": "// v0.10.0", "tag": "v0.10.0"},
+		map[string]string{"cmd/plugin/main.go": "// v0.2.0", "tag": "v0.2.0"},
+		map[string]string{"cmd/plugin/main.go": "// unreleased"},
+	)
+	registryRepo := newRepo(t, map[string]string{IndexFile: `
+plugins:
+  - name: deployer
+    description: Deploy chains to the cloud
+    repository: ` + pluginRepo + `
+    path: cmd/plugin
+  - name: explorer
+    description: Explore chains
+    repository: https://example.com/explorer
+templates:
+  - name: mycompany-chain
+    repository: https://example.com/chain-template
+`})
+
+	r := New(registryRepo, t.TempDir())
+
+	plugins, err := r.Plugins(ctx)
+	require.NoError(t, err)
+	require.Len(t, plugins, 2)
+	require.Equal(t, []Plugin{plugins[0]}, Search(plugins, "CLOUD"))
+	require.Len(t, Search(plugins, ""), 2)
+
+	_, err = r.Plugin(ctx, "indexer")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	template, err := r.Template(ctx, "mycompany-chain")
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/chain-template", template.Repository)
+	_, err = r.Template(ctx, "deployer")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	p, err := r.Plugin(ctx, "deployer")
+	require.NoError(t, err)
+
+	versions, err := Versions(ctx, p)
+	require.NoError(t, err)
+	require.Equal(t, []string{"v0.1.0", "v0.2.0", "v0.10.0"}, versions)
+
+	path, commit, err := r.Fetch(ctx, p, "v0.2.0", "")
+	require.NoError(t, err)
+	main, err := os.ReadFile(filepath.Join(path, "main.go"))
+	require.NoError(t, err)
+	require.Equal(t, "// v0.2.0", string(main))
+
+	// the fetched source is verified against the pinned commit.
+	_, pinned, err := r.Fetch(ctx, p, "v0.2.0", commit)
+	require.NoError(t, err)
+	require.Equal(t, commit, pinned)
+	_, _, err = r.Fetch(ctx, p, "v0.2.0", strings.Repeat("0", 40))
+	require.ErrorIs(t, err, ErrCommitMismatch)
+	_, _, err = r.Fetch(ctx, p, "v0.2.0", commit)
+	require.NoError(t, err)
+
+	_, _, err = r.Fetch(ctx, p, "v0.3.0", "")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// the name and the version cannot lead out of the dir of the sources.
+	_, _, err = r.Fetch(ctx, p, "../../registries", "")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "is invalid")
+	_, _, err = r.Fetch(ctx, Plugin{Name: "../deployer", Repository: pluginRepo}, "v0.2.0", "")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "is invalid")
+
+	p.Path = "../../registries"
+	_, _, err = r.Fetch(ctx, p, "v0.2.0", "")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "must be in its repository")
+
+	require.NoError(t, r.Update(ctx))
+}
+
+func TestIsValidRefName(t *testing.T) {
+	for _, name := range []string{"deployer", "my-company_plugin", "v0.2.0", "v1.0.0-rc.1+build.5"} {
+		require.Truef(t, IsValidRefName(name), "%s is valid", name)
+	}
+	for _, name := range []string{"", ".", "..", "../x", "x/..", "a/b", `a\b`, "v1..2", ".hidden", "-x", "x.lock", "x.", "a b", "a@{1}", "@"} {
+		require.Falsef(t, IsValidRefName(name), "%s is invalid", name)
+	}
+}
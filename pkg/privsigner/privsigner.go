@@ -0,0 +1,195 @@
+// Package privsigner is a remote signer for the consensus key of a validator, like tmkms with its
+// soft signing backend. the key is kept in a keyring instead of in the home of the node and the
+// signer serves it over the privval socket that the node listens on, so the topology of validators
+// that sign with an HSM or tmkms can be rehearsed locally.
+package privsigner
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"net"
+	"os"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdked25519 "github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/privval"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+const (
+	// KeyName is the name of the consensus key in the keyring.
+	KeyName = "consensus"
+
+	// RetryInterval is how long the signer waits before it connects to the node again.
+	RetryInterval = 500 * time.Millisecond
+
+	// timeoutReadWrite is how long the signer waits for the requests of the node, the node pings the
+	// signer more often than that.
+	timeoutReadWrite = 5 * time.Second
+)
+
+// ErrNotImported is returned when the consensus key is not imported into the keyring.
+var ErrNotImported = errors.New("the consensus key is not imported into the keyring of the signer")
+
+// IsImported checks if the consensus key is imported into the keyring of r.
+func IsImported(r cosmosaccount.Registry) (bool, error) {
+	_, err := r.GetByName(KeyName)
+	var accErr *cosmosaccount.AccountDoesNotExistError
+	if errors.As(err, &accErr) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Import imports the consensus key of the priv_validator_key.json at keyFile into the keyring of r
+// and removes keyFile, so the key is only kept by the signer.
+func Import(r cosmosaccount.Registry, keyFile string) error {
+	b, err := os.ReadFile(keyFile)
+	if err != nil {
+		return err
+	}
+	var key privval.FilePVKey
+	if err := tmjson.Unmarshal(b, &key); err != nil {
+		return err
+	}
+	privKey, ok := key.PrivKey.(ed25519.PrivKey)
+	if !ok {
+		return errors.New("only ed25519 consensus keys can be imported")
+	}
+
+	armored := crypto.EncryptArmorPrivKey(&sdked25519.PrivKey{Key: []byte(privKey)}, "", string(hd.Ed25519Type))
+	if _, err := r.Import(KeyName, armored, ""); err != nil {
+		return err
+	}
+	return os.Remove(keyFile)
+}
+
+// Export writes the consensus key in the keyring of r back into the priv_validator_key.json at
+// keyFile and deletes it from the keyring, so the node signs with it again.
+func Export(r cosmosaccount.Registry, keyFile string) error {
+	privKey, err := privKey(r)
+	if err != nil {
+		return err
+	}
+	b, err := tmjson.MarshalIndent(privval.FilePVKey{
+		Address: privKey.PubKey().Address(),
+		PubKey:  privKey.PubKey(),
+		PrivKey: privKey,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(keyFile, b, 0600); err != nil {
+		return err
+	}
+	return r.DeleteByName(KeyName)
+}
+
+func privKey(r cosmosaccount.Registry) (ed25519.PrivKey, error) {
+	ok, err := IsImported(r)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNotImported
+	}
+	key, err := keyring.NewUnsafe(r.Keyring).UnsafeExportPrivKeyHex(KeyName)
+	if err != nil {
+		return nil, err
+	}
+	b, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != ed25519.PrivateKeySize {
+		return nil, errors.New("the consensus key is not an ed25519 key")
+	}
+	return ed25519.PrivKey(b), nil
+}
+
+// Signer signs the votes and proposals of a validator for its node.
+type Signer struct {
+	pv      *privval.FilePV
+	chainID string
+}
+
+// New creates a signer for the chain with chainID that signs with the consensus key in the keyring
+// of r. the last signed height, round and step are kept in the priv_validator_state.json at
+// stateFile so the signer never signs twice for the same step.
+func New(r cosmosaccount.Registry, stateFile, chainID string) (Signer, error) {
+	privKey, err := privKey(r)
+	if err != nil {
+		return Signer{}, err
+	}
+	pv := privval.NewFilePV(privKey, "", stateFile)
+
+	b, err := os.ReadFile(stateFile)
+	switch {
+	case err == nil:
+		if err := tmjson.Unmarshal(b, &pv.LastSignState); err != nil {
+			return Signer{}, err
+		}
+	case !os.IsNotExist(err):
+		return Signer{}, err
+	}
+
+	return Signer{pv: pv, chainID: chainID}, nil
+}
+
+// Run connects to the node that listens for the signer at address and signs its requests until ctx
+// is canceled. the signer connects again when the node is restarted.
+func (s Signer) Run(ctx context.Context, address string) error {
+	dial := privval.DialTCPFn(address, timeoutReadWrite, ed25519.GenPrivKey())
+	endpoint := privval.NewSignerDialerEndpoint(
+		log.NewNopLogger(),
+		dial,
+		privval.SignerDialerEndpointTimeoutReadWrite(timeoutReadWrite),
+	)
+
+	for {
+		if conn, err := dial(); err == nil {
+			s.serve(ctx, endpoint, conn)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(RetryInterval):
+		}
+	}
+}
+
+// serve signs the requests of the node on conn until the connection or ctx is closed.
+func (s Signer) serve(ctx context.Context, endpoint *privval.SignerDialerEndpoint, conn net.Conn) {
+	endpoint.SetConnection(conn)
+	defer endpoint.DropConnection()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	for {
+		req, err := endpoint.ReadMessage()
+		if err != nil {
+			return
+		}
+		// failures, like double signs, are replied to the node as errors.
+		res, _ := privval.DefaultValidationRequestHandler(s.pv, req, s.chainID)
+		if err := endpoint.WriteMessage(res); err != nil {
+			return
+		}
+	}
+}
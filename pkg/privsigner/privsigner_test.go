@@ -0,0 +1,105 @@
+package privsigner
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/privval"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+const chainID = "mars"
+
+func newKeyFile(t *testing.T) (keyFile string, pv *privval.FilePV) {
+	dir := t.TempDir()
+	pv = privval.GenFilePV(filepath.Join(dir, "priv_validator_key.json"), filepath.Join(dir, "priv_validator_state.json"))
+	pv.Key.Save()
+	return filepath.Join(dir, "priv_validator_key.json"), pv
+}
+
+func newRegistry(t *testing.T) cosmosaccount.Registry {
+	r, err := cosmosaccount.New(cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringMemory))
+	require.NoError(t, err)
+	return r
+}
+
+func TestImportExport(t *testing.T) {
+	keyFile, pv := newKeyFile(t)
+	r := newRegistry(t)
+
+	require.NoError(t, Import(r, keyFile))
+	require.NoFileExists(t, keyFile)
+	imported, err := IsImported(r)
+	require.NoError(t, err)
+	require.True(t, imported)
+
+	require.NoError(t, Export(r, keyFile))
+	imported, err = IsImported(r)
+	require.NoError(t, err)
+	require.False(t, imported)
+
+	exported := privval.LoadFilePVEmptyState(keyFile, "")
+	require.Equal(t, pv.Key.PrivKey, exported.Key.PrivKey)
+	require.Equal(t, pv.Key.Address, exported.Key.Address)
+
+	require.Equal(t, ErrNotImported, Export(r, keyFile))
+}
+
+func TestSigner(t *testing.T) {
+	keyFile, pv := newKeyFile(t)
+	r := newRegistry(t)
+	require.NoError(t, Import(r, keyFile))
+
+	stateFile := filepath.Join(t.TempDir(), "priv_validator_state.json")
+	signer, err := New(r, stateFile, chainID)
+	require.NoError(t, err)
+
+	// the node listens for the signer.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	endpoint := privval.NewSignerListenerEndpoint(log.NewNopLogger(), privval.NewTCPListener(ln, ed25519.GenPrivKey()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- signer.Run(ctx, ln.Addr().String()) }()
+
+	client, err := privval.NewSignerClient(endpoint, chainID)
+	require.NoError(t, err)
+	defer client.Close()
+
+	pubKey, err := client.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, pv.Key.PubKey, pubKey)
+
+	vote := &tmproto.Vote{Type: tmproto.PrevoteType, Height: 5, Round: 0}
+	require.NoError(t, client.SignVote(chainID, vote))
+	require.True(t, pubKey.VerifySignature(tmtypes.VoteSignBytes(chainID, vote), vote.Signature))
+
+	// the signer doesn't sign twice for the same step.
+	conflicting := &tmproto.Vote{
+		Type:    tmproto.PrevoteType,
+		Height:  5,
+		Round:   0,
+		BlockID: tmproto.BlockID{Hash: make([]byte, 32)},
+	}
+	require.Error(t, client.SignVote(chainID, conflicting))
+
+	cancel()
+	require.NoError(t, <-done)
+
+	// the last signed step is kept once the signer is restarted.
+	b, err := os.ReadFile(stateFile)
+	require.NoError(t, err)
+	require.Contains(t, string(b), `"height": "5"`)
+	signer, err = New(r, stateFile, chainID)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), signer.pv.LastSignState.Height)
+}
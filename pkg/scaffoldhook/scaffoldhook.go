@@ -0,0 +1,131 @@
+// Package scaffoldhook runs hooks before and after code is scaffolded, such as to format the
+// scaffolded code with custom rules, to add license headers to it or to update registries.
+package scaffoldhook
+
+import (
+	"context"
+	"fmt"
+)
+
+// Stage is the stage of scaffolding that hooks are run at.
+type Stage string
+
+const (
+	// StagePre is before code is scaffolded, a failing hook cancels scaffolding.
+	StagePre Stage = "pre"
+
+	// StagePost is after code is scaffolded.
+	StagePost Stage = "post"
+)
+
+// Target is what's scaffolded.
+type Target string
+
+const (
+	TargetModule  Target = "module"
+	TargetType    Target = "type"
+	TargetMessage Target = "message"
+	TargetQuery   Target = "query"
+	TargetPacket  Target = "packet"
+)
+
+var (
+	stages  = []Stage{StagePre, StagePost}
+	targets = []Target{TargetModule, TargetType, TargetMessage, TargetQuery, TargetPacket}
+)
+
+// EventType is the type of a scaffold event that hooks are subscribed to, such as post-module.
+type EventType string
+
+// NewEventType returns the type of the events of the stage of scaffolding target.
+func NewEventType(stage Stage, target Target) EventType {
+	return EventType(fmt.Sprintf("%s-%s", stage, target))
+}
+
+// EventTypes are the types of scaffold events.
+var EventTypes = func() (types []EventType) {
+	for _, target := range targets {
+		for _, stage := range stages {
+			types = append(types, NewEventType(stage, target))
+		}
+	}
+	return types
+}()
+
+// IsEventType checks if t is the type of scaffold events.
+func IsEventType(t EventType) bool {
+	for _, eventType := range EventTypes {
+		if eventType == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Event is a scaffold event that's passed to hooks.
+type Event struct {
+	Stage  Stage  `json:"stage"`
+	Target Target `json:"target"`
+
+	// AppPath is the path of the app that's scaffolded in.
+	AppPath string `json:"app_path"`
+
+	// Module is the module that's scaffolded, or that's scaffolded in.
+	Module string `json:"module"`
+
+	// Name is the name of what's scaffolded.
+	Name string `json:"name"`
+
+	// Kind is the kind of scaffolded types: list, map, single or type.
+	Kind string `json:"kind,omitempty"`
+
+	// Created and Modified are the files that are created and modified by the scaffolder,
+	// they are only set after scaffolding.
+	Created  []string `json:"created,omitempty"`
+	Modified []string `json:"modified,omitempty"`
+}
+
+// Type returns the type of the event.
+func (e Event) Type() EventType {
+	return NewEventType(e.Stage, e.Target)
+}
+
+// Hook is run on scaffold events.
+type Hook interface {
+	Run(context.Context, Event) error
+}
+
+// HookFunc is an adapter to use funcs as hooks.
+type HookFunc func(context.Context, Event) error
+
+// Run runs f(ctx, event).
+func (f HookFunc) Run(ctx context.Context, event Event) error {
+	return f(ctx, event)
+}
+
+// HookError is returned when a hook fails.
+type HookError struct {
+	Event Event
+	Err   error
+}
+
+func (e *HookError) Error() string {
+	if e.Event.Stage == StagePost {
+		return fmt.Sprintf("%s hook failed after %s %q is scaffolded: %s", e.Event.Type(), e.Event.Target, e.Event.Name, e.Err)
+	}
+	return fmt.Sprintf("%s hook failed, %s %q is not scaffolded: %s", e.Event.Type(), e.Event.Target, e.Event.Name, e.Err)
+}
+
+func (e *HookError) Unwrap() error {
+	return e.Err
+}
+
+// Run runs hooks on the event in order, it stops at the first hook that fails.
+func Run(ctx context.Context, hooks []Hook, event Event) error {
+	for _, hook := range hooks {
+		if err := hook.Run(ctx, event); err != nil {
+			return &HookError{Event: event, Err: err}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package scaffoldhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventTypes(t *testing.T) {
+	require.Len(t, EventTypes, 10)
+	require.True(t, IsEventType("post-module"))
+	require.True(t, IsEventType("pre-type"))
+	require.False(t, IsEventType("post-chain"))
+}
+
+func TestRun(t *testing.T) {
+	var ran []string
+	hook := func(name string, err error) Hook {
+		return HookFunc(func(_ context.Context, event Event) error {
+			require.Equal(t, EventType("post-module"), event.Type())
+			ran = append(ran, name)
+			return err
+		})
+	}
+
+	event := Event{Stage: StagePost, Target: TargetModule, Name: "blog"}
+	err := Run(context.Background(), []Hook{
+		hook("format", nil),
+		hook("license", errors.New("no license")),
+		hook("registry", nil),
+	}, event)
+
+	var hookErr *HookError
+	require.ErrorAs(t, err, &hookErr)
+	require.Equal(t, `post-module hook failed after module "blog" is scaffolded: no license`, err.Error())
+	require.Equal(t, []string{"format", "license"}, ran)
+}
@@ -0,0 +1,143 @@
+// Package selfupdate replaces a binary with the one of a release. the archives of releases are
+// verified with the checksums file of the release, and the checksums file with its signature,
+// before the binary is replaced.
+package selfupdate
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+var (
+	// ErrInvalidSignature is returned when the checksums file is not signed by the release key.
+	ErrInvalidSignature = errors.New("the checksums of the release are not signed by the release key")
+
+	// ErrChecksumMismatch is returned when an archive doesn't match its checksum.
+	ErrChecksumMismatch = errors.New("the archive doesn't match its checksum")
+)
+
+// VerifyChecksums verifies that checksums is signed by the ed25519 key publicKey, signature
+// and publicKey are base64 encoded.
+func VerifyChecksums(checksums, signature []byte, publicKey string) error {
+	key, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid release key %q", publicKey)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	if !ed25519.Verify(key, checksums, sig) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Checksum returns the sha256 checksum of the file with name in checksums, a checksums file in
+// the format of sha256sum.
+func Checksum(checksums []byte, name string) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == name {
+			return fields[0], nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("no checksum for %s", name)
+}
+
+// VerifyArchive checks that the sha256 checksum of archive is sum.
+func VerifyArchive(archive []byte, sum string) error {
+	actual := sha256.Sum256(archive)
+	if !strings.EqualFold(hex.EncodeToString(actual[:]), sum) {
+		return ErrChecksumMismatch
+	}
+	return nil
+}
+
+// ExtractBinary returns the file with name from the tar.gz archive, at the root of the archive
+// or in one of its dirs.
+func ExtractBinary(archive []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("%s is not in the archive", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag == tar.TypeReg && path.Base(header.Name) == name {
+			return ioutil.ReadAll(tr)
+		}
+	}
+}
+
+// Replace replaces the binary at binaryPath with binary, keeping its file mode. the new binary
+// is written next to the old one and moved over it, so the binary is never partially written.
+func Replace(binaryPath string, binary []byte) error {
+	info, err := os.Stat(binaryPath)
+	if err != nil {
+		return err
+	}
+
+	dir, name := filepath.Split(binaryPath)
+	tmp, err := ioutil.TempFile(dir, "."+name+".new-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(binary); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), info.Mode()); err != nil {
+		return err
+	}
+
+	// running binaries cannot be replaced on Windows, they can only be moved.
+	if runtime.GOOS == "windows" {
+		old := binaryPath + ".old"
+		os.Remove(old)
+		if err := os.Rename(binaryPath, old); err != nil {
+			return err
+		}
+		if err := os.Rename(tmp.Name(), binaryPath); err != nil {
+			os.Rename(old, binaryPath)
+			return err
+		}
+		return nil
+	}
+
+	return os.Rename(tmp.Name(), binaryPath)
+}
@@ -0,0 +1,74 @@
+package selfupdate
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelfUpdate(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	key := base64.StdEncoding.EncodeToString(publicKey)
+
+	// a release archive with the binary in a dir.
+	var archive bytes.Buffer
+	gz := gzip.NewWriter(&archive)
+	tw := tar.NewWriter(gz)
+	binary := []byte("#!/bin/sh\necho v0.2.0\n")
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "README.md", Mode: 0644, Size: 2, Typeflag: tar.TypeReg}))
+	_, err = tw.Write([]byte("hi"))
+	require.NoError(t, err)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "release/starport", Mode: 0755, Size: int64(len(binary)), Typeflag: tar.TypeReg}))
+	_, err = tw.Write(binary)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	sum := sha256.Sum256(archive.Bytes())
+	checksums := []byte(fmt.Sprintf("%s  starport_0.2.0_darwin_amd64.tar.gz\n%s  starport_0.2.0_linux_amd64.tar.gz\n",
+		hex.EncodeToString(make([]byte, 32)), hex.EncodeToString(sum[:])))
+	signature := []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, checksums)))
+
+	require.NoError(t, VerifyChecksums(checksums, signature, key))
+	require.ErrorIs(t, VerifyChecksums(append(checksums, '\n'), signature, key), ErrInvalidSignature)
+	require.Error(t, VerifyChecksums(checksums, signature, "not a key"))
+
+	checksum, err := Checksum(checksums, "starport_0.2.0_linux_amd64.tar.gz")
+	require.NoError(t, err)
+	require.NoError(t, VerifyArchive(archive.Bytes(), checksum))
+	require.ErrorIs(t, VerifyArchive([]byte("tampered"), checksum), ErrChecksumMismatch)
+	_, err = Checksum(checksums, "starport_0.2.0_windows_amd64.tar.gz")
+	require.Error(t, err)
+
+	extracted, err := ExtractBinary(archive.Bytes(), "starport")
+	require.NoError(t, err)
+	require.Equal(t, binary, extracted)
+	_, err = ExtractBinary(archive.Bytes(), "starportd")
+	require.Error(t, err)
+
+	binaryPath := filepath.Join(t.TempDir(), "starport")
+	require.NoError(t, os.WriteFile(binaryPath, []byte("v0.1.0"), 0755))
+	require.NoError(t, Replace(binaryPath, extracted))
+	replaced, err := os.ReadFile(binaryPath)
+	require.NoError(t, err)
+	require.Equal(t, binary, replaced)
+	info, err := os.Stat(binaryPath)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0755), info.Mode().Perm())
+
+	entries, err := os.ReadDir(filepath.Dir(binaryPath))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
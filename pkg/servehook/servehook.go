@@ -0,0 +1,122 @@
+// Package servehook notifies hooks of the lifecycle events of chains that are served in
+// development, such as to send a message to a chat or to refresh a remote dev environment when
+// the chain restarts.
+package servehook
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// EventType is the type of a serve event that hooks are subscribed to.
+type EventType string
+
+const (
+	// EventBuildStarted is when the chain starts being built.
+	EventBuildStarted EventType = "build-started"
+
+	// EventBuildFinished is when the chain is built, Error is set when the build failed.
+	EventBuildFinished EventType = "build-finished"
+
+	// EventCodegenFinished is when the code of the proto files is generated.
+	EventCodegenFinished EventType = "codegen-finished"
+
+	// EventStateReset is when the state of the chain is reset.
+	EventStateReset EventType = "state-reset"
+
+	// EventChainStarted is when the node of the chain is started.
+	EventChainStarted EventType = "chain-started"
+)
+
+// EventTypes are the types of serve events.
+var EventTypes = []EventType{
+	EventBuildStarted,
+	EventBuildFinished,
+	EventCodegenFinished,
+	EventStateReset,
+	EventChainStarted,
+}
+
+// IsEventType checks if t is the type of serve events.
+func IsEventType(t EventType) bool {
+	for _, eventType := range EventTypes {
+		if eventType == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Event is a serve event that's passed to hooks.
+type Event struct {
+	Type EventType `json:"type"`
+	Time time.Time `json:"time"`
+
+	// AppPath is the path of the app that's served.
+	AppPath string `json:"app_path"`
+
+	// ChainID is the id of the served chain.
+	ChainID string `json:"chain_id"`
+
+	// Error is why the build failed for build-finished.
+	Error string `json:"error,omitempty"`
+
+	// RPC and API are the addresses of the node for chain-started.
+	RPC string `json:"rpc,omitempty"`
+	API string `json:"api,omitempty"`
+}
+
+// Text returns a message that describes the event.
+func (e Event) Text() string {
+	switch e.Type {
+	case EventBuildStarted:
+		return fmt.Sprintf("🛠️ Building %s...", e.ChainID)
+	case EventBuildFinished:
+		if e.Error != "" {
+			return fmt.Sprintf("❌ %s cannot be built: %s", e.ChainID, e.Error)
+		}
+		return fmt.Sprintf("🛠️ %s is built", e.ChainID)
+	case EventCodegenFinished:
+		return fmt.Sprintf("🛠️ Code of %s is generated", e.ChainID)
+	case EventStateReset:
+		return fmt.Sprintf("🔄 State of %s is reset", e.ChainID)
+	case EventChainStarted:
+		return fmt.Sprintf("🌍 %s is started, Tendermint node: %s, API: %s", e.ChainID, e.RPC, e.API)
+	}
+	return string(e.Type)
+}
+
+// Hook is notified of serve events.
+type Hook interface {
+	Notify(context.Context, Event) error
+}
+
+// HookFunc is an adapter to use funcs as hooks.
+type HookFunc func(context.Context, Event) error
+
+// Notify runs f(ctx, event).
+func (f HookFunc) Notify(ctx context.Context, event Event) error {
+	return f(ctx, event)
+}
+
+// Timeout is the time that hooks have to handle an event, the next events wait no longer.
+const Timeout = 10 * time.Second
+
+// Notify notifies hooks of the event in order. hooks don't stop serving so all of them are
+// notified, the returned errors are of the failing ones.
+func Notify(ctx context.Context, hooks []Hook, event Event) (errs []error) {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	for _, hook := range hooks {
+		hookCtx, cancel := context.WithTimeout(ctx, Timeout)
+		err := hook.Notify(hookCtx, event)
+		cancel()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s hook failed: %w", event.Type, err))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,54 @@
+package servehook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotify(t *testing.T) {
+	var notified []EventType
+	hooks := []Hook{
+		HookFunc(func(_ context.Context, event Event) error {
+			return errors.New("unavailable")
+		}),
+		HookFunc(func(_ context.Context, event Event) error {
+			require.False(t, event.Time.IsZero())
+			notified = append(notified, event.Type)
+			return nil
+		}),
+	}
+
+	errs := Notify(context.Background(), hooks, Event{Type: EventStateReset})
+	require.Len(t, errs, 1)
+	require.EqualError(t, errs[0], "state-reset hook failed: unavailable")
+	require.Equal(t, []EventType{EventStateReset}, notified)
+}
+
+func TestWebhook(t *testing.T) {
+	var payload map[string]interface{}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+	}))
+	defer s.Close()
+
+	event := Event{Type: EventChainStarted, ChainID: "mars", RPC: "http://0.0.0.0:26657", API: "http://0.0.0.0:1317"}
+
+	err := NewWebhook(s.URL, nil).Notify(context.Background(), event)
+	require.EqualError(t, err, "webhook "+s.URL+" responded 401 Unauthorized")
+
+	err = NewWebhook(s.URL, map[string]string{"Authorization": "Bearer token"}).Notify(context.Background(), event)
+	require.NoError(t, err)
+	require.Equal(t, "chain-started", payload["type"])
+	require.Equal(t, "mars", payload["chain_id"])
+	require.Equal(t, "🌍 mars is started, Tendermint node: http://0.0.0.0:26657, API: http://0.0.0.0:1317", payload["text"])
+}
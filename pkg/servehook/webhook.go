@@ -0,0 +1,46 @@
+package servehook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// webhookPayload is the body of webhooks, text makes it compatible with the incoming webhooks
+// of chats such as Slack.
+type webhookPayload struct {
+	Event
+	Text string `json:"text"`
+}
+
+// NewWebhook creates a hook that posts events in JSON to url with the headers.
+func NewWebhook(url string, headers map[string]string) Hook {
+	return HookFunc(func(ctx context.Context, event Event) error {
+		body, err := json.Marshal(webhookPayload{Event: event, Text: event.Text()})
+		if err != nil {
+			return err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+
+		if res.StatusCode < 200 || res.StatusCode >= 300 {
+			return fmt.Errorf("webhook %s responded %s", url, res.Status)
+		}
+		return nil
+	})
+}
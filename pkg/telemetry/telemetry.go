@@ -0,0 +1,272 @@
+// Package telemetry records the usage of commands into a local event log, once it's turned on.
+// events only hold the names of commands and flags and the categories of errors, never their
+// args, the values of flags or the contents of projects.
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/goccy/go-yaml"
+)
+
+const (
+	configFile = "config.yml"
+	logFile    = "events.jsonl"
+
+	// maxLogSize is the size of the event log that it's rotated at, the previous log is kept
+	// with the .old extension.
+	maxLogSize = 1 << 20
+
+	// envDoNotTrack is the env var that turns telemetry off regardless of the config, see
+	// https://consoledonottrack.com.
+	envDoNotTrack = "DO_NOT_TRACK"
+)
+
+// Error categories of events.
+const (
+	ErrorCanceled   = "canceled"
+	ErrorNetwork    = "network"
+	ErrorFilesystem = "filesystem"
+	ErrorExec       = "exec"
+	ErrorOther      = "other"
+)
+
+// Event is the usage of a command.
+type Event struct {
+	Time time.Time `json:"time"`
+
+	// ID is the anonymous id of the installation, it's random and changes each time telemetry
+	// is turned on.
+	ID string `json:"id"`
+
+	// Command is the path of the command, without the name of the root command.
+	Command string `json:"command"`
+
+	// Flags are the names of the flags that are set.
+	Flags []string `json:"flags,omitempty"`
+
+	Duration time.Duration `json:"duration_ns"`
+
+	// Error is the category of the error of the command, if it failed.
+	Error string `json:"error,omitempty"`
+
+	Version string `json:"version"`
+	OS      string `json:"os"`
+	Arch    string `json:"arch"`
+}
+
+// config is the telemetry config of a user.
+type config struct {
+	Enabled bool   `yaml:"enabled"`
+	ID      string `yaml:"id,omitempty"`
+}
+
+// Telemetry records events into the event log in its dir.
+type Telemetry struct {
+	dir    string
+	config config
+}
+
+// New creates a new Telemetry that keeps its config and event log in dir.
+func New(dir string) (*Telemetry, error) {
+	t := &Telemetry{dir: dir}
+
+	b, err := os.ReadFile(filepath.Join(dir, configFile))
+	if os.IsNotExist(err) {
+		return t, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(b, &t.config); err != nil {
+		return nil, fmt.Errorf("invalid telemetry config: %w", err)
+	}
+	return t, nil
+}
+
+// IsEnabled checks if telemetry is turned on, DO_NOT_TRACK turns it off.
+func (t *Telemetry) IsEnabled() bool {
+	return t.config.Enabled && !t.IsDoNotTrack()
+}
+
+// IsDoNotTrack checks if telemetry is turned off with DO_NOT_TRACK.
+func (t *Telemetry) IsDoNotTrack() bool {
+	doNotTrack, _ := strconv.ParseBool(os.Getenv(envDoNotTrack))
+	return doNotTrack
+}
+
+// ID returns the anonymous id of the installation, it's empty when telemetry is off.
+func (t *Telemetry) ID() string {
+	return t.config.ID
+}
+
+// LogPath returns the path of the event log.
+func (t *Telemetry) LogPath() string {
+	return filepath.Join(t.dir, logFile)
+}
+
+// Enable turns telemetry on with a new anonymous id, it's kept if telemetry is already on.
+func (t *Telemetry) Enable() error {
+	if t.config.Enabled && t.config.ID != "" {
+		return nil
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return err
+	}
+	return t.save(config{Enabled: true, ID: hex.EncodeToString(id)})
+}
+
+// Disable turns telemetry off and forgets the anonymous id, the event log is kept so it can
+// still be inspected.
+func (t *Telemetry) Disable() error {
+	return t.save(config{})
+}
+
+func (t *Telemetry) save(c config) error {
+	b, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(t.dir, 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(t.dir, configFile), b, 0644); err != nil {
+		return err
+	}
+	t.config = c
+	return nil
+}
+
+// Record appends e to the event log with the anonymous id, nothing is recorded when telemetry
+// is off.
+func (t *Telemetry) Record(e Event) error {
+	if !t.IsEnabled() {
+		return nil
+	}
+	e.ID = t.config.ID
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if err := t.rotate(); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(t.LogPath(), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.Write(append(b, '\n'))
+	return err
+}
+
+// rotate moves the event log aside once it reaches maxLogSize.
+func (t *Telemetry) rotate() error {
+	info, err := os.Stat(t.LogPath())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Size() < maxLogSize {
+		return nil
+	}
+	return os.Rename(t.LogPath(), t.LogPath()+".old")
+}
+
+// Events returns the events of the event log, oldest first.
+func (t *Telemetry) Events() ([]Event, error) {
+	b, err := os.ReadFile(t.LogPath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var events []Event
+	dec := json.NewDecoder(bytes.NewReader(b))
+	for dec.More() {
+		var e Event
+		if err := dec.Decode(&e); err != nil {
+			return nil, fmt.Errorf("invalid event log %s: %w", t.LogPath(), err)
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// Send sends e to the telemetry endpoint, nothing is sent when telemetry is off.
+func (t *Telemetry) Send(ctx context.Context, endpoint string, e Event) error {
+	if !t.IsEnabled() {
+		return nil
+	}
+	e.ID = t.config.ID
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("cannot send the event: %s", resp.Status)
+	}
+	return nil
+}
+
+// ErrorCategory returns the category of err for events, errors are categorized by their types
+// and their messages are never recorded.
+func ErrorCategory(err error) string {
+	var (
+		netErr  net.Error
+		urlErr  *url.Error
+		pathErr *os.PathError
+		execErr *exec.ExitError
+	)
+
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, context.Canceled):
+		return ErrorCanceled
+	case errors.As(err, &netErr), errors.As(err, &urlErr):
+		return ErrorNetwork
+	case errors.As(err, &pathErr):
+		return ErrorFilesystem
+	case errors.As(err, &execErr), errors.Is(err, exec.ErrNotFound):
+		return ErrorExec
+	default:
+		return ErrorOther
+	}
+}
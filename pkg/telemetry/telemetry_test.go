@@ -0,0 +1,133 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTelemetry(t *testing.T) {
+	dir := t.TempDir()
+	event := Event{
+		Time:     time.Unix(1600000000, 0).UTC(),
+		Command:  "scaffold list",
+		Flags:    []string{"module"},
+		Duration: time.Second,
+		Version:  "v0.1.0",
+		OS:       "linux",
+		Arch:     "amd64",
+	}
+
+	// nothing is recorded before telemetry is turned on.
+	tm, err := New(dir)
+	require.NoError(t, err)
+	require.False(t, tm.IsEnabled())
+	require.NoError(t, tm.Record(event))
+	events, err := tm.Events()
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	require.NoError(t, tm.Enable())
+	id := tm.ID()
+	require.Len(t, id, 32)
+
+	// the config is kept across runs.
+	tm, err = New(dir)
+	require.NoError(t, err)
+	require.True(t, tm.IsEnabled())
+	require.Equal(t, id, tm.ID())
+	require.NoError(t, tm.Enable())
+	require.Equal(t, id, tm.ID())
+
+	require.NoError(t, tm.Record(event))
+	event.Error = ErrorOther
+	require.NoError(t, tm.Record(event))
+
+	events, err = tm.Events()
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, id, events[0].ID)
+	require.Equal(t, "scaffold list", events[0].Command)
+	require.Equal(t, []string{"module"}, events[0].Flags)
+	require.Empty(t, events[0].Error)
+	require.Equal(t, ErrorOther, events[1].Error)
+
+	t.Setenv(envDoNotTrack, "1")
+	require.False(t, tm.IsEnabled())
+	require.NoError(t, tm.Record(event))
+	events, err = tm.Events()
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	t.Setenv(envDoNotTrack, "")
+
+	// the id is forgotten once telemetry is turned off, the log is kept.
+	require.NoError(t, tm.Disable())
+	tm, err = New(dir)
+	require.NoError(t, err)
+	require.False(t, tm.IsEnabled())
+	require.Empty(t, tm.ID())
+	require.FileExists(t, filepath.Join(dir, logFile))
+
+	require.NoError(t, tm.Enable())
+	require.NotEqual(t, id, tm.ID())
+}
+
+func TestTelemetryRotate(t *testing.T) {
+	tm, err := New(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, tm.Enable())
+
+	require.NoError(t, os.WriteFile(tm.LogPath(), make([]byte, maxLogSize), 0644))
+	require.NoError(t, tm.Record(Event{Command: "version"}))
+
+	events, err := tm.Events()
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.FileExists(t, tm.LogPath()+".old")
+}
+
+func TestTelemetrySend(t *testing.T) {
+	var received Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	tm, err := New(t.TempDir())
+	require.NoError(t, err)
+
+	require.NoError(t, tm.Send(context.Background(), server.URL, Event{Command: "chain serve"}))
+	require.Empty(t, received.Command)
+
+	require.NoError(t, tm.Enable())
+	require.NoError(t, tm.Send(context.Background(), server.URL, Event{Command: "chain serve"}))
+	require.Equal(t, "chain serve", received.Command)
+	require.Equal(t, tm.ID(), received.ID)
+}
+
+func TestErrorCategory(t *testing.T) {
+	_, execErr := exec.LookPath("starport-that-does-not-exist")
+
+	for _, tt := range []struct {
+		err      error
+		category string
+	}{
+		{nil, ""},
+		{fmt.Errorf("serve: %w", context.Canceled), ErrorCanceled},
+		{&os.PathError{Op: "open", Path: "config.yml", Err: os.ErrNotExist}, ErrorFilesystem},
+		{execErr, ErrorExec},
+		{errors.New("secret details"), ErrorOther},
+	} {
+		require.Equal(t, tt.category, ErrorCategory(tt.err))
+	}
+}
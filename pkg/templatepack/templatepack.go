@@ -0,0 +1,244 @@
+// Package templatepack applies template packs to the chains that are scaffolded by Starport.
+//
+// A template pack is a git repository with a template.yml manifest at its root:
+//
+//	name: mycompany-chain
+//	description: Chain with the modules and the CI of mycompany
+//	address_prefix: mycompany
+//	no_default_module: true
+//	modules:
+//	  - name: registry
+//	  - name: payments
+//	    ibc: true
+//	    dependencies: [bank, registry]
+//
+// The files of the files dir of the pack are copied to the chain, files with the .tpl
+// extension are rendered with text/template and the data of the chain and written without
+// the extension.
+package templatepack
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/goccy/go-yaml"
+)
+
+const (
+	// ManifestFile is the manifest of a template pack at the root of its repository.
+	ManifestFile = "template.yml"
+
+	// DefaultFilesDir is the dir of the files of a template pack by default.
+	DefaultFilesDir = "files"
+
+	// TemplateExt is the extension of the files of a template pack that are rendered.
+	TemplateExt = ".tpl"
+)
+
+// Module is a module that's scaffolded in the chains of a template pack.
+type Module struct {
+	Name string `yaml:"name"`
+	IBC  bool   `yaml:"ibc"`
+
+	// Dependencies are the dependencies of the module in the format of --dep of
+	// "starport scaffold module".
+	Dependencies []string `yaml:"dependencies"`
+}
+
+// Manifest is the manifest of a template pack.
+type Manifest struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+
+	// AddressPrefix is the address prefix of the chains, the one of the command by default.
+	AddressPrefix string `yaml:"address_prefix"`
+
+	// NoDefaultModule prevents scaffolding the default module of the chains.
+	NoDefaultModule bool `yaml:"no_default_module"`
+
+	Modules []Module `yaml:"modules"`
+
+	// Files is the dir of the files of the pack relative to its root.
+	Files string `yaml:"files"`
+}
+
+// Pack is a template pack.
+type Pack struct {
+	// Path is the root of the pack.
+	Path     string
+	Manifest Manifest
+}
+
+// Data is the data of a chain that the files of a template pack are rendered with.
+type Data struct {
+	ModulePath       string
+	AppName          string
+	BinaryNamePrefix string
+	AddressPrefix    string
+}
+
+// Load loads the template pack at path.
+func Load(path string) (Pack, error) {
+	b, err := os.ReadFile(filepath.Join(path, ManifestFile))
+	if os.IsNotExist(err) {
+		return Pack{}, fmt.Errorf("%s has no %s, it's not a template pack", path, ManifestFile)
+	}
+	if err != nil {
+		return Pack{}, err
+	}
+
+	var m Manifest
+	if err := yaml.Unmarshal(b, &m); err != nil {
+		return Pack{}, fmt.Errorf("invalid %s: %w", ManifestFile, err)
+	}
+	if err := m.validate(); err != nil {
+		return Pack{}, fmt.Errorf("invalid %s: %w", ManifestFile, err)
+	}
+	if m.Files == "" {
+		m.Files = DefaultFilesDir
+	}
+
+	return Pack{Path: path, Manifest: m}, nil
+}
+
+func (m Manifest) validate() error {
+	if m.Name == "" {
+		return errors.New("name is required")
+	}
+	if filepath.IsAbs(m.Files) || strings.HasPrefix(filepath.Clean(m.Files), "..") {
+		return fmt.Errorf("files %s must be in the template pack", m.Files)
+	}
+	for i, module := range m.Modules {
+		if module.Name == "" {
+			return fmt.Errorf("name of module #%d is required", i+1)
+		}
+	}
+	return nil
+}
+
+// Clone clones the template pack of the git repository at url to dest and loads it. ref is a
+// tag or a branch of the repository, its default branch when it's empty.
+func Clone(ctx context.Context, url, ref, dest string) (Pack, error) {
+	var refs []plumbing.ReferenceName
+	if ref != "" {
+		refs = []plumbing.ReferenceName{plumbing.NewTagReferenceName(ref), plumbing.NewBranchReferenceName(ref)}
+	} else {
+		refs = []plumbing.ReferenceName{""}
+	}
+
+	var err error
+	for _, name := range refs {
+		os.RemoveAll(dest)
+		_, err = git.PlainCloneContext(ctx, dest, false, &git.CloneOptions{
+			URL:           url,
+			ReferenceName: name,
+			SingleBranch:  true,
+			Depth:         1,
+		})
+		if err == nil {
+			return Load(dest)
+		}
+	}
+	os.RemoveAll(dest)
+
+	if ref != "" {
+		return Pack{}, fmt.Errorf("cannot clone %s of the template pack %s: %w", ref, url, err)
+	}
+	return Pack{}, fmt.Errorf("cannot clone the template pack %s: %w", url, err)
+}
+
+// Apply writes the files of the pack to the chain at appPath, it returns the written files
+// relative to appPath.
+func (p Pack) Apply(appPath string, data Data) (written []string, err error) {
+	root, err := p.filesDir()
+	if err != nil || root == "" {
+		return nil, err
+	}
+
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		// the links of a pack could read the files of the machine it's applied on.
+		if !d.Type().IsRegular() {
+			rel, _ := filepath.Rel(root, path)
+			return fmt.Errorf("%s of the template pack is not a regular file", rel)
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		if strings.HasSuffix(rel, TemplateExt) {
+			rel = strings.TrimSuffix(rel, TemplateExt)
+			if content, err = render(rel, content, data); err != nil {
+				return err
+			}
+		}
+
+		dest := filepath.Join(appPath, rel)
+		if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(dest, content, info.Mode().Perm()); err != nil {
+			return err
+		}
+
+		written = append(written, rel)
+		return nil
+	})
+	return written, err
+}
+
+// filesDir returns the files dir of the pack with its links resolved, it's empty when the pack
+// has no files.
+func (p Pack) filesDir() (string, error) {
+	path, err := filepath.EvalSymlinks(p.Path)
+	if err != nil {
+		return "", err
+	}
+	root, err := filepath.EvalSymlinks(filepath.Join(path, p.Manifest.Files))
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	rel, err := filepath.Rel(path, root)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("files %s must be in the template pack", p.Manifest.Files)
+	}
+	return root, nil
+}
+
+func render(name string, content []byte, data Data) ([]byte, error) {
+	t, err := template.New(name).Option("missingkey=error").Parse(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("invalid template %s: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("cannot render %s: %w", name, err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,129 @@
+package templatepack
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+}
+
+func TestApply(t *testing.T) {
+	packPath := t.TempDir()
+	writeFiles(t, packPath, map[string]string{
+		ManifestFile: `
+name: mycompany-chain
+modules:
+  - name: payments
+    ibc: true
+    dependencies: [bank]
+`,
+		"files/.github/workflows/test.yml": "name: test",
+		"files/Makefile.tpl":               "build:\n\tgo build -o {{.BinaryNamePrefix}}d {{.ModulePath}}/cmd/{{.BinaryNamePrefix}}d",
+	})
+
+	pack, err := Load(packPath)
+	require.NoError(t, err)
+	require.Equal(t, DefaultFilesDir, pack.Manifest.Files)
+	require.Equal(t, []Module{{Name: "payments", IBC: true, Dependencies: []string{"bank"}}}, pack.Manifest.Modules)
+
+	appPath := t.TempDir()
+	written, err := pack.Apply(appPath, Data{ModulePath: "github.com/mycompany/mars", BinaryNamePrefix: "mars"})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{filepath.Join(".github", "workflows", "test.yml"), "Makefile"}, written)
+
+	makefile, err := os.ReadFile(filepath.Join(appPath, "Makefile"))
+	require.NoError(t, err)
+	require.Equal(t, "build:\n\tgo build -o marsd github.com/mycompany/mars/cmd/marsd", string(makefile))
+}
+
+func TestApplyLinks(t *testing.T) {
+	secret := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secret, []byte("secret"), 0600))
+
+	// a file of the pack that links to a file of the machine.
+	packPath := t.TempDir()
+	writeFiles(t, packPath, map[string]string{ManifestFile: "name: pack", "files/README.md": "readme"})
+	require.NoError(t, os.Symlink(secret, filepath.Join(packPath, "files", "secret.tpl")))
+
+	pack, err := Load(packPath)
+	require.NoError(t, err)
+	appPath := t.TempDir()
+	_, err = pack.Apply(appPath, Data{})
+	require.Error(t, err)
+	require.NoFileExists(t, filepath.Join(appPath, "secret"))
+
+	// the files dir of the pack that links outside of the pack.
+	packPath = t.TempDir()
+	writeFiles(t, packPath, map[string]string{ManifestFile: "name: pack\nfiles: shared"})
+	require.NoError(t, os.Symlink(filepath.Dir(secret), filepath.Join(packPath, "shared")))
+
+	pack, err = Load(packPath)
+	require.NoError(t, err)
+	_, err = pack.Apply(t.TempDir(), Data{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "must be in the template pack")
+}
+
+func TestLoadInvalid(t *testing.T) {
+	_, err := Load(t.TempDir())
+	require.Error(t, err)
+
+	for _, manifest := range []string{
+		"description: no name",
+		"name: pack\nfiles: ../outside",
+		"name: pack\nmodules:\n  - ibc: true",
+	} {
+		path := t.TempDir()
+		writeFiles(t, path, map[string]string{ManifestFile: manifest})
+		_, err := Load(path)
+		require.Error(t, err, manifest)
+	}
+}
+
+func TestClone(t *testing.T) {
+	repoPath := t.TempDir()
+	repo, err := git.PlainInit(repoPath, false)
+	require.NoError(t, err)
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+
+	commit := func(description string) {
+		writeFiles(t, repoPath, map[string]string{ManifestFile: "name: pack\ndescription: " + description})
+		_, err := wt.Add(ManifestFile)
+		require.NoError(t, err)
+		hash, err := wt.Commit(description, &git.CommitOptions{
+			Author: &object.Signature{Name: "test", Email: "test@test", When: time.Now()},
+		})
+		require.NoError(t, err)
+		_, err = repo.CreateTag(description, hash, nil)
+		require.NoError(t, err)
+	}
+	commit("v0.1.0")
+	commit("v0.2.0")
+
+	ctx := context.Background()
+
+	pack, err := Clone(ctx, repoPath, "", filepath.Join(t.TempDir(), "pack"))
+	require.NoError(t, err)
+	require.Equal(t, "v0.2.0", pack.Manifest.Description)
+
+	pack, err = Clone(ctx, repoPath, "v0.1.0", filepath.Join(t.TempDir(), "pack"))
+	require.NoError(t, err)
+	require.Equal(t, "v0.1.0", pack.Manifest.Description)
+
+	_, err = Clone(ctx, repoPath, "v0.3.0", filepath.Join(t.TempDir(), "pack"))
+	require.Error(t, err)
+}
@@ -0,0 +1,58 @@
+//go:build ignore
+// +build ignore
+
+// digests prints the sha256 digests of the archives of the pinned versions of the tools, to pin
+// them in toolchain.go when the versions are bumped.
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/trino-network/trino/pkg/toolchain"
+)
+
+func main() {
+	for _, tool := range toolchain.Tools {
+		fmt.Printf("%s %s:\n", tool.Name, tool.Version)
+		for _, platform := range toolchain.Platforms {
+			goos, goarch := splitPlatform(platform)
+			url, ok := tool.Archive(goos, goarch)
+			if !ok {
+				continue
+			}
+			digest, err := digest(url)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			fmt.Printf("\t%q: %q,\n", platform, digest)
+		}
+	}
+}
+
+func splitPlatform(platform string) (goos, goarch string) {
+	parts := strings.SplitN(platform, "/", 2)
+	return parts[0], parts[1]
+}
+
+func digest(url string) (string, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("cannot download %s: %s", url, resp.Status)
+	}
+
+	h := sha256.New()
+	if _, err := io.Copy(h, resp.Body); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
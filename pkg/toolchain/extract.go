@@ -0,0 +1,145 @@
+package toolchain
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// extractTarGz extracts the tar.gz archive into dir, strip leading dirs are removed from the
+// paths in the archive.
+func extractTarGz(archive []byte, dir string, strip int) error {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target, ok, err := targetPath(dir, header.Name, strip)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(target, 0755)
+		case tar.TypeReg:
+			err = writeFile(target, tr, os.FileMode(header.Mode).Perm())
+		case tar.TypeSymlink:
+			err = symlink(dir, target, header.Linkname)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// extractZip extracts the zip archive into dir, strip leading dirs are removed from the paths in
+// the archive.
+func extractZip(archive []byte, dir string, strip int) error {
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range zr.File {
+		target, ok, err := targetPath(dir, file.Name, strip)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		if file.FileInfo().IsDir() {
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+			continue
+		}
+
+		r, err := file.Open()
+		if err != nil {
+			return err
+		}
+		err = writeFile(target, r, file.Mode().Perm())
+		r.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// targetPath returns the path in dir of the file with name in an archive, without its strip
+// leading dirs. ok is false when nothing is left of name once stripped.
+func targetPath(dir, name string, strip int) (target string, ok bool, err error) {
+	name = strings.TrimPrefix(path.Clean(name), "/")
+	if name == ".." || strings.HasPrefix(name, "../") {
+		return "", false, fmt.Errorf("%s is outside of the archive", name)
+	}
+	parts := strings.Split(name, "/")
+	if len(parts) <= strip {
+		return "", false, nil
+	}
+	target = filepath.Join(dir, filepath.FromSlash(path.Join(parts[strip:]...)))
+	if !isInDir(dir, target) {
+		return "", false, fmt.Errorf("%s is outside of the archive", name)
+	}
+	return target, true, nil
+}
+
+// symlink creates the symlink target to link, link must be inside of dir.
+func symlink(dir, target, link string) error {
+	resolved := link
+	if !filepath.IsAbs(link) {
+		resolved = filepath.Join(filepath.Dir(target), link)
+	}
+	if !isInDir(dir, resolved) {
+		return fmt.Errorf("%s links outside of the archive", target)
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	return os.Symlink(link, target)
+}
+
+func writeFile(target string, r io.Reader, perm os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func isInDir(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
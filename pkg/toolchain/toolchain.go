@@ -0,0 +1,249 @@
+// Package toolchain installs pinned versions of the external tools that Starport runs, node,
+// protoc and buf, into a managed dir. installed tools are preferred over the ones in PATH and
+// over the protoc that's bundled with Starport, so code is generated the same way everywhere.
+package toolchain
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/protoc"
+	"github.com/trino-network/trino/pkg/selfupdate"
+)
+
+// Home is the dir where tools are installed, each version of a tool in its own dir.
+var Home = os.ExpandEnv("$HOME/.starport/toolchain")
+
+// Tool is an external tool with a pinned version.
+type Tool struct {
+	// Name is the name of the tool.
+	Name string
+
+	// Version is the pinned version of the tool.
+	Version string
+
+	// Bins are the names of the binaries of the tool, they're in the bin dir of the tool.
+	Bins []string
+
+	// archive returns the url of the archive of the tool for an OS and an arch.
+	archive func(goos, goarch string) (string, bool)
+
+	// digests are the sha256 digests of the archives of the pinned version, keyed by the
+	// GOOS/GOARCH of their platform. they're pinned with the version, run "go run digests.go" in
+	// this dir to print them when the version is bumped.
+	digests map[string]string
+
+	// strip is the number of leading dirs that are removed from the paths in the archive.
+	strip int
+}
+
+var (
+	// Node is the JavaScript runtime that clients and frontends are built with.
+	Node = Tool{
+		Name:    "node",
+		Version: "16.13.0",
+		Bins:    []string{"node", "npm", "npx"},
+		archive: func(goos, goarch string) (string, bool) {
+			arch, ok := map[string]string{"amd64": "x64", "arm64": "arm64"}[goarch]
+			if !ok || (goos != "linux" && goos != "darwin") {
+				return "", false
+			}
+			return fmt.Sprintf("https://nodejs.org/dist/v16.13.0/node-v16.13.0-%s-%s.tar.gz", goos, arch), true
+		},
+		digests: map[string]string{},
+		strip:   1,
+	}
+
+	// Protoc is the compiler of proto files that code is generated with.
+	Protoc = Tool{
+		Name:    "protoc",
+		Version: "3.19.1",
+		Bins:    []string{"protoc"},
+		archive: func(goos, goarch string) (string, bool) {
+			var platform string
+			switch {
+			case goos == "linux" && goarch == "amd64":
+				platform = "linux-x86_64"
+			case goos == "linux" && goarch == "arm64":
+				platform = "linux-aarch_64"
+			case goos == "darwin":
+				// there is no build for Apple silicon, the x86_64 one runs on it with Rosetta.
+				platform = "osx-x86_64"
+			default:
+				return "", false
+			}
+			return fmt.Sprintf("https://github.com/protocolbuffers/protobuf/releases/download/v3.19.1/protoc-3.19.1-%s.zip", platform), true
+		},
+		digests: map[string]string{},
+	}
+
+	// Buf is the tool that proto files are linted with and proto dependencies are resolved with.
+	Buf = Tool{
+		Name:    "buf",
+		Version: "1.0.0-rc8",
+		Bins:    []string{"buf"},
+		archive: func(goos, goarch string) (string, bool) {
+			osName, ok := map[string]string{"linux": "Linux", "darwin": "Darwin"}[goos]
+			if !ok {
+				return "", false
+			}
+			arch, ok := map[string]string{"amd64": "x86_64", "arm64": "arm64"}[goarch]
+			if !ok {
+				return "", false
+			}
+			if goos == "linux" && goarch == "arm64" {
+				arch = "aarch64"
+			}
+			return fmt.Sprintf("https://github.com/bufbuild/buf/releases/download/v1.0.0-rc8/buf-%s-%s.tar.gz", osName, arch), true
+		},
+		digests: map[string]string{},
+		strip:   1,
+	}
+)
+
+// Platforms are the GOOS/GOARCH of the platforms that tools can be installed on.
+var Platforms = []string{"linux/amd64", "linux/arm64", "darwin/amd64", "darwin/arm64"}
+
+// Tools are the tools that can be installed.
+var Tools = []Tool{Node, Protoc, Buf}
+
+// Find returns the tool with name.
+func Find(name string) (Tool, error) {
+	for _, t := range Tools {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+	var names []string
+	for _, t := range Tools {
+		names = append(names, t.Name)
+	}
+	return Tool{}, fmt.Errorf("unknown tool %q, the tools are: %s", name, strings.Join(names, ", "))
+}
+
+// Dir returns the dir where the pinned version of the tool is installed.
+func (t Tool) Dir() string {
+	return filepath.Join(Home, t.Name+"-"+t.Version)
+}
+
+// BinDir returns the dir of the binaries of the installed tool.
+func (t Tool) BinDir() string {
+	return filepath.Join(t.Dir(), "bin")
+}
+
+// IsInstalled checks if the pinned version of the tool is installed.
+func (t Tool) IsInstalled() bool {
+	for _, bin := range t.Bins {
+		if _, err := os.Stat(filepath.Join(t.BinDir(), bin)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Archive returns the url of the archive of the pinned version of the tool for an OS and an arch,
+// false is returned when the tool cannot be installed on the platform.
+func (t Tool) Archive(goos, goarch string) (string, bool) {
+	return t.archive(goos, goarch)
+}
+
+// Install downloads the pinned version of the tool for the current platform and installs it,
+// the archive is verified with its pinned digest. a tool that's already installed is installed
+// again.
+func (t Tool) Install(ctx context.Context) error {
+	platform := runtime.GOOS + "/" + runtime.GOARCH
+	url, ok := t.archive(runtime.GOOS, runtime.GOARCH)
+	if !ok {
+		return fmt.Errorf("%s cannot be installed on %s", t.Name, platform)
+	}
+	digest, ok := t.digests[platform]
+	if !ok {
+		return fmt.Errorf("%s %s has no pinned digest for %s", t.Name, t.Version, platform)
+	}
+
+	archive, err := download(ctx, url)
+	if err != nil {
+		return err
+	}
+	if err := selfupdate.VerifyArchive(archive, digest); err != nil {
+		return fmt.Errorf("%s: %w", filepath.Base(url), err)
+	}
+
+	if err := os.MkdirAll(Home, 0755); err != nil {
+		return err
+	}
+
+	// the tool is extracted next to its dir and moved to it, so it's never partially installed.
+	tmp, err := ioutil.TempDir(Home, "."+t.Name+"-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	if strings.HasSuffix(url, ".zip") {
+		err = extractZip(archive, tmp, t.strip)
+	} else {
+		err = extractTarGz(archive, tmp, t.strip)
+	}
+	if err != nil {
+		return fmt.Errorf("cannot extract %s: %w", filepath.Base(url), err)
+	}
+
+	if err := os.RemoveAll(t.Dir()); err != nil {
+		return err
+	}
+	return os.Rename(tmp, t.Dir())
+}
+
+// ConfigurePath prepends the bin dirs of the installed tools to PATH, so they're run instead of
+// the ones in PATH.
+func ConfigurePath() error {
+	var dirs []string
+	for _, t := range Tools {
+		if t.IsInstalled() {
+			dirs = append(dirs, t.BinDir())
+		}
+	}
+	if len(dirs) == 0 {
+		return nil
+	}
+	dirs = append(dirs, os.Getenv("PATH"))
+	return os.Setenv("PATH", strings.Join(dirs, string(os.PathListSeparator)))
+}
+
+// ProtocCommand returns the command to run protoc with the include dir of its well-known types,
+// the installed protoc is used when there is one, otherwise the one bundled with Starport.
+func ProtocCommand() (command protoc.Cmd, cleanup func(), err error) {
+	if !Protoc.IsInstalled() {
+		return protoc.Command()
+	}
+	include := filepath.Join(Protoc.Dir(), "include")
+	command = protoc.Cmd{
+		Command:  []string{filepath.Join(Protoc.BinDir(), "protoc"), "-I", include},
+		Included: []string{include},
+	}
+	return command, func() {}, nil
+}
+
+func download(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot download %s: %s", url, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
@@ -0,0 +1,145 @@
+package toolchain
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTarGz(t *testing.T, headers ...tar.Header) []byte {
+	var b bytes.Buffer
+	gz := gzip.NewWriter(&b)
+	tw := tar.NewWriter(gz)
+	for _, h := range headers {
+		h := h
+		content := h.Linkname
+		if h.Typeflag == tar.TypeReg {
+			h.Size = int64(len(h.Name))
+			content = h.Name
+		}
+		require.NoError(t, tw.WriteHeader(&h))
+		if h.Typeflag == tar.TypeReg {
+			_, err := tw.Write([]byte(content))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return b.Bytes()
+}
+
+func newTool(t *testing.T, archive []byte, digest string) Tool {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.Write(archive) }))
+	t.Cleanup(server.Close)
+
+	return Tool{
+		Name:    "tool",
+		Version: "1.0.0",
+		Bins:    []string{"tool", "tool-link"},
+		archive: func(string, string) (string, bool) { return server.URL + "/tool.tar.gz", true },
+		digests: map[string]string{runtime.GOOS + "/" + runtime.GOARCH: digest},
+		strip:   1,
+	}
+}
+
+func TestInstall(t *testing.T) {
+	Home = t.TempDir()
+
+	archive := newTarGz(t,
+		tar.Header{Name: "tool-1.0.0/", Typeflag: tar.TypeDir, Mode: 0755},
+		tar.Header{Name: "tool-1.0.0/bin/tool", Typeflag: tar.TypeReg, Mode: 0755},
+		tar.Header{Name: "tool-1.0.0/bin/tool-link", Typeflag: tar.TypeSymlink, Linkname: "tool"},
+	)
+	sum := sha256.Sum256(archive)
+	tool := newTool(t, archive, fmt.Sprintf("%x", sum))
+
+	require.False(t, tool.IsInstalled())
+	require.NoError(t, tool.Install(context.Background()))
+	require.True(t, tool.IsInstalled())
+
+	content, err := os.ReadFile(filepath.Join(tool.BinDir(), "tool-link"))
+	require.NoError(t, err)
+	require.Equal(t, "tool-1.0.0/bin/tool", string(content))
+
+	info, err := os.Stat(filepath.Join(tool.BinDir(), "tool"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0755), info.Mode().Perm())
+}
+
+func TestInstallChecksumMismatch(t *testing.T) {
+	Home = t.TempDir()
+
+	archive := newTarGz(t, tar.Header{Name: "tool-1.0.0/bin/tool", Typeflag: tar.TypeReg, Mode: 0755})
+	tool := newTool(t, archive, strings.Repeat("0", 64))
+
+	require.Error(t, tool.Install(context.Background()))
+	require.False(t, tool.IsInstalled())
+}
+
+func TestInstallNoDigest(t *testing.T) {
+	Home = t.TempDir()
+
+	tool := newTool(t, nil, "")
+	tool.digests = nil
+
+	err := tool.Install(context.Background())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "has no pinned digest")
+}
+
+func TestInstallOutsideOfArchive(t *testing.T) {
+	Home = t.TempDir()
+
+	for _, header := range []tar.Header{
+		{Name: "tool-1.0.0/../../tool", Typeflag: tar.TypeReg, Mode: 0755},
+		{Name: "tool-1.0.0/bin/tool", Typeflag: tar.TypeSymlink, Linkname: "../../../tool"},
+	} {
+		archive := newTarGz(t, header)
+		sum := sha256.Sum256(archive)
+		tool := newTool(t, archive, fmt.Sprintf("%x", sum))
+
+		require.Error(t, tool.Install(context.Background()))
+	}
+}
+
+func TestConfigurePath(t *testing.T) {
+	Home = t.TempDir()
+	t.Setenv("PATH", "/usr/bin")
+
+	require.NoError(t, ConfigurePath())
+	require.Equal(t, "/usr/bin", os.Getenv("PATH"))
+
+	require.NoError(t, os.MkdirAll(Buf.BinDir(), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(Buf.BinDir(), "buf"), nil, 0755))
+
+	require.NoError(t, ConfigurePath())
+	require.Equal(t, Buf.BinDir()+string(os.PathListSeparator)+"/usr/bin", os.Getenv("PATH"))
+}
+
+// TestDigests fails when a tool that can be installed on a platform has no pinned digest for it,
+// run "go run digests.go" in this dir to print the digests of the pinned versions.
+func TestDigests(t *testing.T) {
+	for _, tool := range Tools {
+		for _, platform := range Platforms {
+			parts := strings.SplitN(platform, "/", 2)
+			if _, ok := tool.Archive(parts[0], parts[1]); !ok {
+				continue
+			}
+			digest, ok := tool.digests[platform]
+			require.Truef(t, ok, "%s %s has no pinned digest for %s", tool.Name, tool.Version, platform)
+			require.Regexpf(t, "^[0-9a-f]{64}$", digest, "the digest of %s %s for %s is not a sha256 digest", tool.Name, tool.Version, platform)
+		}
+	}
+}
@@ -0,0 +1,389 @@
+// Package trinosdk runs the operations of Starport in process, such as scaffolding, building,
+// serving chains, generating their code and relaying packets between them, so programs like
+// developer portals can drive them without running the CLI.
+//
+// operations are canceled through their contexts, they report what they do with events instead
+// of writing to stdout and they return errors instead of exiting.
+package trinosdk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	starportaccount "github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/pkg/relayer"
+	"github.com/tendermint/starport/starport/services/scaffolder"
+	"github.com/trino-network/trino/pkg/chaincmd"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+	"github.com/trino-network/trino/pkg/servehook"
+	"github.com/trino-network/trino/services/chain"
+)
+
+// DefaultAddressPrefix is the address prefix of scaffolded chains.
+const DefaultAddressPrefix = "cosmos"
+
+// EventType is the type of an event.
+type EventType string
+
+const (
+	// EventLog is a line logged by an operation.
+	EventLog EventType = "log"
+
+	// EventFinished is when an operation is done, Error is set when it failed.
+	EventFinished EventType = "finished"
+)
+
+// Event is an event of an operation. the lifecycle events of served chains have the types of
+// servehook.EventType, with Serve set.
+type Event struct {
+	Type EventType
+	Time time.Time
+
+	// Operation is the name of the operation, such as build or serve.
+	Operation string
+
+	// Message describes the event.
+	Message string
+
+	// Error is why the operation failed for finished.
+	Error error
+
+	// Serve is the event of the served chain for the lifecycle events of serve.
+	Serve *servehook.Event
+}
+
+// Option configures operations.
+type Option func(*options)
+
+type options struct {
+	events          chan<- Event
+	keyringBackend  cosmosaccount.KeyringBackend
+	home            string
+	configFile      string
+	addressPrefix   string
+	noDefaultModule bool
+	resetOnce       bool
+	forceReset      bool
+	targets         []chain.GenerateTarget
+}
+
+func newOptions(opts []Option) options {
+	o := options{
+		addressPrefix: DefaultAddressPrefix,
+	}
+	for _, apply := range opts {
+		apply(&o)
+	}
+	return o
+}
+
+// WithEvents sends the events of operations to events. sends block until the events are received
+// or the context of the operation is canceled. the finished event is always sent last, even once
+// the context is canceled, so events must be received until then.
+func WithEvents(events chan<- Event) Option {
+	return func(o *options) {
+		o.events = events
+	}
+}
+
+// WithKeyringBackend sets the keyring backend of the accounts of chains and of the relayer.
+func WithKeyringBackend(backend cosmosaccount.KeyringBackend) Option {
+	return func(o *options) {
+		o.keyringBackend = backend
+	}
+}
+
+// WithHome sets the home dir of served chains.
+func WithHome(path string) Option {
+	return func(o *options) {
+		o.home = path
+	}
+}
+
+// WithConfigFile sets the config file of chains, instead of the config.yml of the chain.
+func WithConfigFile(path string) Option {
+	return func(o *options) {
+		o.configFile = path
+	}
+}
+
+// WithAddressPrefix sets the address prefix of scaffolded chains.
+func WithAddressPrefix(prefix string) Option {
+	return func(o *options) {
+		o.addressPrefix = prefix
+	}
+}
+
+// WithoutDefaultModule scaffolds chains without their default module.
+func WithoutDefaultModule() Option {
+	return func(o *options) {
+		o.noDefaultModule = true
+	}
+}
+
+// WithResetOnce resets the state of served chains the first time they start.
+func WithResetOnce() Option {
+	return func(o *options) {
+		o.resetOnce = true
+	}
+}
+
+// WithForceReset resets the state of served chains every time they restart.
+func WithForceReset() Option {
+	return func(o *options) {
+		o.forceReset = true
+	}
+}
+
+// WithTargets sets what Generate generates, instead of the clients of the config of the chain.
+func WithTargets(targets ...chain.GenerateTarget) Option {
+	return func(o *options) {
+		o.targets = append(o.targets, targets...)
+	}
+}
+
+// ScaffoldChain scaffolds a chain with name, a Go module path, into the dir at path and returns
+// the dir of the chain.
+func ScaffoldChain(ctx context.Context, name, path string, opts ...Option) (appPath string, err error) {
+	o := newOptions(opts)
+	e := newEmitter(ctx, o, "scaffold-chain")
+	defer func() { e.finished(err) }()
+
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	e.log("Scaffolding " + name)
+	return scaffolder.Init(placeholder.New(), path, name, o.addressPrefix, o.noDefaultModule)
+}
+
+// Build builds the chain at appPath, its binary is installed into output or into the Go bin
+// dir when output is empty. the name of the binary is returned.
+func Build(ctx context.Context, appPath, output string, opts ...Option) (binary string, err error) {
+	o := newOptions(opts)
+	e := newEmitter(ctx, o, "build")
+	defer func() { e.finished(err) }()
+
+	c, err := newChain(appPath, o, e)
+	if err != nil {
+		return "", err
+	}
+	return c.Build(ctx, output)
+}
+
+// Generate generates code from the proto files of the chain at appPath, the clients that are
+// configured in its config are generated unless targets are set with WithTargets.
+func Generate(ctx context.Context, appPath string, opts ...Option) (err error) {
+	o := newOptions(opts)
+	e := newEmitter(ctx, o, "generate")
+	defer func() { e.finished(err) }()
+
+	c, err := newChain(appPath, o, e)
+	if err != nil {
+		return err
+	}
+	if len(o.targets) == 0 {
+		return c.GenerateClients(ctx)
+	}
+	return c.Generate(ctx, o.targets[0], o.targets[1:]...)
+}
+
+// Serve builds, starts and rebuilds the chain at appPath on changes until ctx is canceled.
+// nil is returned once the chain is stopped by the cancellation of ctx.
+func Serve(ctx context.Context, appPath string, opts ...Option) (err error) {
+	o := newOptions(opts)
+	e := newEmitter(ctx, o, "serve")
+	defer func() { e.finished(err) }()
+
+	c, err := newChain(appPath, o, e)
+	if err != nil {
+		return err
+	}
+
+	serveOptions := []chain.ServeOption{
+		chain.ServeHooks(servehook.HookFunc(func(_ context.Context, event servehook.Event) error {
+			e.serve(event)
+			return nil
+		})),
+	}
+	if o.resetOnce {
+		serveOptions = append(serveOptions, chain.ServeResetOnce())
+	}
+	if o.forceReset {
+		serveOptions = append(serveOptions, chain.ServeForceReset())
+	}
+
+	err = c.Serve(ctx, serveOptions...)
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
+
+// RelayerLink links the chains of the relayer paths with ids, all paths are linked when no ids
+// are given. paths that are linked already are skipped.
+func RelayerLink(ctx context.Context, ids []string, opts ...Option) (err error) {
+	o := newOptions(opts)
+	e := newEmitter(ctx, o, "relayer-link")
+	defer func() { e.finished(err) }()
+
+	r, ids, err := newRelayer(ctx, ids, o)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		e.log("Linking the chains of " + id)
+		if err := r.Link(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RelayerStart relays packets between the chains of the linked relayer paths with ids until ctx
+// is canceled, all paths are relayed when no ids are given. nil is returned once relaying is
+// stopped by the cancellation of ctx.
+func RelayerStart(ctx context.Context, ids []string, opts ...Option) (err error) {
+	o := newOptions(opts)
+	e := newEmitter(ctx, o, "relayer-start")
+	defer func() { e.finished(err) }()
+
+	r, ids, err := newRelayer(ctx, ids, o)
+	if err != nil {
+		return err
+	}
+	e.log("Relaying packets between the chains of the paths")
+	err = r.Start(ctx, ids...)
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
+
+func newChain(appPath string, o options, e *emitter) (*chain.Chain, error) {
+	chainOptions := []chain.Option{
+		chain.LogWriter(e),
+	}
+	if o.keyringBackend != "" {
+		chainOptions = append(chainOptions, chain.KeyringBackend(chaincmd.KeyringBackend(o.keyringBackend)))
+	}
+	if o.home != "" {
+		chainOptions = append(chainOptions, chain.HomePath(o.home))
+	}
+	if o.configFile != "" {
+		chainOptions = append(chainOptions, chain.ConfigFile(o.configFile))
+	}
+	return chain.New(appPath, chainOptions...)
+}
+
+// newRelayer returns the relayer with the accounts of the keyring of o, and ids or the ids of all
+// paths when there are no ids.
+func newRelayer(ctx context.Context, ids []string, o options) (relayer.Relayer, []string, error) {
+	var accountOptions []cosmosaccount.Option
+	if o.keyringBackend != "" {
+		accountOptions = append(accountOptions, cosmosaccount.WithKeyringBackend(o.keyringBackend))
+	}
+	ca, err := cosmosaccount.New(accountOptions...)
+	if err != nil {
+		return relayer.Relayer{}, nil, err
+	}
+	if err := ca.EnsureDefaultAccount(); err != nil {
+		return relayer.Relayer{}, nil, err
+	}
+
+	r := relayer.New(starportaccount.Registry{Keyring: ca.Keyring})
+	if len(ids) > 0 {
+		return r, ids, nil
+	}
+
+	paths, err := r.ListPaths(ctx)
+	if err != nil {
+		return relayer.Relayer{}, nil, err
+	}
+	for _, path := range paths {
+		ids = append(ids, path.ID)
+	}
+	return r, ids, nil
+}
+
+// emitter sends the events of an operation, it's the writer of the logs of the operation.
+type emitter struct {
+	ctx       context.Context
+	events    chan<- Event
+	operation string
+
+	mu   sync.Mutex
+	line []byte
+}
+
+func newEmitter(ctx context.Context, o options, operation string) *emitter {
+	return &emitter{
+		ctx:       ctx,
+		events:    o.events,
+		operation: operation,
+	}
+}
+
+// Write sends each complete line of p as a log event.
+func (e *emitter) Write(p []byte) (int, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.line = append(e.line, p...)
+	for {
+		i := bytes.IndexByte(e.line, '\n')
+		if i < 0 {
+			break
+		}
+		if line := bytes.TrimSpace(e.line[:i]); len(line) > 0 {
+			e.log(string(line))
+		}
+		e.line = e.line[i+1:]
+	}
+	return len(p), nil
+}
+
+func (e *emitter) log(message string) {
+	e.send(Event{Type: EventLog, Message: message})
+}
+
+func (e *emitter) serve(event servehook.Event) {
+	e.send(Event{Type: EventType(event.Type), Message: event.Text(), Serve: &event})
+}
+
+func (e *emitter) finished(err error) {
+	e.mu.Lock()
+	line := bytes.TrimSpace(e.line)
+	e.line = nil
+	e.mu.Unlock()
+	if len(line) > 0 {
+		e.log(string(line))
+	}
+
+	message := e.operation + " is done"
+	if err != nil {
+		message = e.operation + " failed: " + err.Error()
+	}
+	e.send(Event{Type: EventFinished, Message: message, Error: err})
+}
+
+func (e *emitter) send(event Event) {
+	if e.events == nil {
+		return
+	}
+	event.Time = time.Now()
+	event.Operation = e.operation
+
+	// the finished event is sent even when the operation is canceled.
+	if event.Type == EventFinished {
+		e.events <- event
+		return
+	}
+	select {
+	case e.events <- event:
+	case <-e.ctx.Done():
+	}
+}
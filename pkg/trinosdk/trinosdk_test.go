@@ -0,0 +1,60 @@
+package trinosdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmitter(t *testing.T) {
+	events := make(chan Event, 10)
+	e := newEmitter(context.Background(), newOptions([]Option{WithEvents(events)}), "build")
+
+	fmt.Fprint(e, "🛠️  Building proto...\n📦 Installing")
+	fmt.Fprint(e, " dependencies...\n\n")
+	fmt.Fprint(e, "🛠️  Building the blockchain...")
+	e.finished(nil)
+	close(events)
+
+	var got []string
+	for event := range events {
+		require.Equal(t, "build", event.Operation)
+		got = append(got, string(event.Type)+": "+event.Message)
+	}
+	require.Equal(t, []string{
+		"log: 🛠️  Building proto...",
+		"log: 📦 Installing dependencies...",
+		"log: 🛠️  Building the blockchain...",
+		"finished: build is done",
+	}, got)
+}
+
+func TestEmitterCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	events := make(chan Event)
+	e := newEmitter(ctx, newOptions([]Option{WithEvents(events)}), "serve")
+
+	// logs are dropped once the context is canceled, the finished event is not.
+	e.log("🌍 Tendermint node: http://0.0.0.0:26657")
+	go e.finished(context.Canceled)
+
+	event := <-events
+	require.Equal(t, EventFinished, event.Type)
+	require.ErrorIs(t, event.Error, context.Canceled)
+}
+
+func TestGenerateError(t *testing.T) {
+	events := make(chan Event, 10)
+
+	err := Generate(context.Background(), t.TempDir(), WithEvents(events))
+	require.Error(t, err)
+
+	event := <-events
+	require.Equal(t, EventFinished, event.Type)
+	require.Equal(t, "generate", event.Operation)
+	require.Equal(t, err, event.Error)
+}
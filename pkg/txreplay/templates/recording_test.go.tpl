@@ -0,0 +1,37 @@
+package tests
+
+import "testing"
+
+// Test<% .Name.UpperCamel %> replays the txs recorded by starport chain record <% .Name.Original %>, from
+// the block at height <% .From %> to the block at height <% .To %>.
+func Test<% .Name.UpperCamel %>(t *testing.T) {
+	net := replay(t, <% .Name.LowerCamel %>Addresses, <% .Name.LowerCamel %>Denoms, <% .Name.LowerCamel %>Txs)
+
+	// check the state of the chain once the txs are replayed with the clients of net.
+	_ = net
+}
+
+var <% .Name.LowerCamel %>Addresses = []recordedAddress{
+<%- range .Addresses %>
+	{kind: <% .Kind %>, bytes: "<% .Bytes %>"}, // <% .Original %>
+<%- end %>
+}
+
+var <% .Name.LowerCamel %>Denoms = []string{<% range $i, $denom := .Denoms %><% if $i %>, <% end %><% quote $denom %><% end %>}
+
+var <% .Name.LowerCamel %>Txs = []recordedTx{
+<%- range .Txs %>
+	{
+		hash: "<% .Hash %>",
+		messages: []string{
+		<%- range .Messages %>
+			<% quote . %>,
+		<%- end %>
+		},
+		fee:    <% quote .Fee %>,
+		gas:    <% .Gas %>,
+		code:   <% .Code %>,
+		events: <% quote .Events %>,
+	},
+<%- end %>
+}
@@ -0,0 +1,305 @@
+// Package txreplay turns the txs of a dev session, as indexed by the explorer of chain serve, into
+// Go tests that replay them in an in-process network of the chain and check their results.
+//
+// the addresses of the recorded txs are replaced in the tests: the signers by new accounts that
+// are funded in the genesis of the network, the validator of the dev chain by the validator of the
+// network, and other accounts by the same accounts with the address prefix of the network.
+package txreplay
+
+import (
+	"bytes"
+	"embed"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/trino-network/trino/pkg/explorer"
+)
+
+const (
+	// Dir is the dir of the tests in the chain.
+	Dir = "tests"
+
+	// helpersFile is the file of the replay helpers in Dir, it's written again with each test.
+	helpersFile = "replay_test.go"
+
+	// secp256k1PubKey is the only type of public key that recorded txs can be signed with.
+	secp256k1PubKey = "/cosmos.crypto.secp256k1.PubKey"
+)
+
+// ErrNoTxs is returned when there are no txs to record.
+var ErrNoTxs = errors.New("no txs are recorded")
+
+//go:embed templates/*.tpl
+var templatesFS embed.FS
+
+var templates = template.Must(
+	template.New("").
+		Delims("<%", "%>").
+		Funcs(template.FuncMap{"quote": quote}).
+		ParseFS(templatesFS, "templates/*.tpl"),
+)
+
+// kinds of the recorded addresses, they're the names of the constants of the replay helpers.
+const (
+	kindAccount          = "addressAccount"
+	kindSigner           = "addressSigner"
+	kindValidator        = "addressValidator"
+	kindValidatorAccount = "addressValidatorAccount"
+)
+
+type recording struct {
+	Name       multiformatname.Name
+	ModulePath string
+	From, To   int64
+	Addresses  []*address
+	Denoms     []string
+	Txs        []tx
+}
+
+type address struct {
+	Kind     string
+	Bytes    string
+	Original string
+}
+
+type tx struct {
+	Hash     string
+	Messages []string
+	Fee      string
+	Gas      int64
+	Code     uint32
+	Events   string
+}
+
+// TestPath returns the path of the test of the recording with name in the chain at appPath.
+func TestPath(appPath, name string) (string, error) {
+	n, err := multiformatname.NewName(name)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(appPath, Dir, n.Snake+"_test.go"), nil
+}
+
+// Write writes the test of the recording with name of txs, which are recorded from the block at
+// height from to the block at height to, into the chain at appPath with the Go module modulePath.
+// the path of the test is returned.
+func Write(appPath, modulePath, name string, from, to int64, txs []explorer.Tx) (path string, err error) {
+	if len(txs) == 0 {
+		return "", ErrNoTxs
+	}
+	if _, err := os.Stat(filepath.Join(appPath, "testutil", "network")); err != nil {
+		return "", errors.New("the chain has no testutil/network package to replay txs in")
+	}
+
+	n, err := multiformatname.NewName(name)
+	if err != nil {
+		return "", err
+	}
+	r, err := newRecording(txs)
+	if err != nil {
+		return "", err
+	}
+	r.Name = n
+	r.ModulePath = modulePath
+	r.From = from
+	r.To = to
+
+	dir := filepath.Join(appPath, Dir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	if err := execute(filepath.Join(dir, helpersFile), "replay_test.go.tpl", r); err != nil {
+		return "", err
+	}
+	path = filepath.Join(dir, n.Snake+"_test.go")
+	return path, execute(path, "recording_test.go.tpl", r)
+}
+
+func execute(path, name string, r recording) error {
+	var buf bytes.Buffer
+	if err := templates.ExecuteTemplate(&buf, name, r); err != nil {
+		return err
+	}
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, code, 0644)
+}
+
+// newRecording returns the recording of txs, with their addresses replaced by placeholders.
+func newRecording(txs []explorer.Tx) (recording, error) {
+	var (
+		r          recording
+		addresses  = make(map[string]int)
+		signers    = make(map[string]bool)
+		validators = make(map[string]bool)
+		denoms     = make(map[string]bool)
+	)
+
+	// replace replaces the addresses in v with their placeholders and collects its denoms.
+	var replace func(v interface{}) interface{}
+	replace = func(v interface{}) interface{} {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			// the keys are sorted so addresses are numbered in the same order every time.
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				if denom, ok := v[key].(string); ok && key == "denom" {
+					denoms[denom] = true
+				}
+				v[key] = replace(v[key])
+			}
+		case []interface{}:
+			for i, value := range v {
+				v[i] = replace(value)
+			}
+		case string:
+			hrp, bytes, err := bech32.DecodeAndConvert(v)
+			if err != nil || (len(bytes) != 20 && len(bytes) != 32) {
+				return v
+			}
+			kind := kindAccount
+			switch {
+			case strings.HasSuffix(hrp, "valoper"):
+				kind = kindValidator
+				validators[hex.EncodeToString(bytes)] = true
+			case strings.HasSuffix(hrp, "valcons") || strings.HasSuffix(hrp, "pub"):
+				return v
+			}
+			i, ok := addresses[v]
+			if !ok {
+				i = len(r.Addresses)
+				addresses[v] = i
+				r.Addresses = append(r.Addresses, &address{Kind: kind, Bytes: hex.EncodeToString(bytes), Original: v})
+			}
+			return fmt.Sprintf("{{address%d}}", i)
+		}
+		return v
+	}
+
+	for _, t := range txs {
+		if t.Body == "" {
+			return recording{}, fmt.Errorf("tx %s is not decoded, it cannot be replayed", t.Hash)
+		}
+
+		var body struct {
+			Body struct {
+				Messages []interface{} `json:"messages"`
+			} `json:"body"`
+			AuthInfo struct {
+				SignerInfos []struct {
+					PublicKey struct {
+						Type string `json:"@type"`
+						Key  string `json:"key"`
+					} `json:"public_key"`
+				} `json:"signer_infos"`
+				Fee struct {
+					Amount []struct {
+						Denom  string `json:"denom"`
+						Amount string `json:"amount"`
+					} `json:"amount"`
+					GasLimit string `json:"gas_limit"`
+				} `json:"fee"`
+			} `json:"auth_info"`
+		}
+		d := json.NewDecoder(strings.NewReader(t.Body))
+		d.UseNumber()
+		if err := d.Decode(&body); err != nil {
+			return recording{}, fmt.Errorf("tx %s: %w", t.Hash, err)
+		}
+
+		for _, info := range body.AuthInfo.SignerInfos {
+			if info.PublicKey.Type != secp256k1PubKey {
+				return recording{}, fmt.Errorf("tx %s is signed with a %s key, only secp256k1 keys can be replayed", t.Hash, info.PublicKey.Type)
+			}
+			key, err := base64.StdEncoding.DecodeString(info.PublicKey.Key)
+			if err != nil {
+				return recording{}, fmt.Errorf("tx %s: %w", t.Hash, err)
+			}
+			signers[hex.EncodeToString((&secp256k1.PubKey{Key: key}).Address())] = true
+		}
+
+		replayed := tx{
+			Hash: t.Hash,
+			Gas:  t.GasWanted,
+			Code: t.Code,
+		}
+		if gas, err := strconv.ParseInt(body.AuthInfo.Fee.GasLimit, 10, 64); err == nil {
+			replayed.Gas = gas
+		}
+		var fee []string
+		for _, coin := range body.AuthInfo.Fee.Amount {
+			denoms[coin.Denom] = true
+			fee = append(fee, coin.Amount+coin.Denom)
+		}
+		replayed.Fee = strings.Join(fee, ",")
+
+		for _, msg := range body.Body.Messages {
+			b, err := json.MarshalIndent(replace(msg), "", "  ")
+			if err != nil {
+				return recording{}, err
+			}
+			replayed.Messages = append(replayed.Messages, string(b))
+		}
+
+		// the raw log of txs that succeeded is the events of each of their messages.
+		var logs []struct {
+			Events []interface{} `json:"events"`
+		}
+		if t.Code == 0 && json.Unmarshal([]byte(t.Log), &logs) == nil {
+			events := make([]interface{}, len(logs))
+			for i, log := range logs {
+				events[i] = replace(log.Events)
+			}
+			b, err := json.MarshalIndent(events, "", "  ")
+			if err != nil {
+				return recording{}, err
+			}
+			replayed.Events = string(b)
+		}
+
+		r.Txs = append(r.Txs, replayed)
+	}
+
+	for _, a := range r.Addresses {
+		switch {
+		case a.Kind != kindAccount:
+		case signers[a.Bytes]:
+			a.Kind = kindSigner
+		case validators[a.Bytes]:
+			a.Kind = kindValidatorAccount
+		}
+	}
+	for denom := range denoms {
+		r.Denoms = append(r.Denoms, denom)
+	}
+	sort.Strings(r.Denoms)
+
+	return r, nil
+}
+
+// quote quotes s as a raw string when it can be one.
+func quote(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
@@ -0,0 +1,112 @@
+package txreplay
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/stretchr/testify/require"
+	"github.com/trino-network/trino/pkg/explorer"
+)
+
+// newTx returns a tx that delegates to validator from the account of key, with the events of
+// its message.
+func newTx(t *testing.T, key *secp256k1.PrivKey, validator []byte) explorer.Tx {
+	delegator, err := bech32.ConvertAndEncode("mars", key.PubKey().Address())
+	require.NoError(t, err)
+	operator, err := bech32.ConvertAndEncode("marsvaloper", validator)
+	require.NoError(t, err)
+
+	return explorer.Tx{
+		Hash: "AB12",
+		Body: fmt.Sprintf(`{
+  "body": {"messages": [{"@type": "/cosmos.staking.v1beta1.MsgDelegate", "delegator_address": %q, "validator_address": %q, "amount": {"denom": "stake", "amount": "10"}}]},
+  "auth_info": {
+    "signer_infos": [{"public_key": {"@type": "/cosmos.crypto.secp256k1.PubKey", "key": %q}}],
+    "fee": {"amount": [{"denom": "token", "amount": "5"}], "gas_limit": "300000"}
+  }
+}`, delegator, operator, base64.StdEncoding.EncodeToString(key.PubKey().Bytes())),
+		Log:       fmt.Sprintf(`[{"msg_index":0,"events":[{"type":"delegate","attributes":[{"key":"validator","value":%q}]}]}]`, operator),
+		GasWanted: 300000,
+	}
+}
+
+func TestNewRecording(t *testing.T) {
+	key := secp256k1.GenPrivKey()
+	validator := secp256k1.GenPrivKey().PubKey().Address()
+	validatorAccount, err := bech32.ConvertAndEncode("mars", validator)
+	require.NoError(t, err)
+
+	tx := newTx(t, key, validator)
+	failed := newTx(t, key, validator)
+	failed.Code = 5
+	failed.Log = "insufficient funds"
+
+	r, err := newRecording([]explorer.Tx{tx, failed})
+	require.NoError(t, err)
+
+	require.Len(t, r.Addresses, 2)
+	require.Equal(t, kindSigner, r.Addresses[0].Kind)
+	require.Equal(t, hex.EncodeToString(key.PubKey().Address()), r.Addresses[0].Bytes)
+	require.Equal(t, kindValidator, r.Addresses[1].Kind)
+	require.Equal(t, []string{"stake", "token"}, r.Denoms)
+
+	require.Len(t, r.Txs, 2)
+	require.Equal(t, "5token", r.Txs[0].Fee)
+	require.Equal(t, int64(300000), r.Txs[0].Gas)
+	require.Len(t, r.Txs[0].Messages, 1)
+	require.Contains(t, r.Txs[0].Messages[0], `"delegator_address": "{{address0}}"`)
+	require.Contains(t, r.Txs[0].Messages[0], `"validator_address": "{{address1}}"`)
+	require.Contains(t, r.Txs[0].Events, `"value": "{{address1}}"`)
+	require.Equal(t, uint32(5), r.Txs[1].Code)
+	require.Empty(t, r.Txs[1].Events)
+
+	// the account of the validator is replaced by the account of the validator of the network.
+	r, err = newRecording([]explorer.Tx{newTx(t, key, validator), {Hash: "CD34", Body: fmt.Sprintf(
+		`{"body": {"messages": [{"@type": "/cosmos.bank.v1beta1.MsgSend", "to_address": %q}]}}`, validatorAccount,
+	)}})
+	require.NoError(t, err)
+	require.Len(t, r.Addresses, 3)
+	require.Equal(t, kindValidatorAccount, r.Addresses[2].Kind)
+}
+
+func TestNewRecordingErrors(t *testing.T) {
+	_, err := newRecording([]explorer.Tx{{Hash: "AB12"}})
+	require.EqualError(t, err, "tx AB12 is not decoded, it cannot be replayed")
+
+	_, err = newRecording([]explorer.Tx{{
+		Hash: "AB12",
+		Body: `{"auth_info": {"signer_infos": [{"public_key": {"@type": "/cosmos.crypto.multisig.LegacyAminoPubKey"}}]}}`,
+	}})
+	require.EqualError(t, err, "tx AB12 is signed with a /cosmos.crypto.multisig.LegacyAminoPubKey key, only secp256k1 keys can be replayed")
+}
+
+func TestWrite(t *testing.T) {
+	appPath := t.TempDir()
+	txs := []explorer.Tx{newTx(t, secp256k1.GenPrivKey(), secp256k1.GenPrivKey().PubKey().Address())}
+
+	_, err := Write(appPath, "github.com/alice/mars", "delegate", 1, 3, nil)
+	require.Equal(t, ErrNoTxs, err)
+
+	_, err = Write(appPath, "github.com/alice/mars", "delegate", 1, 3, txs)
+	require.EqualError(t, err, "the chain has no testutil/network package to replay txs in")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(appPath, "testutil", "network"), 0755))
+	path, err := Write(appPath, "github.com/alice/mars", "delegate-twice", 1, 3, txs)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(appPath, "tests", "delegate_twice_test.go"), path)
+
+	test, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(test), "func TestDelegateTwice(t *testing.T) {")
+	require.Contains(t, string(test), "{kind: addressSigner, bytes: ")
+
+	helpers, err := os.ReadFile(filepath.Join(appPath, "tests", "replay_test.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(helpers), `"github.com/alice/mars/testutil/network"`)
+}
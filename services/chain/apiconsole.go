@@ -0,0 +1,60 @@
+package chain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"path/filepath"
+
+	"github.com/tendermint/spm/openapiconsole"
+	"github.com/tendermint/starport/starport/pkg/xhttp"
+	"github.com/tendermint/starport/starport/pkg/xurl"
+	conf "github.com/trino-network/trino/chainconf"
+)
+
+// apiConsoleSpecPath is the path that the OpenAPI spec is served from by the API console.
+const apiConsoleSpecPath = "/openapi.yml"
+
+// openAPISpecPath returns the path of the OpenAPI spec generated for the chain.
+func (c *Chain) openAPISpecPath(config conf.Config) string {
+	path := config.Client.OpenAPI.Path
+	if path == "" {
+		path = defaultOpenAPIPath
+	}
+	return filepath.Join(c.app.Path, path)
+}
+
+// runAPIConsole serves a Swagger UI console for the chain's OpenAPI spec. The spec is read
+// on every request so the console always reflects the latest generated one, other requests
+// are proxied to the API so requests can be tried out from the console.
+func (c *Chain) runAPIConsole(ctx context.Context, config conf.Config) error {
+	apiURL, err := url.Parse(xurl.HTTP(config.Host.API))
+	if err != nil {
+		return err
+	}
+
+	specPath := c.openAPISpecPath(config)
+
+	var (
+		console = openapiconsole.Handler(c.app.Name, apiConsoleSpecPath)
+		proxy   = httputil.NewSingleHostReverseProxy(apiURL)
+	)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			console(w, r)
+		case apiConsoleSpecPath:
+			w.Header().Set("Cache-Control", "no-store")
+			http.ServeFile(w, r, specPath)
+		default:
+			proxy.ServeHTTP(w, r)
+		}
+	})
+
+	return xhttp.Serve(ctx, &http.Server{
+		Addr:    config.Host.APIConsole,
+		Handler: handler,
+	})
+}
@@ -0,0 +1,320 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/pkg/archive"
+	"github.com/pkg/errors"
+	"github.com/tendermint/starport/starport/pkg/checksum"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/exec"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+	"github.com/tendermint/starport/starport/pkg/goanalysis"
+	"github.com/tendermint/starport/starport/pkg/gocmd"
+	"github.com/trino-network/trino/pkg/cmdtrace"
+	"github.com/trino-network/trino/pkg/pipeline"
+	"github.com/trino-network/trino/pkg/servehook"
+)
+
+const (
+	releaseDir  = "release"
+	checksumTxt = "checksum.txt"
+
+	// stages of builds.
+	stageProto        = "proto"
+	stageClients      = "clients"
+	stageDependencies = "dependencies"
+	stageCompile      = "compile"
+)
+
+// Build builds and installs app binaries.
+func (c *Chain) Build(ctx context.Context, output string) (binaryName string, err error) {
+	if err := c.setup(); err != nil {
+		return "", err
+	}
+
+	if err := c.build(ctx, output); err != nil {
+		return "", err
+	}
+
+	return c.Binary()
+}
+
+func (c *Chain) build(ctx context.Context, output string) (err error) {
+	defer func() {
+		var exitErr *exec.ExitError
+
+		if errors.As(err, &exitErr) || errors.Is(err, goanalysis.ErrMultipleMainPackagesFound) {
+			err = &CannotBuildAppError{err}
+		}
+	}()
+
+	conf, err := c.Config()
+	if err != nil {
+		return err
+	}
+
+	// the chain and the clients are built from the generated Go code, so it's generated first.
+	// the clients are generated while the chain is compiled, but the Go client that's generated
+	// with the Go code. they're generated once the dependencies are installed since the proto
+	// files of the dependencies are found from go.mod, which is rewritten by go mod tidy.
+	goTargets := []GenerateTarget{GenerateGo()}
+	if conf.Client.Go.Path != "" {
+		goTargets = append(goTargets, GenerateGoClient())
+	}
+	clientTargets := concurrentClientTargets(conf)
+
+	var buildFlags []string
+
+	stages := []pipeline.Stage{
+		{
+			Name: stageProto,
+			Run: func(ctx context.Context) error {
+				c.step("🛠️  Building proto...", "Generating code from proto files")
+				if err := c.generate(ctx, goTargets...); err != nil {
+					return err
+				}
+				if len(clientTargets) == 0 {
+					c.notifyServeHooks(servehook.Event{Type: servehook.EventCodegenFinished})
+				}
+				return nil
+			},
+		},
+		{
+			Name:  stageDependencies,
+			After: []string{stageProto},
+			Run: func(ctx context.Context) (err error) {
+				buildFlags, err = c.preBuild(ctx)
+				return err
+			},
+		},
+		{
+			Name:  stageCompile,
+			After: []string{stageDependencies},
+			Run: func(ctx context.Context) error {
+				binary, err := c.Binary()
+				if err != nil {
+					return err
+				}
+
+				path, err := c.discoverMain(c.app.Path)
+				if err != nil {
+					return err
+				}
+
+				return gocmd.BuildPath(ctx, output, binary, path, buildFlags, exec.StepOption(cmdtrace.Step()))
+			},
+		},
+	}
+
+	if len(clientTargets) > 0 {
+		stages = append(stages, pipeline.Stage{
+			Name:  stageClients,
+			After: []string{stageDependencies},
+			Run: func(ctx context.Context) error {
+				if err := c.generate(ctx, clientTargets...); err != nil {
+					return err
+				}
+				c.notifyServeHooks(servehook.Event{Type: servehook.EventCodegenFinished})
+				return nil
+			},
+		})
+	}
+
+	timings, err := pipeline.Run(ctx, stages...)
+	if err != nil {
+		return err
+	}
+
+	c.protoBuiltAtLeastOnce = true
+
+	// the step of the compilation is done before the timings are printed below its status line.
+	if c.options.progress != nil {
+		c.options.progress.Stop()
+	}
+	fmt.Fprintf(c.stdLog().out, "⏱  %s\n", formatTimings(timings))
+
+	return nil
+}
+
+// formatTimings returns the time spent in the stages of a build and in the build, the stages
+// are listed by their start.
+func formatTimings(timings []pipeline.Timing) string {
+	var (
+		stages []string
+		total  time.Duration
+	)
+	for _, t := range timings {
+		stages = append(stages, fmt.Sprintf("%s %s", t.Stage, roundTiming(t.Duration)))
+		if end := t.Start + t.Duration; end > total {
+			total = end
+		}
+	}
+	return fmt.Sprintf("Built in %s: %s", roundTiming(total), strings.Join(stages, ", "))
+}
+
+// roundTiming rounds d for the timings of builds.
+func roundTiming(d time.Duration) time.Duration {
+	return d.Round(time.Millisecond * 100)
+}
+
+// BuildRelease builds binaries for a release. targets is a list
+// of GOOS:GOARCH when provided. It defaults to your system when no targets provided.
+// prefix is used as prefix to tarballs containing each target.
+func (c *Chain) BuildRelease(ctx context.Context, output, prefix string, targets ...string) (releasePath string, err error) {
+	if prefix == "" {
+		prefix = c.app.Name
+	}
+	if len(targets) == 0 {
+		targets = []string{gocmd.BuildTarget(runtime.GOOS, runtime.GOARCH)}
+	}
+
+	// prepare for build.
+	if err := c.setup(); err != nil {
+		return "", err
+	}
+
+	buildFlags, err := c.preBuild(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	binary, err := c.Binary()
+	if err != nil {
+		return "", err
+	}
+
+	mainPath, err := c.discoverMain(c.app.Path)
+	if err != nil {
+		return "", err
+	}
+
+	releasePath = output
+	if releasePath == "" {
+		releasePath = filepath.Join(c.app.Path, releaseDir)
+		// reset the release dir.
+		if err := os.RemoveAll(releasePath); err != nil {
+			return "", err
+		}
+	}
+
+	if err := os.MkdirAll(releasePath, 0755); err != nil {
+		return "", err
+	}
+
+	for _, t := range targets {
+		// build binary for a target, tarball it and save it under the release dir.
+		goos, goarch, err := gocmd.ParseTarget(t)
+		if err != nil {
+			return "", err
+		}
+
+		out, err := os.MkdirTemp("", "")
+		if err != nil {
+			return "", err
+		}
+		defer os.RemoveAll(out)
+
+		buildOptions := []exec.Option{
+			exec.StepOption(step.Env(
+				cmdrunner.Env(gocmd.EnvGOOS, goos),
+				cmdrunner.Env(gocmd.EnvGOARCH, goarch),
+			)),
+			exec.StepOption(cmdtrace.Step()),
+		}
+
+		if err := gocmd.BuildPath(ctx, out, binary, mainPath, buildFlags, buildOptions...); err != nil {
+			return "", err
+		}
+
+		tarr, err := archive.Tar(out, archive.Gzip)
+		if err != nil {
+			return "", err
+		}
+
+		tarName := fmt.Sprintf("%s_%s_%s.tar.gz", prefix, goos, goarch)
+		tarPath := filepath.Join(releasePath, tarName)
+
+		tarf, err := os.Create(tarPath)
+		if err != nil {
+			return "", err
+		}
+		defer tarf.Close()
+
+		if _, err := io.Copy(tarf, tarr); err != nil {
+			return "", err
+		}
+		tarf.Close()
+	}
+
+	checksumPath := filepath.Join(releasePath, checksumTxt)
+
+	// create a checksum.txt and return with the path to release dir.
+	return releasePath, checksum.Sum(releasePath, checksumPath)
+}
+
+func (c *Chain) preBuild(ctx context.Context) (buildFlags []string, err error) {
+	chainID, err := c.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	ldflags := gocmd.Ldflags(
+		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.Name=%s", strings.Title(c.app.Name)),
+		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.AppName=%sd", c.app.Name),
+		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.Version=%s", c.sourceVersion.tag),
+		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.Commit=%s", c.sourceVersion.hash),
+		fmt.Sprintf("-X %s/cmd/%s/cmd.ChainID=%s", c.app.ImportPath, c.app.D(), chainID),
+	)
+	buildFlags = []string{
+		gocmd.FlagMod, gocmd.FlagModValueReadOnly,
+		gocmd.FlagLdflags, ldflags,
+	}
+
+	c.step("📦 Installing dependencies...", "Installing dependencies")
+
+	if err := gocmd.ModTidy(ctx, c.app.Path, exec.StepOption(cmdtrace.Step())); err != nil {
+		return nil, err
+	}
+	if err := gocmd.ModVerify(ctx, c.app.Path, exec.StepOption(cmdtrace.Step())); err != nil {
+		return nil, err
+	}
+
+	c.step("🛠️  Building the blockchain...", "Compiling the blockchain")
+
+	return buildFlags, nil
+}
+
+// step starts a step of a build with text when the steps are reported with a progress, log is
+// logged otherwise.
+func (c *Chain) step(log, text string) {
+	if c.options.progress != nil {
+		c.options.progress.Step(text, 0)
+		return
+	}
+	fmt.Fprintln(c.stdLog().out, log)
+}
+
+func (c *Chain) discoverMain(path string) (pkgPath string, err error) {
+	conf, err := c.Config()
+	if err != nil {
+		return "", err
+	}
+
+	if conf.Build.Main != "" {
+		return filepath.Join(c.app.Path, conf.Build.Main), nil
+	}
+
+	path, err = goanalysis.DiscoverOneMain(path)
+	if err == goanalysis.ErrMultipleMainPackagesFound {
+		return "", errors.Wrap(err, "specify the path to your chain's main package in your config.yml>build.main")
+	}
+	return path, err
+}
@@ -0,0 +1,22 @@
+package chain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trino-network/trino/pkg/pipeline"
+)
+
+func TestFormatTimings(t *testing.T) {
+	timings := []pipeline.Timing{
+		{Stage: stageProto, Duration: time.Millisecond * 4120},
+		{Stage: stageDependencies, Start: time.Millisecond * 4120, Duration: time.Millisecond * 1230},
+		{Stage: stageClients, Start: time.Millisecond * 5350, Duration: time.Millisecond * 6310},
+		{Stage: stageCompile, Start: time.Millisecond * 5350, Duration: time.Millisecond * 8940},
+	}
+	require.Equal(t,
+		"Built in 14.3s: proto 4.1s, dependencies 1.2s, clients 6.3s, compile 8.9s",
+		formatTimings(timings),
+	)
+}
@@ -0,0 +1,35 @@
+package chain
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+
+	"github.com/tendermint/starport/starport/pkg/xhttp"
+	conf "github.com/trino-network/trino/chainconf"
+)
+
+// docsPath returns the path of the reference docs site generated for the chain.
+func (c *Chain) docsPath(config conf.Config) string {
+	path := config.Client.Docs.Path
+	if path == "" {
+		path = defaultDocsPath
+	}
+	return filepath.Join(c.app.Path, path)
+}
+
+// runDocs serves the reference docs site generated for the chain's proto files. pages are
+// not cached so the site always reflects the latest generated docs.
+func (c *Chain) runDocs(ctx context.Context, config conf.Config) error {
+	files := http.FileServer(http.Dir(c.docsPath(config)))
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "no-store")
+		files.ServeHTTP(w, r)
+	})
+
+	return xhttp.Serve(ctx, &http.Server{
+		Addr:    config.Host.Docs,
+		Handler: handler,
+	})
+}
@@ -0,0 +1,81 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/tendermint/starport/starport/pkg/xhttp"
+	"github.com/tendermint/starport/starport/pkg/xurl"
+	conf "github.com/trino-network/trino/chainconf"
+	"github.com/trino-network/trino/pkg/explorer"
+	"golang.org/x/sync/errgroup"
+)
+
+// explorerDBFile is the name of the database of the explorer in the home of the chain, so the
+// index is reset with the state of the chain. it's also deleted when the state is restored
+// from an exported genesis, see resetExplorer.
+const explorerDBFile = "explorer.db"
+
+// runExplorer indexes the blocks and txs of the served chain and serves the explorer for them.
+// the explorer is a debugging aid, so its failures are reported without stopping serve.
+func (c *Chain) runExplorer(ctx context.Context, config conf.Config) error {
+	if err := c.serveExplorer(ctx, config); err != nil {
+		fmt.Fprintf(c.stdLog().err, "⚠️  cannot run the explorer: %s\n", err)
+	}
+	return nil
+}
+
+func (c *Chain) serveExplorer(ctx context.Context, config conf.Config) error {
+	path, err := c.explorerDBPath()
+	if err != nil {
+		return err
+	}
+
+	store, err := explorer.Open(path)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	g, ctx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		return explorer.NewIndexer(store, xurl.HTTP(config.Host.RPC), xurl.HTTP(config.Host.API)).Run(ctx)
+	})
+
+	g.Go(func() error {
+		return xhttp.Serve(ctx, &http.Server{
+			Addr:    config.Host.Explorer,
+			Handler: explorer.Handler(store, c.app.Name),
+		})
+	})
+
+	return g.Wait()
+}
+
+func (c *Chain) explorerDBPath() (string, error) {
+	home, err := c.Home()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, explorerDBFile), nil
+}
+
+// resetExplorer deletes the index of the explorer, the blocks that it has indexed are not the
+// blocks of the chain once its state is reset.
+func (c *Chain) resetExplorer() error {
+	path, err := c.explorerDBPath()
+	if err != nil {
+		return err
+	}
+	// the journal of the database is in the -wal and -shm files next to it.
+	for _, p := range []string{path, path + "-wal", path + "-shm"} {
+		if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,486 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
+	"github.com/tendermint/starport/starport/pkg/giturl"
+	"github.com/tendermint/starport/starport/pkg/xurl"
+	conf "github.com/trino-network/trino/chainconf"
+	"github.com/trino-network/trino/pkg/cosmosgen"
+)
+
+const (
+	defaultVuexPath        = "vue/src/store"
+	defaultTSClientPath    = "ts-client"
+	defaultComposablesPath = "vue/src/composables"
+	defaultHooksPath       = "react/src/hooks"
+	defaultPiniaPath       = "vue/src/stores"
+	defaultGoClientPath    = "go-client"
+	defaultDartPath        = "flutter/lib"
+	defaultKotlinPath      = "kotlin-client"
+	defaultSwiftPath       = "swift-client"
+	defaultOpenAPIPath     = "docs/static/openapi.yml"
+	defaultDocsPath        = "docs/proto"
+)
+
+type generateOptions struct {
+	isGoEnabled          bool
+	isGoClientEnabled    bool
+	isVuexEnabled        bool
+	isTSClientEnabled    bool
+	isComposablesEnabled bool
+	isHooksEnabled       bool
+	isPiniaEnabled       bool
+	isDartEnabled        bool
+	isKotlinEnabled      bool
+	isSwiftEnabled       bool
+	isOpenAPIEnabled     bool
+	isDocsEnabled        bool
+	modules              []string
+}
+
+// GenerateTarget is a target to generate code for from proto files.
+type GenerateTarget func(*generateOptions)
+
+// GenerateGo enables generating proto based Go code needed for the chain's source code.
+func GenerateGo() GenerateTarget {
+	return func(o *generateOptions) {
+		o.isGoEnabled = true
+	}
+}
+
+// GenerateGoClient enables generating the standalone Go client for the chain's modules.
+func GenerateGoClient() GenerateTarget {
+	return func(o *generateOptions) {
+		o.isGoEnabled = true
+		o.isGoClientEnabled = true
+	}
+}
+
+// GenerateVuex enables generating proto based Vuex store.
+func GenerateVuex() GenerateTarget {
+	return func(o *generateOptions) {
+		o.isVuexEnabled = true
+	}
+}
+
+// GenerateTSClient enables generating the standalone TypeScript client.
+func GenerateTSClient() GenerateTarget {
+	return func(o *generateOptions) {
+		o.isTSClientEnabled = true
+	}
+}
+
+// GenerateComposables enables generating Vue 3 composables on top of the TypeScript client.
+func GenerateComposables() GenerateTarget {
+	return func(o *generateOptions) {
+		o.isTSClientEnabled = true
+		o.isComposablesEnabled = true
+	}
+}
+
+// GenerateHooks enables generating React hooks on top of the TypeScript client.
+func GenerateHooks() GenerateTarget {
+	return func(o *generateOptions) {
+		o.isTSClientEnabled = true
+		o.isHooksEnabled = true
+	}
+}
+
+// GeneratePinia enables generating Pinia stores on top of the TypeScript client.
+func GeneratePinia() GenerateTarget {
+	return func(o *generateOptions) {
+		o.isTSClientEnabled = true
+		o.isPiniaEnabled = true
+	}
+}
+
+// GenerateDart enables generating Dart client.
+func GenerateDart() GenerateTarget {
+	return func(o *generateOptions) {
+		o.isDartEnabled = true
+	}
+}
+
+// GenerateKotlin enables generating the Kotlin client for Android apps.
+func GenerateKotlin() GenerateTarget {
+	return func(o *generateOptions) {
+		o.isKotlinEnabled = true
+	}
+}
+
+// GenerateSwift enables generating the Swift client for iOS apps.
+func GenerateSwift() GenerateTarget {
+	return func(o *generateOptions) {
+		o.isSwiftEnabled = true
+	}
+}
+
+// GenerateOpenAPI enables generating OpenAPI spec for your chain.
+func GenerateOpenAPI() GenerateTarget {
+	return func(o *generateOptions) {
+		o.isOpenAPIEnabled = true
+	}
+}
+
+// GenerateDocs enables generating the reference docs site for proto files.
+func GenerateDocs() GenerateTarget {
+	return func(o *generateOptions) {
+		o.isDocsEnabled = true
+	}
+}
+
+// GenerateModules limits the generation of Go code and the TypeScript client to the modules
+// with names, code of other modules is left as is.
+func GenerateModules(names ...string) GenerateTarget {
+	return func(o *generateOptions) {
+		o.modules = append(o.modules, names...)
+	}
+}
+
+// GenerateClients makes code generation for the client targets configured in the config.
+func (c *Chain) GenerateClients(ctx context.Context) error {
+	conf, err := c.Config()
+	if err != nil {
+		return err
+	}
+
+	targets := clientTargets(conf)
+	if len(targets) == 0 {
+		return errors.New("no clients are configured to generate, see the client property of config.yml")
+	}
+
+	return c.Generate(ctx, targets[0], targets[1:]...)
+}
+
+// GenerateGoAndClients generates the Go code of the chain and the client targets configured in
+// the config.
+func (c *Chain) GenerateGoAndClients(ctx context.Context) error {
+	conf, err := c.Config()
+	if err != nil {
+		return err
+	}
+
+	return c.Generate(ctx, GenerateGo(), clientTargets(conf)...)
+}
+
+// clientTargets returns the client targets configured in config.
+func clientTargets(config conf.Config) []GenerateTarget {
+	var targets []GenerateTarget
+
+	if config.Client.Go.Path != "" {
+		targets = append(targets, GenerateGoClient())
+	}
+
+	return append(targets, concurrentClientTargets(config)...)
+}
+
+// concurrentClientTargets returns the client targets configured in config that can be generated
+// while the chain is compiled, all but the Go client that's generated with the Go code.
+func concurrentClientTargets(config conf.Config) []GenerateTarget {
+	var targets []GenerateTarget
+
+	if config.Client.VuexPath() != "" {
+		targets = append(targets, GenerateVuex())
+	}
+
+	if config.Client.TypeScript.Path != "" {
+		targets = append(targets, GenerateTSClient())
+	}
+
+	if config.Client.Composables.Path != "" {
+		targets = append(targets, GenerateComposables())
+	}
+
+	if config.Client.Hooks.Path != "" {
+		targets = append(targets, GenerateHooks())
+	}
+
+	if config.Client.PiniaPath() != "" {
+		targets = append(targets, GeneratePinia())
+	}
+
+	if config.Client.Dart.Path != "" {
+		targets = append(targets, GenerateDart())
+	}
+
+	if config.Client.Kotlin.Path != "" {
+		targets = append(targets, GenerateKotlin())
+	}
+
+	if config.Client.Swift.Path != "" {
+		targets = append(targets, GenerateSwift())
+	}
+
+	if config.Client.OpenAPI.Path != "" {
+		targets = append(targets, GenerateOpenAPI())
+	}
+
+	if config.Client.Docs.Path != "" {
+		targets = append(targets, GenerateDocs())
+	}
+
+	return targets
+}
+
+// Generate makes code generation from proto files for given target and additionalTargets.
+func (c *Chain) Generate(
+	ctx context.Context,
+	target GenerateTarget,
+	additionalTargets ...GenerateTarget,
+) error {
+	c.step("🛠️  Building proto...", "Generating code from proto files")
+
+	if err := c.generate(ctx, append(additionalTargets, target)...); err != nil {
+		return err
+	}
+
+	c.protoBuiltAtLeastOnce = true
+
+	return nil
+}
+
+// generate makes code generation from proto files for targets.
+func (c *Chain) generate(ctx context.Context, targets ...GenerateTarget) error {
+	var targetOptions generateOptions
+
+	for _, apply := range targets {
+		apply(&targetOptions)
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return err
+	}
+
+	options := []cosmosgen.Option{
+		cosmosgen.IncludeDirs(conf.Build.Proto.ThirdPartyPaths),
+		cosmosgen.WithPluginVersions(conf.Build.Proto.Plugins),
+		cosmosgen.WithModules(targetOptions.modules...),
+	}
+
+	if c.options.progress != nil {
+		options = append(options, cosmosgen.WithProgress(c.options.progress.Report))
+	}
+
+	// resolve third party proto files from buf dependencies.
+	if conf.Build.Proto.Buf {
+		dependencyDirs, err := c.resolveBufDependencies(ctx)
+		if err != nil {
+			return err
+		}
+
+		options = append(options, cosmosgen.IncludeDependencyDirs(dependencyDirs))
+	}
+
+	if targetOptions.isGoEnabled {
+		options = append(options, cosmosgen.WithGoGeneration(c.app.ImportPath))
+	}
+
+	if targetOptions.isGoClientEnabled {
+		goClientPath := conf.Client.Go.Path
+		if goClientPath == "" {
+			goClientPath = defaultGoClientPath
+		}
+
+		options = append(options, cosmosgen.WithGoClientGeneration(filepath.Join(c.app.Path, goClientPath)))
+	}
+
+	enableThirdPartyModuleCodegen := !c.protoBuiltAtLeastOnce && c.options.isThirdPartyModuleCodegenEnabled
+
+	// generate Vuex code as well if it is enabled.
+	if targetOptions.isVuexEnabled {
+		vuexPath := conf.Client.VuexPath()
+		if vuexPath == "" {
+			vuexPath = defaultVuexPath
+		}
+
+		storeRootPath := filepath.Join(c.app.Path, vuexPath, "generated")
+		if err := os.MkdirAll(storeRootPath, 0766); err != nil {
+			return err
+		}
+
+		options = append(options,
+			cosmosgen.WithVuexGeneration(
+				enableThirdPartyModuleCodegen,
+				func(m module.Module) string {
+					parsedGitURL, _ := giturl.Parse(m.Pkg.GoImportName)
+					return filepath.Join(storeRootPath, parsedGitURL.UserAndRepo(), m.Pkg.Name, "module")
+				},
+				storeRootPath,
+			),
+		)
+
+		// write the selected network presets as an env file for the Vue app.
+		network, found, err := c.Network()
+		if err != nil {
+			return err
+		}
+		if found {
+			if err := writeVueEnv(vueAppPath(filepath.Join(c.app.Path, vuexPath)), c.options.network, network); err != nil {
+				return err
+			}
+		}
+	}
+
+	if targetOptions.isTSClientEnabled {
+		tsClientPath := conf.Client.TypeScript.Path
+		if tsClientPath == "" {
+			tsClientPath = defaultTSClientPath
+		}
+
+		rootPath := filepath.Join(c.app.Path, tsClientPath)
+		if err := os.MkdirAll(rootPath, 0766); err != nil {
+			return err
+		}
+
+		options = append(options,
+			cosmosgen.WithTSClientGeneration(
+				enableThirdPartyModuleCodegen,
+				func(m module.Module) string {
+					return filepath.Join(rootPath, m.Pkg.Name)
+				},
+				rootPath,
+			),
+		)
+
+		if conf.Client.TypeScript.GRPCWeb {
+			options = append(options, cosmosgen.WithTSClientGRPCWeb())
+		}
+
+		if targetOptions.isComposablesEnabled {
+			composablesPath := conf.Client.Composables.Path
+			if composablesPath == "" {
+				composablesPath = defaultComposablesPath
+			}
+
+			options = append(options, cosmosgen.WithComposablesGeneration(filepath.Join(c.app.Path, composablesPath)))
+		}
+
+		if targetOptions.isHooksEnabled {
+			hooksPath := conf.Client.Hooks.Path
+			if hooksPath == "" {
+				hooksPath = defaultHooksPath
+			}
+
+			options = append(options, cosmosgen.WithHooksGeneration(filepath.Join(c.app.Path, hooksPath)))
+		}
+
+		if targetOptions.isPiniaEnabled {
+			piniaPath := conf.Client.PiniaPath()
+			if piniaPath == "" {
+				piniaPath = defaultPiniaPath
+			}
+
+			options = append(options, cosmosgen.WithPiniaGeneration(filepath.Join(c.app.Path, piniaPath)))
+		}
+	}
+
+	if targetOptions.isDartEnabled {
+		dartPath := conf.Client.Dart.Path
+
+		if dartPath == "" {
+			dartPath = defaultDartPath
+		}
+
+		rootPath := filepath.Join(c.app.Path, dartPath, "generated")
+		if err := os.MkdirAll(rootPath, 0766); err != nil {
+			return err
+		}
+
+		options = append(options,
+			cosmosgen.WithDartGeneration(
+				enableThirdPartyModuleCodegen,
+				func(m module.Module) string {
+					return filepath.Join(rootPath, m.Pkg.Name, "module")
+				},
+				rootPath,
+			),
+		)
+	}
+
+	if targetOptions.isKotlinEnabled {
+		kotlinPath := conf.Client.Kotlin.Path
+
+		if kotlinPath == "" {
+			kotlinPath = defaultKotlinPath
+		}
+
+		options = append(options,
+			cosmosgen.WithKotlinGeneration(enableThirdPartyModuleCodegen, filepath.Join(c.app.Path, kotlinPath)),
+		)
+	}
+
+	if targetOptions.isSwiftEnabled {
+		swiftPath := conf.Client.Swift.Path
+
+		if swiftPath == "" {
+			swiftPath = defaultSwiftPath
+		}
+
+		options = append(options,
+			cosmosgen.WithSwiftGeneration(enableThirdPartyModuleCodegen, filepath.Join(c.app.Path, swiftPath)),
+		)
+	}
+
+	if targetOptions.isOpenAPIEnabled {
+		openAPIPath := conf.Client.OpenAPI.Path
+
+		if openAPIPath == "" {
+			openAPIPath = defaultOpenAPIPath
+		}
+
+		options = append(options, cosmosgen.WithOpenAPIGeneration(openAPIPath))
+	}
+
+	if targetOptions.isDocsEnabled {
+		options = append(options, cosmosgen.WithDocsGeneration(c.docsPath(conf)))
+	}
+
+	if err := cosmosgen.Generate(ctx, c.app.Path, conf.Build.Proto.Path, options...); err != nil {
+		return &CannotBuildAppError{err}
+	}
+
+	return nil
+}
+
+// vueAppPath returns the root path of the Vue app that holds the store at storePath.
+func vueAppPath(storePath string) string {
+	for path := storePath; path != filepath.Dir(path); path = filepath.Dir(path) {
+		if filepath.Base(path) == "src" {
+			return filepath.Dir(path)
+		}
+	}
+	return filepath.Dir(storePath)
+}
+
+// writeVueEnv writes network presets to a .env.[name] file inside the Vue app so the app
+// can be run against the network with `--mode [name]`.
+func writeVueEnv(appPath, name string, network conf.Network) error {
+	var b strings.Builder
+
+	write := func(key, value string) {
+		if value != "" {
+			fmt.Fprintf(&b, "%s=%s\n", key, value)
+		}
+	}
+
+	write("VUE_APP_CHAIN_ID", network.ChainID)
+	write("VUE_APP_ADDRESS_PREFIX", network.AddressPrefix)
+	if network.API != "" {
+		write("VUE_APP_API_COSMOS", xurl.HTTP(network.API))
+	}
+	if network.RPC != "" {
+		rpc := xurl.HTTP(network.RPC)
+		write("VUE_APP_API_TENDERMINT", rpc)
+		write("VUE_APP_WS_TENDERMINT", "ws"+strings.TrimPrefix(rpc, "http")+"/websocket")
+	}
+
+	return os.WriteFile(filepath.Join(appPath, ".env."+name), []byte(b.String()), 0644)
+}
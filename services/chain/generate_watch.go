@@ -0,0 +1,58 @@
+package chain
+
+import (
+	"context"
+
+	"github.com/tendermint/starport/starport/pkg/localfs"
+	"golang.org/x/sync/errgroup"
+)
+
+// WatchGenerate calls generate initially and every time the proto files or the config of the
+// chain change, until ctx is canceled. generate is not called concurrently, changes made while
+// generating trigger a single regeneration once it's done.
+func (c *Chain) WatchGenerate(ctx context.Context, generate func(context.Context) error) error {
+	conf, err := c.Config()
+	if err != nil {
+		return err
+	}
+
+	paths := append([]string{conf.Build.Proto.Path}, conf.Build.Proto.ThirdPartyPaths...)
+	if configPath := c.ConfigPath(); configPath != "" {
+		paths = append(paths, configPath)
+	}
+
+	refresher := make(chan struct{}, 1)
+	refresher <- struct{}{}
+
+	g, ctx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		return localfs.Watch(
+			ctx,
+			paths,
+			localfs.WatcherWorkdir(c.app.Path),
+			localfs.WatcherOnChange(func() {
+				select {
+				case refresher <- struct{}{}:
+				default:
+				}
+			}),
+			localfs.WatcherIgnoreHidden(),
+		)
+	})
+
+	g.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-refresher:
+				if err := generate(ctx); err != nil {
+					return err
+				}
+			}
+		}
+	})
+
+	return g.Wait()
+}
@@ -0,0 +1,133 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path/filepath"
+	"time"
+
+	"github.com/pelletier/go-toml"
+	"github.com/tendermint/starport/starport/pkg/xurl"
+	conf "github.com/trino-network/trino/chainconf"
+	"github.com/trino-network/trino/pkg/nodemetrics"
+)
+
+const (
+	// metricsDir is the dir in the home of the chain that the Grafana dashboard and the Prometheus
+	// config of the metrics of the node are exported into.
+	metricsDir = "metrics"
+
+	// metricsInterval is how often the activity of the chain is summarized.
+	metricsInterval = 30 * time.Second
+)
+
+// configureMetrics turns the Prometheus instrumentation of the node on when the chain is served
+// with metrics and exports the dashboard of its metrics. otherwise the instrumentation is set as
+// it's configured by init.config.
+func (c *Chain) configureMetrics(config conf.Config) error {
+	prometheus := c.metrics
+	if !prometheus {
+		instrumentation, _ := config.Init.Config["instrumentation"].(map[string]interface{})
+		prometheus, _ = instrumentation["prometheus"].(bool)
+	}
+
+	err := c.updateConfigTOML(func(configTOML map[string]interface{}) {
+		instrumentation, ok := configTOML["instrumentation"].(map[string]interface{})
+		if !ok {
+			instrumentation = map[string]interface{}{}
+			configTOML["instrumentation"] = instrumentation
+		}
+		instrumentation["prometheus"] = prometheus
+		if c.metrics {
+			instrumentation["prometheus_listen_addr"] = config.Host.Prometheus
+		}
+	})
+	if err != nil || !c.metrics {
+		return err
+	}
+
+	dir, err := c.metricsDir()
+	if err != nil {
+		return err
+	}
+	namespace, err := c.metricsNamespace()
+	if err != nil {
+		return err
+	}
+	return nodemetrics.Export(dir, metricsTarget(config.Host.Prometheus), namespace)
+}
+
+// metricsDir returns the dir that the dashboard of the metrics of the node is exported into.
+func (c *Chain) metricsDir() (string, error) {
+	home, err := c.Home()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, metricsDir), nil
+}
+
+// metricsNamespace returns the namespace of the metrics of the node.
+func (c *Chain) metricsNamespace() (string, error) {
+	configTOMLPath, err := c.ConfigTOMLPath()
+	if err != nil {
+		return "", err
+	}
+	configTOML, err := toml.LoadFile(configTOMLPath)
+	if err != nil {
+		return "", err
+	}
+	if namespace, ok := configTOML.Get("instrumentation.namespace").(string); ok && namespace != "" {
+		return namespace, nil
+	}
+	return nodemetrics.DefaultNamespace, nil
+}
+
+// metricsTarget returns the target that Prometheus scrapes the metrics of the node from, the
+// node listens on all interfaces when its host is empty.
+func metricsTarget(address string) string {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+	if host == "" || host == "0.0.0.0" {
+		host = "localhost"
+	}
+	return net.JoinHostPort(host, port)
+}
+
+// runMetrics prints summaries of the activity of the chain from the metrics of its node until
+// ctx is canceled.
+func (c *Chain) runMetrics(ctx context.Context, config conf.Config) error {
+	namespace, err := c.metricsNamespace()
+	if err != nil {
+		return err
+	}
+
+	var (
+		url    = xurl.HTTP(config.Host.Prometheus) + "/metrics"
+		prev   nodemetrics.Sample
+		ticker = time.NewTicker(metricsInterval)
+	)
+	defer ticker.Stop()
+
+	for {
+		// the metrics are not served until the node is started, and they're reset when it's
+		// restarted, so the summary starts over then.
+		cur, err := nodemetrics.Scrape(ctx, url, namespace)
+		if err == nil {
+			if summary, ok := nodemetrics.Summarize(prev, cur); ok && !prev.Time.IsZero() {
+				fmt.Fprintf(c.stdLog().out, "📈 Last %s: %s\n", metricsInterval, summary)
+			}
+			prev = cur
+		} else {
+			prev = nodemetrics.Sample{}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/tendermint/starport/starport/pkg/xfilepath"
+	"github.com/tendermint/starport/starport/services"
+	"github.com/trino-network/trino/pkg/buf"
+)
+
+// bufCachePath is the path where the proto files of buf dependencies are exported to.
+var bufCachePath = xfilepath.Join(
+	services.StarportConfPath,
+	xfilepath.Path("buf"),
+)
+
+// ProtoPath returns the absolute path of the chain's proto dir.
+func (c *Chain) ProtoPath() (string, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(c.app.Path, conf.Build.Proto.Path), nil
+}
+
+// InitProto creates the buf config of the chain's proto files and pins its dependencies.
+func (c *Chain) InitProto(ctx context.Context) error {
+	protoPath, err := c.ProtoPath()
+	if err != nil {
+		return err
+	}
+
+	return buf.Init(ctx, protoPath)
+}
+
+// LintProto lints the chain's proto files with the rules of the buf config.
+func (c *Chain) LintProto(ctx context.Context) error {
+	protoPath, err := c.ProtoPath()
+	if err != nil {
+		return err
+	}
+
+	return buf.Lint(ctx, protoPath)
+}
+
+// CheckProtoBreaking checks the chain's proto files for wire breaking changes against
+// their version at the git ref.
+func (c *Chain) CheckProtoBreaking(ctx context.Context, ref string) error {
+	protoPath, err := c.ProtoPath()
+	if err != nil {
+		return err
+	}
+
+	repoPath, err := gitRootPath(c.app.Path)
+	if err != nil {
+		return err
+	}
+
+	return buf.Breaking(ctx, repoPath, protoPath, ref)
+}
+
+// resolveBufDependencies exports the proto files of the buf dependencies of the chain
+// and returns their paths.
+func (c *Chain) resolveBufDependencies(ctx context.Context) ([]string, error) {
+	protoPath, err := c.ProtoPath()
+	if err != nil {
+		return nil, err
+	}
+
+	deps, err := buf.Dependencies(protoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	cachePath, err := bufCachePath()
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, dep := range deps {
+		path := filepath.Join(cachePath, dep.Remote, dep.Owner, dep.Repository, dep.Commit)
+		if err := buf.Export(ctx, dep, path); err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// gitRootPath returns the root path of the git repository that path is inside of.
+func gitRootPath(path string) (string, error) {
+	for p := path; ; p = filepath.Dir(p) {
+		if _, err := os.Stat(filepath.Join(p, ".git")); err == nil {
+			return p, nil
+		}
+		if p == filepath.Dir(p) {
+			return "", errors.New("the chain is not inside a git repository")
+		}
+	}
+}
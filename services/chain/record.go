@@ -0,0 +1,36 @@
+package chain
+
+import (
+	"errors"
+	"os"
+
+	"github.com/trino-network/trino/pkg/explorer"
+	"github.com/trino-network/trino/pkg/txreplay"
+)
+
+// ErrNotIndexed is returned when the txs of the chain are not indexed by the explorer.
+var ErrNotIndexed = errors.New("the txs of the chain are not indexed, serve it with starport chain serve first")
+
+// Explorer opens the store of the blocks and txs that are indexed by the explorer while the chain
+// is served.
+func (c *Chain) Explorer() (*explorer.Store, error) {
+	path, err := c.explorerDBPath()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, ErrNotIndexed
+	}
+	return explorer.Open(path)
+}
+
+// ReplayTestPath returns the path of the test that replays the txs recorded with name.
+func (c *Chain) ReplayTestPath(name string) (string, error) {
+	return txreplay.TestPath(c.app.Path, name)
+}
+
+// WriteReplayTest writes the test that replays txs, which are recorded with name from the block at
+// height from to the block at height to. the path of the test is returned.
+func (c *Chain) WriteReplayTest(name string, from, to int64, txs []explorer.Tx) (path string, err error) {
+	return txreplay.Write(c.app.Path, c.app.ImportPath, name, from, to, txs)
+}
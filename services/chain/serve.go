@@ -0,0 +1,853 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/otiai10/copy"
+	"github.com/pkg/errors"
+	"github.com/tendermint/starport/starport/pkg/dirchange"
+	"github.com/tendermint/starport/starport/pkg/localfs"
+	"github.com/tendermint/starport/starport/pkg/xexec"
+	"github.com/tendermint/starport/starport/pkg/xfilepath"
+	"github.com/tendermint/starport/starport/pkg/xhttp"
+	"github.com/tendermint/starport/starport/pkg/xurl"
+	"github.com/tendermint/starport/starport/services"
+	conf "github.com/trino-network/trino/chainconf"
+	sperrors "github.com/trino-network/trino/errors"
+	chaincmdrunner "github.com/trino-network/trino/pkg/chaincmd/runner"
+	"github.com/trino-network/trino/pkg/cosmosfaucet"
+	"github.com/trino-network/trino/pkg/nodemetrics"
+	"github.com/trino-network/trino/pkg/servehook"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// exportedGenesis is the name of the exported genesis file for a chain
+	exportedGenesis = "exported_genesis.json"
+
+	// sourceChecksum is the file containing the checksum to detect source modification
+	sourceChecksum = "source_checksum.txt"
+
+	// binaryChecksum is the file containing the checksum to detect binary modification
+	binaryChecksum = "binary_checksum.txt"
+)
+
+var (
+	// ignoredExts holds a list of ignored files from watching.
+	ignoredExts = []string{"pb.go", "pb.gw.go"}
+
+	// starportSavePath is the place where chain exported genesis are saved
+	starportSavePath = xfilepath.Join(
+		services.StarportConfPath,
+		xfilepath.Path("local-chains"),
+	)
+)
+
+type serveOptions struct {
+	forceReset      bool
+	resetOnce       bool
+	hooks           []servehook.Hook
+	faucetTransfers func(address string, transfers []cosmosfaucet.Transfer)
+	metrics         bool
+}
+
+func newServeOption() serveOptions {
+	return serveOptions{
+		forceReset: false,
+		resetOnce:  false,
+	}
+}
+
+// ServeOption provides options for the serve command
+type ServeOption func(*serveOptions)
+
+// ServeForceReset allows to force reset of the state when the chain is served and on every source change
+func ServeForceReset() ServeOption {
+	return func(c *serveOptions) {
+		c.forceReset = true
+	}
+}
+
+// ServeResetOnce allows to reset of the state when the chain is served once
+func ServeResetOnce() ServeOption {
+	return func(c *serveOptions) {
+		c.resetOnce = true
+	}
+}
+
+// ServeHooks notifies hooks of the lifecycle events of the served chain
+func ServeHooks(hooks ...servehook.Hook) ServeOption {
+	return func(c *serveOptions) {
+		c.hooks = append(c.hooks, hooks...)
+	}
+}
+
+// ServeFaucetTransfers calls fn with the address and the transfers of each request to the faucet.
+func ServeFaucetTransfers(fn func(address string, transfers []cosmosfaucet.Transfer)) ServeOption {
+	return func(c *serveOptions) {
+		c.faucetTransfers = fn
+	}
+}
+
+// ServeMetrics enables the Prometheus instrumentation of the node, exports a Grafana dashboard of
+// its metrics and prints summaries of the activity of the chain.
+func ServeMetrics() ServeOption {
+	return func(c *serveOptions) {
+		c.metrics = true
+	}
+}
+
+// Serve serves an app.
+func (c *Chain) Serve(ctx context.Context, options ...ServeOption) error {
+	serveOptions := newServeOption()
+
+	// apply the options
+	for _, apply := range options {
+		apply(&serveOptions)
+	}
+	c.serveHooks = serveOptions.hooks
+	c.faucetTransfers = serveOptions.faucetTransfers
+	c.metrics = serveOptions.metrics
+	c.resetOnChange = serveOptions.forceReset
+
+	// initial checks and setup.
+	if err := c.setup(); err != nil {
+		return err
+	}
+
+	// make sure that config.yml exists
+	if c.options.ConfigFile != "" {
+		if _, err := os.Stat(c.options.ConfigFile); err != nil {
+			return err
+		}
+	} else if _, err := conf.LocateDefault(c.app.Path); err != nil {
+		return err
+	}
+
+	// start serving components.
+	g, ctx := errgroup.WithContext(ctx)
+
+	// hooks are notified in the background so slow hooks don't hold serving.
+	if len(c.serveHooks) > 0 {
+		c.serveEvents = make(chan servehook.Event, serveEventsBuffer)
+		g.Go(func() error {
+			c.runServeHooks(ctx)
+			return nil
+		})
+	}
+
+	// blockchain node routine
+	g.Go(func() error {
+		c.refreshServe()
+
+		for {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+
+			case <-c.serveRefresher:
+				commands, err := c.Commands(ctx)
+				if err != nil {
+					return err
+				}
+
+				var (
+					serveCtx context.Context
+					buildErr *CannotBuildAppError
+					startErr *CannotStartAppError
+				)
+				serveCtx, c.serveCancel = context.WithCancel(ctx)
+
+				// determine if the chain should reset the state
+				shouldReset := serveOptions.forceReset || serveOptions.resetOnce || c.takeForceReset()
+
+				// serve the app.
+				err = c.serve(serveCtx, shouldReset)
+				serveOptions.resetOnce = false
+
+				switch {
+				case err == nil:
+				case errors.Is(err, context.Canceled):
+					// If the app has been served, we save the genesis state
+					if c.served {
+						c.served = false
+
+						fmt.Fprintln(c.stdLog().out, "💿 Saving genesis state...")
+
+						// If serve has been stopped, save the genesis state
+						if err := c.saveChainState(context.TODO(), commands); err != nil {
+							fmt.Fprint(c.stdLog().err, err.Error())
+							return err
+						}
+
+						genesisPath, err := c.exportedGenesisPath()
+						if err != nil {
+							fmt.Fprintln(c.stdLog().err, err.Error())
+							return err
+						}
+						fmt.Fprintf(c.stdLog().out, "💿 Genesis state saved in %s\n", genesisPath)
+					}
+				case errors.As(err, &buildErr):
+					fmt.Fprintf(c.stdLog().err, "%s\n", errorColor(err.Error()))
+
+					var validationErr *conf.ValidationError
+					if errors.As(err, &validationErr) {
+						fmt.Fprintln(c.stdLog().out, "see: https://github.com/tendermint/starport#configure")
+					}
+
+					fmt.Fprintf(c.stdLog().out, "%s\n", infoColor("Waiting for a fix before retrying..."))
+
+				case errors.As(err, &startErr):
+					if address := startErr.AddressInUse(); address != "" {
+						return sperrors.PortInUse(address, err)
+					}
+
+					// Parse returned error logs
+					parsedErr := startErr.ParseStartError()
+
+					// If empty, we cannot recognized the error
+					// Therefore, the error may be caused by a new logic that is not compatible with the old app state
+					// We suggest the user to eventually reset the app state
+					if parsedErr == "" {
+						fmt.Fprintf(c.stdLog().out, "%s %s\n", infoColor(`Blockchain failed to start.
+If the new code is no longer compatible with the saved state, you can reset the database by launching:`), "starport chain serve --reset-once")
+
+						return fmt.Errorf("cannot run %s", startErr.AppName)
+					}
+
+					// return the clear parsed error
+					return errors.New(parsedErr)
+				default:
+					return err
+				}
+			}
+		}
+	})
+
+	// routine to watch back-end
+	g.Go(func() error {
+		return c.watchAppBackend(ctx)
+	})
+
+	// routine to watch the config
+	g.Go(func() error {
+		return c.watchConfig(ctx)
+	})
+
+	return g.Wait()
+}
+
+func (c *Chain) setup() error {
+	fmt.Fprintf(c.stdLog().out, "Cosmos SDK's version is: %s\n\n", infoColor(c.Version))
+
+	if err := c.checkSystem(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// checkSystem checks if developer's work environment comply must to have
+// dependencies and pre-conditions.
+func (c *Chain) checkSystem() error {
+	// check if Go has installed.
+	if !xexec.IsCommandAvailable("go") {
+		return errors.New("Please, check that Go language is installed correctly in $PATH. See https://golang.org/doc/install")
+	}
+	return nil
+}
+
+func (c *Chain) refreshServe() {
+	if c.serveCancel != nil {
+		c.serveCancel()
+	}
+	c.serveRefresher <- struct{}{}
+}
+
+// Rebuild rebuilds the served app and restarts it, even if its source didn't change.
+func (c *Chain) Rebuild() {
+	c.serveMu.Lock()
+	c.forceRebuild = true
+	c.serveMu.Unlock()
+
+	fmt.Fprintln(c.stdLog().out, "🔄 Rebuilding the app...")
+	c.refreshServe()
+}
+
+// ResetState resets the state of the served app and restarts it.
+func (c *Chain) ResetState() {
+	c.serveMu.Lock()
+	c.forceReset = true
+	c.serveMu.Unlock()
+
+	c.refreshServe()
+}
+
+func (c *Chain) watchAppBackend(ctx context.Context) error {
+	return localfs.Watch(
+		ctx,
+		appBackendSourceWatchPaths,
+		localfs.WatcherWorkdir(c.app.Path),
+		localfs.WatcherOnChange(c.refreshServe),
+		localfs.WatcherIgnoreHidden(),
+		localfs.WatcherIgnoreExt(ignoredExts...),
+	)
+}
+
+// serve performs the operations to serve the blockchain: build, init and start
+// if the chain is already initialized and the file didn't changed, the app is directly started
+// if the files changed, the state is imported
+func (c *Chain) serve(ctx context.Context, forceReset bool) error {
+	conf, err := c.Config()
+	if err != nil {
+		return &CannotBuildAppError{err}
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return err
+	}
+
+	saveDir, err := c.chainSavePath()
+	if err != nil {
+		return err
+	}
+
+	// isInit determines if the app is initialized
+	var isInit, isReset bool
+
+	// determine if the app must reset the state
+	// if the state must be reset, then we consider the chain as being not initialized
+	isInit, err = c.IsInitialized()
+	if err != nil {
+		return err
+	}
+	if isInit {
+		if forceReset {
+			// if forceReset is set, we consider the app as being not initialized
+			fmt.Fprintln(c.stdLog().out, "🔄 Resetting the app state...")
+			isInit, isReset = false, true
+		} else {
+			// config changes that can only be applied to a new app state are not
+			// applied automatically, let the user know that a reset is needed.
+			c.reportConfigResetChanges(saveDir, conf)
+		}
+	}
+
+	// check if source has been modified since last serve
+	// if the state must not be reset but the source has changed, we rebuild the chain and import the exported state
+	sourceModified, err := dirchange.HasDirChecksumChanged(c.app.Path, appBackendSourceWatchPaths, saveDir, sourceChecksum)
+	if err != nil {
+		return err
+	}
+
+	// we also consider the binary in the checksum to ensure the binary has not been changed by a third party
+	var binaryModified bool
+	binaryName, err := c.Binary()
+	if err != nil {
+		return err
+	}
+	binaryPath, err := exec.LookPath(binaryName)
+	if err != nil {
+		if !errors.Is(err, exec.ErrNotFound) {
+			return err
+		}
+		binaryModified = true
+	} else {
+		binaryModified, err = dirchange.HasDirChecksumChanged("", []string{binaryPath}, saveDir, binaryChecksum)
+		if err != nil {
+			return err
+		}
+	}
+
+	appModified := sourceModified || binaryModified
+
+	// a rebuild is requested when the build related config is changed
+	forceRebuild := c.takeForceRebuild()
+
+	// check if exported genesis exists
+	exportGenesisExists := true
+	exportedGenesisPath, err := c.exportedGenesisPath()
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(exportedGenesisPath); os.IsNotExist(err) {
+		exportGenesisExists = false
+	} else if err != nil {
+		return err
+	}
+
+	// build phase
+	if !isInit || appModified || forceRebuild {
+		// build the blockchain app
+		c.notifyServeHooks(servehook.Event{Type: servehook.EventBuildStarted})
+
+		err := c.build(ctx, "")
+		if ctx.Err() == nil {
+			event := servehook.Event{Type: servehook.EventBuildFinished}
+			if err != nil {
+				event.Error = err.Error()
+			}
+			c.notifyServeHooks(event)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	// init phase
+	// nolint:gocritic
+	if !isInit || (appModified && !exportGenesisExists) {
+		fmt.Fprintln(c.stdLog().out, "💿 Initializing the app...")
+
+		if err := c.Init(ctx, true); err != nil {
+			return err
+		}
+
+		// keep the config that the app state is initialized with to detect
+		// the changes that require a reset.
+		if err := c.saveConfigSnapshot(saveDir); err != nil {
+			return err
+		}
+
+		if isReset {
+			c.notifyServeHooks(servehook.Event{Type: servehook.EventStateReset})
+		}
+	} else if appModified {
+		// if the chain is already initialized but the source has been modified
+		// we reset the chain database and import the genesis state
+		fmt.Fprintln(c.stdLog().out, "💿 Existent genesis detected, restoring the database...")
+
+		if err := commands.UnsafeReset(ctx); err != nil {
+			return err
+		}
+
+		if err := c.resetSignerState(); err != nil {
+			return err
+		}
+
+		if err := c.resetExplorer(); err != nil {
+			return err
+		}
+
+		if err := c.importChainState(); err != nil {
+			return err
+		}
+
+		c.notifyServeHooks(servehook.Event{Type: servehook.EventStateReset})
+	} else {
+		fmt.Fprintln(c.stdLog().out, "▶️  Restarting existing app...")
+	}
+
+	// apply the latest config to the existing app state.
+	if isInit {
+		home, err := c.Home()
+		if err != nil {
+			return err
+		}
+		if err := c.configure(home, conf); err != nil {
+			return err
+		}
+	}
+
+	// move the consensus key of the validator to the remote signer or back to the node.
+	if err := c.configureSigner(conf); err != nil {
+		return err
+	}
+
+	if err := c.configureMetrics(conf); err != nil {
+		return err
+	}
+
+	c.setServedConfig(conf)
+
+	// save checksums
+	if err := dirchange.SaveDirChecksum(c.app.Path, appBackendSourceWatchPaths, saveDir, sourceChecksum); err != nil {
+		return err
+	}
+	binaryPath, err = exec.LookPath(binaryName)
+	if err != nil {
+		return err
+	}
+	if err := dirchange.SaveDirChecksum("", []string{binaryPath}, saveDir, binaryChecksum); err != nil {
+		return err
+	}
+
+	// start the blockchain
+	return c.start(ctx, conf)
+}
+
+func (c *Chain) start(ctx context.Context, config conf.Config) error {
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return err
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+
+	// start the blockchain.
+	g.Go(func() error { return c.plugin.Start(ctx, commands, config) })
+
+	// start the faucet if enabled.
+	faucet, err := c.Faucet(ctx)
+	isFaucetEnabled := err != ErrFaucetIsNotEnabled
+
+	if isFaucetEnabled {
+		if err == ErrFaucetAccountDoesNotExist {
+			return &CannotBuildAppError{errors.Wrap(err, "faucet account doesn't exist")}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	// the faucet is restarted on config changes, even if it's not enabled yet.
+	faucetRefresher := make(chan struct{}, 1)
+	c.setFaucetRefresher(faucetRefresher)
+
+	g.Go(func() error {
+		defer c.setFaucetRefresher(nil)
+
+		return c.runFaucet(ctx, faucetRefresher, faucet, isFaucetEnabled)
+	})
+
+	// serve the API console for the generated OpenAPI spec.
+	isAPIConsoleEnabled := config.Client.OpenAPI.Path != "" && config.Host.APIConsole != ""
+
+	if isAPIConsoleEnabled {
+		g.Go(func() error { return c.runAPIConsole(ctx, config) })
+	}
+
+	// serve the reference docs site generated for proto files.
+	isDocsEnabled := config.Client.Docs.Path != "" && config.Host.Docs != ""
+
+	if isDocsEnabled {
+		g.Go(func() error { return c.runDocs(ctx, config) })
+	}
+
+	// index the blocks and txs of the chain and serve the explorer for them.
+	isExplorerEnabled := config.Host.Explorer != ""
+
+	if isExplorerEnabled {
+		g.Go(func() error { return c.runExplorer(ctx, config) })
+	}
+
+	// sign for the validator of the node with the remote signer.
+	isSignerEnabled := config.Validator.RemoteSigner
+
+	if isSignerEnabled {
+		g.Go(func() error { return c.runSigner(ctx, config) })
+	}
+
+	// summarize the activity of the chain from the metrics of the node.
+	if c.metrics {
+		g.Go(func() error { return c.runMetrics(ctx, config) })
+	}
+
+	// set the app as being served
+	c.served = true
+
+	// print the server addresses.
+	fmt.Fprintf(c.stdLog().out, "🌍 Tendermint node: %s\n", xurl.HTTP(config.Host.RPC))
+	fmt.Fprintf(c.stdLog().out, "🌍 Blockchain API: %s\n", xurl.HTTP(config.Host.API))
+	fmt.Fprintf(c.stdLog().out, "🌍 gRPC-web: %s\n", xurl.HTTP(config.Host.GRPCWeb))
+
+	if isAPIConsoleEnabled {
+		fmt.Fprintf(c.stdLog().out, "🌍 API console: %s\n", xurl.HTTP(config.Host.APIConsole))
+	}
+
+	if isDocsEnabled {
+		fmt.Fprintf(c.stdLog().out, "🌍 API docs: %s\n", xurl.HTTP(config.Host.Docs))
+	}
+
+	if isExplorerEnabled {
+		fmt.Fprintf(c.stdLog().out, "🌍 Explorer: %s\n", xurl.HTTP(config.Host.Explorer))
+	}
+
+	if isFaucetEnabled {
+		fmt.Fprintf(c.stdLog().out, "🌍 Token faucet: %s\n", xurl.HTTP(conf.FaucetHost(config)))
+	}
+
+	if isSignerEnabled {
+		fmt.Fprintf(c.stdLog().out, "🔏 Remote signer: %s\n", xurl.TCP(config.Host.Signer))
+	}
+
+	if c.metrics {
+		dir, err := c.metricsDir()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(c.stdLog().out, "📈 Metrics: %s/metrics\n", xurl.HTTP(config.Host.Prometheus))
+		fmt.Fprintf(c.stdLog().out, "📈 Grafana dashboard: %s\n", filepath.Join(dir, nodemetrics.DashboardFile))
+	}
+
+	// the chain is started once its node answers RPC calls.
+	if len(c.serveHooks) > 0 {
+		g.Go(func() error {
+			if err := waitRPC(ctx, xurl.HTTP(config.Host.RPC)); err != nil {
+				return nil
+			}
+			c.notifyServeHooks(servehook.Event{
+				Type: servehook.EventChainStarted,
+				RPC:  xurl.HTTP(config.Host.RPC),
+				API:  xurl.HTTP(config.Host.API),
+			})
+			return nil
+		})
+	}
+
+	return g.Wait()
+}
+
+// serveEventsBuffer is the number of events that are queued for the serve hooks, the events
+// that come while the queue is full are dropped.
+const serveEventsBuffer = 32
+
+// notifyServeHooks queues the event for the serve hooks without waiting for them.
+func (c *Chain) notifyServeHooks(event servehook.Event) {
+	if c.serveEvents == nil {
+		return
+	}
+
+	event.Time = time.Now()
+	event.AppPath = c.app.Path
+	event.ChainID, _ = c.ID()
+
+	select {
+	case c.serveEvents <- event:
+	default:
+		fmt.Fprintf(c.stdLog().err, "⚠️  serve hooks are busy, %s is not notified\n", event.Type)
+	}
+}
+
+// runServeHooks notifies the serve hooks of the queued events in order, failing hooks are
+// reported without stopping serve.
+func (c *Chain) runServeHooks(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-c.serveEvents:
+			for _, err := range servehook.Notify(ctx, c.serveHooks, event) {
+				fmt.Fprintf(c.stdLog().err, "⚠️  %s\n", err)
+			}
+		}
+	}
+}
+
+// waitRPC waits until the Tendermint RPC at address is ready.
+func waitRPC(ctx context.Context, address string) error {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, address+"/health", nil)
+		if err != nil {
+			return err
+		}
+		if res, err := http.DefaultClient.Do(req); err == nil {
+			res.Body.Close()
+			if res.StatusCode == http.StatusOK {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// runFaucet serves the faucet if enabled and restarts it with the latest config
+// every time the refresher is triggered.
+func (c *Chain) runFaucet(ctx context.Context, refresher <-chan struct{}, faucet cosmosfaucet.Faucet, isEnabled bool) error {
+	for {
+		var (
+			faucetCtx, cancel = context.WithCancel(ctx)
+			errc              chan error
+		)
+
+		if isEnabled {
+			errc = make(chan error, 1)
+			go func() { errc <- c.runFaucetServer(faucetCtx, faucet) }()
+		}
+
+		select {
+		case <-ctx.Done():
+			cancel()
+			if errc != nil {
+				<-errc
+			}
+			return nil
+
+		case err := <-errc:
+			cancel()
+			if err != nil {
+				return &CannotBuildAppError{err}
+			}
+			return nil
+
+		case <-refresher:
+			// wait for the server to shutdown to release its port.
+			cancel()
+			if errc != nil {
+				<-errc
+			}
+		}
+
+		var err error
+		faucet, err = c.Faucet(ctx)
+		isEnabled = err != ErrFaucetIsNotEnabled
+
+		switch {
+		case !isEnabled:
+			fmt.Fprintln(c.stdLog().out, "🚫 Token faucet is disabled")
+		case err != nil:
+			// keep the chain running and wait for a fix of the faucet config.
+			fmt.Fprintf(c.stdLog().err, "%s\n", errorColor(errors.Wrap(err, "cannot start the faucet").Error()))
+			isEnabled = false
+		default:
+			config, err := c.Config()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(c.stdLog().out, "🌍 Token faucet: %s\n", xurl.HTTP(conf.FaucetHost(config)))
+		}
+	}
+}
+
+func (c *Chain) runFaucetServer(ctx context.Context, faucet cosmosfaucet.Faucet) error {
+	config, err := c.Config()
+	if err != nil {
+		return err
+	}
+
+	return xhttp.Serve(ctx, &http.Server{
+		Addr:    conf.FaucetHost(config),
+		Handler: faucet,
+	})
+}
+
+// saveChainState runs the export command of the chain and store the exported genesis in the chain saved config
+func (c *Chain) saveChainState(ctx context.Context, commands chaincmdrunner.Runner) error {
+	genesisPath, err := c.exportedGenesisPath()
+	if err != nil {
+		return err
+	}
+
+	return commands.Export(ctx, genesisPath)
+}
+
+// importChainState imports the saved genesis in chain config to use it as the genesis
+func (c *Chain) importChainState() error {
+	exportGenesisPath, err := c.exportedGenesisPath()
+	if err != nil {
+		return err
+	}
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	return copy.Copy(exportGenesisPath, genesisPath)
+}
+
+// chainSavePath returns the path where the chain state is saved
+// create the path if it doesn't exist
+func (c *Chain) chainSavePath() (string, error) {
+	savePath, err := starportSavePath()
+	if err != nil {
+		return "", err
+	}
+
+	chainID, err := c.ID()
+	if err != nil {
+		return "", err
+	}
+	chainSavePath := filepath.Join(savePath, chainID)
+
+	// ensure the path exists
+	if err := os.MkdirAll(savePath, 0700); err != nil && !os.IsExist(err) {
+		return "", err
+	}
+
+	return chainSavePath, nil
+}
+
+// exportedGenesisPath returns the path of the exported genesis file
+func (c *Chain) exportedGenesisPath() (string, error) {
+	savePath, err := c.chainSavePath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(savePath, exportedGenesis), nil
+}
+
+type CannotBuildAppError struct {
+	Err error
+}
+
+func (e *CannotBuildAppError) Error() string {
+	return fmt.Sprintf("cannot build app:\n\n\t%s", e.Err)
+}
+
+func (e *CannotBuildAppError) Unwrap() error {
+	return e.Err
+}
+
+type CannotStartAppError struct {
+	AppName string
+	Err     error
+}
+
+func (e *CannotStartAppError) Error() string {
+	return fmt.Sprintf("cannot run %sd start:\n%s", e.AppName, errors.Unwrap(e.Err))
+}
+
+func (e *CannotStartAppError) Unwrap() error {
+	return e.Err
+}
+
+// addressInUseRe matches the address of the errors of listening on an address that's in use.
+var addressInUseRe = regexp.MustCompile(`listen \S+ (\S+): bind: address already in use`)
+
+// AddressInUse returns the address that the app cannot start on because it's in use, if that's
+// why the app cannot start.
+func (e *CannotStartAppError) AddressInUse() string {
+	if e.Err == nil || errors.Unwrap(e.Err) == nil {
+		return ""
+	}
+	if m := addressInUseRe.FindStringSubmatch(errors.Unwrap(e.Err).Error()); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// ParseStartError parses the error into a clear error string
+// The error logs from Cosmos SDK application are too extensive to be directly printed
+// If the error is not recognized, returns an empty string
+func (e *CannotStartAppError) ParseStartError() string {
+	errorLogs := errors.Unwrap(e.Err).Error()
+	switch {
+	case strings.Contains(errorLogs, "bind: address already in use"):
+		r := regexp.MustCompile(`listen .* bind: address already in use`)
+		return r.FindString(errorLogs)
+	case strings.Contains(errorLogs, "validator set is nil in genesis"):
+		return "Error: error during handshake: error on replay: validator set is nil in genesis and still empty after InitChain"
+	default:
+		return ""
+	}
+}
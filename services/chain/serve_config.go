@@ -0,0 +1,247 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/localfs"
+	conf "github.com/trino-network/trino/chainconf"
+)
+
+// configSnapshot is the copy of the config that the app state is initialized with.
+const configSnapshot = "config.yml"
+
+// configChangeKind describes how a config change can be applied to a served chain.
+type configChangeKind int
+
+const (
+	configChangeNone configChangeKind = iota
+
+	// configChangeFaucet is applied by restarting the faucet server.
+	configChangeFaucet
+
+	// configChangeRestart is applied by reconfiguring and restarting the app, state is kept.
+	configChangeRestart
+
+	// configChangeRebuild is applied by rebuilding and restarting the app, state is kept.
+	configChangeRebuild
+
+	// configChangeReset is only applied by resetting the app state.
+	configChangeReset
+)
+
+// configChange is a changed field of the config.
+type configChange struct {
+	field string
+	kind  configChangeKind
+}
+
+// diffConfig returns the fields changed between old and new configs, network is
+// the name of the selected network presets.
+func diffConfig(old, new conf.Config, network string) (changes []configChange) {
+	check := func(field string, kind configChangeKind, a, b interface{}) {
+		if !reflect.DeepEqual(a, b) {
+			changes = append(changes, configChange{field, kind})
+		}
+	}
+
+	check("accounts", configChangeReset, old.Accounts, new.Accounts)
+	check("validator", configChangeReset, old.Validator, new.Validator)
+	check("genesis", configChangeReset, old.Genesis, new.Genesis)
+	check("init.home", configChangeReset, old.Init.Home, new.Init.Home)
+	check("build", configChangeRebuild, old.Build, new.Build)
+	check("client", configChangeRebuild, old.Client, new.Client)
+	check("host", configChangeRestart, old.Host, new.Host)
+	check("init.app", configChangeRestart, old.Init.App, new.Init.App)
+	check("init.config", configChangeRestart, old.Init.Config, new.Init.Config)
+	check("init.client", configChangeRestart, old.Init.Client, new.Init.Client)
+	check("init.keyring-backend", configChangeRestart, old.Init.KeyringBackend, new.Init.KeyringBackend)
+	check("faucet", configChangeFaucet, old.Faucet, new.Faucet)
+
+	if network != "" {
+		oldNetwork, newNetwork := old.Networks[network], new.Networks[network]
+		check("networks."+network+".chain_id", configChangeReset, oldNetwork.ChainID, newNetwork.ChainID)
+
+		// endpoints of the network are used by the faucet and while relaying.
+		oldNetwork.ChainID, newNetwork.ChainID = "", ""
+		check("networks."+network, configChangeRestart, oldNetwork, newNetwork)
+	}
+
+	return changes
+}
+
+// watchConfig watches the config file and applies the changes to the served chain.
+func (c *Chain) watchConfig(ctx context.Context) error {
+	configPath := c.ConfigPath()
+	if configPath == "" {
+		return nil
+	}
+
+	return localfs.Watch(
+		ctx,
+		[]string{configPath},
+		localfs.WatcherWorkdir(c.app.Path),
+		localfs.WatcherOnChange(c.reloadConfig),
+	)
+}
+
+// reloadConfig applies the config changes that don't need a reset of the app state
+// on the fly and reports the ones that do, unless the app state is reset on every refresh.
+func (c *Chain) reloadConfig() {
+	newConf, err := c.Config()
+	if err != nil {
+		fmt.Fprintf(c.stdLog().err, "%s\n", errorColor(err.Error()))
+		fmt.Fprintf(c.stdLog().out, "%s\n", infoColor("Waiting for a fix before reloading the config..."))
+		return
+	}
+
+	c.serveMu.Lock()
+	oldConf := c.servedConfig
+	c.servedConfig = &newConf
+	faucetRefresher := c.faucetRefresher
+	c.serveMu.Unlock()
+
+	// the app isn't served yet, probably because of a previously invalid config.
+	if oldConf == nil {
+		c.refreshServe()
+		return
+	}
+
+	var (
+		kind        configChangeKind
+		resetFields []string
+	)
+	for _, change := range diffConfig(*oldConf, newConf, c.options.network) {
+		if change.kind == configChangeReset {
+			resetFields = append(resetFields, change.field)
+		} else if change.kind > kind {
+			kind = change.kind
+		}
+	}
+
+	if len(resetFields) > 0 {
+		// the app state is reset on every refresh, so the changes are applied by a refresh.
+		if c.resetOnChange {
+			fmt.Fprintln(c.stdLog().out, "🔄 Resetting the app state with the new config...")
+			c.refreshServe()
+			return
+		}
+		printResetRequired(c.stdLog().out, resetFields)
+	}
+
+	switch kind {
+	case configChangeFaucet:
+		// restart only the faucet when it's running, the whole app otherwise.
+		if faucetRefresher != nil {
+			fmt.Fprintln(c.stdLog().out, "🔄 Reloading the faucet config...")
+
+			select {
+			case faucetRefresher <- struct{}{}:
+			default:
+			}
+			return
+		}
+		c.refreshServe()
+
+	case configChangeRebuild:
+		c.serveMu.Lock()
+		c.forceRebuild = true
+		c.serveMu.Unlock()
+
+		fmt.Fprintln(c.stdLog().out, "🔄 Rebuilding the app with the new config...")
+		c.refreshServe()
+
+	case configChangeRestart:
+		fmt.Fprintln(c.stdLog().out, "🔄 Restarting the app with the new config...")
+		c.refreshServe()
+	}
+}
+
+// reportConfigResetChanges reports changes made to the config since the app state
+// has been initialized that require a reset.
+func (c *Chain) reportConfigResetChanges(saveDir string, config conf.Config) {
+	// the snapshot might be missing or saved by an incompatible version, don't report anything then.
+	snapshot, err := conf.ParseFile(filepath.Join(saveDir, configSnapshot))
+	if err != nil {
+		return
+	}
+
+	var resetFields []string
+	for _, change := range diffConfig(snapshot, config, c.options.network) {
+		if change.kind == configChangeReset {
+			resetFields = append(resetFields, change.field)
+		}
+	}
+
+	if len(resetFields) > 0 {
+		printResetRequired(c.stdLog().out, resetFields)
+	}
+}
+
+// saveConfigSnapshot saves a copy of the config file that the app state is initialized with.
+func (c *Chain) saveConfigSnapshot(saveDir string) error {
+	configPath := c.ConfigPath()
+	if configPath == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(saveDir, 0700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(saveDir, configSnapshot), data, 0644)
+}
+
+// setServedConfig sets the config that the served app is running with.
+func (c *Chain) setServedConfig(config conf.Config) {
+	c.serveMu.Lock()
+	defer c.serveMu.Unlock()
+
+	c.servedConfig = &config
+}
+
+// setFaucetRefresher sets the channel used to restart the running faucet server.
+func (c *Chain) setFaucetRefresher(refresher chan struct{}) {
+	c.serveMu.Lock()
+	defer c.serveMu.Unlock()
+
+	c.faucetRefresher = refresher
+}
+
+// takeForceRebuild returns true if a rebuild is requested by a config change and clears the request.
+func (c *Chain) takeForceRebuild() bool {
+	c.serveMu.Lock()
+	defer c.serveMu.Unlock()
+
+	forceRebuild := c.forceRebuild
+	c.forceRebuild = false
+	return forceRebuild
+}
+
+// takeForceReset returns true if a reset of the app state is requested and clears the request.
+func (c *Chain) takeForceReset() bool {
+	c.serveMu.Lock()
+	defer c.serveMu.Unlock()
+
+	forceReset := c.forceReset
+	c.forceReset = false
+	return forceReset
+}
+
+// printResetRequired prints the changed fields that require resetting the app state.
+func printResetRequired(out io.Writer, fields []string) {
+	fmt.Fprintf(out, "%s %s\n",
+		infoColor(fmt.Sprintf("⚠️  Changes to %s require resetting the app state, to apply them run:", strings.Join(fields, ", "))),
+		"starport chain serve --reset-once",
+	)
+}
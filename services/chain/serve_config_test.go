@@ -0,0 +1,80 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	conf "github.com/trino-network/trino/chainconf"
+)
+
+func TestDiffConfig(t *testing.T) {
+	base := func() conf.Config {
+		return conf.Config{
+			Accounts: []conf.Account{{Name: "alice", Coins: []string{"1000token"}}},
+			Faucet:   conf.Faucet{Name: strPtr("alice"), Coins: []string{"5token"}},
+			Networks: map[string]conf.Network{
+				"testnet": {ChainID: "mars-1", RPC: "http://0.0.0.0:26657"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		network string
+		change  func(*conf.Config)
+		want    []configChange
+	}{
+		{
+			name:   "no changes",
+			change: func(*conf.Config) {},
+		},
+		{
+			name:   "faucet",
+			change: func(c *conf.Config) { c.Faucet.Coins = []string{"10token"} },
+			want:   []configChange{{"faucet", configChangeFaucet}},
+		},
+		{
+			name: "accounts and host",
+			change: func(c *conf.Config) {
+				c.Accounts[0].Coins = []string{"2000token"}
+				c.Host.RPC = ":26659"
+			},
+			want: []configChange{
+				{"accounts", configChangeReset},
+				{"host", configChangeRestart},
+			},
+		},
+		{
+			name:   "build",
+			change: func(c *conf.Config) { c.Build.Binary = "marsd" },
+			want:   []configChange{{"build", configChangeRebuild}},
+		},
+		{
+			name:    "selected network",
+			network: "testnet",
+			change: func(c *conf.Config) {
+				c.Networks["testnet"] = conf.Network{ChainID: "mars-2", RPC: "http://0.0.0.0:26659"}
+			},
+			want: []configChange{
+				{"networks.testnet.chain_id", configChangeReset},
+				{"networks.testnet", configChangeRestart},
+			},
+		},
+		{
+			name: "not selected network",
+			change: func(c *conf.Config) {
+				c.Networks["testnet"] = conf.Network{ChainID: "mars-2"}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old, new := base(), base()
+			tt.change(&new)
+			require.Equal(t, tt.want, diffConfig(old, new, tt.network))
+		})
+	}
+}
+
+func strPtr(s string) *string { return &s }
@@ -0,0 +1,72 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trino-network/trino/pkg/servehook"
+)
+
+func TestCannotStartAppErrorAddressInUse(t *testing.T) {
+	logs := "ERR failed to start node: listen tcp 0.0.0.0:26657: bind: address already in use"
+	err := &CannotStartAppError{"mars", fmt.Errorf("exit status 1: %w", errors.New(logs))}
+	require.Equal(t, "0.0.0.0:26657", err.AddressInUse())
+
+	err = &CannotStartAppError{"mars", fmt.Errorf("exit status 1: %w", errors.New("validator set is nil in genesis"))}
+	require.Empty(t, err.AddressInUse())
+}
+
+func TestNotifyServeHooks(t *testing.T) {
+	var (
+		release = make(chan struct{})
+		events  = make(chan servehook.EventType, 2)
+		logs    bytes.Buffer
+	)
+	c := &Chain{
+		options: chainOptions{chainID: "mars", logWriter: &logs},
+		serveHooks: []servehook.Hook{servehook.HookFunc(func(_ context.Context, event servehook.Event) error {
+			<-release
+			events <- event.Type
+			return nil
+		})},
+		serveEvents: make(chan servehook.Event, 2),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.runServeHooks(ctx)
+
+	// the events are queued while the hook is busy and notified in order.
+	c.notifyServeHooks(servehook.Event{Type: servehook.EventBuildStarted})
+	c.notifyServeHooks(servehook.Event{Type: servehook.EventBuildFinished})
+	c.notifyServeHooks(servehook.Event{Type: servehook.EventStateReset})
+	close(release)
+
+	require.Equal(t, servehook.EventBuildStarted, <-events)
+	require.Equal(t, servehook.EventBuildFinished, <-events)
+}
+
+func TestWaitRPC(t *testing.T) {
+	var calls int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/health", r.URL.Path)
+		if atomic.AddInt32(&calls, 1) < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer s.Close()
+
+	require.NoError(t, waitRPC(context.Background(), s.URL))
+	require.EqualValues(t, 2, atomic.LoadInt32(&calls))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, waitRPC(ctx, "http://127.0.0.1:1"), context.DeadlineExceeded)
+}
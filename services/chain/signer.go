@@ -0,0 +1,129 @@
+package chain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/tendermint/starport/starport/pkg/xurl"
+	conf "github.com/trino-network/trino/chainconf"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+	"github.com/trino-network/trino/pkg/privsigner"
+)
+
+const (
+	// signerDir is the dir of the remote signer in the home of the chain, it has the keyring of the
+	// signer and its last signed state, so they're reset with the state of the chain.
+	signerDir = "signer"
+
+	signerStateFile = "priv_validator_state.json"
+)
+
+// signerRegistry returns the keyring of the remote signer.
+func (c *Chain) signerRegistry() (cosmosaccount.Registry, error) {
+	home, err := c.Home()
+	if err != nil {
+		return cosmosaccount.Registry{}, err
+	}
+	backend, err := c.KeyringBackend()
+	if err != nil {
+		return cosmosaccount.Registry{}, err
+	}
+	return cosmosaccount.New(
+		cosmosaccount.WithHome(filepath.Join(home, signerDir)),
+		cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringBackend(backend)),
+		cosmosaccount.WithKeyringServiceName(c.app.Name),
+	)
+}
+
+// configureSigner moves the consensus key of the validator from the node into the keyring of the
+// remote signer when validator.remote_signer is set, and back into the node when it's not. the
+// node is configured to listen for the signer when it's set.
+func (c *Chain) configureSigner(config conf.Config) error {
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+	keyFile := filepath.Join(home, "config", "priv_validator_key.json")
+
+	// the node generates a key that it doesn't sign with when it starts without its key. the laddr
+	// is cleared when there is no signer.
+	var laddr string
+	switch _, err := os.Stat(filepath.Join(home, signerDir)); {
+	case config.Validator.RemoteSigner:
+		if err := c.moveKeyToSigner(keyFile); err != nil {
+			return err
+		}
+		laddr = xurl.TCP(config.Host.Signer)
+	case err == nil:
+		if err := c.moveKeyToNode(keyFile); err != nil {
+			return err
+		}
+	case !os.IsNotExist(err):
+		return err
+	}
+
+	return c.updateConfigTOML(func(configTOML map[string]interface{}) {
+		configTOML["priv_validator_laddr"] = laddr
+	})
+}
+
+func (c *Chain) moveKeyToSigner(keyFile string) error {
+	r, err := c.signerRegistry()
+	if err != nil {
+		return err
+	}
+	imported, err := privsigner.IsImported(r)
+	if err != nil || imported {
+		return err
+	}
+	return privsigner.Import(r, keyFile)
+}
+
+func (c *Chain) moveKeyToNode(keyFile string) error {
+	r, err := c.signerRegistry()
+	if err != nil {
+		return err
+	}
+	imported, err := privsigner.IsImported(r)
+	if err != nil || !imported {
+		return err
+	}
+	return privsigner.Export(r, keyFile)
+}
+
+// resetSignerState resets the last signed state of the remote signer, for when the state of the
+// chain is reset to its genesis.
+func (c *Chain) resetSignerState() error {
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+	err = os.Remove(filepath.Join(home, signerDir, signerStateFile))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// runSigner signs for the validator of the node until ctx is canceled.
+func (c *Chain) runSigner(ctx context.Context, config conf.Config) error {
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+	chainID, err := c.ID()
+	if err != nil {
+		return err
+	}
+	r, err := c.signerRegistry()
+	if err != nil {
+		return err
+	}
+
+	signer, err := privsigner.New(r, filepath.Join(home, signerDir, signerStateFile), chainID)
+	if err != nil {
+		return err
+	}
+	return signer.Run(ctx, config.Host.Signer)
+}
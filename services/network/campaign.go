@@ -0,0 +1,150 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Campaign is an incentivized program of a coordinator, the shares of its mainnet are
+// allocated to the campaign and a part of them is distributed as rewards to the validators of
+// the testnets that are launched for the campaign.
+type Campaign struct {
+	ID   uint64 `json:"id"`
+	Name string `json:"name"`
+
+	// Coordinator is the address of the account that created the campaign.
+	Coordinator string `json:"coordinator"`
+
+	// TotalShares are the shares of the mainnet that are allocated to the campaign, such as
+	// 1000000uatom.
+	TotalShares string `json:"total_shares"`
+
+	// RewardShares are the part of the total shares that are distributed as rewards.
+	RewardShares string `json:"reward_shares"`
+
+	Vesting Vesting `json:"vesting"`
+
+	// MinUptime is the min percentage of blocks that validators must sign to be rewarded.
+	MinUptime float64 `json:"min_uptime,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Vesting is the vesting of rewards, they are vested linearly over the duration after the
+// cliff. durations are in the format of time.ParseDuration, rewards are not vested when the
+// duration is empty.
+type Vesting struct {
+	Cliff    string `json:"cliff,omitempty"`
+	Duration string `json:"duration,omitempty"`
+}
+
+// Validate checks that the shares, the vesting and the min uptime of the campaign are valid.
+func (c Campaign) Validate() error {
+	if c.Name == "" {
+		return errors.New("name of the campaign is required")
+	}
+
+	total, err := sdktypes.ParseCoinsNormalized(c.TotalShares)
+	if err != nil {
+		return fmt.Errorf("invalid total shares: %w", err)
+	}
+	if total.IsZero() {
+		return errors.New("total shares of the campaign are required")
+	}
+
+	rewards, err := sdktypes.ParseCoinsNormalized(c.RewardShares)
+	if err != nil {
+		return fmt.Errorf("invalid reward shares: %w", err)
+	}
+	if !rewards.IsAllLTE(total) {
+		return fmt.Errorf("reward shares %s are more than the total shares %s", rewards, total)
+	}
+
+	if c.MinUptime < 0 || c.MinUptime > 100 {
+		return fmt.Errorf("min uptime must be a percentage between 0 and 100, not %v", c.MinUptime)
+	}
+
+	return c.Vesting.Validate()
+}
+
+// Validate checks that the durations of the vesting are valid.
+func (v Vesting) Validate() error {
+	var cliff, duration time.Duration
+
+	for _, d := range []struct {
+		name  string
+		value string
+		dest  *time.Duration
+	}{
+		{"cliff", v.Cliff, &cliff},
+		{"duration", v.Duration, &duration},
+	} {
+		if d.value == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(d.value)
+		if err != nil {
+			return fmt.Errorf("invalid vesting %s: %w", d.name, err)
+		}
+		if parsed < 0 {
+			return fmt.Errorf("vesting %s cannot be negative", d.name)
+		}
+		*d.dest = parsed
+	}
+
+	if cliff > 0 && duration == 0 {
+		return errors.New("vesting cliff is set without a vesting duration")
+	}
+	if cliff > duration {
+		return fmt.Errorf("vesting cliff %s is longer than the vesting duration %s", cliff, duration)
+	}
+	return nil
+}
+
+// CreateCampaign creates the campaign with the coordinator, the campaign is returned with the
+// fields set by the coordinator such as its ID.
+func (c Client) CreateCampaign(ctx context.Context, campaign Campaign) (Campaign, error) {
+	if err := campaign.Validate(); err != nil {
+		return Campaign{}, err
+	}
+
+	acc, err := c.Account()
+	if err != nil {
+		return Campaign{}, err
+	}
+	campaign.Coordinator = acc.Address(AccountPrefix)
+
+	var created Campaign
+	err = c.post(ctx, "/campaigns", campaign, &created)
+	return created, err
+}
+
+// UpdateCampaign updates the name, the shares, the vesting and the min uptime of the campaign,
+// only the coordinator of the campaign can update it.
+func (c Client) UpdateCampaign(ctx context.Context, campaign Campaign) (Campaign, error) {
+	if err := campaign.Validate(); err != nil {
+		return Campaign{}, err
+	}
+
+	var updated Campaign
+	err := c.post(ctx, fmt.Sprintf("/campaigns/%d", campaign.ID), campaign, &updated)
+	return updated, err
+}
+
+// Campaign returns the campaign with id.
+func (c Client) Campaign(ctx context.Context, id uint64) (Campaign, error) {
+	var campaign Campaign
+	err := c.get(ctx, fmt.Sprintf("/campaigns/%d", id), &campaign)
+	return campaign, err
+}
+
+// Campaigns returns the campaigns created with the coordinator.
+func (c Client) Campaigns(ctx context.Context) ([]Campaign, error) {
+	var campaigns []Campaign
+	err := c.get(ctx, "/campaigns", &campaigns)
+	return campaigns, err
+}
@@ -0,0 +1,60 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCampaignValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		campaign Campaign
+		err      string
+	}{
+		{
+			name:     "valid",
+			campaign: Campaign{Name: "mars", TotalShares: "1000uatom", RewardShares: "100uatom", Vesting: Vesting{Cliff: "24h", Duration: "720h"}},
+		},
+		{
+			name:     "without rewards",
+			campaign: Campaign{Name: "mars", TotalShares: "1000uatom"},
+		},
+		{
+			name:     "without total shares",
+			campaign: Campaign{Name: "mars"},
+			err:      "total shares of the campaign are required",
+		},
+		{
+			name:     "more rewards than total shares",
+			campaign: Campaign{Name: "mars", TotalShares: "1000uatom", RewardShares: "100uatom,1stake"},
+			err:      "reward shares 1stake,100uatom are more than the total shares 1000uatom",
+		},
+		{
+			name:     "min uptime over 100",
+			campaign: Campaign{Name: "mars", TotalShares: "1000uatom", MinUptime: 101},
+			err:      "min uptime must be a percentage between 0 and 100, not 101",
+		},
+		{
+			name:     "cliff without duration",
+			campaign: Campaign{Name: "mars", TotalShares: "1000uatom", Vesting: Vesting{Cliff: "24h"}},
+			err:      "vesting cliff is set without a vesting duration",
+		},
+		{
+			name:     "cliff longer than duration",
+			campaign: Campaign{Name: "mars", TotalShares: "1000uatom", Vesting: Vesting{Cliff: "48h", Duration: "24h"}},
+			err:      "vesting cliff 48h0m0s is longer than the vesting duration 24h0m0s",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.campaign.Validate()
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
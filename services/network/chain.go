@@ -0,0 +1,237 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/trino-network/trino/pkg/chaincmd"
+	chaincmdrunner "github.com/trino-network/trino/pkg/chaincmd/runner"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+	"github.com/trino-network/trino/services/chain"
+)
+
+// Home is the dir where the chains of launches are prepared.
+var Home = os.ExpandEnv("$HOME/.starport/network")
+
+// keyImportPassphrase encrypts keys while they are imported to the keyring of chains.
+const keyImportPassphrase = "starport-network"
+
+// Chain is the chain of a launch that's prepared locally, its source is cloned and its node
+// is initialized in the dir of the launch under Home.
+type Chain struct {
+	launch Launch
+	chain  *chain.Chain
+}
+
+// ChainPath returns the dir of the launch with id.
+func ChainPath(launchID uint64) string {
+	return filepath.Join(Home, strconv.FormatUint(launchID, 10))
+}
+
+// ChainSourcePath returns the dir that the source of the chain of the launch with id is cloned to.
+func ChainSourcePath(launchID uint64) string {
+	return filepath.Join(ChainPath(launchID), "source")
+}
+
+// ChainHome returns the home of the node of the chain of the launch with id.
+func ChainHome(launchID uint64) string {
+	return filepath.Join(ChainPath(launchID), "home")
+}
+
+// NewChain clones the source of the chain of launch at its commit, unless it's already cloned.
+func NewChain(ctx context.Context, launch Launch, options ...chain.Option) (*Chain, error) {
+	path := ChainSourcePath(launch.ID)
+
+	repo, err := git.PlainOpen(path)
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		repo, err = git.PlainCloneContext(ctx, path, false, &git.CloneOptions{URL: launch.SourceURL})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot clone the source of the chain: %w", err)
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return nil, err
+	}
+	checkout := &git.CheckoutOptions{Hash: plumbing.NewHash(launch.SourceHash), Force: true}
+	if err := wt.Checkout(checkout); err != nil {
+		// the source that's already cloned may not have the commit yet.
+		if err := repo.FetchContext(ctx, &git.FetchOptions{}); err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+			return nil, fmt.Errorf("cannot fetch the source of the chain: %w", err)
+		}
+		if err := wt.Checkout(checkout); err != nil {
+			return nil, fmt.Errorf("cannot checkout the commit %s of the chain: %w", launch.SourceHash, err)
+		}
+	}
+
+	c, err := chain.New(path, append([]chain.Option{
+		chain.ID(launch.ChainID),
+		chain.HomePath(ChainHome(launch.ID)),
+		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+	}, options...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Chain{launch: launch, chain: c}, nil
+}
+
+// Build builds and installs the binary of the chain.
+func (c *Chain) Build(ctx context.Context) (binaryName string, err error) {
+	return c.chain.Build(ctx, "")
+}
+
+// nodeKeyFiles are the keys of nodes that are kept when they are initialized again, so
+// validators keep their node IDs and consensus keys.
+var nodeKeyFiles = []string{
+	"config/node_key.json",
+	"config/priv_validator_key.json",
+}
+
+// Init initializes the node of the chain, previous data of the node is removed except its keys.
+// the genesis template of the launch replaces the genesis generated by the chain's binary.
+func (c *Chain) Init(ctx context.Context) error {
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string][]byte)
+	for _, name := range nodeKeyFiles {
+		key, err := os.ReadFile(filepath.Join(home, name))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		keys[name] = key
+	}
+
+	if err := c.chain.InitChain(ctx); err != nil {
+		return err
+	}
+
+	for name, key := range keys {
+		if err := os.WriteFile(filepath.Join(home, name), key, 0600); err != nil {
+			return err
+		}
+	}
+
+	if c.launch.GenesisURL == "" {
+		return nil
+	}
+
+	genesis, err := FetchGenesis(ctx, c.launch.GenesisURL)
+	if err != nil {
+		return err
+	}
+	if hash := hashBytes(genesis); hash != c.launch.GenesisHash {
+		return fmt.Errorf("hash of the genesis template is %s, the launch expects %s", hash, c.launch.GenesisHash)
+	}
+
+	genesisPath, err := c.chain.GenesisPath()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(genesisPath, genesis, 0644)
+}
+
+// Home returns the home of the node of the chain.
+func (c *Chain) Home() (string, error) {
+	return c.chain.Home()
+}
+
+// Commands returns the runner of the commands of the chain's binary.
+func (c *Chain) Commands(ctx context.Context) (chaincmdrunner.Runner, error) {
+	return c.chain.Commands(ctx)
+}
+
+// ImportAccount imports the account with name from registry to the keyring of the node so it
+// can sign gentxs, the account keeps its name.
+func (c *Chain) ImportAccount(registry cosmosaccount.Registry, name string) error {
+	acc, err := registry.GetByName(name)
+	if err != nil {
+		return err
+	}
+	if acc.IsLedger() || acc.IsWatchOnly() || acc.IsMultisig() {
+		return fmt.Errorf("account %q cannot sign gentxs, its private key is not in the keyring", name)
+	}
+
+	armor, err := registry.Export(name, keyImportPassphrase)
+	if err != nil {
+		return err
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+
+	kr, err := keyring.New(sdktypes.KeyringServiceName(), keyring.BackendTest, home, os.Stdin)
+	if err != nil {
+		return err
+	}
+
+	if _, err := kr.Key(name); err == nil {
+		if err := kr.Delete(name); err != nil {
+			return err
+		}
+	}
+
+	return kr.ImportPrivKey(name, armor, keyImportPassphrase)
+}
+
+// Gentx adds the validator's account to the genesis with coins and generates its gentx,
+// it returns the address of the validator's account and the gentx.
+func (c *Chain) Gentx(ctx context.Context, validator chain.Validator, coins string) (address string, gentx []byte, err error) {
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	acc, err := commands.ShowAccount(ctx, validator.Name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := commands.AddGenesisAccount(ctx, acc.Address, coins); err != nil {
+		return "", nil, err
+	}
+
+	gentxPath, err := commands.Gentx(
+		ctx,
+		validator.Name,
+		validator.StakingAmount,
+		chaincmd.GentxWithMoniker(validator.Moniker),
+		chaincmd.GentxWithCommissionRate(validator.CommissionRate),
+		chaincmd.GentxWithCommissionMaxRate(validator.CommissionMaxRate),
+		chaincmd.GentxWithCommissionMaxChangeRate(validator.CommissionMaxChangeRate),
+		chaincmd.GentxWithMinSelfDelegation(validator.MinSelfDelegation),
+		chaincmd.GentxWithGasPrices(validator.GasPrices),
+	)
+	if err != nil {
+		return "", nil, err
+	}
+
+	gentx, err = os.ReadFile(gentxPath)
+	return acc.Address, gentx, err
+}
+
+// NodeID returns the ID of the node of the chain.
+func (c *Chain) NodeID(ctx context.Context) (string, error) {
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return "", err
+	}
+	return commands.ShowNodeID(ctx)
+}
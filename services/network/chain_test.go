@@ -0,0 +1,93 @@
+package network
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+const chainGoMod = `module github.com/alice/mars
+
+go 1.16
+
+require github.com/cosmos/cosmos-sdk v0.44.3
+`
+
+// newChainSource creates the git repository of a chain and returns its path and commit.
+func newChainSource(t *testing.T) (path, hash string) {
+	path = t.TempDir()
+	repo, err := git.PlainInit(path, false)
+	require.NoError(t, err)
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(path, "go.mod"), []byte(chainGoMod), 0644))
+	_, err = wt.Add("go.mod")
+	require.NoError(t, err)
+	commit, err := wt.Commit("commit", &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@test", When: time.Now()},
+	})
+	require.NoError(t, err)
+	return path, commit.String()
+}
+
+func TestChainPaths(t *testing.T) {
+	home := Home
+	Home = "/network"
+	defer func() { Home = home }()
+
+	require.Equal(t, "/network/7", ChainPath(7))
+	require.Equal(t, "/network/7/source", ChainSourcePath(7))
+	require.Equal(t, "/network/7/home", ChainHome(7))
+}
+
+func TestChainImportAccount(t *testing.T) {
+	home := Home
+	Home = t.TempDir()
+	defer func() { Home = home }()
+
+	source, hash := newChainSource(t)
+	launch := Launch{ID: 1, ChainID: "mars-1", SourceURL: source, SourceHash: hash}
+
+	c, err := NewChain(context.Background(), launch)
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(ChainSourcePath(1), "go.mod"))
+	require.NoError(t, err)
+	chainHome, err := c.Home()
+	require.NoError(t, err)
+	require.Equal(t, ChainHome(1), chainHome)
+
+	// the source that's already cloned is reused.
+	c, err = NewChain(context.Background(), launch)
+	require.NoError(t, err)
+
+	ca, err := cosmosaccount.New(cosmosaccount.WithHome(t.TempDir()))
+	require.NoError(t, err)
+	validator, _, err := ca.Create("validator")
+	require.NoError(t, err)
+	_, err = ca.AddWatchOnly("watcher", validator.Address(AccountPrefix))
+	require.NoError(t, err)
+
+	err = c.ImportAccount(ca, "watcher")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `account "watcher" cannot sign gentxs`)
+
+	// the account is imported again when it's already in the keyring of the node.
+	require.NoError(t, c.ImportAccount(ca, "validator"))
+	require.NoError(t, c.ImportAccount(ca, "validator"))
+
+	kr, err := keyring.New(sdktypes.KeyringServiceName(), keyring.BackendTest, chainHome, os.Stdin)
+	require.NoError(t, err)
+	info, err := kr.Key("validator")
+	require.NoError(t, err)
+	require.Equal(t, validator.Info.GetAddress(), info.GetAddress())
+}
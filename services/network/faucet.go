@@ -0,0 +1,81 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	sperrors "github.com/trino-network/trino/errors"
+	"github.com/trino-network/trino/pkg/cosmosfaucet"
+)
+
+// Faucet is a faucet of a chain that's registered with the coordinator.
+// anyone can register faucets, clients check that a faucet serves the chain before using it.
+type Faucet struct {
+	ChainID string `json:"chain_id"`
+	Address string `json:"address"`
+
+	// Registrant is the address of the account that registered the faucet.
+	Registrant string `json:"registrant"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// RegisterFaucet registers the faucet at address for the chain with the chain ID, faucets of
+// testnets can be registered before their launch.
+func (c Client) RegisterFaucet(ctx context.Context, chainID, address string) (Faucet, error) {
+	if chainID == "" {
+		return Faucet{}, errors.New("chain ID of the faucet is required")
+	}
+	if u, err := url.Parse(address); err != nil || u.Host == "" {
+		return Faucet{}, fmt.Errorf("invalid faucet address %q", address)
+	}
+
+	acc, err := c.Account()
+	if err != nil {
+		return Faucet{}, err
+	}
+
+	var registered Faucet
+	err = c.post(ctx, "/faucets", Faucet{
+		ChainID:    chainID,
+		Address:    address,
+		Registrant: acc.Address(AccountPrefix),
+	}, &registered)
+	return registered, err
+}
+
+// Faucets returns the faucets registered for the chain with the chain ID.
+func (c Client) Faucets(ctx context.Context, chainID string) ([]Faucet, error) {
+	var faucets []Faucet
+	err := c.get(ctx, "/faucets/"+url.PathEscape(chainID), &faucets)
+	return faucets, err
+}
+
+// DiscoverFaucet returns the address of the first faucet registered for the chain with the
+// chain ID that serves the chain, ErrNotFound is returned when there is none. it's wrapped in an
+// error with the faucet unreachable code when faucets are registered but none serves the chain.
+func (c Client) DiscoverFaucet(ctx context.Context, chainID string) (string, error) {
+	faucets, err := c.Faucets(ctx, chainID)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return "", err
+	}
+
+	for _, faucet := range faucets {
+		ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+		info, err := cosmosfaucet.NewClient(faucet.Address).FaucetInfo(ctx)
+		cancel()
+
+		if err == nil && info.IsAFaucet && info.ChainID == chainID {
+			return faucet.Address, nil
+		}
+	}
+
+	err = fmt.Errorf("faucet of %s: %w", chainID, ErrNotFound)
+	if len(faucets) > 0 {
+		return "", sperrors.FaucetUnreachable(chainID, err)
+	}
+	return "", err
+}
@@ -0,0 +1,62 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	sperrors "github.com/trino-network/trino/errors"
+	"github.com/trino-network/trino/pkg/cosmosfaucet"
+)
+
+func newFaucetServer(t *testing.T, chainID string) *httptest.Server {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(cosmosfaucet.FaucetInfoResponse{IsAFaucet: true, ChainID: chainID})
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestDiscoverFaucet(t *testing.T) {
+	var (
+		venus = newFaucetServer(t, "venus-1")
+		mars  = newFaucetServer(t, "mars-1")
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/faucets/mars-1":
+			json.NewEncoder(w).Encode([]Faucet{
+				{ChainID: "mars-1", Address: "http://127.0.0.1:1"},
+				{ChainID: "mars-1", Address: venus.URL},
+				{ChainID: "mars-1", Address: mars.URL},
+			})
+		case "/faucets/earth-1":
+			json.NewEncoder(w).Encode([]Faucet{
+				{ChainID: "earth-1", Address: "http://127.0.0.1:1"},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(errorResponse{Error: "no faucets"})
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	faucet, err := New(server.URL).DiscoverFaucet(ctx, "mars-1")
+	require.NoError(t, err)
+	require.Equal(t, mars.URL, faucet)
+
+	_, err = New(server.URL).DiscoverFaucet(ctx, "venus-1")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = New(server.URL).DiscoverFaucet(ctx, "earth-1")
+	require.ErrorIs(t, err, ErrNotFound)
+	var unreachable *sperrors.Error
+	require.ErrorAs(t, err, &unreachable)
+	require.Equal(t, sperrors.CodeFaucetUnreachable, unreachable.Code)
+}
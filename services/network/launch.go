@@ -0,0 +1,166 @@
+package network
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+// Launch is the launch of a chain that's published with a coordinator.
+type Launch struct {
+	ID      uint64 `json:"id"`
+	ChainID string `json:"chain_id"`
+
+	// Coordinator is the address of the account that published the launch.
+	Coordinator string `json:"coordinator"`
+
+	// SourceURL is the git repository of the chain and SourceHash is the commit that its binary
+	// is built from.
+	SourceURL  string `json:"source_url"`
+	SourceHash string `json:"source_hash"`
+
+	// GenesisURL is the genesis template of the chain and GenesisHash is the sha256 of it. the
+	// genesis generated by the chain's binary is used when it's empty.
+	GenesisURL  string `json:"genesis_url,omitempty"`
+	GenesisHash string `json:"genesis_hash,omitempty"`
+
+	// CampaignID is the campaign that the chain is launched for, the validators of the chain
+	// are rewarded with the reward shares of the campaign. it's zero when there is no campaign.
+	CampaignID uint64 `json:"campaign_id,omitempty"`
+
+	// LaunchTime is the genesis time of the chain, the launch is not scheduled yet when it's zero.
+	LaunchTime time.Time `json:"launch_time"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// IsScheduled checks if the launch time of the chain is set.
+func (l Launch) IsScheduled() bool {
+	return !l.LaunchTime.IsZero()
+}
+
+// PublishLaunch publishes the launch with the coordinator, the launch is returned with the
+// fields set by the coordinator such as its ID.
+func (c Client) PublishLaunch(ctx context.Context, launch Launch) (Launch, error) {
+	acc, err := c.Account()
+	if err != nil {
+		return Launch{}, err
+	}
+	launch.Coordinator = acc.Address(AccountPrefix)
+
+	var published Launch
+	err = c.post(ctx, "/launches", launch, &published)
+	return published, err
+}
+
+// Launch returns the launch with id.
+func (c Client) Launch(ctx context.Context, id uint64) (Launch, error) {
+	var launch Launch
+	err := c.get(ctx, fmt.Sprintf("/launches/%d", id), &launch)
+	return launch, err
+}
+
+// Launches returns the launches published with the coordinator.
+func (c Client) Launches(ctx context.Context) ([]Launch, error) {
+	var launches []Launch
+	err := c.get(ctx, "/launches", &launches)
+	return launches, err
+}
+
+// ResolveSourceHash returns the hash of the commit that ref points to in the git repository at
+// url, ref is a branch or a tag, the default branch is used when it's empty.
+func ResolveSourceHash(url, ref string) (string, error) {
+	remote := git.NewRemote(memory.NewStorage(), &config.RemoteConfig{
+		Name: git.DefaultRemoteName,
+		URLs: []string{url},
+	})
+
+	refs, err := remote.List(&git.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("cannot reach the repository %s: %w", url, err)
+	}
+
+	names := []plumbing.ReferenceName{plumbing.HEAD}
+	if ref != "" {
+		names = []plumbing.ReferenceName{
+			plumbing.NewBranchReferenceName(ref),
+			plumbing.NewTagReferenceName(ref),
+		}
+	}
+
+	byName := make(map[plumbing.ReferenceName]*plumbing.Reference)
+	for _, r := range refs {
+		byName[r.Name()] = r
+	}
+
+	for _, name := range names {
+		r, ok := byName[name]
+		if !ok {
+			continue
+		}
+		if r.Type() == plumbing.SymbolicReference {
+			if r, ok = byName[r.Target()]; !ok {
+				continue
+			}
+		}
+		return r.Hash().String(), nil
+	}
+
+	if ref == "" {
+		return "", fmt.Errorf("default branch of %s cannot be found", url)
+	}
+	return "", fmt.Errorf("no branch or tag %q in %s", ref, url)
+}
+
+// GenesisHash downloads the genesis at url and returns its sha256 in hex.
+func GenesisHash(ctx context.Context, url string) (string, error) {
+	genesis, err := FetchGenesis(ctx, url)
+	if err != nil {
+		return "", err
+	}
+	return hashBytes(genesis), nil
+}
+
+// FetchGenesis downloads the genesis at url.
+func FetchGenesis(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot download the genesis at %s: %s", url, http.StatusText(res.StatusCode))
+	}
+
+	genesis, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(strings.TrimSpace(string(genesis))) == 0 {
+		return nil, errors.New("genesis is empty")
+	}
+
+	return genesis, nil
+}
+
+func hashBytes(b []byte) string {
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,209 @@
+// Package network is a client of launch coordinator services that chains are launched with.
+//
+// A coordinator keeps the launches of chains that are published by their coordinators, validator
+// candidates discover launches from it and prepare their nodes for the launch time. The API of
+// coordinators is served over HTTP with JSON bodies:
+//
+//	POST /launches                                 publishes a launch
+//	GET  /launches                                 lists the launches
+//	GET  /launches/{id}                            shows a launch
+//	POST /launches/{id}/requests                   sends a request to join a launch
+//	GET  /launches/{id}/requests                   lists the requests to join a launch
+//	POST /launches/{id}/requests/{rid}/approve     approves a request
+//	POST /launches/{id}/requests/{rid}/reject      rejects a request
+//	POST /launches/{id}/genesis                    reports the hash of the genesis of a launch
+//	GET  /launches/{id}/genesis                    lists the hashes reported by participants
+//	POST /campaigns                                creates a campaign
+//	GET  /campaigns                                lists the campaigns
+//	GET  /campaigns/{id}                           shows a campaign
+//	POST /campaigns/{id}                           updates a campaign
+//	POST /faucets                                  registers a faucet of a chain
+//	GET  /faucets/{chain-id}                       lists the faucets of a chain
+//
+// Requests that change the state of coordinators are signed by a Starport account, see SignBytes.
+package network
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tendermint/starport/starport/pkg/xurl"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+const (
+	// HeaderAddress is the header of the address of the account that signed a request.
+	HeaderAddress = "X-Starport-Address"
+
+	// HeaderPubKey is the header of the public key of the account that signed a request in base64.
+	HeaderPubKey = "X-Starport-PubKey"
+
+	// HeaderTimestamp is the header of the unix time that a request is signed at.
+	HeaderTimestamp = "X-Starport-Timestamp"
+
+	// HeaderSignature is the header of the signature of a request in base64.
+	HeaderSignature = "X-Starport-Signature"
+
+	// AccountPrefix is the prefix of the addresses of accounts on coordinators.
+	AccountPrefix = cosmosaccount.AccountPrefixCosmos
+)
+
+// ErrNotFound is returned when the coordinator doesn't have the requested resource.
+var ErrNotFound = errors.New("not found")
+
+// Client is a client of a launch coordinator.
+type Client struct {
+	address string
+
+	// registry and accountName are the account that requests are signed with.
+	registry    cosmosaccount.Registry
+	accountName string
+}
+
+// Option configures the client.
+type Option func(*Client)
+
+// WithAccount signs the requests of the client with the account with name in registry.
+func WithAccount(registry cosmosaccount.Registry, name string) Option {
+	return func(c *Client) {
+		c.registry = registry
+		c.accountName = name
+	}
+}
+
+// New creates a client of the coordinator at address.
+func New(address string, options ...Option) Client {
+	c := Client{
+		address: strings.TrimSuffix(xurl.HTTP(address), "/"),
+	}
+
+	for _, apply := range options {
+		apply(&c)
+	}
+
+	return c
+}
+
+// Account returns the account that requests are signed with.
+func (c Client) Account() (cosmosaccount.Account, error) {
+	if c.accountName == "" {
+		return cosmosaccount.Account{}, errors.New("an account is required to sign requests")
+	}
+	return c.registry.GetByName(c.accountName)
+}
+
+// Error is an error returned by the coordinator.
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return http.StatusText(e.StatusCode)
+	}
+	return fmt.Sprintf("%s: %s", http.StatusText(e.StatusCode), e.Message)
+}
+
+// Is makes errors of not found responses match ErrNotFound.
+func (e *Error) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// errorResponse is the body of the responses of failed requests.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// get requests the resource at path and decodes it into out.
+func (c Client) get(ctx context.Context, path string, out interface{}) error {
+	return c.do(ctx, http.MethodGet, path, nil, out)
+}
+
+// post sends in to path as a signed request and decodes the response into out.
+func (c Client) post(ctx context.Context, path string, in, out interface{}) error {
+	return c.do(ctx, http.MethodPost, path, in, out)
+}
+
+func (c Client) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body []byte
+	if in != nil {
+		var err error
+		if body, err = json.Marshal(in); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.address+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if method != http.MethodGet {
+		if err := c.sign(req, body); err != nil {
+			return err
+		}
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		var resErr errorResponse
+		b, _ := io.ReadAll(io.LimitReader(res.Body, 1<<16))
+		if json.Unmarshal(b, &resErr) != nil {
+			resErr.Error = strings.TrimSpace(string(b))
+		}
+		return &Error{StatusCode: res.StatusCode, Message: resErr.Error}
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// sign signs req with body by the account of the client.
+func (c Client) sign(req *http.Request, body []byte) error {
+	acc, err := c.Account()
+	if err != nil {
+		return err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	signature, pubKey, err := c.registry.Keyring.Sign(acc.Info.GetName(), SignBytes(req.Method, req.URL.Path, timestamp, body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set(HeaderAddress, acc.Address(AccountPrefix))
+	req.Header.Set(HeaderPubKey, base64.StdEncoding.EncodeToString(pubKey.Bytes()))
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, base64.StdEncoding.EncodeToString(signature))
+
+	return nil
+}
+
+// SignBytes returns the bytes that are signed for a request, coordinators verify the signature
+// in the HeaderSignature header against them with the public key in the HeaderPubKey header.
+func SignBytes(method, path, timestamp string, body []byte) []byte {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n%s\n", method, path, timestamp)
+	h.Write(body)
+	return h.Sum(nil)
+}
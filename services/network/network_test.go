@@ -0,0 +1,64 @@
+package network
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/stretchr/testify/require"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+func TestPublishLaunch(t *testing.T) {
+	ca, err := cosmosaccount.New(cosmosaccount.WithHome(t.TempDir()))
+	require.NoError(t, err)
+	coordinator, _, err := ca.Create("coordinator")
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/launches":
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+
+			pubKey, err := base64.StdEncoding.DecodeString(r.Header.Get(HeaderPubKey))
+			require.NoError(t, err)
+			signature, err := base64.StdEncoding.DecodeString(r.Header.Get(HeaderSignature))
+			require.NoError(t, err)
+
+			signBytes := SignBytes(r.Method, r.URL.Path, r.Header.Get(HeaderTimestamp), body)
+			require.True(t, (&secp256k1.PubKey{Key: pubKey}).VerifySignature(signBytes, signature))
+			require.Equal(t, coordinator.Address(AccountPrefix), r.Header.Get(HeaderAddress))
+
+			var launch Launch
+			require.NoError(t, json.Unmarshal(body, &launch))
+			launch.ID = 1
+			json.NewEncoder(w).Encode(launch)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(errorResponse{Error: "launch not found"})
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	_, err = New(server.URL).PublishLaunch(ctx, Launch{ChainID: "mars-1"})
+	require.Error(t, err)
+
+	launch, err := New(server.URL, WithAccount(ca, "coordinator")).PublishLaunch(ctx, Launch{ChainID: "mars-1"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), launch.ID)
+	require.Equal(t, coordinator.Address(AccountPrefix), launch.Coordinator)
+
+	_, err = New(server.URL).Launch(ctx, 2)
+	require.True(t, errors.Is(err, ErrNotFound))
+	require.EqualError(t, err, "Not Found: launch not found")
+}
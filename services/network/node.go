@@ -0,0 +1,428 @@
+package network
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/exec"
+	"github.com/tendermint/starport/starport/pkg/confile"
+	"github.com/tendermint/starport/starport/pkg/gocmd"
+	"github.com/trino-network/trino/pkg/chaincmd"
+	chaincmdrunner "github.com/trino-network/trino/pkg/chaincmd/runner"
+	"github.com/trino-network/trino/pkg/chainregistry"
+	"github.com/trino-network/trino/pkg/cmdtrace"
+)
+
+const (
+	// maxPeers is the max number of healthy peers that nodes connect to.
+	maxPeers = 10
+
+	// stateSyncTrustOffset is the number of blocks before the latest block of state sync
+	// providers that's trusted by nodes that sync from them.
+	stateSyncTrustOffset = 2000
+
+	// stateSyncTrustPeriod is the trust period of the block that's trusted by state sync.
+	stateSyncTrustPeriod = "168h0m0s"
+
+	healthCheckTimeout = 5 * time.Second
+)
+
+// NodePath returns the dir of the node of the public chain with the chain ID.
+func NodePath(chainID string) string {
+	return filepath.Join(Home, "nodes", chainID)
+}
+
+// NodeSourcePath returns the dir that the source of the public chain with the chain ID is
+// cloned to.
+func NodeSourcePath(chainID string) string {
+	return filepath.Join(NodePath(chainID), "source")
+}
+
+// NodeHome returns the home of the node of the public chain with the chain ID.
+func NodeHome(chainID string) string {
+	return filepath.Join(NodePath(chainID), "home")
+}
+
+// Node is a full node of a public chain that's described in a chain registry, its source is
+// cloned and its node is initialized in the dir of the chain under Home.
+type Node struct {
+	chain    chainregistry.Chain
+	commands chaincmdrunner.Runner
+}
+
+// NewNode clones the source of chain at its recommended version, unless it's already cloned.
+func NewNode(ctx context.Context, chain chainregistry.Chain) (*Node, error) {
+	switch {
+	case chain.DaemonName == "":
+		return nil, fmt.Errorf("daemon name of %s is not in the registry", chain.ChainID)
+	case chain.Codebase.GitRepo == "":
+		return nil, fmt.Errorf("source of %s is not in the registry", chain.ChainID)
+	case chain.Codebase.RecommendedVersion == "":
+		return nil, fmt.Errorf("recommended version of %s is not in the registry", chain.ChainID)
+	case chain.Codebase.Genesis.GenesisURL == "":
+		return nil, fmt.Errorf("genesis of %s is not in the registry", chain.ChainID)
+	}
+
+	path := NodeSourcePath(chain.ChainID)
+
+	repo, err := git.PlainOpen(path)
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		repo, err = git.PlainCloneContext(ctx, path, false, &git.CloneOptions{URL: chain.Codebase.GitRepo})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot clone the source of the chain: %w", err)
+	}
+
+	version := chain.Codebase.RecommendedVersion
+	checkout := func() error {
+		hash, err := repo.ResolveRevision(plumbing.Revision(version))
+		if err != nil {
+			return err
+		}
+		wt, err := repo.Worktree()
+		if err != nil {
+			return err
+		}
+		return wt.Checkout(&git.CheckoutOptions{Hash: *hash, Force: true})
+	}
+	if err := checkout(); err != nil {
+		// the source that's already cloned may not have the version yet.
+		err := repo.FetchContext(ctx, &git.FetchOptions{Tags: git.AllTags})
+		if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+			return nil, fmt.Errorf("cannot fetch the source of the chain: %w", err)
+		}
+		if err := checkout(); err != nil {
+			return nil, fmt.Errorf("cannot checkout the version %s of the chain: %w", version, err)
+		}
+	}
+
+	commands, err := chaincmdrunner.New(ctx, chaincmd.New(
+		chain.DaemonName,
+		chaincmd.WithHome(NodeHome(chain.ChainID)),
+		chaincmd.WithChainID(chain.ChainID),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Node{chain: chain, commands: commands}, nil
+}
+
+// Build builds and installs the binary of the chain from cmd/<daemon-name> of its source.
+func (n *Node) Build(ctx context.Context) (binaryName string, err error) {
+	path := filepath.Join(NodeSourcePath(n.chain.ChainID), "cmd", n.chain.DaemonName)
+	if _, err := os.Stat(path); err != nil {
+		return "", fmt.Errorf("main package of %s cannot be found in its source: %w", n.chain.DaemonName, err)
+	}
+
+	if err := gocmd.BuildPath(ctx, "", n.chain.DaemonName, path, nil, exec.StepOption(cmdtrace.Step())); err != nil {
+		return "", err
+	}
+	return n.chain.DaemonName, nil
+}
+
+// Home returns the home of the node.
+func (n *Node) Home() string {
+	return NodeHome(n.chain.ChainID)
+}
+
+// Init initializes the node with moniker, the genesis of the chain and its peers, the node
+// syncs from the state of the chain when stateSync is set.
+// the keys and data of a node that's already initialized are kept.
+func (n *Node) Init(ctx context.Context, moniker string, peers []chainregistry.Peer, stateSync *StateSync) error {
+	genesis, err := FetchGenesis(ctx, n.chain.Codebase.Genesis.GenesisURL)
+	if err != nil {
+		return err
+	}
+	if genesis, err = decompressGenesis(genesis); err != nil {
+		return err
+	}
+
+	configPath := filepath.Join(n.Home(), "config")
+	genesisPath := filepath.Join(configPath, "genesis.json")
+
+	// the chain's binary doesn't initialize nodes that already have a genesis.
+	if err := os.RemoveAll(genesisPath); err != nil {
+		return err
+	}
+	if err := n.commands.Init(ctx, moniker); err != nil {
+		return err
+	}
+	if err := os.WriteFile(genesisPath, genesis, 0644); err != nil {
+		return err
+	}
+
+	var seeds []string
+	for _, seed := range n.chain.Peers.Seeds {
+		seeds = append(seeds, seed.String())
+	}
+
+	cf := confile.New(confile.DefaultTOMLEncodingCreator, filepath.Join(configPath, "config.toml"))
+
+	var config map[string]interface{}
+	if err := cf.Load(&config); err != nil {
+		return err
+	}
+
+	p2p := configSection(config, "p2p")
+	p2p["seeds"] = strings.Join(seeds, ",")
+	p2p["persistent_peers"] = joinPeers(peers)
+
+	statesync := configSection(config, "statesync")
+	statesync["enable"] = stateSync != nil
+	if stateSync != nil {
+		statesync["rpc_servers"] = strings.Join(stateSync.RPCServers, ",")
+		statesync["trust_height"] = stateSync.TrustHeight
+		statesync["trust_hash"] = stateSync.TrustHash
+		statesync["trust_period"] = stateSyncTrustPeriod
+	}
+
+	return cf.Save(config)
+}
+
+// Start starts the node, it runs until ctx is canceled.
+func (n *Node) Start(ctx context.Context, stdout, stderr io.Writer) error {
+	return n.commands.Copy(
+		chaincmdrunner.Stdout(stdout),
+		chaincmdrunner.Stderr(stderr),
+	).Start(ctx)
+}
+
+// HealthyPeers returns the peers that accept connections, at most maxPeers of them are
+// returned in their order.
+func HealthyPeers(ctx context.Context, peers []chainregistry.Peer) []chainregistry.Peer {
+	healthy := make([]bool, len(peers))
+
+	var wg sync.WaitGroup
+	for i, peer := range peers {
+		wg.Add(1)
+		go func(i int, peer chainregistry.Peer) {
+			defer wg.Done()
+			healthy[i] = isReachable(ctx, peer.Address)
+		}(i, peer)
+	}
+	wg.Wait()
+
+	var selected []chainregistry.Peer
+	for i, peer := range peers {
+		if healthy[i] && len(selected) < maxPeers {
+			selected = append(selected, peer)
+		}
+	}
+	return selected
+}
+
+// isReachable checks if the node at address in the <host>:<port> format accepts connections.
+func isReachable(ctx context.Context, address string) bool {
+	d := net.Dialer{Timeout: healthCheckTimeout}
+	conn, err := d.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
+
+// StateSync is the configuration of nodes that sync from the state of a chain instead of
+// replaying its blocks.
+type StateSync struct {
+	// RPCServers are the RPC endpoints that light client verification is done with.
+	RPCServers  []string
+	TrustHeight int64
+	TrustHash   string
+}
+
+// FindStateSync checks the RPC endpoints of the chain with the chain ID and configures state
+// sync with the healthy ones, endpoints are healthy when they are synced to the chain.
+func FindStateSync(ctx context.Context, chainID string, endpoints []chainregistry.Endpoint) (StateSync, error) {
+	heights := make([]int64, len(endpoints))
+
+	var wg sync.WaitGroup
+	for i, endpoint := range endpoints {
+		wg.Add(1)
+		go func(i int, address string) {
+			defer wg.Done()
+
+			height, err := latestHeight(ctx, address, chainID)
+			if err == nil {
+				heights[i] = height
+			}
+		}(i, endpoint.Address)
+	}
+	wg.Wait()
+
+	var (
+		servers []string
+		seen    = make(map[string]bool)
+		height  int64
+	)
+	for i, endpoint := range endpoints {
+		address := strings.TrimSuffix(endpoint.Address, "/")
+		// the endpoints that are listed more than once are a single RPC server.
+		if heights[i] == 0 || seen[address] {
+			continue
+		}
+		seen[address] = true
+		servers = append(servers, address)
+		if height == 0 || heights[i] < height {
+			height = heights[i]
+		}
+	}
+
+	switch len(servers) {
+	case 0:
+		return StateSync{}, fmt.Errorf("none of the %d RPC endpoints of %s is healthy", len(endpoints), chainID)
+	case 1:
+		// light clients verify the state with two distinct RPC servers at least.
+		return StateSync{}, fmt.Errorf("only %s of the RPC endpoints of %s is healthy, state sync needs two distinct healthy RPC endpoints", servers[0], chainID)
+	}
+	if height <= stateSyncTrustOffset {
+		return StateSync{}, fmt.Errorf("%s is too young to sync from its state, its height is %d", chainID, height)
+	}
+
+	trustHeight := height - stateSyncTrustOffset
+	trustHash, err := blockHash(ctx, servers[0], trustHeight)
+	if err != nil {
+		return StateSync{}, err
+	}
+
+	return StateSync{
+		RPCServers:  servers,
+		TrustHeight: trustHeight,
+		TrustHash:   trustHash,
+	}, nil
+}
+
+// NodeChainID returns the chain ID of the node with the RPC endpoint at address.
+func NodeChainID(ctx context.Context, address string) (string, error) {
+	var status struct {
+		NodeInfo struct {
+			Network string `json:"network"`
+		} `json:"node_info"`
+	}
+	if err := rpcCall(ctx, address, "/status", &status); err != nil {
+		return "", err
+	}
+	return status.NodeInfo.Network, nil
+}
+
+// latestHeight returns the height of the latest block of the RPC endpoint at address, the
+// endpoint must be synced to the chain with the chain ID.
+func latestHeight(ctx context.Context, address, chainID string) (int64, error) {
+	var status struct {
+		NodeInfo struct {
+			Network string `json:"network"`
+		} `json:"node_info"`
+		SyncInfo struct {
+			LatestBlockHeight string `json:"latest_block_height"`
+			CatchingUp        bool   `json:"catching_up"`
+		} `json:"sync_info"`
+	}
+	if err := rpcCall(ctx, address, "/status", &status); err != nil {
+		return 0, err
+	}
+
+	switch {
+	case status.NodeInfo.Network != chainID:
+		return 0, fmt.Errorf("%s is a node of %s", address, status.NodeInfo.Network)
+	case status.SyncInfo.CatchingUp:
+		return 0, fmt.Errorf("%s is catching up", address)
+	}
+
+	return strconv.ParseInt(status.SyncInfo.LatestBlockHeight, 10, 64)
+}
+
+// blockHash returns the hash of the block at height from the RPC endpoint at address.
+func blockHash(ctx context.Context, address string, height int64) (string, error) {
+	var block struct {
+		BlockID struct {
+			Hash string `json:"hash"`
+		} `json:"block_id"`
+	}
+	if err := rpcCall(ctx, address, fmt.Sprintf("/block?height=%d", height), &block); err != nil {
+		return "", err
+	}
+	if block.BlockID.Hash == "" {
+		return "", fmt.Errorf("block %d is not found in %s", height, address)
+	}
+	return block.BlockID.Hash, nil
+}
+
+// rpcCall calls the Tendermint RPC endpoint at address with path and decodes its result into
+// result.
+func rpcCall(ctx context.Context, address, path string, result interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(address, "/")+path, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", address, http.StatusText(res.StatusCode))
+	}
+
+	var response struct {
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return err
+	}
+	return json.Unmarshal(response.Result, result)
+}
+
+// decompressGenesis decompresses genesis when it's gzipped, registries link to gzipped
+// genesis for chains with large genesis.
+func decompressGenesis(genesis []byte) ([]byte, error) {
+	if !bytes.HasPrefix(genesis, []byte{0x1f, 0x8b}) {
+		return genesis, nil
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(genesis))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
+
+// configSection returns the section with name of the config, it's added when it's missing.
+func configSection(config map[string]interface{}, name string) map[string]interface{} {
+	section, ok := config[name].(map[string]interface{})
+	if !ok {
+		section = make(map[string]interface{})
+		config[name] = section
+	}
+	return section
+}
+
+func joinPeers(peers []chainregistry.Peer) string {
+	addresses := make([]string, len(peers))
+	for i, peer := range peers {
+		addresses[i] = peer.String()
+	}
+	return strings.Join(addresses, ",")
+}
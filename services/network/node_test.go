@@ -0,0 +1,78 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trino-network/trino/pkg/chainregistry"
+)
+
+func newRPCServer(t *testing.T, network string, height int64, catchingUp bool) *httptest.Server {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/status":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":-1,"result":{"node_info":{"network":%q},"sync_info":{"latest_block_height":"%d","catching_up":%t}}}`,
+				network, height, catchingUp)
+		case "/block":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":-1,"result":{"block_id":{"hash":"HASH%s"}}}`, r.URL.Query().Get("height"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestFindStateSync(t *testing.T) {
+	var (
+		synced     = newRPCServer(t, "mars-1", 10500, false)
+		behind     = newRPCServer(t, "mars-1", 10000, false)
+		catchingUp = newRPCServer(t, "mars-1", 100, true)
+		other      = newRPCServer(t, "venus-1", 20000, false)
+	)
+
+	ss, err := FindStateSync(context.Background(), "mars-1", []chainregistry.Endpoint{
+		{Address: synced.URL},
+		{Address: catchingUp.URL},
+		{Address: other.URL},
+		{Address: behind.URL},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{synced.URL, behind.URL}, ss.RPCServers)
+	require.Equal(t, int64(8000), ss.TrustHeight)
+	require.Equal(t, "HASH8000", ss.TrustHash)
+
+	_, err = FindStateSync(context.Background(), "mars-1", []chainregistry.Endpoint{{Address: other.URL}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "none of the 1 RPC endpoints of mars-1 is healthy")
+
+	// the endpoints that are listed twice are a single RPC server.
+	_, err = FindStateSync(context.Background(), "mars-1", []chainregistry.Endpoint{
+		{Address: synced.URL},
+		{Address: synced.URL + "/"},
+		{Address: other.URL},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "state sync needs two distinct healthy RPC endpoints")
+}
+
+func TestHealthyPeers(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closed.Close()
+
+	peers := HealthyPeers(context.Background(), []chainregistry.Peer{
+		{ID: "a", Address: closed.Addr().String()},
+		{ID: "b", Address: l.Addr().String()},
+	})
+	require.Equal(t, []chainregistry.Peer{{ID: "b", Address: l.Addr().String()}}, peers)
+}
@@ -0,0 +1,181 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tendermint/starport/starport/pkg/confile"
+)
+
+// ErrGenesisMismatch is returned when the genesis of a launch doesn't match the genesis of
+// other participants.
+var ErrGenesisMismatch = errors.New("genesis doesn't match the genesis of other participants")
+
+// GenesisReport is the hash of the genesis of a launch that's assembled by a participant.
+type GenesisReport struct {
+	// Participant is the address of the account that reported the hash.
+	Participant string    `json:"participant"`
+	Hash        string    `json:"hash"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ReportGenesis reports the hash of the genesis of the launch with id that's assembled locally.
+func (c Client) ReportGenesis(ctx context.Context, launchID uint64, hash string) error {
+	return c.post(ctx, fmt.Sprintf("/launches/%d/genesis", launchID), GenesisReport{Hash: hash}, nil)
+}
+
+// GenesisReports returns the hashes of the genesis of the launch with id that are reported by
+// its participants.
+func (c Client) GenesisReports(ctx context.Context, launchID uint64) ([]GenesisReport, error) {
+	var reports []GenesisReport
+	err := c.get(ctx, fmt.Sprintf("/launches/%d/genesis", launchID), &reports)
+	return reports, err
+}
+
+// VerifyGenesisHash checks that hash matches the hashes reported by participants, the error
+// lists the participants with different hashes.
+func VerifyGenesisHash(hash string, reports []GenesisReport) error {
+	var mismatches []string
+	for _, report := range reports {
+		if report.Hash != hash {
+			mismatches = append(mismatches, fmt.Sprintf("%s (%s)", report.Participant, report.Hash))
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return fmt.Errorf("%w, genesis hash is %s but these participants reported: %s",
+			ErrGenesisMismatch, hash, strings.Join(mismatches, ", "))
+	}
+	return nil
+}
+
+// ApprovedRequests returns the approved requests sorted by their IDs, the genesis is assembled
+// from them in this order.
+func ApprovedRequests(requests []Request) []Request {
+	var approved []Request
+	for _, request := range requests {
+		if request.Status == RequestApproved {
+			approved = append(approved, request)
+		}
+	}
+
+	sort.Slice(approved, func(i, j int) bool { return approved[i].ID < approved[j].ID })
+
+	return approved
+}
+
+// Prepare assembles the final genesis of the launch from the approved requests and configures
+// the node to connect to the nodes of the validators, the genesis is the same for all
+// participants so its hash is returned to be verified with theirs.
+func (c *Chain) Prepare(ctx context.Context, requests []Request) (genesisHash string, err error) {
+	if !c.launch.IsScheduled() {
+		return "", errors.New("launch time is not set yet, the genesis time is the launch time")
+	}
+
+	approved := ApprovedRequests(requests)
+	if len(approved) == 0 {
+		return "", errors.New("there are no approved validators yet")
+	}
+
+	if err := c.Init(ctx); err != nil {
+		return "", err
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return "", err
+	}
+	genesisPath := filepath.Join(home, "config/genesis.json")
+
+	if err := setGenesisTime(genesisPath, c.launch.LaunchTime); err != nil {
+		return "", err
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	gentxDir := filepath.Join(home, "config/gentx")
+	if err := os.MkdirAll(gentxDir, 0755); err != nil {
+		return "", err
+	}
+
+	var peers []string
+
+	for _, request := range approved {
+		if err := commands.AddGenesisAccount(ctx, request.Validator.Address, request.Validator.Coins); err != nil {
+			return "", fmt.Errorf("cannot add the account of request #%d: %w", request.ID, err)
+		}
+
+		gentxPath := filepath.Join(gentxDir, fmt.Sprintf("gentx-%d.json", request.ID))
+		if err := os.WriteFile(gentxPath, request.Validator.Gentx, 0644); err != nil {
+			return "", err
+		}
+
+		peers = append(peers, request.Validator.Peer)
+	}
+
+	if err := commands.CollectGentxs(ctx); err != nil {
+		return "", err
+	}
+	if err := commands.ValidateGenesis(ctx); err != nil {
+		return "", err
+	}
+
+	nodeID, err := commands.ShowNodeID(ctx)
+	if err != nil {
+		return "", err
+	}
+	if err := setPersistentPeers(filepath.Join(home, "config/config.toml"), nodeID, peers); err != nil {
+		return "", err
+	}
+
+	genesis, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return "", err
+	}
+
+	return hashBytes(genesis), nil
+}
+
+// setGenesisTime sets the genesis time of the genesis at path.
+func setGenesisTime(path string, genesisTime time.Time) error {
+	cf := confile.New(confile.DefaultJSONEncodingCreator, path)
+
+	var genesis map[string]interface{}
+	if err := cf.Load(&genesis); err != nil {
+		return err
+	}
+	genesis["genesis_time"] = genesisTime.UTC().Format(time.RFC3339Nano)
+
+	return cf.Save(genesis)
+}
+
+// setPersistentPeers sets the persistent peers of the node with nodeID in the config.toml at
+// path to peers, the node itself is excluded.
+func setPersistentPeers(path, nodeID string, peers []string) error {
+	var others []string
+	for _, peer := range peers {
+		if !strings.HasPrefix(peer, nodeID+"@") {
+			others = append(others, peer)
+		}
+	}
+
+	cf := confile.New(confile.DefaultTOMLEncodingCreator, path)
+
+	var config map[string]interface{}
+	if err := cf.Load(&config); err != nil {
+		return err
+	}
+
+	configSection(config, "p2p")["persistent_peers"] = strings.Join(others, ",")
+
+	return cf.Save(config)
+}
@@ -0,0 +1,47 @@
+package network
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyGenesisHash(t *testing.T) {
+	reports := []GenesisReport{
+		{Participant: "cosmos1a", Hash: "aaa"},
+		{Participant: "cosmos1b", Hash: "aaa"},
+	}
+	require.NoError(t, VerifyGenesisHash("aaa", reports))
+
+	reports = append(reports, GenesisReport{Participant: "cosmos1c", Hash: "ccc"})
+	err := VerifyGenesisHash("aaa", reports)
+	require.ErrorIs(t, err, ErrGenesisMismatch)
+	require.Contains(t, err.Error(), "cosmos1c (ccc)")
+}
+
+func TestApprovedRequests(t *testing.T) {
+	approved := ApprovedRequests([]Request{
+		{ID: 3, Status: RequestApproved},
+		{ID: 1, Status: RequestRejected},
+		{ID: 2, Status: RequestApproved},
+		{ID: 4, Status: RequestPending},
+	})
+
+	require.Len(t, approved, 2)
+	require.Equal(t, uint64(2), approved[0].ID)
+	require.Equal(t, uint64(3), approved[1].ID)
+}
+
+func TestSetPersistentPeers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, os.WriteFile(path, []byte("moniker = \"mynode\"\n\n[p2p]\nladdr = \"tcp://0.0.0.0:26656\"\n"), 0644))
+
+	require.NoError(t, setPersistentPeers(path, "self", []string{"a@1.1.1.1:26656", "self@2.2.2.2:26656", "b@3.3.3.3:26656"}))
+
+	config, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(config), `persistent_peers = "a@1.1.1.1:26656,b@3.3.3.3:26656"`)
+	require.Contains(t, string(config), `laddr = "tcp://0.0.0.0:26656"`)
+}
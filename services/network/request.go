@@ -0,0 +1,78 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// RequestStatus is the status of a request to join a launch.
+type RequestStatus string
+
+const (
+	RequestPending  RequestStatus = "pending"
+	RequestApproved RequestStatus = "approved"
+	RequestRejected RequestStatus = "rejected"
+)
+
+// Request is a request of a validator to join a launch, coordinators approve the requests of the
+// validators that start the chain.
+type Request struct {
+	ID       uint64 `json:"id"`
+	LaunchID uint64 `json:"launch_id"`
+
+	// Creator is the address of the account that sent the request.
+	Creator string        `json:"creator"`
+	Status  RequestStatus `json:"status"`
+
+	Validator Validator `json:"validator"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Validator is a validator of a launch.
+type Validator struct {
+	// Address is the address of the validator's account on the chain, it's added to the genesis
+	// with Coins.
+	Address string `json:"address"`
+	Coins   string `json:"coins"`
+
+	// Gentx is the genesis transaction of the validator.
+	Gentx json.RawMessage `json:"gentx"`
+
+	// Peer is the address of the validator's node in the <node-id>@<host>:<port> format.
+	Peer string `json:"peer"`
+}
+
+// SendRequest sends a request to join the launch with id as validator.
+func (c Client) SendRequest(ctx context.Context, launchID uint64, validator Validator) (Request, error) {
+	var request Request
+	err := c.post(ctx, fmt.Sprintf("/launches/%d/requests", launchID), validator, &request)
+	return request, err
+}
+
+// Requests returns the requests to join the launch with id.
+func (c Client) Requests(ctx context.Context, launchID uint64) ([]Request, error) {
+	var requests []Request
+	err := c.get(ctx, fmt.Sprintf("/launches/%d/requests", launchID), &requests)
+	return requests, err
+}
+
+// ApproveRequest approves the request with id to join the launch with launchID, only the
+// coordinator of the launch can approve its requests.
+func (c Client) ApproveRequest(ctx context.Context, launchID, id uint64) (Request, error) {
+	return c.reviewRequest(ctx, launchID, id, "approve")
+}
+
+// RejectRequest rejects the request with id to join the launch with launchID, only the
+// coordinator of the launch can reject its requests.
+func (c Client) RejectRequest(ctx context.Context, launchID, id uint64) (Request, error) {
+	return c.reviewRequest(ctx, launchID, id, "reject")
+}
+
+func (c Client) reviewRequest(ctx context.Context, launchID, id uint64, action string) (Request, error) {
+	var request Request
+	err := c.post(ctx, fmt.Sprintf("/launches/%d/requests/%d/%s", launchID, id, action), nil, &request)
+	return request, err
+}
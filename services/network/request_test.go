@@ -0,0 +1,93 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+)
+
+func TestRequests(t *testing.T) {
+	ca, err := cosmosaccount.New(cosmosaccount.WithHome(t.TempDir()))
+	require.NoError(t, err)
+	validator, _, err := ca.Create("validator")
+	require.NoError(t, err)
+
+	requests := map[uint64]*Request{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/launches/1/requests":
+			request := Request{ID: uint64(len(requests) + 1), LaunchID: 1, Status: RequestPending}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request.Validator))
+			request.Creator = r.Header.Get(HeaderAddress)
+			requests[request.ID] = &request
+			json.NewEncoder(w).Encode(request)
+
+		case r.Method == http.MethodGet && r.URL.Path == "/launches/1/requests":
+			list := []Request{}
+			for id := uint64(1); id <= uint64(len(requests)); id++ {
+				list = append(list, *requests[id])
+			}
+			json.NewEncoder(w).Encode(list)
+
+		case r.Method == http.MethodPost && r.URL.Path == "/launches/1/requests/1/approve":
+			requests[1].Status = RequestApproved
+			json.NewEncoder(w).Encode(requests[1])
+
+		case r.Method == http.MethodPost && r.URL.Path == "/launches/1/requests/2/reject":
+			requests[2].Status = RequestRejected
+			json.NewEncoder(w).Encode(requests[2])
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(errorResponse{Error: "request not found"})
+		}
+	}))
+	defer server.Close()
+
+	var (
+		ctx = context.Background()
+		c   = New(server.URL, WithAccount(ca, "validator"))
+		v   = Validator{
+			Address: "cosmos1validator",
+			Coins:   "1000stake",
+			Gentx:   json.RawMessage(`{"body":{}}`),
+			Peer:    "e0c1@10.0.0.1:26656",
+		}
+	)
+
+	_, err = New(server.URL).SendRequest(ctx, 1, v)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "an account is required")
+
+	for i := 0; i < 2; i++ {
+		request, err := c.SendRequest(ctx, 1, v)
+		require.NoError(t, err)
+		require.Equal(t, uint64(i+1), request.ID)
+		require.Equal(t, RequestPending, request.Status)
+		require.Equal(t, validator.Address(AccountPrefix), request.Creator)
+		require.Equal(t, v, request.Validator)
+	}
+
+	request, err := c.ApproveRequest(ctx, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, RequestApproved, request.Status)
+
+	request, err = c.RejectRequest(ctx, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, RequestRejected, request.Status)
+
+	list, err := New(server.URL).Requests(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.Equal(t, RequestApproved, list[0].Status)
+	require.Equal(t, RequestRejected, list[1].Status)
+
+	_, err = c.ApproveRequest(ctx, 1, 3)
+	require.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,220 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// blockIDFlagCommit is the flag of the signatures of validators that signed a block.
+	blockIDFlagCommit = 2
+
+	// maxConcurrentRPCCalls is the max number of calls that are made to an RPC endpoint at once.
+	maxConcurrentRPCCalls = 10
+)
+
+// RewardsStatus is the uptime of the validators of a launched chain over its last blocks and
+// the preview of the distribution of the reward shares of its campaign.
+type RewardsStatus struct {
+	Launch   Launch
+	Campaign Campaign
+
+	// FromHeight and ToHeight are the first and the last blocks that the uptime is tracked over.
+	FromHeight int64
+	ToHeight   int64
+
+	Validators []ValidatorRewards
+}
+
+// ValidatorRewards is the uptime of a validator and its rewards.
+type ValidatorRewards struct {
+	Request Request
+
+	// ConsensusAddress is the address that the validator signs blocks with.
+	ConsensusAddress string
+
+	// Signed is the number of blocks signed by the validator out of Blocks.
+	Signed int64
+	Blocks int64
+
+	// Eligible is true when the uptime of the validator meets the min uptime of the campaign.
+	Eligible bool
+	Rewards  sdktypes.Coins
+}
+
+// Uptime returns the percentage of blocks signed by the validator.
+func (v ValidatorRewards) Uptime() float64 {
+	if v.Blocks == 0 {
+		return 0
+	}
+	return float64(v.Signed) * 100 / float64(v.Blocks)
+}
+
+// RewardsStatus tracks the blocks that are signed by the approved validators of the launch
+// with id over the last blocks of its chain, that are fetched from the RPC endpoint at
+// rpcAddress, and previews the distribution of the reward shares of its campaign.
+func (c Client) RewardsStatus(ctx context.Context, launchID uint64, rpcAddress string, blocks int64) (RewardsStatus, error) {
+	launch, err := c.Launch(ctx, launchID)
+	if err != nil {
+		return RewardsStatus{}, err
+	}
+	if launch.CampaignID == 0 {
+		return RewardsStatus{}, fmt.Errorf("launch #%d is not launched for a campaign", launchID)
+	}
+
+	campaign, err := c.Campaign(ctx, launch.CampaignID)
+	if err != nil {
+		return RewardsStatus{}, err
+	}
+
+	requests, err := c.Requests(ctx, launchID)
+	if err != nil {
+		return RewardsStatus{}, err
+	}
+
+	from, to, signed, err := signedBlocks(ctx, rpcAddress, launch.ChainID, blocks)
+	if err != nil {
+		return RewardsStatus{}, err
+	}
+
+	status := RewardsStatus{
+		Launch:     launch,
+		Campaign:   campaign,
+		FromHeight: from,
+		ToHeight:   to,
+	}
+
+	for _, request := range ApprovedRequests(requests) {
+		validator, err := parseGentx(request.Validator.Gentx)
+		if err != nil {
+			return RewardsStatus{}, fmt.Errorf("invalid gentx of request #%d: %w", request.ID, err)
+		}
+
+		address := validator.ConsensusAddress()
+		status.Validators = append(status.Validators, ValidatorRewards{
+			Request:          request,
+			ConsensusAddress: address,
+			Signed:           signed[address],
+			Blocks:           to - from + 1,
+		})
+	}
+
+	rewardShares, err := sdktypes.ParseCoinsNormalized(campaign.RewardShares)
+	if err != nil {
+		return RewardsStatus{}, err
+	}
+	DistributeRewards(rewardShares, campaign.MinUptime, status.Validators)
+
+	return status, nil
+}
+
+// DistributeRewards distributes rewardShares to the validators with the min uptime, the
+// rewards of each validator are proportional to the blocks signed by it. rewards are rounded
+// down so a remainder of the shares may be left undistributed.
+func DistributeRewards(rewardShares sdktypes.Coins, minUptime float64, validators []ValidatorRewards) {
+	var totalSigned int64
+	for i := range validators {
+		validators[i].Eligible = validators[i].Signed > 0 && validators[i].Uptime() >= minUptime
+		validators[i].Rewards = nil
+		if validators[i].Eligible {
+			totalSigned += validators[i].Signed
+		}
+	}
+	if totalSigned == 0 {
+		return
+	}
+
+	for i := range validators {
+		if !validators[i].Eligible {
+			continue
+		}
+
+		var rewards sdktypes.Coins
+		for _, share := range rewardShares {
+			amount := share.Amount.MulRaw(validators[i].Signed).QuoRaw(totalSigned)
+			rewards = rewards.Add(sdktypes.NewCoin(share.Denom, amount))
+		}
+		validators[i].Rewards = rewards
+	}
+}
+
+// signedBlocks returns the number of blocks that are signed by each validator over the last
+// blocks of the chain with the chain ID, that are fetched from the RPC endpoint at address.
+// validators are identified by their consensus addresses.
+func signedBlocks(ctx context.Context, address, chainID string, blocks int64) (from, to int64, signed map[string]int64, err error) {
+	to, err = latestHeight(ctx, address, chainID)
+	if err != nil {
+		return 0, 0, nil, fmt.Errorf("cannot reach the node of %s: %w", chainID, err)
+	}
+
+	from = to - blocks + 1
+	if from < 1 {
+		from = 1
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		heights = make(chan int64)
+		errs    = make(chan error, maxConcurrentRPCCalls)
+	)
+	signed = make(map[string]int64)
+
+	for i := 0; i < maxConcurrentRPCCalls; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for height := range heights {
+				var commit struct {
+					SignedHeader struct {
+						Commit struct {
+							Signatures []struct {
+								BlockIDFlag      int    `json:"block_id_flag"`
+								ValidatorAddress string `json:"validator_address"`
+							} `json:"signatures"`
+						} `json:"commit"`
+					} `json:"signed_header"`
+				}
+				if err := rpcCall(ctx, address, fmt.Sprintf("/commit?height=%d", height), &commit); err != nil {
+					errs <- fmt.Errorf("cannot fetch the commit of block %d: %w", height, err)
+					return
+				}
+
+				mu.Lock()
+				for _, signature := range commit.SignedHeader.Commit.Signatures {
+					if signature.BlockIDFlag == blockIDFlagCommit {
+						signed[signature.ValidatorAddress]++
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+feed:
+	for height := from; height <= to; height++ {
+		select {
+		case heights <- height:
+		case err = <-errs:
+			break feed
+		}
+	}
+	close(heights)
+	wg.Wait()
+
+	if err == nil {
+		select {
+		case err = <-errs:
+		default:
+		}
+	}
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	return from, to, signed, nil
+}
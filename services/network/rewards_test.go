@@ -0,0 +1,86 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistributeRewards(t *testing.T) {
+	validators := []ValidatorRewards{
+		{Signed: 90, Blocks: 100},
+		{Signed: 60, Blocks: 100},
+		{Signed: 100, Blocks: 100},
+		{Signed: 0, Blocks: 100},
+	}
+
+	DistributeRewards(sdktypes.NewCoins(sdktypes.NewInt64Coin("uatom", 1000)), 80, validators)
+
+	require.True(t, validators[0].Eligible)
+	require.False(t, validators[1].Eligible)
+	require.True(t, validators[2].Eligible)
+	require.False(t, validators[3].Eligible)
+
+	require.Equal(t, "473uatom", validators[0].Rewards.String())
+	require.Nil(t, validators[1].Rewards)
+	require.Equal(t, "526uatom", validators[2].Rewards.String())
+}
+
+func TestRewardsStatus(t *testing.T) {
+	gentx := func(key string) json.RawMessage {
+		return json.RawMessage(fmt.Sprintf(`{"body":{"messages":[{"@type":%q,"value":{"denom":"stake","amount":"1000000"},"pubkey":{"key":%q}}]}}`,
+			msgCreateValidator, key))
+	}
+	requests := []Request{
+		{ID: 1, Status: RequestApproved, Validator: Validator{Gentx: gentx("AAAA")}},
+		{ID: 2, Status: RequestApproved, Validator: Validator{Gentx: gentx("BBBB")}},
+	}
+	validatorA, err := parseGentx(requests[0].Validator.Gentx)
+	require.NoError(t, err)
+	validatorB, err := parseGentx(requests[1].Validator.Gentx)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/launches/1":
+			json.NewEncoder(w).Encode(Launch{ID: 1, ChainID: "mars-1", CampaignID: 1})
+		case "/campaigns/1":
+			json.NewEncoder(w).Encode(Campaign{ID: 1, RewardShares: "100uatom", MinUptime: 50})
+		case "/launches/1/requests":
+			json.NewEncoder(w).Encode(requests)
+		case "/status":
+			fmt.Fprint(w, `{"result":{"node_info":{"network":"mars-1"},"sync_info":{"latest_block_height":"20"}}}`)
+		case "/commit":
+			height, _ := strconv.Atoi(r.URL.Query().Get("height"))
+			// validator b signs every other block.
+			flagB := blockIDFlagCommit
+			if height%2 == 0 {
+				flagB = 1
+			}
+			fmt.Fprintf(w, `{"result":{"signed_header":{"commit":{"signatures":[{"block_id_flag":2,"validator_address":%q},{"block_id_flag":%d,"validator_address":%q}]}}}}`,
+				validatorA.ConsensusAddress(), flagB, validatorB.ConsensusAddress())
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	status, err := New(server.URL).RewardsStatus(context.Background(), 1, server.URL, 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), status.FromHeight)
+	require.Equal(t, int64(20), status.ToHeight)
+
+	require.Len(t, status.Validators, 2)
+	require.Equal(t, int64(10), status.Validators[0].Signed)
+	require.Equal(t, int64(5), status.Validators[1].Signed)
+	require.Equal(t, float64(50), status.Validators[1].Uptime())
+	require.Equal(t, "66uatom", status.Validators[0].Rewards.String())
+	require.Equal(t, "33uatom", status.Validators[1].Rewards.String())
+}
@@ -0,0 +1,180 @@
+package network
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// msgCreateValidator is the type of the message of gentxs that creates their validators.
+const msgCreateValidator = "/cosmos.staking.v1beta1.MsgCreateValidator"
+
+// LaunchStatus is the readiness of the approved validators of a launch.
+type LaunchStatus struct {
+	Launch     Launch
+	Validators []ValidatorStatus
+
+	// GenesisHash is the hash of the genesis that's reported by most validators, it's empty
+	// when none of them reported it yet.
+	GenesisHash string
+}
+
+// ValidatorStatus is the readiness of an approved validator of a launch.
+type ValidatorStatus struct {
+	Request Request
+
+	// SelfDelegation is the bonded tokens of the validator in its gentx.
+	SelfDelegation sdktypes.Coin
+
+	// PeerReachable is true when the node of the validator accepts connections.
+	PeerReachable bool
+
+	// GenesisHash is the hash of the genesis reported by the validator, it's empty when it's
+	// not reported yet.
+	GenesisHash string
+}
+
+// Power returns the consensus power of the validator.
+func (v ValidatorStatus) Power() int64 {
+	if v.SelfDelegation.Amount.IsNil() {
+		return 0
+	}
+	return sdktypes.TokensToConsensusPower(v.SelfDelegation.Amount, sdktypes.DefaultPowerReduction)
+}
+
+// IsReady checks if the node of the validator is reachable and it assembled the genesis of the
+// launch.
+func (v ValidatorStatus) IsReady(genesisHash string) bool {
+	return v.PeerReachable && v.GenesisHash != "" && v.GenesisHash == genesisHash
+}
+
+// Power returns the total consensus power of the validators and the power of the ones that
+// are ready, more than 2/3 of the total power must be ready for the chain to produce blocks.
+func (s LaunchStatus) Power() (total, ready int64) {
+	for _, validator := range s.Validators {
+		total += validator.Power()
+		if validator.IsReady(s.GenesisHash) {
+			ready += validator.Power()
+		}
+	}
+	return total, ready
+}
+
+// IsReady checks if more than 2/3 of the power of the validators is ready.
+func (s LaunchStatus) IsReady() bool {
+	total, ready := s.Power()
+	return total > 0 && ready*3 > total*2
+}
+
+// LaunchStatus returns the readiness of the approved validators of the launch with id, their
+// nodes are checked to be reachable.
+func (c Client) LaunchStatus(ctx context.Context, launchID uint64) (LaunchStatus, error) {
+	launch, err := c.Launch(ctx, launchID)
+	if err != nil {
+		return LaunchStatus{}, err
+	}
+
+	requests, err := c.Requests(ctx, launchID)
+	if err != nil {
+		return LaunchStatus{}, err
+	}
+
+	reports, err := c.GenesisReports(ctx, launchID)
+	if err != nil {
+		return LaunchStatus{}, err
+	}
+
+	hashes := make(map[string]string)
+	for _, report := range reports {
+		hashes[report.Participant] = report.Hash
+	}
+
+	status := LaunchStatus{
+		Launch:      launch,
+		GenesisHash: majorityHash(reports),
+	}
+
+	approved := ApprovedRequests(requests)
+	status.Validators = make([]ValidatorStatus, len(approved))
+
+	var wg sync.WaitGroup
+	for i, request := range approved {
+		validator, _ := parseGentx(request.Validator.Gentx)
+
+		status.Validators[i] = ValidatorStatus{
+			Request:        request,
+			SelfDelegation: validator.Value,
+			GenesisHash:    hashes[request.Creator],
+		}
+
+		wg.Add(1)
+		go func(v *ValidatorStatus) {
+			defer wg.Done()
+
+			peer := v.Request.Validator.Peer
+			if i := strings.Index(peer, "@"); i >= 0 {
+				v.PeerReachable = isReachable(ctx, peer[i+1:])
+			}
+		}(&status.Validators[i])
+	}
+	wg.Wait()
+
+	return status, nil
+}
+
+// majorityHash returns the hash that's reported by most participants.
+func majorityHash(reports []GenesisReport) string {
+	var (
+		counts = make(map[string]int)
+		hash   string
+	)
+	for _, report := range reports {
+		counts[report.Hash]++
+		if counts[report.Hash] > counts[hash] {
+			hash = report.Hash
+		}
+	}
+	return hash
+}
+
+// gentxValidator is the validator that's created by a gentx.
+type gentxValidator struct {
+	Value  sdktypes.Coin `json:"value"`
+	Pubkey struct {
+		Key []byte `json:"key"`
+	} `json:"pubkey"`
+}
+
+// ConsensusAddress returns the address of the consensus key of the validator in hex, blocks
+// are signed with this address.
+func (v gentxValidator) ConsensusAddress() string {
+	return strings.ToUpper(hex.EncodeToString(tmhash.SumTruncated(v.Pubkey.Key)))
+}
+
+// parseGentx returns the validator that's created by gentx.
+func parseGentx(gentx json.RawMessage) (gentxValidator, error) {
+	var tx struct {
+		Body struct {
+			Messages []struct {
+				Type string `json:"@type"`
+				gentxValidator
+			} `json:"messages"`
+		} `json:"body"`
+	}
+	if err := json.Unmarshal(gentx, &tx); err != nil {
+		return gentxValidator{}, err
+	}
+
+	for _, msg := range tx.Body.Messages {
+		if msg.Type == msgCreateValidator {
+			return msg.gentxValidator, nil
+		}
+	}
+	return gentxValidator{}, errors.New("gentx doesn't create a validator")
+}
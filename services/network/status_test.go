@@ -0,0 +1,70 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func gentx(amount string) json.RawMessage {
+	return json.RawMessage(fmt.Sprintf(`{"body":{"messages":[{"@type":%q,"value":{"denom":"stake","amount":%q}}]}}`,
+		msgCreateValidator, amount))
+}
+
+func TestLaunchStatus(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	requests := []Request{
+		{ID: 1, Creator: "cosmos1a", Status: RequestApproved, Validator: Validator{Gentx: gentx("50000000"), Peer: "a@" + l.Addr().String()}},
+		{ID: 2, Creator: "cosmos1b", Status: RequestApproved, Validator: Validator{Gentx: gentx("30000000"), Peer: "b@" + l.Addr().String()}},
+		{ID: 3, Creator: "cosmos1c", Status: RequestApproved, Validator: Validator{Gentx: gentx("20000000"), Peer: "c@127.0.0.1:1"}},
+		{ID: 4, Creator: "cosmos1d", Status: RequestRejected},
+	}
+	reports := []GenesisReport{
+		{Participant: "cosmos1a", Hash: "aaa"},
+		{Participant: "cosmos1b", Hash: "bbb"},
+		{Participant: "cosmos1c", Hash: "aaa"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/launches/1":
+			json.NewEncoder(w).Encode(Launch{ID: 1, ChainID: "mars-1"})
+		case "/launches/1/requests":
+			json.NewEncoder(w).Encode(requests)
+		case "/launches/1/genesis":
+			json.NewEncoder(w).Encode(reports)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	status, err := New(server.URL).LaunchStatus(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, "aaa", status.GenesisHash)
+	require.Len(t, status.Validators, 3)
+
+	require.True(t, status.Validators[0].IsReady(status.GenesisHash))
+	require.False(t, status.Validators[1].IsReady(status.GenesisHash), "genesis mismatches")
+	require.False(t, status.Validators[2].IsReady(status.GenesisHash), "peer is unreachable")
+	require.Equal(t, int64(30), status.Validators[1].Power())
+
+	total, ready := status.Power()
+	require.Equal(t, int64(100), total)
+	require.Equal(t, int64(50), ready)
+	require.False(t, status.IsReady())
+
+	reports[1].Hash = "aaa"
+	status, err = New(server.URL).LaunchStatus(context.Background(), 1)
+	require.NoError(t, err)
+	require.True(t, status.IsReady())
+}
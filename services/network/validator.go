@@ -0,0 +1,156 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/tendermint/starport/starport/pkg/confile"
+	"github.com/trino-network/trino/pkg/cosmosaccount"
+	"github.com/trino-network/trino/services/chain"
+)
+
+// tmkmsProtocolVersion is the version of the protocol of remote signers that Tendermint
+// v0.34 nodes speak.
+const tmkmsProtocolVersion = "v0.34"
+
+// InitValidator initializes the node of the validator and generates its gentx that's signed
+// by the account with the validator's name in registry, the account is added to the genesis
+// with coins. it returns the validator to join the launch with, whose node is reachable at
+// peerAddress in the <host>:<port> format.
+func (c *Chain) InitValidator(
+	ctx context.Context,
+	registry cosmosaccount.Registry,
+	validator chain.Validator,
+	coins,
+	peerAddress string,
+) (Validator, error) {
+	selfDelegation, err := sdktypes.ParseCoinNormalized(validator.StakingAmount)
+	if err != nil {
+		return Validator{}, fmt.Errorf("invalid self-delegation: %w", err)
+	}
+
+	if err := c.Init(ctx); err != nil {
+		return Validator{}, err
+	}
+
+	bondDenom, err := c.BondDenom()
+	if err != nil {
+		return Validator{}, err
+	}
+	if selfDelegation.Denom != bondDenom {
+		return Validator{}, fmt.Errorf("self-delegation must be in %s, the bond denom of %s", bondDenom, c.launch.ChainID)
+	}
+
+	if err := c.ImportAccount(registry, validator.Name); err != nil {
+		return Validator{}, err
+	}
+
+	address, gentx, err := c.Gentx(ctx, validator, coins)
+	if err != nil {
+		return Validator{}, err
+	}
+
+	nodeID, err := c.NodeID(ctx)
+	if err != nil {
+		return Validator{}, err
+	}
+
+	return Validator{
+		Address: address,
+		Coins:   coins,
+		Gentx:   gentx,
+		Peer:    fmt.Sprintf("%s@%s", nodeID, peerAddress),
+	}, nil
+}
+
+// BondDenom returns the denom of the tokens that are staked with validators in the genesis.
+func (c *Chain) BondDenom() (string, error) {
+	home, err := c.Home()
+	if err != nil {
+		return "", err
+	}
+
+	var genesis struct {
+		AppState struct {
+			Staking struct {
+				Params struct {
+					BondDenom string `json:"bond_denom"`
+				} `json:"params"`
+			} `json:"staking"`
+		} `json:"app_state"`
+	}
+	cf := confile.New(confile.DefaultJSONEncodingCreator, filepath.Join(home, "config/genesis.json"))
+	if err := cf.Load(&genesis); err != nil {
+		return "", err
+	}
+
+	if genesis.AppState.Staking.Params.BondDenom == "" {
+		return "", fmt.Errorf("bond denom of %s is not in its genesis", c.launch.ChainID)
+	}
+	return genesis.AppState.Staking.Params.BondDenom, nil
+}
+
+// ConfigureRemoteSigner configures the node to listen for a remote signer such as tmkms at
+// laddr, such as tcp://0.0.0.0:26659, blocks are signed by the remote signer instead of the
+// consensus key of the node.
+func (c *Chain) ConfigureRemoteSigner(laddr string) error {
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+
+	cf := confile.New(confile.DefaultTOMLEncodingCreator, filepath.Join(home, "config/config.toml"))
+
+	var config map[string]interface{}
+	if err := cf.Load(&config); err != nil {
+		return err
+	}
+	config["priv_validator_laddr"] = laddr
+
+	return cf.Save(config)
+}
+
+// TMKMSConfig returns the tmkms.toml of a tmkms in kmsHome that signs the blocks of the
+// validator whose account is validatorAddress, it connects to the node at nodeAddress such as
+// tcp://10.0.0.1:26659. the key prefixes of the chain are derived from the bech32 prefix of the
+// account address.
+// the consensus key of the node is imported to the softsign provider of tmkms with:
+//
+//	tmkms softsign import <node-home>/config/priv_validator_key.json <kms-home>/secrets/<chain-id>-consensus.key
+func (c *Chain) TMKMSConfig(nodeAddress, kmsHome, validatorAddress string) (string, error) {
+	prefix, _, err := bech32.DecodeAndConvert(validatorAddress)
+	if err != nil {
+		return "", fmt.Errorf("invalid address of the validator %s: %w", validatorAddress, err)
+	}
+
+	chainID := c.launch.ChainID
+	return fmt.Sprintf(`[[chain]]
+id = %[1]q
+key_format = { type = "bech32", account_key_prefix = %[7]q, consensus_key_prefix = %[8]q }
+state_file = %[3]q
+
+[[validator]]
+chain_id = %[1]q
+addr = %[2]q
+secret_key = %[4]q
+protocol_version = %[6]q
+reconnect = true
+
+[[providers.softsign]]
+chain_ids = [%[1]q]
+key_type = "consensus"
+path = %[5]q
+`,
+		chainID,
+		nodeAddress,
+		filepath.Join(kmsHome, "state", chainID+"-consensus.json"),
+		filepath.Join(kmsHome, "secrets", "kms-identity.key"),
+		filepath.Join(kmsHome, "secrets", chainID+"-consensus.key"),
+		tmkmsProtocolVersion,
+		prefix+"pub",
+		prefix+"valconspub",
+	), nil
+}
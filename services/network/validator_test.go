@@ -0,0 +1,38 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/pelletier/go-toml"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTMKMSConfig(t *testing.T) {
+	c := &Chain{launch: Launch{ChainID: "mars-1"}}
+
+	address, err := bech32.ConvertAndEncode("mars", make([]byte, 20))
+	require.NoError(t, err)
+	tmkmsConfig, err := c.TMKMSConfig("tcp://10.0.0.1:26659", "/kms", address)
+	require.NoError(t, err)
+	config, err := toml.Load(tmkmsConfig)
+	require.NoError(t, err)
+
+	require.Equal(t, "mars-1", config.GetPath([]string{"chain"}).([]*toml.Tree)[0].Get("id"))
+	require.Equal(t, "/kms/state/mars-1-consensus.json", config.GetPath([]string{"chain"}).([]*toml.Tree)[0].Get("state_file"))
+	keyFormat := config.GetPath([]string{"chain"}).([]*toml.Tree)[0].Get("key_format").(*toml.Tree)
+	require.Equal(t, "marspub", keyFormat.Get("account_key_prefix"))
+	require.Equal(t, "marsvalconspub", keyFormat.Get("consensus_key_prefix"))
+
+	validator := config.Get("validator").([]*toml.Tree)[0]
+	require.Equal(t, "tcp://10.0.0.1:26659", validator.Get("addr"))
+	require.Equal(t, "/kms/secrets/kms-identity.key", validator.Get("secret_key"))
+	require.Equal(t, "v0.34", validator.Get("protocol_version"))
+
+	softsign := config.GetPath([]string{"providers", "softsign"}).([]*toml.Tree)[0]
+	require.Equal(t, "/kms/secrets/mars-1-consensus.key", softsign.Get("path"))
+	require.Equal(t, "consensus", softsign.Get("key_type"))
+
+	_, err = c.TMKMSConfig("tcp://10.0.0.1:26659", "/kms", "mars")
+	require.Error(t, err)
+}